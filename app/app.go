@@ -37,6 +37,7 @@ import (
 	sgeappparams "github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/app/upgrades"
 	v1 "github.com/sge-network/sge/app/upgrades/v1"
+	v2 "github.com/sge-network/sge/app/upgrades/v2"
 	"github.com/spf13/cast"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
@@ -66,7 +67,7 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
-	Upgrades        = []upgrades.Upgrade{v1.Upgrade}
+	Upgrades        = []upgrades.Upgrade{v1.Upgrade, v2.Upgrade}
 )
 
 var (
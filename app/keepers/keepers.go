@@ -409,6 +409,8 @@ func NewAppKeeper(
 	)
 	appKeepers.MarketKeeper.SetOVMKeeper(appKeepers.OVMKeeper)
 	appKeepers.MarketKeeper.SetOrderbookKeeper(appKeepers.OrderbookKeeper)
+	appKeepers.MarketKeeper.SetStakingKeeper(appKeepers.StakingKeeper)
+	appKeepers.MarketKeeper.SetBankKeeper(appKeepers.BankKeeper)
 
 	appKeepers.BetKeeper = betmodulekeeper.NewKeeper(
 		appCodec,
@@ -428,6 +430,7 @@ func NewAppKeeper(
 		appCodec,
 		appKeepers.keys[housemoduletypes.StoreKey],
 		appKeepers.OrderbookKeeper,
+		appKeepers.MarketKeeper,
 		appKeepers.OVMKeeper,
 		appKeepers.GetSubspace(housemoduletypes.ModuleName),
 		housemodulekeeper.SdkExpectedKeepers{
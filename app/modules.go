@@ -82,8 +82,11 @@ var mAccPerms = map[string][]string{
 
 	// sge
 	betmoduletypes.BetFeeCollectorFunder{}.GetModuleAcc():          nil,
+	betmoduletypes.BetPromoPoolFunder{}.GetModuleAcc():             nil,
+	betmoduletypes.BetDelayEscrowFunder{}.GetModuleAcc():           nil,
 	housemoduletypes.HouseFeeCollectorFunder{}.GetModuleAcc():      nil,
 	orderbookmoduletypes.OrderBookLiquidityFunder{}.GetModuleAcc(): nil,
+	marketmoduletypes.MarketDisputeBondFunder{}.GetModuleAcc():     {authtypes.Burner},
 }
 
 // ModuleBasics defines the module BasicManager is in charge of setting up basic,
@@ -0,0 +1,25 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/sge-network/sge/app/keepers"
+)
+
+// CreateUpgradeHandler runs the store migrations of the modules, the
+// params and denom fields that are added in this version are set there.
+// The market dispute period is set to its default of one hour, so the
+// resolutions declared after the upgrade are held for an hour before
+// their settlement starts, the governance can set it to zero to keep
+// the immediate settlement.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	_ *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
@@ -7,8 +7,17 @@ import (
 	"github.com/sge-network/sge/x/bet/keeper"
 )
 
-// EndBlocker settles the active bets of resolved markets
+// EndBlocker processes the delayed in-play bets, releases the reserve of the expired free bet
+// credits and settles the active bets of resolved markets
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if err := k.ProcessDelayedBets(ctx); err != nil {
+		panic(fmt.Sprintf("end block no %d failed : %s", ctx.BlockHeight(), err.Error()))
+	}
+
+	if err := k.ProcessExpiredFreeBetCredits(ctx); err != nil {
+		panic(fmt.Sprintf("end block no %d failed : %s", ctx.BlockHeight(), err.Error()))
+	}
+
 	err := k.BatchMarketSettlements(ctx)
 	if err != nil {
 		panic(fmt.Sprintf("end block no %d failed : %s", ctx.BlockHeight(), err.Error()))
@@ -31,6 +31,15 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListPendingBets(),
 		CmdListBetByUIDs(),
 		CmdShowBet(),
+		CmdShowBettorFeeTier(),
+		CmdShowBettorLimits(),
+		CmdCalcPayout(),
+		CmdListFreeBetCredits(),
+		CmdShowFreeBetCredit(),
+		CmdListAffiliates(),
+		CmdShowAffiliate(),
+		CmdShowReferral(),
+		CmdShowBettorCaps(),
 	)
 
 	return cmd
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdListAffiliates implements a command to return all affiliates
+func CmdListAffiliates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "affiliates",
+		Short: "get list of affiliates",
+		Long:  "Get list of affiliates and their bet fee shares in paginated response.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAffiliatesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Affiliates(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdShowAffiliate implements a command to return an affiliate and its accrued earnings
+func CmdShowAffiliate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "affiliate [address]",
+		Short: "shows an affiliate and its earnings",
+		Long:  "Get an affiliate and its accrued bet fee share earnings by address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAffiliateRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.Affiliate(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdShowReferral implements a command to return the referral binding of a bettor
+func CmdShowReferral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "referral [bettor]",
+		Short: "shows the referrer of a bettor",
+		Long:  "Get the referral binding of a bettor by bettor address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryReferralRequest{
+				Bettor: args[0],
+			}
+
+			res, err := queryClient.Referral(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
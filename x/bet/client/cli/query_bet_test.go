@@ -66,6 +66,7 @@ func networkWithBetObjects(t *testing.T, n int) (*network.Network, []types.Bet)
 			Amount:            sdk.NewInt(10),
 			Fee:               sdk.NewInt(1),
 			MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+			DeadHeatFactor:    sdk.ZeroDec(),
 		}
 		nullify.Fill(&bet)
 
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdShowBettorCaps implements a command to return the remaining amounts to the caps of a bettor on a market
+func CmdShowBettorCaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bettor-caps [address] [market_uid] [odds_uid]",
+		Short: "remaining stake and payout caps of a bettor on a market",
+		Long:  "Get the total stake and potential payout and the remaining amounts to the caps of a bettor on a market, the odds caps are returned if the odds uid is provided.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBettorCapsRequest{
+				Address:   args[0],
+				MarketUid: args[1],
+			}
+			if len(args) > 2 {
+				params.OddsUid = args[2]
+			}
+
+			res, err := queryClient.BettorCaps(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
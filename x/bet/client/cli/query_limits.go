@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdShowBettorLimits implements a command to return the self-exclusion and the limits of a bettor
+func CmdShowBettorLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limits [address]",
+		Short: "responsible gambling limits of a bettor",
+		Long:  "Get the self-exclusion and the stake and net loss limits of a bettor by bettor address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBettorLimitsRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.BettorLimits(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+// CmdCalcPayout implements a command to calculate the payout of a bet amount
+// and convert the odds value to all of the supported odds types
+func CmdCalcPayout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "calc-payout [odds-type] [odds-value] [amount]",
+		Short: "calculate the payout of a bet amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Calculate the payout and the profit of a bet amount by the odds value the same as the blockchain
+and convert the odds value to all of the supported odds types.
+the odds type is 1 for decimal, 2 for fractional, 3 for moneyline, 4 for hong kong, 5 for indonesian and 6 for malay odds.
+
+Example:
+$ %s query bet calc-payout %d %s %d
+`,
+				version.AppName, 2, "5/2", 1000000,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			oddsType, err := cast.ToInt32E(args[0])
+			if err != nil {
+				return fmt.Errorf("odds type argument provided must be an integer: %v", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return types.ErrInvalidAmount
+			}
+
+			params := &types.QueryCalcPayoutRequest{
+				OddsType:  types.OddsType(oddsType),
+				OddsValue: args[1],
+				Amount:    amount,
+			}
+
+			res, err := queryClient.CalcPayout(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
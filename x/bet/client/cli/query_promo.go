@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdListFreeBetCredits implements a command to return all free bet credits of a bettor
+func CmdListFreeBetCredits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "free-bet-credits [address]",
+		Short: "get list of free bet credits of a bettor",
+		Long:  "Get list of free bet credits of a bettor address in paginated response.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFreeBetCreditsRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FreeBetCredits(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdShowFreeBetCredit implements a command to return a free bet credit of a bettor
+func CmdShowFreeBetCredit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "free-bet-credit [address] [uid]",
+		Short: "shows a free bet credit",
+		Long:  "Get a free bet credit by bettor address and uid.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFreeBetCreditRequest{
+				Address: args[0],
+				Uid:     args[1],
+			}
+
+			res, err := queryClient.FreeBetCredit(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
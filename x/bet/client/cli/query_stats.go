@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdShowBettorFeeTier implements a command to return the fee tier in effect of a bettor
+func CmdShowBettorFeeTier() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-tier [address] [denom]",
+		Short: "fee tier in effect of a bettor",
+		Long:  "Get the wagered volume and the fee tier in effect of a bettor by bettor address and denom.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBettorFeeTierRequest{
+				Address: args[0],
+				Denom:   args[1],
+			}
+
+			res, err := queryClient.BettorFeeTier(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -12,6 +12,7 @@ import (
 
 const (
 	listSeparator = ","
+	mapSeparator  = ":"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -25,6 +26,18 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdWager())
+	cmd.AddCommand(CmdWagerBatch())
+	cmd.AddCommand(CmdCancelBet())
+	cmd.AddCommand(CmdCashOut())
+	cmd.AddCommand(CmdVoidBets())
+	cmd.AddCommand(CmdSettleBets())
+	cmd.AddCommand(CmdSetSelfExclusion())
+	cmd.AddCommand(CmdSetBettorLimit())
+	cmd.AddCommand(CmdFundPromoPool())
+	cmd.AddCommand(CmdIssueFreeBet())
+	cmd.AddCommand(CmdSetAffiliate())
+	cmd.AddCommand(CmdSetReferrer())
+	cmd.AddCommand(CmdBindReferrer())
 
 	return cmd
 }
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdSetAffiliate implements a command to register an affiliate or update its bet fee share
+func CmdSetAffiliate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-affiliate [ticket]",
+		Short: "Register an affiliate",
+		Long:  "Register an affiliate or update its bet fee share. the ticket containing the affiliate address and fee share is required.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAffiliate(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSetReferrer implements a command to set the referrer of the bettor
+func CmdSetReferrer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-referrer [referrer]",
+		Short: "Set the referrer of the bettor",
+		Long:  "Set the referrer of the bettor. the referrer should be a registered affiliate and can be set only once.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetReferrer(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdBindReferrer implements a command to bind a bettor to a referrer by ticket
+func CmdBindReferrer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bind-referrer [ticket]",
+		Short: "Bind a bettor to a referrer",
+		Long:  "Bind a bettor to a referrer. the ticket containing the bettor and referrer addresses is required.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBindReferrer(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,6 +9,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagMinFillRatio = "min-fill-ratio"
+	flagMinOddsType  = "min-odds-type"
+	flagMinOdds      = "min-odds"
+	flagFreeBet      = "free-bet-credit"
+)
+
 // CmdWager implements a command to place and store a single bet
 func CmdWager() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,6 +34,36 @@ func CmdWager() *cobra.Command {
 				return types.ErrInvalidAmount
 			}
 
+			var minFillRatio sdk.Dec
+			argMinFillRatio, err := cmd.Flags().GetString(flagMinFillRatio)
+			if err != nil {
+				return err
+			}
+			if argMinFillRatio != "" {
+				minFillRatio, err = sdk.NewDecFromStr(argMinFillRatio)
+				if err != nil {
+					return types.ErrInvalidMinFillRatio
+				}
+			}
+
+			var minOddsType types.OddsType
+			minOdds, err := cmd.Flags().GetString(flagMinOdds)
+			if err != nil {
+				return err
+			}
+			if minOdds != "" {
+				argMinOddsType, err := cmd.Flags().GetInt32(flagMinOddsType)
+				if err != nil {
+					return err
+				}
+				minOddsType = types.OddsType(argMinOddsType)
+			}
+
+			freeBetCreditUID, err := cmd.Flags().GetString(flagFreeBet)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -35,9 +72,13 @@ func CmdWager() *cobra.Command {
 			msg := types.NewMsgWager(
 				clientCtx.GetFromAddress().String(),
 				types.WagerProps{
-					UID:    uid,
-					Amount: argAmountCosmosInt,
-					Ticket: argTicket,
+					UID:              uid,
+					Amount:           argAmountCosmosInt,
+					Ticket:           argTicket,
+					MinFillRatio:     minFillRatio,
+					MinOddsType:      minOddsType,
+					MinOddsValue:     minOdds,
+					FreeBetCreditUID: freeBetCreditUID,
 				},
 			)
 			if err := msg.ValidateBasic(); err != nil {
@@ -47,6 +88,106 @@ func CmdWager() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagMinFillRatio, "", "minimum ratio of the amount to be fulfilled, enables partial fulfillment of the bet")
+	cmd.Flags().Int32(flagMinOddsType, int32(types.OddsType_ODDS_TYPE_DECIMAL), "odds type of the minimum acceptable odds, 1: decimal, 2: fractional, 3: moneyline, 4: hong kong, 5: indonesian, 6: malay")
+	cmd.Flags().String(flagMinOdds, "", "minimum acceptable odds value, the wager is rejected if the odds of the ticket is lower")
+	cmd.Flags().String(flagFreeBet, "", "uid of the free bet credit to be used as the stake of the bet")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCancelBet implements a command to cancel a placed bet before the market start
+func CmdCancelBet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [ticket]",
+		Short: "Cancel a placed bet",
+		Long:  "Cancel a placed bet before the start of the market. the ticket containing bet uid is required.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Get value arguments
+			argTicket := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelBet(
+				clientCtx.GetFromAddress().String(),
+				argTicket,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCashOut implements a command to cash out a placed bet before the market resolution
+func CmdCashOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cashout [ticket]",
+		Short: "Cash out a placed bet",
+		Long:  "Cash out a placed bet before the resolution of the market. the ticket containing bet uid and the quoted cash-out amount is required.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Get value arguments
+			argTicket := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCashOut(
+				clientCtx.GetFromAddress().String(),
+				argTicket,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdVoidBets implements a command to void the bets accepted at an erroneous price
+func CmdVoidBets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "void [ticket]",
+		Short: "Void placed bets",
+		Long:  "Void and refund the placed bets accepted at an erroneous price. the ticket containing the bet uids, bettor addresses and the void reason is required.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Get value arguments
+			argTicket := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoidBets(
+				clientCtx.GetFromAddress().String(),
+				argTicket,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
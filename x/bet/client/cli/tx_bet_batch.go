@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdWagerBatch implements a command to place many bets in a single message
+func CmdWagerBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wager-batch [mode] [bets-file]",
+		Short: "Wager on many odds",
+		Long: "Wager on many odds in a single message. the mode is atomic or best-effort and the bets file is a json list " +
+			"of the wager props such as [{\"uid\":\"...\",\"amount\":\"1000000\",\"ticket\":\"...\"}].",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			mode, ok := types.WagerBatchMode_value["WAGER_BATCH_MODE_"+enumArg(args[0])]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidWagerBatch, "mode %s", args[0])
+			}
+
+			bets, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWagerBatch{}
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(fmt.Sprintf(`{"props":%s}`, bets)), msg); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidWagerBatch, "%s", err)
+			}
+
+			msg = types.NewMsgWagerBatch(
+				clientCtx.GetFromAddress().String(),
+				msg.Props,
+				types.WagerBatchMode(mode),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
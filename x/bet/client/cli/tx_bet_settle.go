@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdSettleBets implements a command to settle the bets of the resolved markets
+func CmdSettleBets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle [creator:uid]",
+		Short: "Settle bets of the resolved markets",
+		Long: "Settle the bets of the resolved markets without waiting for the batch settlement. " +
+			"the bets are a creator:UID comma separated list ex: \"address1:uid1,address2:uid2\" .",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqItems := strings.Split(args[0], listSeparator)
+
+			bets := make([]types.SettleBetItem, 0, len(reqItems))
+			for _, item := range reqItems {
+				creator, uid, ok := strings.Cut(item, mapSeparator)
+				if !ok {
+					return sdkerrors.Wrapf(types.ErrInvalidBetUID, "%s", item)
+				}
+				bets = append(bets, types.SettleBetItem{UID: uid, Creator: creator})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSettleBets(
+				clientCtx.GetFromAddress().String(),
+				bets,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
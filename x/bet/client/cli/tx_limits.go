@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+// CmdSetSelfExclusion implements a command to exclude the bettor from wagering until a certain time
+func CmdSetSelfExclusion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "self-exclude [until]",
+		Short: "Exclude the bettor from wagering",
+		Long:  "Exclude the bettor from wagering until the given unix timestamp. the self-exclusion can not be shortened before it ends.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argUntil, err := cast.ToInt64E(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidSelfExclusion, "%s", err)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSelfExclusion(
+				clientCtx.GetFromAddress().String(),
+				argUntil,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSetBettorLimit implements a command to set a stake or net loss limit of the bettor
+func CmdSetBettorLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-limit [limit-type] [period] [denom] [amount]",
+		Short: "Set a stake or net loss limit",
+		Long: "Set a stake or net loss limit of the bettor for a rolling period. the limit type is stake or net-loss, " +
+			"the period is daily, weekly or monthly and zero amount removes the limit. " +
+			"tightened limits take effect immediately and loosened limits take effect after the cooling-off period.",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			limitType, ok := types.LimitType_value["LIMIT_TYPE_"+enumArg(args[0])]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidBettorLimit, "limit type %s", args[0])
+			}
+
+			period, ok := types.LimitPeriod_value["LIMIT_PERIOD_"+enumArg(args[1])]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidBettorLimit, "limit period %s", args[1])
+			}
+
+			argAmount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return types.ErrInvalidAmount
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBettorLimit(
+				clientCtx.GetFromAddress().String(),
+				types.LimitType(limitType),
+				types.LimitPeriod(period),
+				args[2],
+				argAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// enumArg converts a command argument such as net-loss to the format of the enum names.
+func enumArg(arg string) string {
+	return strings.ToUpper(strings.ReplaceAll(arg, "-", "_"))
+}
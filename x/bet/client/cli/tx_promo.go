@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdFundPromoPool implements a command to fund the promo pool
+func CmdFundPromoPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-promo-pool [ticket]",
+		Short: "Fund the promo pool",
+		Long:  "Fund the promo pool that pays the stake of the free bets. the ticket containing the denom and amount is required.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundPromoPool(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdIssueFreeBet implements a command to issue a free bet credit to a bettor
+func CmdIssueFreeBet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue-free-bet [ticket]",
+		Short: "Issue a free bet credit",
+		Long:  "Issue a free bet credit to a bettor. the ticket containing the credit uid, bettor address, denom, amount and expiry is required.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgIssueFreeBet(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
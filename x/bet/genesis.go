@@ -24,7 +24,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		for i := range genState.PendingBetList {
 			active := genState.PendingBetList[i]
 			if genState.PendingBetList[i].UID == bet.UID {
-				k.SetPendingBet(ctx, &active, id, bet.MarketUID)
+				k.SetPendingBet(ctx, &active, id, pendingBetMarketUID(active, bet))
+			}
+		}
+
+		// Set all the waiting parlay bet
+		for i := range genState.ParlayWaitingBetList {
+			waiting := genState.ParlayWaitingBetList[i]
+			if waiting.UID == bet.UID {
+				k.SetParlayWaitingBet(ctx, &waiting, id, pendingBetMarketUID(waiting, bet))
+			}
+		}
+
+		// Set all the delayed bet
+		for _, delayed := range genState.DelayedBetList {
+			if delayed.UID == bet.UID {
+				k.SetDelayedBet(ctx, delayed, id)
 			}
 		}
 
@@ -39,9 +54,63 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetBet(ctx, bet, id)
 	}
 
+	for _, marketUID := range genState.DeferredBookSettlementList {
+		k.SetDeferredBookSettlement(ctx, marketUID)
+	}
+
+	for _, bettorStats := range genState.BettorStatsList {
+		k.SetBettorStats(ctx, bettorStats)
+	}
+
+	for _, bettorLimits := range genState.BettorLimitsList {
+		k.SetBettorLimits(ctx, bettorLimits)
+	}
+
+	for _, bettorDailyTotals := range genState.BettorDailyTotalsList {
+		k.SetBettorDailyTotals(ctx, bettorDailyTotals)
+	}
+
+	for _, credit := range genState.FreeBetCreditList {
+		k.SetFreeBetCredit(ctx, credit)
+		k.SetFreeBetCreditExpiry(ctx, credit)
+
+		// the balance of the promo pool is reserved for the unused credits,
+		// the reserve of the expired credits is released by the end-blocker.
+		if !credit.IsUsed() {
+			reserve := k.GetFreeBetCreditReserve(ctx, credit.Denom)
+			reserve.Amount = reserve.Amount.Add(credit.Amount)
+			k.SetFreeBetCreditReserve(ctx, reserve)
+		}
+	}
+
+	for _, affiliate := range genState.AffiliateList {
+		k.SetAffiliate(ctx, affiliate)
+	}
+
+	for _, referral := range genState.ReferralList {
+		k.SetReferral(ctx, referral)
+	}
+
+	for _, earnings := range genState.AffiliateEarningsList {
+		k.SetAffiliateEarnings(ctx, earnings)
+	}
+
+	for _, exposure := range genState.BettorExposureList {
+		k.SetBettorExposure(ctx, exposure)
+	}
+
 	k.SetParams(ctx, genState.Params)
 }
 
+// pendingBetMarketUID returns the market uid of the pending bet record,
+// the records without market uid belong to the market of the bet.
+func pendingBetMarketUID(pendingBet types.PendingBet, bet types.Bet) string {
+	if pendingBet.MarketUID != "" {
+		return pendingBet.MarketUID
+	}
+	return bet.MarketUID
+}
+
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
@@ -58,6 +127,16 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	genesis.ParlayWaitingBetList, err = k.GetParlayWaitingBets(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.DeferredBookSettlementList, err = k.GetDeferredBookSettlements(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	genesis.SettledBetList, err = k.GetSettledBets(ctx)
 	if err != nil {
 		panic(err)
@@ -70,5 +149,50 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.Stats = k.GetBetStats(ctx)
 
+	genesis.BettorStatsList, err = k.GetAllBettorStats(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.BettorLimitsList, err = k.GetAllBettorLimits(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.BettorDailyTotalsList, err = k.GetAllBettorDailyTotals(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.FreeBetCreditList, err = k.GetAllFreeBetCredits(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.AffiliateList, err = k.GetAllAffiliates(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.ReferralList, err = k.GetAllReferrals(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.AffiliateEarningsList, err = k.GetAllAffiliateEarnings(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.BettorExposureList, err = k.GetAllBettorExposures(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.DelayedBetList, err = k.GetDelayedBets(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return genesis
 }
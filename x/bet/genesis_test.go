@@ -3,6 +3,8 @@ package bet_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/testutil/nullify"
 	simappUtil "github.com/sge-network/sge/testutil/simapp"
 	"github.com/sge-network/sge/x/bet"
@@ -52,6 +54,13 @@ func TestGenesis(t *testing.T) {
 		Stats: types.BetStats{
 			Count: 2,
 		},
+		BettorStatsList: []types.BettorStats{
+			{
+				Address: simappUtil.TestParamUsers["user1"].Address.String(),
+				Denom:   params.DefaultBondDenom,
+				Volume:  sdk.NewInt(1000),
+			},
+		},
 	}
 
 	bet.InitGenesis(ctx, *tApp.BetKeeper, genesisState)
@@ -62,4 +71,5 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(got)
 
 	require.ElementsMatch(t, genesisState.BetList, got.BetList)
+	require.ElementsMatch(t, genesisState.BettorStatsList, got.BettorStatsList)
 }
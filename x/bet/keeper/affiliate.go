@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+// SetAffiliate sets an affiliate in the store
+func (k Keeper) SetAffiliate(ctx sdk.Context, affiliate types.Affiliate) {
+	store := k.getAffiliateStore(ctx)
+	b := k.cdc.MustMarshal(&affiliate)
+	store.Set(types.AffiliateKey(affiliate.Address), b)
+}
+
+// GetAffiliate returns an affiliate by its address
+func (k Keeper) GetAffiliate(ctx sdk.Context, address string) (val types.Affiliate, found bool) {
+	store := k.getAffiliateStore(ctx)
+
+	b := store.Get(types.AffiliateKey(address))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllAffiliates returns all of the affiliates
+func (k Keeper) GetAllAffiliates(ctx sdk.Context) (list []types.Affiliate, err error) {
+	store := k.getAffiliateStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Affiliate
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetReferral sets the referral binding of a bettor in the store
+func (k Keeper) SetReferral(ctx sdk.Context, referral types.Referral) {
+	store := k.getReferralStore(ctx)
+	b := k.cdc.MustMarshal(&referral)
+	store.Set(types.ReferralKey(referral.Bettor), b)
+}
+
+// GetReferral returns the referral binding of a bettor
+func (k Keeper) GetReferral(ctx sdk.Context, bettor string) (val types.Referral, found bool) {
+	store := k.getReferralStore(ctx)
+
+	b := store.Get(types.ReferralKey(bettor))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllReferrals returns the referral bindings of all of the bettors
+func (k Keeper) GetAllReferrals(ctx sdk.Context) (list []types.Referral, err error) {
+	store := k.getReferralStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Referral
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetAffiliateEarnings sets the earnings of an affiliate in a certain denom in the store
+func (k Keeper) SetAffiliateEarnings(ctx sdk.Context, earnings types.AffiliateEarnings) {
+	store := k.getAffiliateEarningsStore(ctx)
+	b := k.cdc.MustMarshal(&earnings)
+	store.Set(types.AffiliateEarningsKey(earnings.Address, earnings.Denom), b)
+}
+
+// GetAffiliateEarnings returns the earnings of an affiliate in a certain denom
+func (k Keeper) GetAffiliateEarnings(ctx sdk.Context, address, denom string) (val types.AffiliateEarnings, found bool) {
+	store := k.getAffiliateEarningsStore(ctx)
+
+	b := store.Get(types.AffiliateEarningsKey(address, denom))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAffiliateEarningsOfAddress returns the earnings of an affiliate in all denoms
+func (k Keeper) GetAffiliateEarningsOfAddress(ctx sdk.Context, address string) (list []types.AffiliateEarnings, err error) {
+	store := k.getAffiliateEarningsByAddressStore(ctx, address)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AffiliateEarnings
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllAffiliateEarnings returns the earnings of all of the affiliates
+func (k Keeper) GetAllAffiliateEarnings(ctx sdk.Context) (list []types.AffiliateEarnings, err error) {
+	store := k.getAffiliateEarningsStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AffiliateEarnings
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// addAffiliateEarnings adds the paid bet fee share to the earnings of the affiliate.
+func (k Keeper) addAffiliateEarnings(ctx sdk.Context, address, denom string, amount sdkmath.Int) {
+	earnings, found := k.GetAffiliateEarnings(ctx, address, denom)
+	if !found {
+		earnings = types.AffiliateEarnings{
+			Address: address,
+			Denom:   denom,
+			Amount:  sdk.ZeroInt(),
+		}
+	}
+
+	earnings.Amount = earnings.Amount.Add(amount)
+	k.SetAffiliateEarnings(ctx, earnings)
+}
+
+// SetReferrer binds the bettor to the referrer, the referrer should be a registered
+// affiliate and the referrer of a bettor can be set only once.
+func (k Keeper) SetReferrer(ctx sdk.Context, bettor, referrer string) (types.Referral, error) {
+	if _, found := k.GetAffiliate(ctx, referrer); !found {
+		return types.Referral{}, sdkerrors.Wrapf(types.ErrAffiliateNotFound, "%s", referrer)
+	}
+
+	if referral, found := k.GetReferral(ctx, bettor); found {
+		return types.Referral{}, sdkerrors.Wrapf(types.ErrReferrerAlreadySet, "%s", referral.Referrer)
+	}
+
+	referral := types.NewReferral(bettor, referrer, ctx.BlockTime().Unix())
+	k.SetReferral(ctx, referral)
+
+	return referral, nil
+}
+
+// withdrawBetFee pays the share of the referrer of the bettor from the bet fee
+// and the rest of the bet fee to the market creator.
+func (k Keeper) withdrawBetFee(ctx sdk.Context, bet *types.Bet, marketCreator sdk.AccAddress) error {
+	creatorFee := bet.Fee
+
+	if referral, found := k.GetReferral(ctx, bet.Creator); found {
+		affiliate, found := k.GetAffiliate(ctx, referral.Referrer)
+		if found {
+			affiliateFee := affiliate.CalculateFeeShare(bet.Fee)
+			if affiliateFee.IsPositive() {
+				referrerAddress, err := sdk.AccAddressFromBech32(referral.Referrer)
+				if err != nil {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+				}
+
+				if err := k.orderbookKeeper.WithdrawBetFee(ctx, referrerAddress, affiliateFee, bet.Denom); err != nil {
+					return err
+				}
+
+				k.addAffiliateEarnings(ctx, referral.Referrer, bet.Denom, affiliateFee)
+				creatorFee = creatorFee.Sub(affiliateFee)
+			}
+		}
+	}
+
+	return k.orderbookKeeper.WithdrawBetFee(ctx, marketCreator, creatorFee, bet.Denom)
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+func setTestAffiliate(t testing.TB, tApp *simappUtil.TestApp, ctx sdk.Context, address string, feeShare sdk.Dec) {
+	ticket, err := createJwtTicket(jwt.MapClaims{
+		"exp":       9999999999,
+		"iat":       7777777777,
+		"address":   address,
+		"fee_share": feeShare,
+	})
+	require.NoError(t, err)
+
+	betSrv := keeper.NewMsgServerImpl(*tApp.BetKeeper)
+	res, err := betSrv.SetAffiliate(sdk.WrapSDKContext(ctx), &types.MsgSetAffiliate{
+		Creator: simappUtil.TestParamUsers["user4"].Address.String(),
+		Ticket:  ticket,
+	})
+	require.NoError(t, err)
+	require.Equal(t, address, res.Affiliate.Address)
+	require.True(t, feeShare.Equal(res.Affiliate.FeeShare))
+}
+
+func TestSetReferrer(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	betSrv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	bettor := simappUtil.TestParamUsers["user1"].Address.String()
+	affiliate := simappUtil.TestParamUsers["user5"].Address.String()
+
+	// the referrer is not an affiliate yet
+	_, err := betSrv.SetReferrer(wctx, &types.MsgSetReferrer{Creator: bettor, Referrer: affiliate})
+	require.ErrorContains(t, err, types.ErrAffiliateNotFound.Error())
+
+	setTestAffiliate(t, tApp, ctx, affiliate, sdk.NewDecWithPrec(3, 1))
+
+	res, err := betSrv.SetReferrer(wctx, &types.MsgSetReferrer{Creator: bettor, Referrer: affiliate})
+	require.NoError(t, err)
+	require.Equal(t, bettor, res.Referral.Bettor)
+	require.Equal(t, affiliate, res.Referral.Referrer)
+
+	// the referrer can be set only once
+	_, err = betSrv.SetReferrer(wctx, &types.MsgSetReferrer{Creator: bettor, Referrer: affiliate})
+	require.ErrorContains(t, err, types.ErrReferrerAlreadySet.Error())
+
+	// the referrer of another bettor is bound by ticket
+	otherBettor := simappUtil.TestParamUsers["user6"].Address.String()
+	ticket, err := createJwtTicket(jwt.MapClaims{
+		"exp":      9999999999,
+		"iat":      7777777777,
+		"bettor":   otherBettor,
+		"referrer": affiliate,
+	})
+	require.NoError(t, err)
+
+	_, err = betSrv.BindReferrer(wctx, &types.MsgBindReferrer{
+		Creator: simappUtil.TestParamUsers["user4"].Address.String(),
+		Ticket:  ticket,
+	})
+	require.NoError(t, err)
+
+	referral, found := k.GetReferral(ctx, otherBettor)
+	require.True(t, found)
+	require.Equal(t, affiliate, referral.Referrer)
+
+	queryRes, err := k.Referral(wctx, &types.QueryReferralRequest{Bettor: otherBettor})
+	require.NoError(t, err)
+	require.Equal(t, referral, queryRes.Referral)
+}
+
+func TestSettleBetAffiliateFeeShare(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+
+	bettor := simappUtil.TestParamUsers["user1"].Address.String()
+	affiliateAddress := simappUtil.TestParamUsers["user5"].Address
+
+	setTestAffiliate(t, tApp, ctx, affiliateAddress.String(), sdk.NewDecWithPrec(3, 1))
+	_, err := k.SetReferrer(ctx, bettor, affiliateAddress.String())
+	require.NoError(t, err)
+
+	placeTestBet(ctx, t, tApp, uuid.NewString(), &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUIDs[0],
+		Value:             "2.00",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	})
+
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUIDs[0])
+	require.True(t, found)
+	marketCreator := sdk.MustAccAddressFromBech32(market.Creator)
+
+	affiliateBalanceBefore := tApp.BankKeeper.GetBalance(ctx, affiliateAddress, params.DefaultBondDenom)
+	creatorBalanceBefore := tApp.BankKeeper.GetBalance(ctx, marketCreator, params.DefaultBondDenom)
+
+	resolveTestMarketWithOutcome(t, tApp, ctx, marketUIDs[0], markettypes.OddsResult_ODDS_RESULT_LOSE)
+	require.NoError(t, k.BatchMarketSettlements(ctx))
+
+	bet, found := k.GetBet(ctx, bettor, 1)
+	require.True(t, found)
+	require.Equal(t, types.Bet_STATUS_SETTLED, bet.Status)
+	require.Equal(t, sdk.NewInt(100), bet.Fee)
+
+	// 30% of the bet fee is paid to the affiliate and the rest to the market creator
+	affiliateBalanceAfter := tApp.BankKeeper.GetBalance(ctx, affiliateAddress, params.DefaultBondDenom)
+	require.Equal(t, sdk.NewInt(30), affiliateBalanceAfter.Amount.Sub(affiliateBalanceBefore.Amount))
+
+	creatorBalanceAfter := tApp.BankKeeper.GetBalance(ctx, marketCreator, params.DefaultBondDenom)
+	require.Equal(t, sdk.NewInt(70), creatorBalanceAfter.Amount.Sub(creatorBalanceBefore.Amount))
+
+	res, err := k.Affiliate(sdk.WrapSDKContext(ctx), &types.QueryAffiliateRequest{Address: affiliateAddress.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AffiliateEarnings{
+		{
+			Address: affiliateAddress.String(),
+			Denom:   params.DefaultBondDenom,
+			Amount:  sdk.NewInt(30),
+		},
+	}, res.Earnings)
+}
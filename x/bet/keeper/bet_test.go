@@ -32,6 +32,7 @@ func createNBet(
 		items[i].Fee = sdk.NewInt(1)
 		items[i].MarketUID = testMarketUID
 		items[i].MaxLossMultiplier = sdk.NewDec(10)
+		items[i].DeadHeatFactor = sdk.ZeroDec()
 
 		id := uint64(i + 1)
 		keeper.SetBet(ctx, items[i], id)
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+
+	"github.com/sge-network/sge/utils"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+// CancelBet cancels a placed bet before the start of its market, reverts the bet
+// fulfillments from the order book and refunds the bet amount and optionally the bet fee.
+func (k Keeper) CancelBet(ctx sdk.Context, bettorAddressStr, betUID string, refundFee bool) error {
+	if !utils.IsValidUID(betUID) {
+		return types.ErrInvalidBetUID
+	}
+
+	uid2ID, found := k.GetBetID(ctx, betUID)
+	if !found {
+		return types.ErrNoMatchingBet
+	}
+
+	bet, found := k.GetBet(ctx, bettorAddressStr, uid2ID.ID)
+	if !found {
+		return types.ErrNoMatchingBet
+	}
+
+	bettorAddress, err := sdk.AccAddressFromBech32(bet.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if err := bet.CheckSettlementEligiblity(); err != nil {
+		return err
+	}
+
+	if bet.Status != types.Bet_STATUS_PLACED {
+		return sdkerrors.Wrapf(types.ErrBetIsNotPlaced, "%s", bet.Status)
+	}
+
+	marketBets := bet.MarketBets()
+
+	refundAmount := sdk.ZeroInt()
+	var feeReceiver sdk.AccAddress
+	for _, mb := range marketBets {
+		market, found := k.marketKeeper.GetMarket(ctx, mb.MarketUID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrNoMatchingMarket, "%s", mb.MarketUID)
+		}
+
+		if market.StartTS <= cast.ToUint64(ctx.BlockTime().Unix()) {
+			return sdkerrors.Wrapf(types.ErrMarketIsStarted, "%s", market.UID)
+		}
+
+		if feeReceiver == nil {
+			feeReceiver = sdk.MustAccAddressFromBech32(market.Creator)
+		}
+
+		if err := k.orderbookKeeper.RevertBetFulfillment(
+			ctx, uid2ID.ID, mb.OddsUID, mb.BetFulfillment, market.UID,
+		); err != nil {
+			return sdkerrors.Wrapf(types.ErrInOBCancellation, "%s", err)
+		}
+
+		stake, payout := types.FulfillmentExposure(mb.BetFulfillment)
+		refundAmount = refundAmount.Add(stake)
+
+		// the canceled bet is not counted in the caps of the bettor
+		k.updateBettorExposure(ctx, bet.Creator, mb.MarketUID, mb.OddsUID, stake.Neg(), payout.Neg())
+	}
+
+	refundFeeAmount := sdk.ZeroInt()
+	if refundFee {
+		refundFeeAmount = bet.Fee
+	}
+
+	if err := k.orderbookKeeper.RefundBettor(ctx, bettorAddress, refundAmount, refundFeeAmount, sdk.ZeroInt(), bet.UID, bet.Denom); err != nil {
+		return sdkerrors.Wrapf(types.ErrInOBRefund, "%s", err)
+	}
+
+	// the bet fee that is not refunded belongs to the market creator
+	if !refundFee {
+		if err := k.withdrawBetFee(ctx, &bet, feeReceiver); err != nil {
+			return err
+		}
+	}
+
+	// the refunded stake of a free bet belongs to the promo pool and the credit can be used again
+	// with the returned amount, the charged bet fee is deducted from the credit
+	if err := k.returnFreeBetStake(ctx, &bet, refundAmount.Add(refundFeeAmount)); err != nil {
+		return err
+	}
+	k.restoreFreeBetCredit(ctx, &bet, refundAmount.Add(refundFeeAmount))
+
+	// the canceled bet amount is not counted in the wagered volume of the bettor
+	if !bet.IsFreeBet() {
+		k.updateBettorVolume(ctx, bet.Creator, bet.Denom, bet.Amount.Neg())
+	}
+	k.revertBettorStake(ctx, &bet)
+
+	bet.Status = types.Bet_STATUS_CANCELED
+	bet.Result = types.Bet_RESULT_REFUNDED
+	bet.SettlementHeight = ctx.BlockHeight()
+	k.SetBet(ctx, bet, uid2ID.ID)
+
+	for _, mb := range marketBets {
+		k.RemovePendingBet(ctx, mb.MarketUID, uid2ID.ID)
+	}
+
+	// canceled bets are kept in the settled list to be tracked by the oracle services
+	k.SetSettledBet(ctx, types.NewSettledBet(bet.UID, bet.Creator), uid2ID.ID, ctx.BlockHeight())
+
+	return nil
+}
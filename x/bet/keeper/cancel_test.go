@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func TestCancelBet(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		started   bool
+		refundFee bool
+		err       error
+	}{
+		{
+			desc:    "market is started",
+			started: true,
+			err:     types.ErrMarketIsStarted,
+		},
+		{
+			desc: "success without fee refund",
+		},
+		{
+			desc:      "success with fee refund",
+			refundFee: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, k, ctx := setupKeeperAndApp(t)
+			ctx = ctx.WithBlockTime(time.Now())
+			marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+
+			market, found := tApp.MarketKeeper.GetMarket(ctx, marketUIDs[0])
+			require.True(t, found)
+			if !tc.started {
+				market.StartTS = uint64(ctx.BlockTime().Unix()) + 100
+				tApp.MarketKeeper.SetMarket(ctx, market)
+			}
+
+			bettorAddress := simappUtil.TestParamUsers["user1"].Address
+			balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+			betUID := uuid.NewString()
+			placeTestBet(ctx, t, tApp, betUID, &types.BetOdds{
+				UID:               testOddsUID1,
+				MarketUID:         market.UID,
+				Value:             "4.20",
+				MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+			})
+
+			ticket, err := createJwtTicket(jwt.MapClaims{
+				"exp":        9999999999,
+				"iat":        7777777777,
+				"uid":        betUID,
+				"refund_fee": tc.refundFee,
+			})
+			require.NoError(t, err)
+
+			betSrv := keeper.NewMsgServerImpl(*k)
+			_, err = betSrv.CancelBet(sdk.WrapSDKContext(ctx), &types.MsgCancelBet{
+				Creator: bettorAddress.String(),
+				Ticket:  ticket,
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, types.ErrInBetCancellation)
+				require.ErrorContains(t, err, tc.err.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			bet, found := k.GetBet(ctx, bettorAddress.String(), 1)
+			require.True(t, found)
+			require.Equal(t, types.Bet_STATUS_CANCELED, bet.Status)
+			require.Equal(t, types.Bet_RESULT_REFUNDED, bet.Result)
+
+			pendingBets, err := k.GetPendingBets(ctx)
+			require.NoError(t, err)
+			require.Empty(t, pendingBets)
+
+			settledBets, err := k.GetSettledBets(ctx)
+			require.NoError(t, err)
+			require.Len(t, settledBets, 1)
+
+			// the participations should not have any bet amount after cancellation
+			participations, err := tApp.OrderbookKeeper.GetParticipationsOfOrderBook(ctx, market.UID)
+			require.NoError(t, err)
+			for _, participation := range participations {
+				require.True(t, participation.TotalBetAmount.IsZero())
+				require.True(t, participation.CurrentRoundTotalBetAmount.IsZero())
+			}
+
+			exposures, err := tApp.OrderbookKeeper.GetExposureByOrderBookAndOdds(ctx, market.UID, testOddsUID1)
+			require.NoError(t, err)
+			for _, exposure := range exposures {
+				require.True(t, exposure.Exposure.IsZero())
+				require.True(t, exposure.BetAmount.IsZero())
+			}
+
+			balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+			if tc.refundFee {
+				require.Equal(t, balanceBefore.Amount, balanceAfter.Amount)
+			} else {
+				require.Equal(t, balanceBefore.Amount.Sub(bet.Fee), balanceAfter.Amount)
+			}
+
+			// the canceled bet can not be canceled again
+			err = k.CancelBet(ctx, bettorAddress.String(), betUID, tc.refundFee)
+			require.ErrorIs(t, err, types.ErrBetIsCanceled)
+		})
+	}
+}
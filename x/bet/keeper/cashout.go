@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sge-network/sge/utils"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+// CashOut settles a placed bet before the resolution of its market by paying the
+// quoted cash-out amount to the bettor from the order book liquidity.
+func (k Keeper) CashOut(ctx sdk.Context, bettorAddressStr, betUID string, amount sdkmath.Int) error {
+	if !utils.IsValidUID(betUID) {
+		return types.ErrInvalidBetUID
+	}
+
+	uid2ID, found := k.GetBetID(ctx, betUID)
+	if !found {
+		return types.ErrNoMatchingBet
+	}
+
+	bet, found := k.GetBet(ctx, bettorAddressStr, uid2ID.ID)
+	if !found {
+		return types.ErrNoMatchingBet
+	}
+
+	bettorAddress, err := sdk.AccAddressFromBech32(bet.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if err := bet.CheckSettlementEligiblity(); err != nil {
+		return err
+	}
+
+	if bet.Status != types.Bet_STATUS_PLACED {
+		return sdkerrors.Wrapf(types.ErrBetIsNotPlaced, "%s", bet.Status)
+	}
+
+	if bet.IsFreeBet() {
+		return types.ErrCashOutNotAllowedForFreeBet
+	}
+
+	marketBets := bet.MarketBets()
+
+	betAmounts := make([]sdkmath.Int, len(marketBets))
+	totalBetAmount := sdk.ZeroInt()
+	maxCashOutAmount := sdk.ZeroInt()
+	var feeReceiver sdk.AccAddress
+	for i, mb := range marketBets {
+		market, found := k.marketKeeper.GetMarket(ctx, mb.MarketUID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrNoMatchingMarket, "%s", mb.MarketUID)
+		}
+
+		// the resolved legs of a parlay bet are waiting for the rest of the legs,
+		// so only the market of the pending legs should not be resolved.
+		pending := true
+		if bet.IsParlay() {
+			pending = bet.Legs[i].Result == types.Bet_RESULT_PENDING
+		}
+		if pending &&
+			market.Status != markettypes.MarketStatus_MARKET_STATUS_ACTIVE &&
+			market.Status != markettypes.MarketStatus_MARKET_STATUS_INACTIVE {
+			return sdkerrors.Wrapf(types.ErrMarketIsResolved, "%s", market.UID)
+		}
+
+		if feeReceiver == nil {
+			feeReceiver = sdk.MustAccAddressFromBech32(market.Creator)
+		}
+
+		stake, payout := types.FulfillmentExposure(mb.BetFulfillment)
+		betAmounts[i] = stake
+		totalBetAmount = totalBetAmount.Add(stake)
+		maxCashOutAmount = maxCashOutAmount.Add(payout)
+	}
+
+	if !amount.IsPositive() || amount.GT(maxCashOutAmount) {
+		return sdkerrors.Wrapf(types.ErrInvalidCashOutAmount, "%s, max: %s", amount, maxCashOutAmount)
+	}
+
+	// the cash-out amount is divided between the markets proportional to the bet amount of each market.
+	remainingAmount := amount
+	for i, mb := range marketBets {
+		marketAmount := remainingAmount
+		if i != len(marketBets)-1 && totalBetAmount.IsPositive() {
+			marketAmount = amount.Mul(betAmounts[i]).Quo(totalBetAmount)
+		}
+		remainingAmount = remainingAmount.Sub(marketAmount)
+
+		if err := k.orderbookKeeper.CashOutBettor(
+			ctx, bettorAddress, uid2ID.ID, mb.OddsUID, marketAmount, mb.BetFulfillment, mb.MarketUID,
+		); err != nil {
+			return sdkerrors.Wrapf(types.ErrInOBCashOut, "%s", err)
+		}
+	}
+
+	if err := k.withdrawBetFee(ctx, &bet, feeReceiver); err != nil {
+		return err
+	}
+
+	k.addBettorReturn(ctx, &bet, amount)
+
+	bet.Status = types.Bet_STATUS_SETTLED
+	bet.Result = types.Bet_RESULT_CASHED_OUT
+	bet.SettlementHeight = ctx.BlockHeight()
+	k.SetBet(ctx, bet, uid2ID.ID)
+
+	for _, mb := range marketBets {
+		k.RemovePendingBet(ctx, mb.MarketUID, uid2ID.ID)
+		k.RemoveParlayWaitingBet(ctx, mb.MarketUID, uid2ID.ID)
+
+		if err := k.settleDeferredBook(ctx, mb.MarketUID); err != nil {
+			return err
+		}
+	}
+
+	k.SetSettledBet(ctx, types.NewSettledBet(bet.UID, bet.Creator), uid2ID.ID, ctx.BlockHeight())
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+	orderbooktypes "github.com/sge-network/sge/x/orderbook/types"
+)
+
+func TestCashOut(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		amount   sdk.Int
+		resolved bool
+		err      error
+	}{
+		{
+			desc:     "market is resolved",
+			amount:   sdk.NewInt(500000),
+			resolved: true,
+			err:      types.ErrMarketIsResolved,
+		},
+		{
+			desc:   "more than max cash-out amount",
+			amount: sdk.NewInt(5000000),
+			err:    types.ErrInvalidCashOutAmount,
+		},
+		{
+			desc:   "success with loss",
+			amount: sdk.NewInt(500000),
+		},
+		{
+			desc:   "success with profit",
+			amount: sdk.NewInt(2500000),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, k, ctx := setupKeeperAndApp(t)
+			liquidityPoolAddress := tApp.AccountKeeper.GetModuleAddress(orderbooktypes.OrderBookLiquidityFunder{}.GetModuleAcc())
+			liquidityPoolBefore := tApp.BankKeeper.GetBalance(ctx, liquidityPoolAddress, params.DefaultBondDenom)
+
+			marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+			marketUID := marketUIDs[0]
+
+			bettorAddress := simappUtil.TestParamUsers["user1"].Address
+
+			betUID := uuid.NewString()
+			placeTestBet(ctx, t, tApp, betUID, &types.BetOdds{
+				UID:               testOddsUID1,
+				MarketUID:         marketUID,
+				Value:             "4.20",
+				MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+			})
+
+			if tc.resolved {
+				resolveTestMarket(t, tApp, ctx, marketUID, markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED, []string{testOddsUID1})
+			}
+
+			balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+			ticket, err := createJwtTicket(jwt.MapClaims{
+				"exp":    9999999999,
+				"iat":    7777777777,
+				"uid":    betUID,
+				"amount": tc.amount,
+			})
+			require.NoError(t, err)
+
+			betSrv := keeper.NewMsgServerImpl(*k)
+			res, err := betSrv.CashOut(sdk.WrapSDKContext(ctx), &types.MsgCashOut{
+				Creator: bettorAddress.String(),
+				Ticket:  ticket,
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, types.ErrInBetCashOut)
+				require.ErrorContains(t, err, tc.err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.amount, res.Amount)
+
+			bet, found := k.GetBet(ctx, bettorAddress.String(), 1)
+			require.True(t, found)
+			require.Equal(t, types.Bet_STATUS_SETTLED, bet.Status)
+			require.Equal(t, types.Bet_RESULT_CASHED_OUT, bet.Result)
+
+			pendingBets, err := k.GetPendingBets(ctx)
+			require.NoError(t, err)
+			require.Empty(t, pendingBets)
+
+			settledBets, err := k.GetSettledBets(ctx)
+			require.NoError(t, err)
+			require.Len(t, settledBets, 1)
+
+			// the participations receive the bet amount and pay the cash-out amount
+			participations, err := tApp.OrderbookKeeper.GetParticipationsOfOrderBook(ctx, marketUID)
+			require.NoError(t, err)
+			totalActualProfit := sdk.ZeroInt()
+			for _, participation := range participations {
+				require.True(t, participation.TotalBetAmount.IsZero())
+				require.True(t, participation.CurrentRoundTotalBetAmount.IsZero())
+				totalActualProfit = totalActualProfit.Add(participation.ActualProfit)
+			}
+			require.Equal(t, bet.Amount.Sub(tc.amount), totalActualProfit)
+
+			participationBets, err := tApp.OrderbookKeeper.GetAllParticipationBetPair(ctx)
+			require.NoError(t, err)
+			require.Empty(t, participationBets)
+
+			exposures, err := tApp.OrderbookKeeper.GetExposureByOrderBookAndOdds(ctx, marketUID, testOddsUID1)
+			require.NoError(t, err)
+			for _, exposure := range exposures {
+				require.True(t, exposure.Exposure.IsZero())
+				require.True(t, exposure.BetAmount.IsZero())
+			}
+
+			balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+			require.Equal(t, balanceBefore.Amount.Add(tc.amount), balanceAfter.Amount)
+
+			// the cashed out bet is not settled again by the end blocker
+			resolveTestMarket(t, tApp, ctx, marketUID, markettypes.MarketStatus_MARKET_STATUS_ABORTED, nil)
+			require.NoError(t, k.BatchMarketSettlements(ctx))
+			require.Equal(t, balanceAfter, tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom))
+
+			// the settlement of the aborted market pays the cash-out from the liquidity of the market only
+			require.NoError(t, tApp.OrderbookKeeper.BatchOrderBookSettlements(ctx))
+			require.Equal(t, liquidityPoolBefore, tApp.BankKeeper.GetBalance(ctx, liquidityPoolAddress, params.DefaultBondDenom))
+
+			// the cashed out bet can not be cashed out again
+			err = k.CashOut(ctx, bettorAddress.String(), betUID, tc.amount)
+			require.ErrorIs(t, err, types.ErrBetIsSettled)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+// SetDelayedBet sets a delayed bet in the store
+func (k Keeper) SetDelayedBet(ctx sdk.Context, delayed types.DelayedBet, id uint64) {
+	store := k.getDelayedBetStore(ctx)
+	b := k.cdc.MustMarshal(&delayed)
+	store.Set(types.DelayedBetKey(delayed.AcceptHeight, delayed.AcceptTS, id), b)
+}
+
+// GetDelayedBet returns a delayed bet by its accept height and timestamp and the id of the bet
+func (k Keeper) GetDelayedBet(ctx sdk.Context, acceptHeight, acceptTS int64, id uint64) (val types.DelayedBet, found bool) {
+	store := k.getDelayedBetStore(ctx)
+
+	b := store.Get(types.DelayedBetKey(acceptHeight, acceptTS, id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveDelayedBet removes a delayed bet from the store
+func (k Keeper) RemoveDelayedBet(ctx sdk.Context, delayed types.DelayedBet, id uint64) {
+	store := k.getDelayedBetStore(ctx)
+	store.Delete(types.DelayedBetKey(delayed.AcceptHeight, delayed.AcceptTS, id))
+}
+
+// getDelayedBetByID returns the delayed bet of a bet by the id of the bet.
+func (k Keeper) getDelayedBetByID(ctx sdk.Context, id uint64) (val types.DelayedBet, found bool) {
+	store := k.getDelayedBetStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if _, betID := types.ParseDelayedBetKey(iterator.Key()); betID == id {
+			k.cdc.MustUnmarshal(iterator.Value(), &val)
+			return val, true
+		}
+	}
+
+	return val, false
+}
+
+// GetDelayedBets returns all of the delayed bets
+func (k Keeper) GetDelayedBets(ctx sdk.Context) (list []types.DelayedBet, err error) {
+	store := k.getDelayedBetStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DelayedBet
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// isInPlay returns true if any of the markets of the bet is started.
+func isInPlay(ctx sdk.Context, markets []markettypes.Market) bool {
+	blockTime := cast.ToUint64(ctx.BlockTime().Unix())
+	for _, market := range markets {
+		if market.StartTS <= blockTime {
+			return true
+		}
+	}
+	return false
+}
+
+// delayBet holds the amount and fee of the bet in the bet delay escrow and stores the bet
+// as a pending bet to be fulfilled or rejected after the in-play bet delay.
+func (k Keeper) delayBet(
+	ctx sdk.Context,
+	bet *types.Bet,
+	betID uint64,
+	bettorAddress sdk.AccAddress,
+	betOdds map[string]map[string]*types.BetOddsCompact,
+	markets []markettypes.Market,
+	minFillRatio sdk.Dec,
+	params types.Params,
+) error {
+	if err := k.orderbookKeeper.FundBetDelayEscrow(ctx, bettorAddress, bet.Amount.Add(bet.Fee), bet.Denom); err != nil {
+		return sdkerrors.Wrapf(types.ErrInBetDelayEscrowTransfer, "%s", err)
+	}
+
+	// the odds are stored in the order of the market odds to be deterministic
+	marketOdds := make([]types.DelayedBetMarketOdds, 0, len(markets))
+	for _, market := range markets {
+		odds := make([]*types.BetOddsCompact, 0, len(market.Odds))
+		for _, oddsUID := range market.OddsUIDS() {
+			odds = append(odds, betOdds[market.UID][oddsUID])
+		}
+		marketOdds = append(marketOdds, types.DelayedBetMarketOdds{MarketUID: market.UID, Odds: odds})
+	}
+
+	bet.Status = types.Bet_STATUS_PENDING
+	bet.Result = types.Bet_RESULT_PENDING
+
+	k.SetBet(ctx, *bet, betID)
+
+	k.SetDelayedBet(ctx, types.NewDelayedBet(
+		bet.UID,
+		bet.Creator,
+		ctx.BlockHeight()+cast.ToInt64(params.InPlayDelayBlocks),
+		ctx.BlockTime().Unix()+cast.ToInt64(params.InPlayDelaySeconds),
+		minFillRatio,
+		marketOdds,
+	), betID)
+
+	return nil
+}
+
+// ProcessDelayedBets fulfills the delayed bets that their bet delay is passed by the order book,
+// or rejects and refunds them if the market is not active anymore or the odds is moved. The
+// delayed bets are ordered by the accept height, so only the bets that their accept height is
+// reached are iterated and at most the delayed bet batch count of them are processed in a block.
+func (k Keeper) ProcessDelayedBets(ctx sdk.Context) error {
+	batchCount := k.GetParams(ctx).DelayedBetBatchCount
+	blockTime := ctx.BlockTime().Unix()
+
+	store := k.getDelayedBetStore(ctx)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(types.DelayedBetListOfAcceptHeightPrefix(ctx.BlockHeight())))
+
+	var ids []uint64
+	var dueBets []types.DelayedBet
+	for ; iterator.Valid() && len(dueBets) < int(batchCount); iterator.Next() {
+		// the accept timestamp is in the key, so the bets that are not due are skipped without decoding
+		acceptTS, id := types.ParseDelayedBetKey(iterator.Key())
+		if acceptTS > blockTime {
+			continue
+		}
+
+		var val types.DelayedBet
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		ids = append(ids, id)
+		dueBets = append(dueBets, val)
+	}
+
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for i, delayed := range dueBets {
+		if err := k.processDelayedBet(ctx, ids[i], delayed); err != nil {
+			return sdkerrors.Wrapf(err, "delayed bet %s", delayed.UID)
+		}
+	}
+
+	return nil
+}
+
+// processDelayedBet returns the escrowed amount to the bettor and places the bet,
+// the bet is rejected and the bettor keeps the returned amount if the placement fails.
+func (k Keeper) processDelayedBet(ctx sdk.Context, betID uint64, delayed types.DelayedBet) error {
+	k.RemoveDelayedBet(ctx, delayed, betID)
+
+	bet, found := k.GetBet(ctx, delayed.Creator, betID)
+	if !found {
+		return types.ErrNoMatchingBet
+	}
+
+	bettorAddress, err := sdk.AccAddressFromBech32(bet.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if err := k.orderbookKeeper.WithdrawFromBetDelayEscrow(ctx, bettorAddress, bet.Amount.Add(bet.Fee), bet.Denom); err != nil {
+		return sdkerrors.Wrapf(types.ErrInBetDelayEscrowTransfer, "%s", err)
+	}
+
+	// the placement is done in a cached context to drop the partial changes of a rejected bet
+	cacheCtx, writeCache := ctx.CacheContext()
+	acceptedBet := bet
+	if err := k.acceptDelayedBet(cacheCtx, &acceptedBet, betID, bettorAddress, delayed); err != nil {
+		return k.rejectDelayedBet(ctx, bet, betID)
+	}
+	writeCache()
+
+	return nil
+}
+
+// acceptDelayedBet validates the markets and the odds of the delayed bet and places the bet.
+func (k Keeper) acceptDelayedBet(
+	ctx sdk.Context,
+	bet *types.Bet,
+	betID uint64,
+	bettorAddress sdk.AccAddress,
+	delayed types.DelayedBet,
+) error {
+	betOdds := delayed.BetOdds()
+
+	// the markets suspended during the bet delay are not active anymore
+	markets, err := k.getBetMarkets(ctx, bet, betOdds)
+	if err != nil {
+		return err
+	}
+
+	for i, market := range markets {
+		oddsUID := bet.OddsUID
+		if bet.IsParlay() {
+			oddsUID = bet.Legs[i].OddsUID
+		}
+
+		if market.IsOddsMovedSince(oddsUID, cast.ToUint64(bet.CreatedAt)) {
+			return sdkerrors.Wrapf(types.ErrOddsMovedDuringDelay, "%s", oddsUID)
+		}
+	}
+
+	stakeAmount := bet.Amount
+	if bet.IsFreeBet() {
+		stakeAmount = sdk.ZeroInt()
+	}
+	if err := k.checkBettorLimits(ctx, bet.Creator, bet.Denom, stakeAmount); err != nil {
+		return err
+	}
+
+	return k.placeBet(ctx, bet, betID, bettorAddress, betOdds, markets, delayed.MinFillRatio)
+}
+
+// rejectDelayedBet aborts and refunds the delayed bet, the stake of a free bet
+// is returned to the promo pool and the credit can be used again.
+func (k Keeper) rejectDelayedBet(ctx sdk.Context, bet types.Bet, betID uint64) error {
+	if err := k.returnFreeBetStake(ctx, &bet, bet.Amount.Add(bet.Fee)); err != nil {
+		return err
+	}
+	k.restoreFreeBetCredit(ctx, &bet, bet.Amount.Add(bet.Fee))
+
+	bet.Status = types.Bet_STATUS_ABORTED
+	bet.Result = types.Bet_RESULT_REFUNDED
+	bet.SettlementHeight = ctx.BlockHeight()
+
+	k.SetBet(ctx, bet, betID)
+	k.SetSettledBet(ctx, types.NewSettledBet(bet.UID, bet.Creator), betID, ctx.BlockHeight())
+
+	return nil
+}
+
+// voidDelayedBet removes the delayed bet and refunds the amount and fee of the bet
+// held in the bet delay escrow.
+func (k Keeper) voidDelayedBet(ctx sdk.Context, bet types.Bet, betID uint64, bettorAddress sdk.AccAddress) error {
+	delayed, found := k.getDelayedBetByID(ctx, betID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidDelayedBet, "%s", bet.UID)
+	}
+	k.RemoveDelayedBet(ctx, delayed, betID)
+
+	if err := k.orderbookKeeper.WithdrawFromBetDelayEscrow(ctx, bettorAddress, bet.Amount.Add(bet.Fee), bet.Denom); err != nil {
+		return sdkerrors.Wrapf(types.ErrInBetDelayEscrowTransfer, "%s", err)
+	}
+
+	return k.rejectDelayedBet(ctx, bet, betID)
+}
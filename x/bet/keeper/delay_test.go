@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+func setupInPlayDelay(t *testing.T) (*simappUtil.TestApp, sdk.Context, string) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(10)
+
+	p := k.GetParams(ctx)
+	p.InPlayDelayBlocks = 2
+	p.InPlayDelaySeconds = 5
+	k.SetParams(ctx, p)
+
+	// the markets of the test are started so the bets are in-play
+	marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+	return tApp, ctx, marketUID
+}
+
+func TestDelayedBetAccepted(t *testing.T) {
+	tApp, ctx, marketUID := setupInPlayDelay(t)
+	k := tApp.BetKeeper
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address
+	escrowAddress := tApp.AccountKeeper.GetModuleAddress(types.BetDelayEscrowFunder{}.GetModuleAcc())
+	balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+	betUID := uuid.NewString()
+	placeTestBet(ctx, t, tApp, betUID, &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUID,
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	})
+
+	betID, found := k.GetBetID(ctx, betUID)
+	require.True(t, found)
+	acceptTS := ctx.BlockTime().Unix() + 5
+
+	// the stake is escrowed and the bet is not fulfilled by the order book
+	bet, found := k.GetBet(ctx, testCreator, betID.ID)
+	require.True(t, found)
+	require.Equal(t, types.Bet_STATUS_PENDING, bet.Status)
+	require.Nil(t, bet.BetFulfillment)
+	require.Equal(t, balanceBefore.Amount.Sub(sdk.NewInt(1000000)), tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom).Amount)
+	require.Equal(t, sdk.NewInt(1000000), tApp.BankKeeper.GetBalance(ctx, escrowAddress, params.DefaultBondDenom).Amount)
+
+	// the pending bet can not be canceled
+	require.ErrorIs(t, k.CancelBet(ctx, testCreator, betUID, false), types.ErrBetIsNotPlaced)
+
+	// the blocks of the delay are passed but the seconds are not
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, k.ProcessDelayedBets(ctx))
+	_, found = k.GetDelayedBet(ctx, 12, acceptTS, betID.ID)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	require.NoError(t, k.ProcessDelayedBets(ctx))
+	_, found = k.GetDelayedBet(ctx, 12, acceptTS, betID.ID)
+	require.False(t, found)
+
+	bet, found = k.GetBet(ctx, testCreator, betID.ID)
+	require.True(t, found)
+	require.Equal(t, types.Bet_STATUS_PLACED, bet.Status)
+	require.Equal(t, types.Bet_RESULT_PENDING, bet.Result)
+	require.NotNil(t, bet.BetFulfillment)
+	require.True(t, tApp.BankKeeper.GetBalance(ctx, escrowAddress, params.DefaultBondDenom).Amount.IsZero())
+	require.Equal(t, balanceBefore.Amount.Sub(sdk.NewInt(1000000)), tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom).Amount)
+
+	pendingBets, err := k.GetPendingBets(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingBets, 1)
+}
+
+func TestDelayedBetRejected(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		modify func(ctx sdk.Context, market *markettypes.Market)
+	}{
+		{
+			desc: "market suspended",
+			modify: func(_ sdk.Context, market *markettypes.Market) {
+				market.Status = markettypes.MarketStatus_MARKET_STATUS_INACTIVE
+			},
+		},
+		{
+			desc: "odds moved",
+			modify: func(ctx sdk.Context, market *markettypes.Market) {
+				for _, odds := range market.Odds {
+					if odds.UID == testOddsUID1 {
+						odds.MovedTS = cast.ToUint64(ctx.BlockTime().Unix())
+					}
+				}
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, ctx, marketUID := setupInPlayDelay(t)
+			k := tApp.BetKeeper
+			bettorAddress := simappUtil.TestParamUsers["user1"].Address
+			balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+			betUID := uuid.NewString()
+			placeTestBet(ctx, t, tApp, betUID, &types.BetOdds{
+				UID:               testOddsUID1,
+				MarketUID:         marketUID,
+				Value:             "1.90",
+				MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+			})
+
+			ctx = ctx.WithBlockHeight(12).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+
+			market, found := tApp.MarketKeeper.GetMarket(ctx, marketUID)
+			require.True(t, found)
+			tc.modify(ctx, &market)
+			tApp.MarketKeeper.SetMarket(ctx, market)
+
+			require.NoError(t, k.ProcessDelayedBets(ctx))
+
+			betID, found := k.GetBetID(ctx, betUID)
+			require.True(t, found)
+			bet, found := k.GetBet(ctx, testCreator, betID.ID)
+			require.True(t, found)
+			require.Equal(t, types.Bet_STATUS_ABORTED, bet.Status)
+			require.Equal(t, types.Bet_RESULT_REFUNDED, bet.Result)
+			require.Equal(t, ctx.BlockHeight(), bet.SettlementHeight)
+			require.Nil(t, bet.BetFulfillment)
+
+			// the whole amount and fee is refunded to the bettor
+			require.Equal(t, balanceBefore, tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom))
+
+			settledBets, err := k.GetSettledBets(ctx)
+			require.NoError(t, err)
+			require.Len(t, settledBets, 1)
+		})
+	}
+}
+
+func TestDelayedBetBatchCount(t *testing.T) {
+	tApp, ctx, marketUID := setupInPlayDelay(t)
+	k := tApp.BetKeeper
+
+	p := k.GetParams(ctx)
+	p.DelayedBetBatchCount = 1
+	k.SetParams(ctx, p)
+
+	for i := 0; i < 2; i++ {
+		placeTestBet(ctx, t, tApp, uuid.NewString(), &types.BetOdds{
+			UID:               testOddsUID1,
+			MarketUID:         marketUID,
+			Value:             "1.90",
+			MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+		})
+	}
+
+	// the delayed bets are not due yet
+	require.NoError(t, k.ProcessDelayedBets(ctx.WithBlockHeight(11).WithBlockTime(ctx.BlockTime().Add(5*time.Second))))
+	delayedBets, err := k.GetDelayedBets(ctx)
+	require.NoError(t, err)
+	require.Len(t, delayedBets, 2)
+
+	// only one of the due delayed bets is processed in each block
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	require.NoError(t, k.ProcessDelayedBets(ctx))
+	delayedBets, err = k.GetDelayedBets(ctx)
+	require.NoError(t, err)
+	require.Len(t, delayedBets, 1)
+
+	require.NoError(t, k.ProcessDelayedBets(ctx.WithBlockHeight(13)))
+	delayedBets, err = k.GetDelayedBets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, delayedBets)
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+// SetBettorExposure sets the exposure of a bettor on an odds of a market in the store
+func (k Keeper) SetBettorExposure(ctx sdk.Context, exposure types.BettorExposure) {
+	store := k.getBettorExposureStore(ctx)
+	b := k.cdc.MustMarshal(&exposure)
+	store.Set(types.BettorExposureKey(exposure.Address, exposure.MarketUID, exposure.OddsUID), b)
+}
+
+// GetBettorExposure returns the exposure of a bettor on an odds of a market,
+// the totals are zero if the bettor has no bet on the odds.
+func (k Keeper) GetBettorExposure(ctx sdk.Context, address, marketUID, oddsUID string) (val types.BettorExposure) {
+	store := k.getBettorExposureStore(ctx)
+
+	b := store.Get(types.BettorExposureKey(address, marketUID, oddsUID))
+	if b == nil {
+		return types.NewBettorExposure(address, marketUID, oddsUID)
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllBettorExposures returns the exposures of all of the bettors
+func (k Keeper) GetAllBettorExposures(ctx sdk.Context) (list []types.BettorExposure, err error) {
+	store := k.getBettorExposureStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BettorExposure
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetBettorMarketExposure returns the total stake and potential payout of a bettor
+// on all of the odds of a market.
+func (k Keeper) GetBettorMarketExposure(ctx sdk.Context, address, marketUID string) (stake, payout sdkmath.Int) {
+	store := k.getBettorExposureByMarketStore(ctx, address, marketUID)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	stake, payout = sdk.ZeroInt(), sdk.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BettorExposure
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		stake = stake.Add(val.Stake)
+		payout = payout.Add(val.Payout)
+	}
+
+	return stake, payout
+}
+
+// checkBettorCaps checks the per-bettor stake and potential payout caps of the market
+// and the odds for a new bet on the odds.
+func (k Keeper) checkBettorCaps(
+	ctx sdk.Context,
+	address string,
+	market *markettypes.Market,
+	oddsUID string,
+	stake, payout sdkmath.Int,
+) error {
+	if caps := market.BettorCaps; caps.HasMaxStake() || caps.HasMaxPayout() {
+		marketStake, marketPayout := k.GetBettorMarketExposure(ctx, address, market.UID)
+		if err := types.CheckBettorCaps(caps, marketStake.Add(stake), marketPayout.Add(payout)); err != nil {
+			return sdkerrors.Wrapf(err, "market %s", market.UID)
+		}
+	}
+
+	if caps := market.OddsBettorCaps(oddsUID); caps.HasMaxStake() || caps.HasMaxPayout() {
+		exposure := k.GetBettorExposure(ctx, address, market.UID, oddsUID)
+		if err := types.CheckBettorCaps(caps, exposure.Stake.Add(stake), exposure.Payout.Add(payout)); err != nil {
+			return sdkerrors.Wrapf(err, "odds %s", oddsUID)
+		}
+	}
+
+	return nil
+}
+
+// updateBettorExposure adds the stake and the potential payout of the bet fulfillments
+// to the exposure of the bettor on the odds, negative values are used to revert a bet.
+func (k Keeper) updateBettorExposure(ctx sdk.Context, address, marketUID, oddsUID string, stake, payout sdkmath.Int) {
+	exposure := k.GetBettorExposure(ctx, address, marketUID, oddsUID)
+	exposure.Add(stake, payout)
+	k.SetBettorExposure(ctx, exposure)
+}
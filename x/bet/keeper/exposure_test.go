@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+func TestBettorMarketStakeCap(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUID)
+	require.True(t, found)
+	market.BettorCaps = &markettypes.BettorCaps{MaxStake: sdk.NewInt(1500000), MaxPayout: sdk.ZeroInt()}
+	tApp.MarketKeeper.SetMarket(ctx, market)
+
+	selectedOdds := &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUID,
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	}
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+
+	// the market cap is applied to the total stake on all of the odds
+	selectedOdds.UID = testOddsUID2
+	err := wagerTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+	require.ErrorContains(t, err, types.ErrBettorStakeCapExceeded.Error())
+
+	res, err := k.BettorCaps(sdk.WrapSDKContext(ctx), &types.QueryBettorCapsRequest{
+		Address:   bettorAddress,
+		MarketUid: marketUID,
+		OddsUid:   testOddsUID1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(999900), res.Market.Stake)
+	require.Equal(t, sdk.NewInt(1899810), res.Market.Payout)
+	require.Equal(t, sdk.NewInt(500100), res.Market.RemainingStake)
+	require.Equal(t, sdk.ZeroInt(), res.Market.MaxPayout)
+	require.Equal(t, sdk.NewInt(999900), res.Odds.Stake)
+	require.Equal(t, sdk.ZeroInt(), res.Odds.MaxStake)
+
+	// the bets of the other bettors are not counted in the caps of the bettor
+	res, err = k.BettorCaps(sdk.WrapSDKContext(ctx), &types.QueryBettorCapsRequest{
+		Address:   simappUtil.TestParamUsers["user4"].Address.String(),
+		MarketUid: marketUID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroInt(), res.Market.Stake)
+	require.Equal(t, sdk.NewInt(1500000), res.Market.RemainingStake)
+	require.Nil(t, res.Odds)
+}
+
+func TestBettorOddsPayoutCap(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUID)
+	require.True(t, found)
+	market.StartTS = uint64(ctx.BlockTime().Unix()) + 100
+	require.NoError(t, market.SetOddsBettorCaps(testOddsUID1, &markettypes.BettorCaps{
+		MaxStake:  sdk.ZeroInt(),
+		MaxPayout: sdk.NewInt(3000000),
+	}))
+	tApp.MarketKeeper.SetMarket(ctx, market)
+
+	selectedOdds := &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUID,
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	}
+	betUID := uuid.NewString()
+	placeTestBet(ctx, t, tApp, betUID, selectedOdds)
+
+	err := wagerTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+	require.ErrorContains(t, err, types.ErrBettorPayoutCapExceeded.Error())
+
+	// the other odds of the market are not capped
+	otherOdds := *selectedOdds
+	otherOdds.UID = testOddsUID2
+	placeTestBet(ctx, t, tApp, uuid.NewString(), &otherOdds)
+
+	// the canceled bet is reverted from the totals of the bettor
+	require.NoError(t, k.CancelBet(ctx, testCreator, betUID, false))
+	exposure := k.GetBettorExposure(ctx, testCreator, marketUID, testOddsUID1)
+	require.True(t, exposure.Stake.IsZero())
+	require.True(t, exposure.Payout.IsZero())
+
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func TestWagerFeeTiers(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+
+	betParams := k.GetParams(ctx)
+	betParams.Constraints.Fee = sdk.ZeroInt()
+	betParams.Constraints.FeeRate = sdk.NewDecWithPrec(2, 2)
+	betParams.Constraints.FeeTiers = []types.FeeTier{
+		{MinVolume: sdk.NewInt(900000), FeeRate: sdk.NewDecWithPrec(1, 2)},
+	}
+	k.SetParams(ctx, betParams)
+
+	selectedOdds := &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUID,
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	wctx := sdk.WrapSDKContext(ctx)
+	res, err := k.BettorFeeTier(wctx, &types.QueryBettorFeeTierRequest{Address: bettorAddress, Denom: params.DefaultBondDenom})
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroInt().String(), res.Volume.String())
+	require.True(t, betParams.Constraints.FeeRate.Equal(res.FeeTier.FeeRate))
+
+	// the first bet is charged by the base fee rate
+	firstBetUID := uuid.NewString()
+	placeTestBet(ctx, t, tApp, firstBetUID, selectedOdds)
+
+	bet, found := k.GetBet(ctx, bettorAddress, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(20000).String(), bet.Fee.String())
+	require.Equal(t, sdk.NewInt(980000).String(), bet.Amount.String())
+
+	res, err = k.BettorFeeTier(wctx, &types.QueryBettorFeeTierRequest{Address: bettorAddress, Denom: params.DefaultBondDenom})
+	require.NoError(t, err)
+	require.Equal(t, bet.Amount.String(), res.Volume.String())
+	require.True(t, betParams.Constraints.FeeTiers[0].FeeRate.Equal(res.FeeTier.FeeRate))
+
+	// the second bet is charged by the fee rate of the reached tier
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+
+	bet, found = k.GetBet(ctx, bettorAddress, 2)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(10000).String(), bet.Fee.String())
+
+	// the canceled bet amount is removed from the volume
+	err = k.CancelBet(ctx, bettorAddress, firstBetUID, true)
+	require.NoError(t, err)
+	require.Equal(t, bet.Amount.String(), k.GetBettorStats(ctx, bettorAddress, params.DefaultBondDenom).Volume.String())
+
+	_, err = k.BettorFeeTier(wctx, &types.QueryBettorFeeTierRequest{Address: "invalid", Denom: params.DefaultBondDenom})
+	require.Error(t, err)
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sge-network/sge/consts"
+	"github.com/sge-network/sge/x/bet/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Affiliates returns all of the affiliates
+func (k Keeper) Affiliates(
+	c context.Context,
+	req *types.QueryAffiliatesRequest,
+) (*types.QueryAffiliatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	var affiliates []types.Affiliate
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := k.getAffiliateStore(ctx)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var affiliate types.Affiliate
+		if err := k.cdc.Unmarshal(value, &affiliate); err != nil {
+			return err
+		}
+
+		affiliates = append(affiliates, affiliate)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAffiliatesResponse{Affiliates: affiliates, Pagination: pageRes}, nil
+}
+
+// Affiliate returns an affiliate and its accrued earnings by address
+func (k Keeper) Affiliate(
+	c context.Context,
+	req *types.QueryAffiliateRequest,
+) (*types.QueryAffiliateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	affiliate, found := k.GetAffiliate(ctx, req.Address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "affiliate %s not found", req.Address)
+	}
+
+	earnings, err := k.GetAffiliateEarningsOfAddress(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAffiliateResponse{Affiliate: affiliate, Earnings: earnings}, nil
+}
+
+// Referral returns the referral binding of a bettor
+func (k Keeper) Referral(
+	c context.Context,
+	req *types.QueryReferralRequest,
+) (*types.QueryReferralResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	referral, found := k.GetReferral(ctx, req.Bettor)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "referral of %s not found", req.Bettor)
+	}
+
+	return &types.QueryReferralResponse{Referral: referral}, nil
+}
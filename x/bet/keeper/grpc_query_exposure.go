@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sge-network/sge/consts"
+	"github.com/sge-network/sge/x/bet/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BettorCaps returns the totals and the remaining amounts to the caps of a bettor on a market
+// and optionally on an odds of the market.
+func (k Keeper) BettorCaps(
+	c context.Context,
+	req *types.QueryBettorCapsRequest,
+) (*types.QueryBettorCapsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	market, found := k.marketKeeper.GetMarket(ctx, req.MarketUid)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "market %s not found", req.MarketUid)
+	}
+
+	stake, payout := k.GetBettorMarketExposure(ctx, req.Address, market.UID)
+	res := &types.QueryBettorCapsResponse{
+		Market: types.NewRemainingBettorCaps(market.BettorCaps, stake, payout),
+	}
+
+	if req.OddsUid != "" {
+		if !market.HasOdds(req.OddsUid) {
+			return nil, status.Errorf(codes.NotFound, "odds %s not found", req.OddsUid)
+		}
+
+		exposure := k.GetBettorExposure(ctx, req.Address, market.UID, req.OddsUid)
+		oddsCaps := types.NewRemainingBettorCaps(market.OddsBettorCaps(req.OddsUid), exposure.Stake, exposure.Payout)
+		res.Odds = &oddsCaps
+	}
+
+	return res, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sge-network/sge/consts"
+	"github.com/sge-network/sge/x/bet/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BettorLimits returns the self-exclusion and the stake and net loss limits of a bettor
+func (k Keeper) BettorLimits(
+	c context.Context,
+	req *types.QueryBettorLimitsRequest,
+) (*types.QueryBettorLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	limits := k.GetBettorLimits(ctx, req.Address)
+	limits.ApplyPendingChanges(ctx.BlockTime().Unix())
+
+	return &types.QueryBettorLimitsResponse{Limits: limits}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sge-network/sge/consts"
+	"github.com/sge-network/sge/x/bet/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CalcPayout returns the payout of a bet amount by the odds value and the odds value
+// converted to all of the supported odds types, it does not depend on the state.
+func (k Keeper) CalcPayout(
+	_ context.Context,
+	req *types.QueryCalcPayoutRequest,
+) (*types.QueryCalcPayoutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	if req.Amount.IsNil() || !req.Amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, types.ErrInvalidAmount.Error())
+	}
+
+	payoutProfit, err := types.CalculatePayoutProfit(req.OddsType, req.OddsValue, req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	conversions, err := types.CalculateOddsConversions(req.OddsType, req.OddsValue, req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCalcPayoutResponse{
+		Payout:       sdk.NewDecFromInt(req.Amount).Add(payoutProfit),
+		PayoutProfit: payoutProfit,
+		Conversions:  conversions,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sge-network/sge/consts"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func TestCalcPayoutQuery(t *testing.T) {
+	_, k, ctx := setupKeeperAndApp(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	for _, tc := range []struct {
+		desc            string
+		request         *types.QueryCalcPayoutRequest
+		expPayout       sdk.Dec
+		expPayoutProfit sdk.Dec
+		err             error
+	}{
+		{
+			desc: "moneyline",
+			request: &types.QueryCalcPayoutRequest{
+				OddsType:  types.OddsType_ODDS_TYPE_MONEYLINE,
+				OddsValue: "-450",
+				Amount:    sdk.NewInt(35625789),
+			},
+			expPayout:       sdk.MustNewDecFromStr("43542631.000000000000000000"),
+			expPayoutProfit: sdk.MustNewDecFromStr("7916842.000000000000000000"),
+		},
+		{
+			desc: "hong kong",
+			request: &types.QueryCalcPayoutRequest{
+				OddsType:  types.OddsType_ODDS_TYPE_HONG_KONG,
+				OddsValue: "0.75",
+				Amount:    sdk.NewInt(3564819),
+			},
+			expPayout:       sdk.MustNewDecFromStr("6238433.25"),
+			expPayoutProfit: sdk.MustNewDecFromStr("2673614.25"),
+		},
+		{
+			desc: "invalid odds value",
+			request: &types.QueryCalcPayoutRequest{
+				OddsType:  types.OddsType_ODDS_TYPE_MALAY,
+				OddsValue: "1.5",
+				Amount:    sdk.NewInt(1000),
+			},
+			err: types.ErrMalayOddsOutOfRange,
+		},
+		{
+			desc: "invalid amount",
+			request: &types.QueryCalcPayoutRequest{
+				OddsType:  types.OddsType_ODDS_TYPE_DECIMAL,
+				OddsValue: "1.5",
+				Amount:    sdk.ZeroInt(),
+			},
+			err: status.Error(codes.InvalidArgument, types.ErrInvalidAmount.Error()),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := k.CalcPayout(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorContains(t, err, tc.err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expPayout, response.Payout)
+			require.Equal(t, tc.expPayoutProfit, response.PayoutProfit)
+			require.Len(t, response.Conversions, len(types.SupportedOddsTypes))
+			for _, conversion := range response.Conversions {
+				if conversion.OddsType == tc.request.OddsType {
+					require.Equal(t, tc.request.OddsValue, conversion.OddsValue)
+					require.Equal(t, tc.expPayoutProfit, conversion.PayoutProfit)
+				}
+			}
+		})
+	}
+}
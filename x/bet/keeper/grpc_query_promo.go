@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sge-network/sge/consts"
+	"github.com/sge-network/sge/x/bet/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FreeBetCredits returns the free bet credits of a bettor
+func (k Keeper) FreeBetCredits(
+	c context.Context,
+	req *types.QueryFreeBetCreditsRequest,
+) (*types.QueryFreeBetCreditsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var credits []types.FreeBetCredit
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := k.getFreeBetCreditByAddressStore(ctx, req.Address)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var credit types.FreeBetCredit
+		if err := k.cdc.Unmarshal(value, &credit); err != nil {
+			return err
+		}
+
+		credits = append(credits, credit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFreeBetCreditsResponse{Credits: credits, Pagination: pageRes}, nil
+}
+
+// FreeBetCredit returns a free bet credit of a bettor by its uid
+func (k Keeper) FreeBetCredit(
+	c context.Context,
+	req *types.QueryFreeBetCreditRequest,
+) (*types.QueryFreeBetCreditResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	credit, found := k.GetFreeBetCredit(ctx, req.Address, req.Uid)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "free bet credit %s of %s not found", req.Uid, req.Address)
+	}
+
+	return &types.QueryFreeBetCreditResponse{Credit: credit}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cast"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sge-network/sge/app/params"
 	simappUtil "github.com/sge-network/sge/testutil/simapp"
 	sgetypes "github.com/sge-network/sge/types"
 	"github.com/sge-network/sge/x/bet/keeper"
@@ -59,6 +60,7 @@ var (
 		EndTS:   uint64(time.Now().Unix()) + 5000,
 		Odds:    testMarketOdds,
 		Status:  markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		Denom:   params.DefaultBondDenom,
 	}
 )
 
@@ -145,6 +147,16 @@ func placeTestBet(
 	betUID string,
 	selectedOdds *types.BetOdds,
 ) {
+	require.Nil(t, wagerTestBet(ctx, t, tApp, betUID, selectedOdds))
+}
+
+func wagerTestBet(
+	ctx sdk.Context,
+	t testing.TB,
+	tApp *simappUtil.TestApp,
+	betUID string,
+	selectedOdds *types.BetOdds,
+) error {
 	testCreator = simappUtil.TestParamUsers["user1"].Address.String()
 	wctx := sdk.WrapSDKContext(ctx)
 	betSrv := keeper.NewMsgServerImpl(*tApp.BetKeeper)
@@ -176,9 +188,8 @@ func placeTestBet(
 			Ticket: testWagerTicket,
 		},
 	}
-	resWagerBet, err := betSrv.Wager(wctx, testBet)
-	require.Nil(t, err)
-	require.NotNil(t, resWagerBet)
+	_, err = betSrv.Wager(wctx, testBet)
+	return err
 }
 
 func createJwtTicket(claim jwt.MapClaims) (string, error) {
@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	"github.com/sge-network/sge/utils"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+// SetBettorLimits sets the responsible gambling settings of a bettor in the store
+func (k Keeper) SetBettorLimits(ctx sdk.Context, limits types.BettorLimits) {
+	store := k.getBettorLimitsStore(ctx)
+	b := k.cdc.MustMarshal(&limits)
+	store.Set(utils.StrBytes(limits.Address), b)
+}
+
+// GetBettorLimits returns the responsible gambling settings of a bettor,
+// the settings are empty if the bettor has not set any yet.
+func (k Keeper) GetBettorLimits(ctx sdk.Context, address string) (val types.BettorLimits) {
+	store := k.getBettorLimitsStore(ctx)
+
+	b := store.Get(utils.StrBytes(address))
+	if b == nil {
+		return types.BettorLimits{Address: address}
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllBettorLimits returns the responsible gambling settings of all of the bettors
+func (k Keeper) GetAllBettorLimits(ctx sdk.Context) (list []types.BettorLimits, err error) {
+	store := k.getBettorLimitsStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BettorLimits
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetBettorDailyTotals sets the daily totals of a bettor in the store
+func (k Keeper) SetBettorDailyTotals(ctx sdk.Context, totals types.BettorDailyTotals) {
+	store := k.getBettorDailyTotalsStore(ctx)
+	b := k.cdc.MustMarshal(&totals)
+	store.Set(types.BettorDailyTotalsKey(totals.Address, totals.Denom, totals.Day), b)
+}
+
+// GetBettorDailyTotals returns the totals of a bettor in a certain denom and day,
+// the totals are zero if the bettor has no activity in the day.
+func (k Keeper) GetBettorDailyTotals(ctx sdk.Context, address, denom string, day uint64) (val types.BettorDailyTotals) {
+	store := k.getBettorDailyTotalsStore(ctx)
+
+	b := store.Get(types.BettorDailyTotalsKey(address, denom, day))
+	if b == nil {
+		return types.BettorDailyTotals{
+			Address: address,
+			Denom:   denom,
+			Day:     day,
+			Stake:   sdk.ZeroInt(),
+			NetLoss: sdk.ZeroInt(),
+		}
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllBettorDailyTotals returns the daily totals of all of the bettors
+func (k Keeper) GetAllBettorDailyTotals(ctx sdk.Context) (list []types.BettorDailyTotals, err error) {
+	store := k.getBettorDailyTotalsStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BettorDailyTotals
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetSelfExclusion excludes the bettor from wagering until the given unix timestamp.
+func (k Keeper) SetSelfExclusion(ctx sdk.Context, address string, until int64) error {
+	limits := k.GetBettorLimits(ctx, address)
+	if err := limits.SetSelfExclusion(until, ctx.BlockTime().Unix()); err != nil {
+		return err
+	}
+
+	k.SetBettorLimits(ctx, limits)
+	return nil
+}
+
+// SetBettorLimit sets a stake or net loss limit of the bettor, the loosened
+// limit takes effect after the cooling-off period of the params.
+func (k Keeper) SetBettorLimit(
+	ctx sdk.Context,
+	address string,
+	limitType types.LimitType,
+	period types.LimitPeriod,
+	denom string,
+	amount sdkmath.Int,
+) (types.BettorLimit, error) {
+	limits := k.GetBettorLimits(ctx, address)
+
+	coolingOffPeriod := cast.ToInt64(k.GetParams(ctx).LimitCoolingOffPeriod)
+	limit, err := limits.SetLimit(limitType, period, denom, amount, ctx.BlockTime().Unix(), coolingOffPeriod)
+	if err != nil {
+		return types.BettorLimit{}, err
+	}
+
+	k.SetBettorLimits(ctx, limits)
+	return limit, nil
+}
+
+// checkBettorLimits checks the self-exclusion and the stake and net loss
+// limits of the bettor for a new bet amount.
+func (k Keeper) checkBettorLimits(ctx sdk.Context, address, denom string, amount sdkmath.Int) error {
+	limits := k.GetBettorLimits(ctx, address)
+	if limits.SelfExcludedUntil == 0 && len(limits.Limits) == 0 {
+		return nil
+	}
+
+	today := types.DayOfTimestamp(ctx.BlockTime().Unix())
+	dailyTotals := k.getRecentBettorDailyTotals(ctx, address, denom, today)
+
+	return limits.CheckWager(denom, amount, ctx.BlockTime().Unix(),
+		func(period types.LimitPeriod) (stake, netLoss sdkmath.Int) {
+			stake, netLoss = sdk.ZeroInt(), sdk.ZeroInt()
+			for _, totals := range dailyTotals {
+				if totals.Day+period.Days() > today {
+					stake = stake.Add(totals.Stake)
+					netLoss = netLoss.Add(totals.NetLoss)
+				}
+			}
+			return stake, netLoss
+		},
+	)
+}
+
+// getRecentBettorDailyTotals returns the daily totals of the bettor in the longest limit period.
+func (k Keeper) getRecentBettorDailyTotals(ctx sdk.Context, address, denom string, today uint64) (list []types.BettorDailyTotals) {
+	store := prefix.NewStore(k.getBettorDailyTotalsStore(ctx), types.BettorDailyTotalsPrefix(address, denom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BettorDailyTotals
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if val.Day+types.MaxLimitPeriodDays > today {
+			list = append(list, val)
+		}
+	}
+
+	return list
+}
+
+// updateBettorDailyTotals adds the stake and net loss to the totals of the bettor in the given day,
+// the totals that are older than the longest limit period are removed from the store. The totals
+// are recorded only if the bettor has a limit in the denom, or the totals of the day are already
+// recorded so that the reverted stakes and the returns stay consistent after a limit is removed.
+func (k Keeper) updateBettorDailyTotals(
+	ctx sdk.Context,
+	address, denom string,
+	day uint64,
+	stake, netLoss sdkmath.Int,
+) {
+	today := types.DayOfTimestamp(ctx.BlockTime().Unix())
+	if day+types.MaxLimitPeriodDays <= today {
+		return
+	}
+
+	if !k.GetBettorLimits(ctx, address).HasLimitOfDenom(denom, ctx.BlockTime().Unix()) &&
+		!k.getBettorDailyTotalsStore(ctx).Has(types.BettorDailyTotalsKey(address, denom, day)) {
+		return
+	}
+
+	k.pruneBettorDailyTotals(ctx, address, denom, today)
+
+	totals := k.GetBettorDailyTotals(ctx, address, denom, day)
+	totals.Stake = sdk.MaxInt(totals.Stake.Add(stake), sdk.ZeroInt())
+	totals.NetLoss = totals.NetLoss.Add(netLoss)
+	k.SetBettorDailyTotals(ctx, totals)
+}
+
+// pruneBettorDailyTotals removes the totals of the bettor that are older than the longest limit period.
+func (k Keeper) pruneBettorDailyTotals(ctx sdk.Context, address, denom string, today uint64) {
+	if today < types.MaxLimitPeriodDays {
+		return
+	}
+
+	store := prefix.NewStore(k.getBettorDailyTotalsStore(ctx), types.BettorDailyTotalsPrefix(address, denom))
+	iterator := store.Iterator(nil, utils.Uint64ToBytes(today-types.MaxLimitPeriodDays+1))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// addBettorStake adds the wagered amount to the stake and the net loss totals of the bettor,
+// the bet amount is counted as a loss until the bet is settled, the free bets are not counted.
+func (k Keeper) addBettorStake(ctx sdk.Context, bet *types.Bet) {
+	if bet.IsFreeBet() {
+		return
+	}
+
+	day := types.DayOfTimestamp(bet.CreatedAt)
+	k.updateBettorDailyTotals(ctx, bet.Creator, bet.Denom, day, bet.Amount, bet.Amount)
+}
+
+// revertBettorStake reverts the stake and the net loss of a canceled bet from the totals of
+// the day that the bet is placed in.
+func (k Keeper) revertBettorStake(ctx sdk.Context, bet *types.Bet) {
+	if bet.IsFreeBet() {
+		return
+	}
+
+	day := types.DayOfTimestamp(bet.CreatedAt)
+	k.updateBettorDailyTotals(ctx, bet.Creator, bet.Denom, day, bet.Amount.Neg(), bet.Amount.Neg())
+}
+
+// addBettorReturn subtracts the amount returned to the bettor by the settlement
+// of a bet from the net loss totals of the settlement day.
+func (k Keeper) addBettorReturn(ctx sdk.Context, bet *types.Bet, amount sdkmath.Int) {
+	if bet.IsFreeBet() || !amount.IsPositive() {
+		return
+	}
+
+	day := types.DayOfTimestamp(ctx.BlockTime().Unix())
+	k.updateBettorDailyTotals(ctx, bet.Creator, bet.Denom, day, sdk.ZeroInt(), amount.Neg())
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+func TestSelfExclusion(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0))
+	marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	selectedOdds := &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUID,
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	until := ctx.BlockTime().Unix() + 500
+	_, err := msgServer.SetSelfExclusion(sdk.WrapSDKContext(ctx), types.NewMsgSetSelfExclusion(bettorAddress, until))
+	require.NoError(t, err)
+
+	err = wagerTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+	require.ErrorContains(t, err, types.ErrBettorSelfExcluded.Error())
+
+	// the self-exclusion can not be shortened or set in the past
+	_, err = msgServer.SetSelfExclusion(sdk.WrapSDKContext(ctx), types.NewMsgSetSelfExclusion(bettorAddress, until-100))
+	require.ErrorContains(t, err, types.ErrSelfExclusionCannotBeShortened.Error())
+	_, err = msgServer.SetSelfExclusion(sdk.WrapSDKContext(ctx), types.NewMsgSetSelfExclusion(bettorAddress, ctx.BlockTime().Unix()-1))
+	require.ErrorContains(t, err, types.ErrInvalidSelfExclusion.Error())
+
+	res, err := k.BettorLimits(sdk.WrapSDKContext(ctx), &types.QueryBettorLimitsRequest{Address: bettorAddress})
+	require.NoError(t, err)
+	require.Equal(t, until, res.Limits.SelfExcludedUntil)
+
+	// the bettor is allowed to wager after the end of the self-exclusion
+	ctx = ctx.WithBlockTime(time.Unix(until, 0))
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+}
+
+func TestBettorStakeLimit(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0))
+	marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+
+	// the market should remain open for the whole test period
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUID)
+	require.True(t, found)
+	market.EndTS = uint64(ctx.BlockTime().Add(30 * 24 * time.Hour).Unix())
+	tApp.MarketKeeper.SetMarket(ctx, market)
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	selectedOdds := &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUID,
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	_, err := msgServer.SetBettorLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetBettorLimit(
+		bettorAddress, types.LimitType_LIMIT_TYPE_STAKE, types.LimitPeriod_LIMIT_PERIOD_DAILY, params.DefaultBondDenom, sdk.NewInt(1500000),
+	))
+	require.NoError(t, err)
+
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+
+	day := types.DayOfTimestamp(ctx.BlockTime().Unix())
+	totals := k.GetBettorDailyTotals(ctx, bettorAddress, params.DefaultBondDenom, day)
+	require.Equal(t, sdk.NewInt(999900).String(), totals.Stake.String())
+	require.Equal(t, sdk.NewInt(999900).String(), totals.NetLoss.String())
+
+	err = wagerTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+	require.ErrorContains(t, err, types.ErrBettorLimitExceeded.Error())
+
+	// the loosened limit waits for the cooling-off period
+	res, err := msgServer.SetBettorLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetBettorLimit(
+		bettorAddress, types.LimitType_LIMIT_TYPE_STAKE, types.LimitPeriod_LIMIT_PERIOD_DAILY, params.DefaultBondDenom, sdk.NewInt(3000000),
+	))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1500000).String(), res.Limit.Amount.String())
+	require.Equal(t, sdk.NewInt(3000000).String(), res.Limit.PendingAmount.String())
+
+	err = wagerTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+	require.ErrorContains(t, err, types.ErrBettorLimitExceeded.Error())
+
+	coolingOffPeriod := int64(k.GetParams(ctx).LimitCoolingOffPeriod)
+	require.Equal(t, ctx.BlockTime().Unix()+coolingOffPeriod, res.Limit.PendingEffectiveAt)
+
+	// the daily total of the previous days is not counted in the daily limit
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+
+	err = wagerTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+	require.ErrorContains(t, err, types.ErrBettorLimitExceeded.Error())
+
+	// the monthly limit counts the totals of the previous days
+	_, err = msgServer.SetBettorLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetBettorLimit(
+		bettorAddress, types.LimitType_LIMIT_TYPE_STAKE, types.LimitPeriod_LIMIT_PERIOD_MONTHLY, params.DefaultBondDenom, sdk.NewInt(2500000),
+	))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(time.Unix(res.Limit.PendingEffectiveAt, 0))
+	err = wagerTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+	require.ErrorContains(t, err, types.ErrBettorLimitExceeded.Error())
+
+	// the limit is removed by setting zero amount after the cooling-off period
+	_, err = msgServer.SetBettorLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetBettorLimit(
+		bettorAddress, types.LimitType_LIMIT_TYPE_STAKE, types.LimitPeriod_LIMIT_PERIOD_MONTHLY, params.DefaultBondDenom, sdk.ZeroInt(),
+	))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(coolingOffPeriod) * time.Second))
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+
+	limitsRes, err := k.BettorLimits(sdk.WrapSDKContext(ctx), &types.QueryBettorLimitsRequest{Address: bettorAddress})
+	require.NoError(t, err)
+	require.Len(t, limitsRes.Limits.Limits, 1)
+	require.Equal(t, sdk.NewInt(3000000).String(), limitsRes.Limits.Limits[0].Amount.String())
+	require.False(t, limitsRes.Limits.Limits[0].HasPendingChange())
+}
+
+func TestBettorNetLossLimit(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0))
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 2)
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	_, err := msgServer.SetBettorLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetBettorLimit(
+		bettorAddress, types.LimitType_LIMIT_TYPE_NET_LOSS, types.LimitPeriod_LIMIT_PERIOD_MONTHLY, params.DefaultBondDenom, sdk.NewInt(1500000),
+	))
+	require.NoError(t, err)
+
+	betUID := uuid.NewString()
+	placeTestBet(ctx, t, tApp, betUID, &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUIDs[0],
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	})
+
+	selectedOdds := &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUIDs[1],
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	// the pending bet amount is counted as a loss
+	err = wagerTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+	require.ErrorContains(t, err, types.ErrBettorLimitExceeded.Error())
+
+	// the won amount is subtracted from the net loss on the settlement
+	resolveTestMarketWithOutcome(t, tApp, ctx, marketUIDs[0], markettypes.OddsResult_ODDS_RESULT_WIN)
+	err = k.Settle(ctx, bettorAddress, betUID)
+	require.NoError(t, err)
+
+	day := types.DayOfTimestamp(ctx.BlockTime().Unix())
+	totals := k.GetBettorDailyTotals(ctx, bettorAddress, params.DefaultBondDenom, day)
+	require.Equal(t, sdk.NewInt(999900).String(), totals.Stake.String())
+	require.Equal(t, sdk.NewInt(-899910).String(), totals.NetLoss.String())
+
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+}
+
+func TestBettorDailyTotalsWithoutLimit(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0))
+	marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	selectedOdds := &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUID,
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	// the totals are not recorded for the bettors without limits
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+
+	allTotals, err := k.GetAllBettorDailyTotals(ctx)
+	require.NoError(t, err)
+	require.Empty(t, allTotals)
+
+	// the totals are recorded after a limit is set
+	_, err = msgServer.SetBettorLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetBettorLimit(
+		bettorAddress, types.LimitType_LIMIT_TYPE_STAKE, types.LimitPeriod_LIMIT_PERIOD_DAILY, params.DefaultBondDenom, sdk.NewInt(3000000),
+	))
+	require.NoError(t, err)
+
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+
+	day := types.DayOfTimestamp(ctx.BlockTime().Unix())
+	totals := k.GetBettorDailyTotals(ctx, bettorAddress, params.DefaultBondDenom, day)
+	require.Equal(t, sdk.NewInt(999900).String(), totals.Stake.String())
+
+	// the totals remain recorded in the pending removal of the limit
+	_, err = msgServer.SetBettorLimit(sdk.WrapSDKContext(ctx), types.NewMsgSetBettorLimit(
+		bettorAddress, types.LimitType_LIMIT_TYPE_STAKE, types.LimitPeriod_LIMIT_PERIOD_DAILY, params.DefaultBondDenom, sdk.ZeroInt(),
+	))
+	require.NoError(t, err)
+
+	placeTestBet(ctx, t, tApp, uuid.NewString(), selectedOdds)
+
+	totals = k.GetBettorDailyTotals(ctx, bettorAddress, params.DefaultBondDenom, day)
+	require.Equal(t, sdk.NewInt(1999800).String(), totals.Stake.String())
+}
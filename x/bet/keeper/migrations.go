@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sge-network/sge/app/params"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, the missing params and the
+// missing fee fields of the stored wager constraints are set to the
+// default values and the bets without denom are set to the bond denom.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	moduleParams := types.DefaultParams()
+	defaultConstraints := moduleParams.Constraints
+
+	// the stored constraints are decoded into the empty values to keep the
+	// default values untouched, the wager constraints of version 1 do not
+	// contain the fee fields.
+	moduleParams.Constraints = types.Constraints{}
+	m.keeper.paramstore.GetParamSetIfExists(ctx, &moduleParams)
+
+	if moduleParams.Constraints.MinAmount.IsNil() {
+		moduleParams.Constraints.MinAmount = defaultConstraints.MinAmount
+	}
+	if moduleParams.Constraints.Fee.IsNil() {
+		moduleParams.Constraints.Fee = defaultConstraints.Fee
+	}
+	if moduleParams.Constraints.FeeRate.IsNil() {
+		moduleParams.Constraints.FeeRate = defaultConstraints.FeeRate
+	}
+	if moduleParams.Constraints.MinFee.IsNil() {
+		moduleParams.Constraints.MinFee = defaultConstraints.MinFee
+	}
+	if moduleParams.Constraints.MaxFee.IsNil() {
+		moduleParams.Constraints.MaxFee = defaultConstraints.MaxFee
+	}
+
+	if err := moduleParams.Validate(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, moduleParams)
+
+	bets, err := m.keeper.GetBets(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, bet := range bets {
+		if bet.Denom != "" {
+			continue
+		}
+
+		uid2ID, found := m.keeper.GetBetID(ctx, bet.UID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrNoMatchingBet, "%s", bet.UID)
+		}
+
+		bet.Denom = params.DefaultBondDenom
+		m.keeper.SetBet(ctx, bet, uid2ID.ID)
+	}
+
+	return nil
+}
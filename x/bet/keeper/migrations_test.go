@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+
+	originalParams := k.GetParams(ctx)
+	t.Cleanup(func() { k.SetParams(ctx, originalParams) })
+
+	// the params of version 1 do not have the new keys and the fee
+	// fields of the wager constraints
+	paramStore := prefix.NewStore(ctx.KVStore(tApp.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range []string{"LimitCoolingOffPeriod", "MaxWagerBatchCount", "InPlayDelayBlocks", "InPlayDelaySeconds", "DelayedBetBatchCount"} {
+		paramStore.Delete([]byte(key))
+	}
+	paramStore.Set([]byte("WagerConstraints"), []byte(`{"min_amount":"3000000","fee":"300"}`))
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	items := createNBet(tApp, k, ctx, 3)
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+
+	defaultParams := types.DefaultParams()
+	moduleParams := k.GetParams(ctx)
+	require.Equal(t, defaultParams.LimitCoolingOffPeriod, moduleParams.LimitCoolingOffPeriod)
+	require.Equal(t, defaultParams.MaxWagerBatchCount, moduleParams.MaxWagerBatchCount)
+	require.Equal(t, defaultParams.DelayedBetBatchCount, moduleParams.DelayedBetBatchCount)
+	require.Equal(t, sdk.NewInt(3000000).String(), moduleParams.Constraints.MinAmount.String())
+	require.Equal(t, sdk.NewInt(300).String(), moduleParams.Constraints.Fee.String())
+	require.True(t, moduleParams.Constraints.FeeRate.IsZero())
+	require.True(t, moduleParams.Constraints.MinFee.IsZero())
+	require.True(t, moduleParams.Constraints.MaxFee.IsZero())
+
+	for i, item := range items {
+		bet, found := k.GetBet(ctx, item.Creator, uint64(i+1))
+		require.True(t, found)
+		require.Equal(t, params.DefaultBondDenom, bet.Denom)
+	}
+}
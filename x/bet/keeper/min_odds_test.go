@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	sgetypes "github.com/sge-network/sge/types"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func TestWagerMinOdds(t *testing.T) {
+	for _, tc := range []struct {
+		desc         string
+		parlay       bool
+		minOddsType  types.OddsType
+		minOddsValue string
+		err          error
+	}{
+		{
+			desc: "min odds not set",
+		},
+		{
+			desc:         "ticket odds higher than min odds",
+			minOddsType:  types.OddsType_ODDS_TYPE_DECIMAL,
+			minOddsValue: "1.85",
+		},
+		{
+			desc:         "ticket odds equal to min odds of other odds type",
+			minOddsType:  types.OddsType_ODDS_TYPE_FRACTIONAL,
+			minOddsValue: "9/10",
+		},
+		{
+			desc:         "ticket odds lower than moneyline min odds",
+			minOddsType:  types.OddsType_ODDS_TYPE_MONEYLINE,
+			minOddsValue: "-110",
+			err:          types.ErrOddsLowerThanMinOdds,
+		},
+		{
+			desc:         "ticket odds lower than malay min odds",
+			minOddsType:  types.OddsType_ODDS_TYPE_MALAY,
+			minOddsValue: "0.95",
+			err:          types.ErrOddsLowerThanMinOdds,
+		},
+		{
+			desc:         "parlay combined odds higher than min odds",
+			parlay:       true,
+			minOddsType:  types.OddsType_ODDS_TYPE_DECIMAL,
+			minOddsValue: "4",
+		},
+		{
+			desc:         "parlay combined odds lower than min odds",
+			parlay:       true,
+			minOddsType:  types.OddsType_ODDS_TYPE_HONG_KONG,
+			minOddsValue: "3.5",
+			err:          types.ErrOddsLowerThanMinOdds,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, k, ctx := setupKeeperAndApp(t)
+			marketUIDs := setupParlayMarkets(t, tApp, ctx, 2)
+			bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+
+			claims := jwt.MapClaims{
+				"exp": 9999999999,
+				"iat": 7777777777,
+				"kyc_data": &sgetypes.KycDataPayload{
+					Approved: true,
+					ID:       bettorAddress,
+				},
+				"odds_type": 1,
+			}
+			if tc.parlay {
+				var legs []types.WagerTicketLeg
+				for _, marketUID := range marketUIDs {
+					legs = append(legs, types.WagerTicketLeg{
+						SelectedOdds: &types.BetOdds{
+							UID:               testOddsUID1,
+							MarketUID:         marketUID,
+							Value:             "2.00",
+							MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+						},
+						AllOdds: []*types.BetOddsCompact{
+							{UID: testOddsUID1, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+							{UID: testOddsUID2, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+							{UID: testOddsUID3, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+						},
+					})
+				}
+				claims["parlay_legs"] = legs
+			} else {
+				claims["selected_odds"] = &types.BetOdds{
+					UID:               testOddsUID1,
+					MarketUID:         marketUIDs[0],
+					Value:             "1.90",
+					MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+				}
+				claims["all_odds"] = testBetOdds
+			}
+			ticket, err := createJwtTicket(claims)
+			require.NoError(t, err)
+
+			betSrv := keeper.NewMsgServerImpl(*k)
+			_, err = betSrv.Wager(sdk.WrapSDKContext(ctx), &types.MsgWager{
+				Creator: bettorAddress,
+				Props: &types.WagerProps{
+					UID:          uuid.NewString(),
+					Amount:       sdk.NewInt(1000000),
+					Ticket:       ticket,
+					MinOddsType:  tc.minOddsType,
+					MinOddsValue: tc.minOddsValue,
+				},
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, found := k.GetBet(ctx, bettorAddress, 1)
+			require.True(t, found)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func (k msgServer) SetAffiliate(
+	goCtx context.Context,
+	msg *types.MsgSetAffiliate,
+) (*types.MsgSetAffiliateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payload := &types.SetAffiliateTicketPayload{}
+	err := k.ovmKeeper.VerifyTicketUnmarshal(sdk.WrapSDKContext(ctx), msg.Ticket, &payload)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
+	}
+
+	if err = payload.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketValidation, "%s", err)
+	}
+
+	affiliate := types.NewAffiliate(payload.Address, payload.FeeShare)
+	k.Keeper.SetAffiliate(ctx, affiliate)
+
+	msg.EmitEvent(&ctx, affiliate)
+
+	return &types.MsgSetAffiliateResponse{Affiliate: affiliate}, nil
+}
+
+func (k msgServer) SetReferrer(
+	goCtx context.Context,
+	msg *types.MsgSetReferrer,
+) (*types.MsgSetReferrerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	referral, err := k.Keeper.SetReferrer(ctx, msg.Creator, msg.Referrer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInSetReferrer, "%s", err)
+	}
+
+	msg.EmitEvent(&ctx)
+
+	return &types.MsgSetReferrerResponse{Referral: referral}, nil
+}
+
+func (k msgServer) BindReferrer(
+	goCtx context.Context,
+	msg *types.MsgBindReferrer,
+) (*types.MsgBindReferrerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payload := &types.BindReferrerTicketPayload{}
+	err := k.ovmKeeper.VerifyTicketUnmarshal(sdk.WrapSDKContext(ctx), msg.Ticket, &payload)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
+	}
+
+	if err = payload.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketValidation, "%s", err)
+	}
+
+	referral, err := k.Keeper.SetReferrer(ctx, payload.Bettor, payload.Referrer)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInSetReferrer, "%s", err)
+	}
+
+	msg.EmitEvent(&ctx, referral)
+
+	return &types.MsgBindReferrerResponse{Referral: referral}, nil
+}
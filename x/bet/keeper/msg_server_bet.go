@@ -14,29 +14,204 @@ func (k msgServer) Wager(
 ) (*types.MsgWagerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.wager(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	msg.EmitEvent(&ctx)
+
+	return &types.MsgWagerResponse{Props: msg.Props}, nil
+}
+
+func (k msgServer) WagerBatch(
+	goCtx context.Context,
+	msg *types.MsgWagerBatch,
+) (*types.MsgWagerBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if maxCount := k.GetParams(ctx).MaxWagerBatchCount; len(msg.Props) > int(maxCount) {
+		return nil, sdkerrors.Wrapf(types.ErrWagerBatchCountExceeded, "%d > %d", len(msg.Props), maxCount)
+	}
+
+	results := make([]types.WagerBatchResult, 0, len(msg.Props))
+	for i, props := range msg.Props {
+		wagerMsg := types.NewMsgWager(msg.Creator, *props)
+
+		// each bet is placed in a cached context to discard the state changes of the failed bets
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.wager(cacheCtx, wagerMsg); err != nil {
+			if msg.IsAtomic() {
+				return nil, sdkerrors.Wrapf(types.ErrInWagerBatch, "bet %d %s: %s", i, props.UID, err)
+			}
+
+			results = append(results, types.WagerBatchResult{UID: props.UID, Error: err.Error()})
+			continue
+		}
+
+		wagerMsg.EmitEvent(&cacheCtx)
+		writeCache()
+
+		results = append(results, types.WagerBatchResult{UID: props.UID, Success: true})
+	}
+
+	return &types.MsgWagerBatchResponse{Results: results}, nil
+}
+
+// wager verifies the ticket of the wager message and places the single or parlay bet.
+func (k msgServer) wager(ctx sdk.Context, msg *types.MsgWager) error {
 	// Check if the value already exists
 	_, isFound := k.GetBetID(ctx, msg.Props.UID)
 	if isFound {
-		return nil, sdkerrors.Wrapf(types.ErrDuplicateUID, "%s", msg.Props.UID)
+		return sdkerrors.Wrapf(types.ErrDuplicateUID, "%s", msg.Props.UID)
 	}
 
 	payload := &types.WagerTicketPayload{}
 	err := k.ovmKeeper.VerifyTicketUnmarshal(sdk.WrapSDKContext(ctx), msg.Props.Ticket, &payload)
 	if err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
+		return sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
 	}
 
 	if err = payload.Validate(msg.Creator); err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrInTicketValidation, "%s", err)
+		return sdkerrors.Wrapf(types.ErrInTicketValidation, "%s", err)
+	}
+
+	if payload.IsParlay() {
+		if msg.Props.HasMinFillRatio() {
+			return types.ErrPartialFillNotAllowedForParlay
+		}
+
+		if msg.Props.FreeBetCreditUID != "" {
+			return types.ErrFreeBetNotAllowedForParlay
+		}
+
+		bet, err := types.NewParlayBet(msg.Creator, msg.Props, payload.OddsType, payload.ParlayLegs)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInTicketValidation, "%s", err)
+		}
+
+		// the combined odds of the parlay is compared with the minimum odds
+		if err := msg.Props.ValidateMinOdds(bet.OddsType, bet.OddsValue); err != nil {
+			return err
+		}
+
+		if err := k.Keeper.WagerParlay(ctx, bet, payload.LegOddsMaps()); err != nil {
+			return sdkerrors.Wrapf(types.ErrInWager, "%s", err)
+		}
+
+		return nil
 	}
 
 	bet := types.NewBet(msg.Creator, msg.Props, payload.OddsType, payload.SelectedOdds)
 
-	if err := k.Keeper.Wager(ctx, bet, payload.OddsMap()); err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrInWager, "%s", err)
+	if err := msg.Props.ValidateMinOdds(bet.OddsType, bet.OddsValue); err != nil {
+		return err
+	}
+
+	if err := k.Keeper.Wager(ctx, bet, payload.OddsMap(), msg.Props.MinFillRatio); err != nil {
+		return sdkerrors.Wrapf(types.ErrInWager, "%s", err)
+	}
+
+	return nil
+}
+
+func (k msgServer) CancelBet(
+	goCtx context.Context,
+	msg *types.MsgCancelBet,
+) (*types.MsgCancelBetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payload := &types.CancelBetTicketPayload{}
+	err := k.ovmKeeper.VerifyTicketUnmarshal(sdk.WrapSDKContext(ctx), msg.Ticket, &payload)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
+	}
+
+	if err = payload.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketValidation, "%s", err)
+	}
+
+	if err := k.Keeper.CancelBet(ctx, msg.Creator, payload.UID, payload.RefundFee); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInBetCancellation, "%s", err)
+	}
+
+	msg.EmitEvent(&ctx, payload.UID)
+
+	return &types.MsgCancelBetResponse{UID: payload.UID}, nil
+}
+
+func (k msgServer) CashOut(
+	goCtx context.Context,
+	msg *types.MsgCashOut,
+) (*types.MsgCashOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payload := &types.CashOutTicketPayload{}
+	err := k.ovmKeeper.VerifyTicketUnmarshal(sdk.WrapSDKContext(ctx), msg.Ticket, &payload)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
+	}
+
+	if err = payload.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketValidation, "%s", err)
+	}
+
+	if err := k.Keeper.CashOut(ctx, msg.Creator, payload.UID, payload.Amount); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInBetCashOut, "%s", err)
+	}
+
+	msg.EmitEvent(&ctx, payload.UID, payload.Amount)
+
+	return &types.MsgCashOutResponse{UID: payload.UID, Amount: payload.Amount}, nil
+}
+
+func (k msgServer) VoidBets(
+	goCtx context.Context,
+	msg *types.MsgVoidBets,
+) (*types.MsgVoidBetsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payload := &types.VoidBetsTicketPayload{}
+	err := k.ovmKeeper.VerifyTicketUnmarshal(sdk.WrapSDKContext(ctx), msg.Ticket, &payload)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
+	}
+
+	if err = payload.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketValidation, "%s", err)
+	}
+
+	uids := make([]string, 0, len(payload.Bets))
+	for _, bet := range payload.Bets {
+		if err := k.Keeper.VoidBet(ctx, bet.Creator, bet.UID); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInBetVoiding, "%s: %s", bet.UID, err)
+		}
+		uids = append(uids, bet.UID)
+	}
+
+	msg.EmitEvent(&ctx, payload.Bets, payload.Reason)
+
+	return &types.MsgVoidBetsResponse{UIDs: uids}, nil
+}
+
+func (k msgServer) SettleBets(
+	goCtx context.Context,
+	msg *types.MsgSettleBets,
+) (*types.MsgSettleBetsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if maxCount := k.GetParams(ctx).BatchSettlementCount; uint32(len(msg.Bets)) > maxCount {
+		return nil, sdkerrors.Wrapf(types.ErrSettleBetsCountExceeded, "%d > %d", len(msg.Bets), maxCount)
+	}
+
+	uids := make([]string, 0, len(msg.Bets))
+	for _, bet := range msg.Bets {
+		if err := k.Keeper.SettleOnDemand(ctx, bet.Creator, bet.UID); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInBetSettlement, "%s: %s", bet.UID, err)
+		}
+		uids = append(uids, bet.UID)
 	}
 
 	msg.EmitEvent(&ctx)
 
-	return &types.MsgWagerResponse{Props: msg.Props}, nil
+	return &types.MsgSettleBetsResponse{UIDs: uids}, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/golang-jwt/jwt"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sge-network/sge/app/params"
 	simappUtil "github.com/sge-network/sge/testutil/simapp"
 	sgetypes "github.com/sge-network/sge/types"
 	"github.com/sge-network/sge/x/bet/types"
@@ -141,6 +142,7 @@ func TestBetMsgServerWager(t *testing.T) {
 			EndTS:   uint64(ctx.BlockTime().Unix()) + 1000,
 			Odds:    testMarketOdds,
 			Status:  markettypes.MarketStatus_MARKET_STATUS_ACTIVE,
+			Denom:   params.DefaultBondDenom,
 		}
 
 		tApp.MarketKeeper.SetMarket(ctx, marketItem)
@@ -149,7 +151,7 @@ func TestBetMsgServerWager(t *testing.T) {
 		for _, v := range marketItem.Odds {
 			oddsUIDs = append(oddsUIDs, v.UID)
 		}
-		err = tApp.OrderbookKeeper.InitiateOrderBook(ctx, marketItem.UID, oddsUIDs)
+		err = tApp.OrderbookKeeper.InitiateOrderBook(ctx, marketItem.UID, params.DefaultBondDenom, oddsUIDs)
 		require.NoError(t, err)
 
 		_, err = tApp.OrderbookKeeper.InitiateOrderBookParticipation(
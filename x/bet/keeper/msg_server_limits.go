@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func (k msgServer) SetSelfExclusion(
+	goCtx context.Context,
+	msg *types.MsgSetSelfExclusion,
+) (*types.MsgSetSelfExclusionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetSelfExclusion(ctx, msg.Creator, msg.Until); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInSetSelfExclusion, "%s", err)
+	}
+
+	msg.EmitEvent(&ctx)
+
+	return &types.MsgSetSelfExclusionResponse{}, nil
+}
+
+func (k msgServer) SetBettorLimit(
+	goCtx context.Context,
+	msg *types.MsgSetBettorLimit,
+) (*types.MsgSetBettorLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	limit, err := k.Keeper.SetBettorLimit(ctx, msg.Creator, msg.LimitType, msg.Period, msg.Denom, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInSetBettorLimit, "%s", err)
+	}
+
+	msg.EmitEvent(&ctx)
+
+	return &types.MsgSetBettorLimitResponse{Limit: limit}, nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func (k msgServer) FundPromoPool(
+	goCtx context.Context,
+	msg *types.MsgFundPromoPool,
+) (*types.MsgFundPromoPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payload := &types.FundPromoPoolTicketPayload{}
+	err := k.ovmKeeper.VerifyTicketUnmarshal(sdk.WrapSDKContext(ctx), msg.Ticket, &payload)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
+	}
+
+	if err = payload.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketValidation, "%s", err)
+	}
+
+	if err := k.Keeper.FundPromoPool(ctx, msg.Creator, payload); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInFundPromoPool, "%s", err)
+	}
+
+	msg.EmitEvent(&ctx, payload.Denom, payload.Amount)
+
+	return &types.MsgFundPromoPoolResponse{}, nil
+}
+
+func (k msgServer) IssueFreeBet(
+	goCtx context.Context,
+	msg *types.MsgIssueFreeBet,
+) (*types.MsgIssueFreeBetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payload := &types.IssueFreeBetTicketPayload{}
+	err := k.ovmKeeper.VerifyTicketUnmarshal(sdk.WrapSDKContext(ctx), msg.Ticket, &payload)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
+	}
+
+	if err = payload.Validate(ctx.BlockTime().Unix()); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketValidation, "%s", err)
+	}
+
+	credit, err := k.IssueFreeBetCredit(ctx, payload)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInIssueFreeBet, "%s", err)
+	}
+
+	msg.EmitEvent(&ctx, credit)
+
+	return &types.MsgIssueFreeBetResponse{Credit: credit}, nil
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	sgetypes "github.com/sge-network/sge/types"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+	marketkeeper "github.com/sge-network/sge/x/market/keeper"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+func TestWagerOddsStatus(t *testing.T) {
+	tApp, _, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+	creator := simappUtil.TestParamUsers["user1"].Address.String()
+	marketSrv := marketkeeper.NewMsgServerImpl(*tApp.MarketKeeper)
+	betSrv := keeper.NewMsgServerImpl(*tApp.BetKeeper)
+
+	updateOdds := func(claims jwt.MapClaims) error {
+		market, found := tApp.MarketKeeper.GetMarket(ctx, marketUID)
+		require.True(t, found)
+
+		claims["uid"] = marketUID
+		claims["start_ts"] = market.StartTS
+		claims["end_ts"] = market.EndTS
+		claims["status"] = markettypes.MarketStatus_MARKET_STATUS_ACTIVE
+		claims["exp"] = 9999999999
+		claims["iat"] = 7777777777
+		ticket, err := createJwtTicket(claims)
+		require.NoError(t, err)
+
+		_, err = marketSrv.Update(sdk.WrapSDKContext(ctx), markettypes.NewMsgUpdate(creator, ticket))
+		return err
+	}
+
+	allOdds := append([]types.BetOdds{}, *testBetOdds...)
+	wager := func(oddsUID string) error {
+		ticket, err := createJwtTicket(jwt.MapClaims{
+			"exp": 9999999999,
+			"iat": 7777777777,
+			"selected_odds": &types.BetOdds{
+				UID:               oddsUID,
+				MarketUID:         marketUID,
+				Value:             "1.90",
+				MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+			},
+			"kyc_data":  &sgetypes.KycDataPayload{Approved: true, ID: creator},
+			"odds_type": 1,
+			"all_odds":  allOdds,
+		})
+		require.NoError(t, err)
+
+		_, err = betSrv.Wager(sdk.WrapSDKContext(ctx), &types.MsgWager{
+			Creator: creator,
+			Props: &types.WagerProps{
+				UID:    uuid.NewString(),
+				Amount: sdk.NewInt(1000000),
+				Ticket: ticket,
+			},
+		})
+		return err
+	}
+
+	// bets are not accepted on the suspended odds, the other odds are not affected
+	require.NoError(t, updateOdds(jwt.MapClaims{"suspended_odds_uids": []string{testOddsUID2}}))
+	err := wager(testOddsUID2)
+	require.ErrorContains(t, err, types.ErrOddsIsNotActive.Error())
+	require.NoError(t, wager(testOddsUID1))
+
+	require.NoError(t, updateOdds(jwt.MapClaims{"reactivated_odds_uids": []string{testOddsUID2}}))
+	require.NoError(t, wager(testOddsUID2))
+
+	// the new odds is fulfilled by the existing participations of the order book
+	newOddsUID := uuid.NewString()
+	require.NoError(t, updateOdds(jwt.MapClaims{
+		"new_odds": []markettypes.Odds{{UID: newOddsUID, Meta: "late runner"}},
+	}))
+	allOdds = append(allOdds, types.BetOdds{UID: newOddsUID, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")})
+	require.NoError(t, wager(newOddsUID))
+
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUID)
+	require.True(t, found)
+	require.Len(t, market.Odds, 4)
+	require.True(t, market.IsOddsActive(newOddsUID))
+
+	err = updateOdds(jwt.MapClaims{
+		"new_odds": []markettypes.Odds{{UID: newOddsUID, Meta: "late runner"}},
+	})
+	require.ErrorIs(t, err, markettypes.ErrInTicketPayloadValidation)
+
+	// the removed odds can not be reactivated and is pushed in the resolution
+	require.NoError(t, updateOdds(jwt.MapClaims{"removed_odds_uids": []string{newOddsUID}}))
+	err = wager(newOddsUID)
+	require.ErrorContains(t, err, types.ErrOddsIsNotActive.Error())
+
+	err = updateOdds(jwt.MapClaims{"reactivated_odds_uids": []string{newOddsUID}})
+	require.ErrorIs(t, err, markettypes.ErrInTicketPayloadValidation)
+
+	market, found = tApp.MarketKeeper.GetMarket(ctx, marketUID)
+	require.True(t, found)
+	require.Equal(t, markettypes.OddsResult_ODDS_RESULT_PUSH, market.OddsResult(newOddsUID))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/sge-network/sge/x/bet/types"
 )
 
@@ -16,7 +17,12 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
 
-// GetConstraints get bet constraint values of the bet wager constraints
-func (k Keeper) GetConstraints(ctx sdk.Context) (params types.Constraints) {
-	return k.GetParams(ctx).Constraints
+// GetConstraints get bet constraint values of the bet wager constraints of the denom,
+// the bets in a denom without constraints are not accepted.
+func (k Keeper) GetConstraints(ctx sdk.Context, denom string) (types.Constraints, error) {
+	constraints, found := k.GetParams(ctx).ConstraintsOfDenom(denom)
+	if !found {
+		return types.Constraints{}, sdkerrors.Wrapf(types.ErrDenomConstraintsNotFound, "%s", denom)
+	}
+	return constraints, nil
 }
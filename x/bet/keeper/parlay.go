@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+// SetParlayWaitingBet sets a parlay bet waiting for the rest of its legs
+func (k Keeper) SetParlayWaitingBet(
+	ctx sdk.Context,
+	waitingBet *types.PendingBet,
+	id uint64,
+	marketUID string,
+) {
+	store := k.getParlayWaitingBetStore(ctx)
+	b := k.cdc.MustMarshal(waitingBet)
+	store.Set(types.PendingBetOfMarketKey(marketUID, id), b)
+}
+
+// RemoveParlayWaitingBet removes a waiting parlay bet
+func (k Keeper) RemoveParlayWaitingBet(ctx sdk.Context, marketUID string, betID uint64) {
+	store := k.getParlayWaitingBetStore(ctx)
+	store.Delete(types.PendingBetOfMarketKey(marketUID, betID))
+}
+
+// IsAnyParlayWaitingBetForMarket checks if there is any waiting parlay bet for the market
+func (k Keeper) IsAnyParlayWaitingBetForMarket(ctx sdk.Context, marketUID string) (thereIs bool, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParlayWaitingBetListOfMarketPrefix(marketUID))
+
+	// create iterator for all existing records
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	// check if the iterator has any records
+	thereIs = iterator.Valid()
+
+	return
+}
+
+// GetParlayWaitingBets returns list of the waiting parlay bets
+func (k Keeper) GetParlayWaitingBets(ctx sdk.Context) (list []types.PendingBet, err error) {
+	store := k.getParlayWaitingBetStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingBet
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetDeferredBookSettlement marks the order book settlement of a market as deferred
+func (k Keeper) SetDeferredBookSettlement(ctx sdk.Context, marketUID string) {
+	store := k.getDeferredBookSettlementStore(ctx)
+	store.Set(utils.StrBytes(marketUID), utils.StrBytes(marketUID))
+}
+
+// IsBookSettlementDeferred checks if the order book settlement of a market is deferred
+func (k Keeper) IsBookSettlementDeferred(ctx sdk.Context, marketUID string) bool {
+	store := k.getDeferredBookSettlementStore(ctx)
+	return store.Has(utils.StrBytes(marketUID))
+}
+
+// RemoveDeferredBookSettlement removes the deferred order book settlement mark of a market
+func (k Keeper) RemoveDeferredBookSettlement(ctx sdk.Context, marketUID string) {
+	store := k.getDeferredBookSettlementStore(ctx)
+	store.Delete(utils.StrBytes(marketUID))
+}
+
+// GetDeferredBookSettlements returns list of the markets that their
+// order book settlement is deferred
+func (k Keeper) GetDeferredBookSettlements(ctx sdk.Context) (list []string, err error) {
+	store := k.getDeferredBookSettlementStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
+
+// settleParlay sets the result of the resolved legs of the parlay bet and settles the bet
+// if any of the legs is lost or all of the legs are resolved, otherwise the bet waits
+// for the rest of the legs to be resolved.
+func (k Keeper) settleParlay(ctx sdk.Context, bet types.Bet, betID uint64) error {
+	for _, leg := range bet.Legs {
+		if leg.Result != types.Bet_RESULT_PENDING {
+			continue
+		}
+
+		market, found := k.marketKeeper.GetMarket(ctx, leg.MarketUID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrNoMatchingMarket, "%s", leg.MarketUID)
+		}
+
+		leg.SetResult(&market)
+	}
+
+	if !bet.HasLostLeg() && !bet.AllLegsResolved() {
+		// move the bet from the pending list of the resolved markets to the waiting list.
+		for _, leg := range bet.Legs {
+			if leg.Result != types.Bet_RESULT_PENDING {
+				k.RemovePendingBet(ctx, leg.MarketUID, betID)
+				k.SetParlayWaitingBet(ctx, types.NewPendingBet(bet.UID, bet.Creator, leg.MarketUID), betID, leg.MarketUID)
+			}
+		}
+
+		k.SetBet(ctx, bet, betID)
+		return nil
+	}
+
+	bettorAddress, err := sdk.AccAddressFromBech32(bet.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if err := k.settleParlayLegs(ctx, &bet, bettorAddress); err != nil {
+		return err
+	}
+
+	bet.Status = types.Bet_STATUS_SETTLED
+	bet.SettlementHeight = ctx.BlockHeight()
+	k.SetBet(ctx, bet, betID)
+	k.SetSettledBet(ctx, types.NewSettledBet(bet.UID, bet.Creator), betID, ctx.BlockHeight())
+
+	for _, leg := range bet.Legs {
+		k.RemovePendingBet(ctx, leg.MarketUID, betID)
+		k.RemoveParlayWaitingBet(ctx, leg.MarketUID, betID)
+
+		if err := k.settleDeferredBook(ctx, leg.MarketUID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// settleParlayLegs calls order book functions for each of the legs of the parlay bet
+// according to the results of the legs. The bet amount of the refunded and pushed legs
+// is returned to the bettor and the rest of the legs remain in the parlay with the combined
+// odds of the remaining legs, the half won and half lost legs are counted in the combined
+// odds with the average of their odds and one, and one half respectively.
+func (k Keeper) settleParlayLegs(ctx sdk.Context, bet *types.Bet, bettorAddress sdk.AccAddress) error {
+	refundedAmount := sdk.ZeroInt()
+	refundedPayoutProfit := sdk.ZeroInt()
+	var payingMarket *markettypes.Market
+	for _, leg := range bet.Legs {
+		if leg.Result != types.Bet_RESULT_REFUNDED && payingMarket == nil {
+			market, found := k.marketKeeper.GetMarket(ctx, leg.MarketUID)
+			if !found {
+				return sdkerrors.Wrapf(types.ErrNoMatchingMarket, "%s", leg.MarketUID)
+			}
+			payingMarket = &market
+		}
+		if !leg.IsRefunded() {
+			continue
+		}
+		for _, bf := range leg.BetFulfillment {
+			refundedAmount = refundedAmount.Add(bf.BetAmount)
+			refundedPayoutProfit = refundedPayoutProfit.Add(bf.PayoutProfit)
+		}
+	}
+
+	// all of the legs are refunded so the whole bet is refunded.
+	if payingMarket == nil {
+		payoutProfit, err := types.CalculatePayoutProfit(bet.OddsType, bet.OddsValue, bet.Amount)
+		if err != nil {
+			return err
+		}
+
+		if err := k.orderbookKeeper.RefundBettor(ctx, bettorAddress, bet.Amount, bet.Fee, payoutProfit.TruncateInt(), bet.UID, bet.Denom); err != nil {
+			return sdkerrors.Wrapf(types.ErrInOBRefund, "%s", err)
+		}
+
+		k.addBettorReturn(ctx, bet, bet.Amount)
+
+		bet.Result = types.Bet_RESULT_REFUNDED
+		return nil
+	}
+
+	if refundedAmount.IsPositive() {
+		if err := k.orderbookKeeper.RefundBettor(ctx, bettorAddress, refundedAmount, sdk.ZeroInt(), refundedPayoutProfit, bet.UID, bet.Denom); err != nil {
+			return sdkerrors.Wrapf(types.ErrInOBRefund, "%s", err)
+		}
+	}
+
+	// the amount returned to the bettor by the refunded and the settled legs.
+	returnedAmount := refundedAmount
+
+	bet.Result = types.Bet_RESULT_WON
+	lossRatio := sdk.ZeroDec()
+	winRatio := sdk.OneDec()
+	if bet.HasLostLeg() {
+		bet.Result = types.Bet_RESULT_LOST
+		lossRatio = sdk.OneDec()
+	} else if refundedAmount.IsPositive() || bet.HasHalfResultLeg() || bet.HasDeadHeatLeg() {
+		effectiveOdds, err := bet.EffectiveParlayOdds()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case effectiveOdds.LT(sdk.OneDec()):
+			// the bettor gets back the remaining bet amount multiplied by the
+			// effective odds and the rest is lost.
+			bet.Result = types.Bet_RESULT_HALF_LOST
+			lossRatio = sdk.OneDec().Sub(effectiveOdds)
+		case effectiveOdds.Equal(sdk.OneDec()):
+			bet.Result = types.Bet_RESULT_PUSH
+			winRatio = sdk.ZeroDec()
+		default:
+			if bet.HasHalfResultLeg() {
+				bet.Result = types.Bet_RESULT_HALF_WON
+			}
+
+			// the payout profit of the remaining legs is scaled according to the
+			// remaining bet amount and the combined odds of the remaining legs.
+			fullPayoutProfit, err := types.CalculatePayoutProfit(bet.OddsType, bet.OddsValue, bet.Amount)
+			if err != nil {
+				return err
+			}
+
+			remainingPayoutProfit := fullPayoutProfit.Sub(sdk.NewDecFromInt(refundedPayoutProfit))
+			if remainingPayoutProfit.IsPositive() {
+				effectivePayoutProfit := sdk.NewDecFromInt(bet.Amount.Sub(refundedAmount)).Mul(effectiveOdds.Sub(sdk.OneDec()))
+				winRatio = sdk.MinDec(effectivePayoutProfit.Quo(remainingPayoutProfit), sdk.OneDec())
+			}
+		}
+	}
+
+	for _, leg := range bet.Legs {
+		if leg.IsRefunded() {
+			continue
+		}
+
+		if lossRatio.IsPositive() {
+			if err := k.orderbookKeeper.BettorLoses(ctx, bettorAddress, bet.Amount, sdk.ZeroInt(), bet.UID, leg.BetFulfillment, leg.MarketUID, lossRatio); err != nil {
+				return sdkerrors.Wrapf(types.ErrInOBBettorLoses, "%s", err)
+			}
+			returnedAmount = returnedAmount.Add(types.CalculateLostReturnedAmount(leg.BetFulfillment, lossRatio))
+			continue
+		}
+
+		if err := k.orderbookKeeper.BettorWins(ctx, bettorAddress, bet.Amount, sdk.ZeroInt(), bet.UID, leg.BetFulfillment, leg.MarketUID, winRatio, sdk.OneDec()); err != nil {
+			return sdkerrors.Wrapf(types.ErrInOBBettorWins, "%s", err)
+		}
+		returnedAmount = returnedAmount.Add(types.CalculateWonAmount(leg.BetFulfillment, winRatio, sdk.OneDec()))
+	}
+
+	k.addBettorReturn(ctx, bet, returnedAmount)
+
+	return k.withdrawBetFee(ctx, bet, sdk.MustAccAddressFromBech32(payingMarket.Creator))
+}
+
+// settleDeferredBook sets the order book of the market as unsettled resolved if its
+// settlement is deferred and there is not any parlay bet waiting for the market.
+func (k Keeper) settleDeferredBook(ctx sdk.Context, marketUID string) error {
+	if !k.IsBookSettlementDeferred(ctx, marketUID) {
+		return nil
+	}
+
+	waitingBetExists, err := k.IsAnyParlayWaitingBetForMarket(ctx, marketUID)
+	if err != nil {
+		return err
+	}
+	if waitingBetExists {
+		return nil
+	}
+
+	k.RemoveDeferredBookSettlement(ctx, marketUID)
+	if err := k.orderbookKeeper.SetOrderBookAsUnsettledResolved(ctx, marketUID); err != nil {
+		return fmt.Errorf("could not resolve orderbook %s %s", marketUID, err)
+	}
+
+	return nil
+}
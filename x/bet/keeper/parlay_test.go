@@ -0,0 +1,355 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	sgetypes "github.com/sge-network/sge/types"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+func setupParlayMarkets(t testing.TB, tApp *simappUtil.TestApp, ctx sdk.Context, count int) []string {
+	marketUIDs := addTestMarketBatch(t, tApp, ctx, count)
+	for _, marketUID := range marketUIDs {
+		for i := 2; i <= 3; i++ {
+			_, err := tApp.OrderbookKeeper.InitiateOrderBookParticipation(
+				ctx,
+				simappUtil.TestParamUsers["user"+cast.ToString(i)].Address,
+				marketUID,
+				sdk.NewInt(100000000),
+				sdk.NewInt(1),
+			)
+			require.NoError(t, err)
+		}
+	}
+	return marketUIDs
+}
+
+func placeTestParlayBet(
+	ctx sdk.Context,
+	t testing.TB,
+	tApp *simappUtil.TestApp,
+	betUID string,
+	marketUIDs []string,
+) {
+	testCreator = simappUtil.TestParamUsers["user1"].Address.String()
+	betSrv := keeper.NewMsgServerImpl(*tApp.BetKeeper)
+
+	var legs []types.WagerTicketLeg
+	for _, marketUID := range marketUIDs {
+		legs = append(legs, types.WagerTicketLeg{
+			SelectedOdds: &types.BetOdds{
+				UID:               testOddsUID1,
+				MarketUID:         marketUID,
+				Value:             "2.00",
+				MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+			},
+			AllOdds: []*types.BetOddsCompact{
+				{UID: testOddsUID1, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+				{UID: testOddsUID2, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+				{UID: testOddsUID3, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+			},
+		})
+	}
+
+	testWagerClaim := jwt.MapClaims{
+		"exp":         9999999999,
+		"iat":         7777777777,
+		"parlay_legs": legs,
+		"kyc_data": &sgetypes.KycDataPayload{
+			Approved: true,
+			ID:       testCreator,
+		},
+		"odds_type": 1,
+	}
+	testWagerTicket, err := createJwtTicket(testWagerClaim)
+	require.Nil(t, err)
+
+	resWagerBet, err := betSrv.Wager(sdk.WrapSDKContext(ctx), &types.MsgWager{
+		Creator: testCreator,
+		Props: &types.WagerProps{
+			UID:    betUID,
+			Amount: sdk.NewInt(1000000),
+			Ticket: testWagerTicket,
+		},
+	})
+	require.Nil(t, err)
+	require.NotNil(t, resWagerBet)
+}
+
+func resolveTestMarket(
+	t testing.TB,
+	tApp *simappUtil.TestApp,
+	ctx sdk.Context,
+	marketUID string,
+	status markettypes.MarketStatus,
+	winnerOddsUIDs []string,
+) {
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUID)
+	require.True(t, found)
+	tApp.MarketKeeper.Resolve(ctx, market, &markettypes.MarketResolutionTicketPayload{
+		UID:            marketUID,
+		ResolutionTS:   uint64(ctx.BlockTime().Unix()) + 10000,
+		WinnerOddsUIDs: winnerOddsUIDs,
+		Status:         status,
+	})
+}
+
+func resolveTestMarketWithOutcome(
+	t testing.TB,
+	tApp *simappUtil.TestApp,
+	ctx sdk.Context,
+	marketUID string,
+	oddsResult markettypes.OddsResult,
+) {
+	resolveTestMarketWithOutcomes(t, tApp, ctx, marketUID, &markettypes.OddsOutcome{
+		OddsUID: testOddsUID1,
+		Result:  oddsResult,
+	})
+}
+
+func resolveTestMarketWithOutcomes(
+	t testing.TB,
+	tApp *simappUtil.TestApp,
+	ctx sdk.Context,
+	marketUID string,
+	outcomes ...*markettypes.OddsOutcome,
+) {
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUID)
+	require.True(t, found)
+	tApp.MarketKeeper.Resolve(ctx, market, &markettypes.MarketResolutionTicketPayload{
+		UID:          marketUID,
+		ResolutionTS: uint64(ctx.BlockTime().Unix()) + 10000,
+		OddsOutcomes: outcomes,
+		Status:       markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+	})
+}
+
+func TestParlayWager(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 3)
+
+	betUID := uuid.NewString()
+	placeTestParlayBet(ctx, t, tApp, betUID, marketUIDs)
+
+	bet, found := k.GetBet(ctx, testCreator, 1)
+	require.True(t, found)
+	require.Equal(t, betUID, bet.UID)
+	require.True(t, bet.IsParlay())
+	require.Len(t, bet.Legs, len(marketUIDs))
+	require.Equal(t, types.OddsType_ODDS_TYPE_DECIMAL, bet.OddsType)
+	require.True(t, sdk.MustNewDecFromStr(bet.OddsValue).Equal(sdk.NewDec(8)))
+
+	for _, leg := range bet.Legs {
+		require.Equal(t, types.Bet_RESULT_PENDING, leg.Result)
+		require.NotEmpty(t, leg.BetFulfillment)
+	}
+
+	pendingBets, err := k.GetPendingBets(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingBets, len(marketUIDs))
+}
+
+func TestParlayWagerDenomMismatch(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 2)
+
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUIDs[1])
+	require.True(t, found)
+	market.Denom = "uatom"
+	tApp.MarketKeeper.SetMarket(ctx, market)
+
+	oddsCompact := []*types.BetOddsCompact{
+		{UID: testOddsUID1, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+		{UID: testOddsUID2, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+		{UID: testOddsUID3, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+	}
+	payload := types.WagerTicketPayload{}
+	for _, marketUID := range marketUIDs {
+		payload.ParlayLegs = append(payload.ParlayLegs, &types.WagerTicketLeg{
+			SelectedOdds: &types.BetOdds{
+				UID:               testOddsUID1,
+				MarketUID:         marketUID,
+				Value:             "2.00",
+				MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+			},
+			AllOdds: oddsCompact,
+		})
+	}
+
+	bet, err := types.NewParlayBet(
+		simappUtil.TestParamUsers["user1"].Address.String(),
+		&types.WagerProps{UID: uuid.NewString(), Amount: sdk.NewInt(1000000)},
+		types.OddsType_ODDS_TYPE_DECIMAL,
+		payload.ParlayLegs,
+	)
+	require.NoError(t, err)
+
+	err = k.WagerParlay(ctx, bet, payload.LegOddsMaps())
+	require.ErrorIs(t, err, types.ErrParlayMarketsDenomMismatch)
+}
+
+func TestParlaySettlement(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		resolveFn func(t *testing.T, tApp *simappUtil.TestApp, ctx sdk.Context, marketUIDs []string)
+		result    types.Bet_Result
+		payout    int64
+	}{
+		{
+			desc: "all legs won",
+			resolveFn: func(t *testing.T, tApp *simappUtil.TestApp, ctx sdk.Context, marketUIDs []string) {
+				resolveTestMarket(t, tApp, ctx, marketUIDs[1], markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED, []string{testOddsUID1})
+			},
+			result: types.Bet_RESULT_WON,
+			// combined odds of 4.00
+			payout: 3999600,
+		},
+		{
+			desc: "second leg lost",
+			resolveFn: func(t *testing.T, tApp *simappUtil.TestApp, ctx sdk.Context, marketUIDs []string) {
+				resolveTestMarket(t, tApp, ctx, marketUIDs[1], markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED, []string{testOddsUID2})
+			},
+			result: types.Bet_RESULT_LOST,
+			payout: 0,
+		},
+		{
+			desc: "second leg canceled",
+			resolveFn: func(t *testing.T, tApp *simappUtil.TestApp, ctx sdk.Context, marketUIDs []string) {
+				resolveTestMarket(t, tApp, ctx, marketUIDs[1], markettypes.MarketStatus_MARKET_STATUS_CANCELED, nil)
+			},
+			result: types.Bet_RESULT_WON,
+			// half of the bet amount is refunded and the other half is won on odds of 2.00
+			payout: 1499850,
+		},
+		{
+			desc: "second leg pushed",
+			resolveFn: func(t *testing.T, tApp *simappUtil.TestApp, ctx sdk.Context, marketUIDs []string) {
+				resolveTestMarketWithOutcome(t, tApp, ctx, marketUIDs[1], markettypes.OddsResult_ODDS_RESULT_PUSH)
+			},
+			result: types.Bet_RESULT_WON,
+			// half of the bet amount is returned and the other half is won on odds of 2.00
+			payout: 1499850,
+		},
+		{
+			desc: "second leg half won",
+			resolveFn: func(t *testing.T, tApp *simappUtil.TestApp, ctx sdk.Context, marketUIDs []string) {
+				resolveTestMarketWithOutcome(t, tApp, ctx, marketUIDs[1], markettypes.OddsResult_ODDS_RESULT_HALF_WIN)
+			},
+			result: types.Bet_RESULT_HALF_WON,
+			// effective combined odds of 2.00 * 1.50
+			payout: 2999700,
+		},
+		{
+			desc: "second leg half lost",
+			resolveFn: func(t *testing.T, tApp *simappUtil.TestApp, ctx sdk.Context, marketUIDs []string) {
+				resolveTestMarketWithOutcome(t, tApp, ctx, marketUIDs[1], markettypes.OddsResult_ODDS_RESULT_HALF_LOSE)
+			},
+			result: types.Bet_RESULT_PUSH,
+			// effective combined odds of 2.00 * 0.50
+			payout: 999900,
+		},
+		{
+			desc: "second leg won in dead heat",
+			resolveFn: func(t *testing.T, tApp *simappUtil.TestApp, ctx sdk.Context, marketUIDs []string) {
+				resolveTestMarketWithOutcomes(t, tApp, ctx, marketUIDs[1],
+					&markettypes.OddsOutcome{OddsUID: testOddsUID1, Result: markettypes.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.NewDecWithPrec(5, 1)},
+					&markettypes.OddsOutcome{OddsUID: testOddsUID2, Result: markettypes.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.NewDecWithPrec(5, 1)},
+				)
+			},
+			result: types.Bet_RESULT_WON,
+			// effective combined odds of 2.00 * 2.00 * 0.50, the win ratio of the legs is truncated
+			payout: 1999798,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, k, ctx := setupKeeperAndApp(t)
+			marketUIDs := setupParlayMarkets(t, tApp, ctx, 2)
+
+			betUID := uuid.NewString()
+			placeTestParlayBet(ctx, t, tApp, betUID, marketUIDs)
+
+			bettorAddress := simappUtil.TestParamUsers["user1"].Address
+			balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+			// the first leg is won, the bet should wait for the second leg
+			resolveTestMarket(t, tApp, ctx, marketUIDs[0], markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED, []string{testOddsUID1})
+			require.NoError(t, k.BatchMarketSettlements(ctx))
+
+			bet, found := k.GetBet(ctx, testCreator, 1)
+			require.True(t, found)
+			require.Equal(t, types.Bet_STATUS_PLACED, bet.Status)
+			require.Equal(t, types.Bet_RESULT_WON, bet.Legs[0].Result)
+			require.Equal(t, types.Bet_RESULT_PENDING, bet.Legs[1].Result)
+
+			waitingBets, err := k.GetParlayWaitingBets(ctx)
+			require.NoError(t, err)
+			require.Len(t, waitingBets, 1)
+			require.True(t, k.IsBookSettlementDeferred(ctx, marketUIDs[0]))
+
+			tc.resolveFn(t, tApp, ctx, marketUIDs)
+			require.NoError(t, k.BatchMarketSettlements(ctx))
+
+			bet, found = k.GetBet(ctx, testCreator, 1)
+			require.True(t, found)
+			require.Equal(t, types.Bet_STATUS_SETTLED, bet.Status)
+			require.Equal(t, tc.result, bet.Result)
+
+			waitingBets, err = k.GetParlayWaitingBets(ctx)
+			require.NoError(t, err)
+			require.Empty(t, waitingBets)
+			require.False(t, k.IsBookSettlementDeferred(ctx, marketUIDs[0]))
+
+			pendingBets, err := k.GetPendingBets(ctx)
+			require.NoError(t, err)
+			require.Empty(t, pendingBets)
+
+			balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+			// the payout is calculated on bet amount of 999900 after deduction of the bet fee
+			payout := balanceAfter.Amount.Sub(balanceBefore.Amount)
+			require.InDelta(t, tc.payout, payout.Int64(), 1)
+		})
+	}
+}
+
+func TestParlayBetFee(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 2)
+
+	betParams := k.GetParams(ctx)
+	betParams.Constraints.Fee = sdk.ZeroInt()
+	betParams.Constraints.FeeRate = sdk.NewDecWithPrec(2, 2)
+	k.SetParams(ctx, betParams)
+
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address
+	feeCollectorAddress := tApp.AccountKeeper.GetModuleAddress(types.BetFeeCollectorFunder{}.GetModuleAcc())
+	bettorBalance := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom).Amount
+	feeCollectorBalance := tApp.BankKeeper.GetBalance(ctx, feeCollectorAddress, params.DefaultBondDenom).Amount
+
+	placeTestParlayBet(ctx, t, tApp, uuid.NewString(), marketUIDs)
+
+	// the bet fee is charged once on the fulfilled amount of all of the legs
+	bet, found := k.GetBet(ctx, testCreator, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(20000).String(), bet.Fee.String())
+	require.Equal(t, sdk.NewInt(980000).String(), bet.Amount.String())
+	require.Equal(t, bet.Amount.String(), bet.FulfilledAmount().String())
+
+	require.Equal(t,
+		feeCollectorBalance.Add(bet.Fee).String(),
+		tApp.BankKeeper.GetBalance(ctx, feeCollectorAddress, params.DefaultBondDenom).Amount.String(),
+	)
+	require.Equal(t,
+		bettorBalance.SubRaw(1000000).String(),
+		tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom).Amount.String(),
+	)
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	sgetypes "github.com/sge-network/sge/types"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func TestWagerPartialFill(t *testing.T) {
+	for _, tc := range []struct {
+		desc         string
+		amount       sdk.Int
+		minFillRatio sdk.Dec
+		partial      bool
+		err          string
+	}{
+		{
+			desc:   "not enough liquidity without min fill ratio",
+			amount: sdk.NewInt(10000000),
+			err:    "insufficient liquidity in order book",
+		},
+		{
+			desc:         "zero min fill ratio as not set",
+			amount:       sdk.NewInt(10000000),
+			minFillRatio: sdk.ZeroDec(),
+			err:          "insufficient liquidity in order book",
+		},
+		{
+			desc:         "fulfilled amount less than min fill ratio",
+			amount:       sdk.NewInt(10000000),
+			minFillRatio: sdk.MustNewDecFromStr("0.9"),
+			err:          "is less than the minimum",
+		},
+		{
+			desc:         "partially fulfilled",
+			amount:       sdk.NewInt(10000000),
+			minFillRatio: sdk.MustNewDecFromStr("0.5"),
+			partial:      true,
+		},
+		{
+			desc:         "fully fulfilled with min fill ratio",
+			amount:       sdk.NewInt(1000000),
+			minFillRatio: sdk.MustNewDecFromStr("0.5"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, k, ctx := setupKeeperAndApp(t)
+			marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+
+			betParams := k.GetParams(ctx)
+			betParams.Constraints.Fee = sdk.ZeroInt()
+			betParams.Constraints.FeeRate = sdk.NewDecWithPrec(1, 2)
+			k.SetParams(ctx, betParams)
+			requestedFee := betParams.Constraints.CalculateFee(tc.amount, sdk.ZeroInt())
+
+			bettorAddress := simappUtil.TestParamUsers["user1"].Address
+			balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+			ticket, err := createJwtTicket(jwt.MapClaims{
+				"exp": 9999999999,
+				"iat": 7777777777,
+				"selected_odds": &types.BetOdds{
+					UID:               testOddsUID1,
+					MarketUID:         marketUIDs[0],
+					Value:             "4.20",
+					MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+				},
+				"kyc_data": &sgetypes.KycDataPayload{
+					Approved: true,
+					ID:       bettorAddress.String(),
+				},
+				"odds_type": 1,
+				"all_odds":  testBetOdds,
+			})
+			require.NoError(t, err)
+
+			betUID := uuid.NewString()
+			betSrv := keeper.NewMsgServerImpl(*k)
+			_, err = betSrv.Wager(sdk.WrapSDKContext(ctx), &types.MsgWager{
+				Creator: bettorAddress.String(),
+				Props: &types.WagerProps{
+					UID:          betUID,
+					Amount:       tc.amount,
+					Ticket:       ticket,
+					MinFillRatio: tc.minFillRatio,
+				},
+			})
+			if tc.err != "" {
+				require.ErrorIs(t, err, types.ErrInWager)
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			bet, found := k.GetBet(ctx, bettorAddress.String(), 1)
+			require.True(t, found)
+			require.Equal(t, bet.FulfilledAmount(), bet.Amount)
+
+			effectiveAmount := tc.amount.Sub(requestedFee)
+			if tc.partial {
+				require.True(t, bet.Amount.LT(effectiveAmount))
+				require.True(t, bet.Amount.GTE(tc.minFillRatio.MulInt(effectiveAmount).TruncateInt()))
+
+				// the bet fee is charged for the fulfilled amount only
+				require.Equal(t, betParams.Constraints.CalculateFee(bet.Amount, sdk.ZeroInt()), bet.Fee)
+				require.True(t, bet.Fee.LT(requestedFee))
+			} else {
+				require.Equal(t, effectiveAmount, bet.Amount)
+				require.Equal(t, requestedFee, bet.Fee)
+			}
+
+			// only the fulfilled amount and the bet fee are charged from the bettor
+			balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+			require.Equal(t, bet.Amount.Add(bet.Fee), balanceBefore.Amount.Sub(balanceAfter.Amount))
+		})
+	}
+}
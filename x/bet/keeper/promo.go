@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sge-network/sge/utils"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+// SetFreeBetCredit sets a free bet credit of a bettor in the store
+func (k Keeper) SetFreeBetCredit(ctx sdk.Context, credit types.FreeBetCredit) {
+	store := k.getFreeBetCreditStore(ctx)
+	b := k.cdc.MustMarshal(&credit)
+	store.Set(types.FreeBetCreditKey(credit.Address, credit.UID), b)
+}
+
+// GetFreeBetCredit returns a free bet credit of a bettor by its uid
+func (k Keeper) GetFreeBetCredit(ctx sdk.Context, address, uid string) (val types.FreeBetCredit, found bool) {
+	store := k.getFreeBetCreditStore(ctx)
+
+	b := store.Get(types.FreeBetCreditKey(address, uid))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllFreeBetCredits returns the free bet credits of all of the bettors
+func (k Keeper) GetAllFreeBetCredits(ctx sdk.Context) (list []types.FreeBetCredit, err error) {
+	store := k.getFreeBetCreditStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FreeBetCredit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetFreeBetCreditReserve sets the reserved amount of the unused free bet credits of a denom in the store
+func (k Keeper) SetFreeBetCreditReserve(ctx sdk.Context, reserve types.FreeBetCreditReserve) {
+	store := k.getFreeBetCreditReserveStore(ctx)
+	b := k.cdc.MustMarshal(&reserve)
+	store.Set(types.FreeBetCreditReserveKey(reserve.Denom), b)
+}
+
+// GetFreeBetCreditReserve returns the reserved amount of the unused free bet credits of a denom
+func (k Keeper) GetFreeBetCreditReserve(ctx sdk.Context, denom string) types.FreeBetCreditReserve {
+	store := k.getFreeBetCreditReserveStore(ctx)
+
+	b := store.Get(types.FreeBetCreditReserveKey(denom))
+	if b == nil {
+		return types.FreeBetCreditReserve{
+			Denom:  denom,
+			Amount: sdk.ZeroInt(),
+		}
+	}
+
+	var val types.FreeBetCreditReserve
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// addFreeBetCreditReserve adds the amount to the reserved amount of the unused free bet credits of a denom.
+func (k Keeper) addFreeBetCreditReserve(ctx sdk.Context, denom string, amount sdkmath.Int) {
+	reserve := k.GetFreeBetCreditReserve(ctx, denom)
+	reserve.Amount = reserve.Amount.Add(amount)
+	k.SetFreeBetCreditReserve(ctx, reserve)
+}
+
+// SetFreeBetCreditExpiry adds the free bet credit to the expiry index, the credits
+// without the expiry timestamp are not indexed.
+func (k Keeper) SetFreeBetCreditExpiry(ctx sdk.Context, credit types.FreeBetCredit) {
+	if credit.ExpiresAt == 0 {
+		return
+	}
+
+	store := k.getFreeBetCreditExpiryStore(ctx)
+	key := types.FreeBetCreditKey(credit.Address, credit.UID)
+	store.Set(types.FreeBetCreditExpiryKey(credit.ExpiresAt, credit.Address, credit.UID), key)
+}
+
+// ProcessExpiredFreeBetCredits releases the reserved promo pool balance of the unused
+// free bet credits that are expired, the expired credits are removed from the expiry index.
+func (k Keeper) ProcessExpiredFreeBetCredits(ctx sdk.Context) error {
+	blockTime := ctx.BlockTime().Unix()
+	store := k.getFreeBetCreditExpiryStore(ctx)
+
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(utils.Int64ToBytes(blockTime)))
+	var expiryKeys, creditKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiryKeys = append(expiryKeys, iterator.Key())
+		creditKeys = append(creditKeys, iterator.Value())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	creditStore := k.getFreeBetCreditStore(ctx)
+	for i, expiryKey := range expiryKeys {
+		store.Delete(expiryKey)
+
+		b := creditStore.Get(creditKeys[i])
+		if b == nil {
+			continue
+		}
+
+		var credit types.FreeBetCredit
+		k.cdc.MustUnmarshal(b, &credit)
+
+		// the used credits are not reserved
+		if !credit.IsUsed() {
+			k.addFreeBetCreditReserve(ctx, credit.Denom, credit.Amount.Neg())
+		}
+	}
+
+	return nil
+}
+
+// FundPromoPool transfers the amount of the funding ticket from the operator account to the promo pool.
+func (k Keeper) FundPromoPool(ctx sdk.Context, creator string, payload *types.FundPromoPoolTicketPayload) error {
+	funderAddress, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if err := k.orderbookKeeper.FundPromoPool(ctx, funderAddress, payload.Amount, payload.Denom); err != nil {
+		return sdkerrors.Wrapf(types.ErrInPromoPoolTransfer, "%s", err)
+	}
+
+	return nil
+}
+
+// IssueFreeBetCredit stores a new free bet credit for the bettor, the promo pool
+// should have enough balance for the credited amount in addition to the amount
+// of the unused credits that are issued before.
+func (k Keeper) IssueFreeBetCredit(ctx sdk.Context, payload *types.IssueFreeBetTicketPayload) (types.FreeBetCredit, error) {
+	if _, found := k.GetFreeBetCredit(ctx, payload.Address, payload.UID); found {
+		return types.FreeBetCredit{}, sdkerrors.Wrapf(types.ErrFreeBetCreditAlreadyExists, "%s", payload.UID)
+	}
+
+	reserve := k.GetFreeBetCreditReserve(ctx, payload.Denom)
+	if balance := k.orderbookKeeper.GetPromoPoolBalance(ctx, payload.Denom); balance.LT(reserve.Amount.Add(payload.Amount)) {
+		return types.FreeBetCredit{}, sdkerrors.Wrapf(
+			types.ErrInsufficientPromoPoolBalance, "%s%s, reserved %s%s", balance, payload.Denom, reserve.Amount, payload.Denom,
+		)
+	}
+
+	credit := types.NewFreeBetCredit(payload, ctx.BlockTime().Unix())
+	k.SetFreeBetCredit(ctx, credit)
+	k.SetFreeBetCreditExpiry(ctx, credit)
+	k.addFreeBetCreditReserve(ctx, credit.Denom, credit.Amount)
+
+	return credit, nil
+}
+
+// useFreeBetCredit marks the free bet credit of the bet as used and transfers the credited
+// amount from the promo pool to the bettor to be charged by the order book the same as
+// the bets placed with the balance of the bettor.
+func (k Keeper) useFreeBetCredit(ctx sdk.Context, bet *types.Bet, bettorAddress sdk.AccAddress) error {
+	credit, found := k.GetFreeBetCredit(ctx, bet.Creator, bet.FreeBetCreditUID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrFreeBetCreditNotFound, "%s", bet.FreeBetCreditUID)
+	}
+
+	if credit.IsUsed() {
+		return sdkerrors.Wrapf(types.ErrFreeBetCreditAlreadyUsed, "%s", credit.BetUID)
+	}
+
+	if credit.IsExpired(ctx.BlockTime().Unix()) {
+		return sdkerrors.Wrapf(types.ErrFreeBetCreditExpired, "%d", credit.ExpiresAt)
+	}
+
+	// the bet amount is deducted by the bet fee, so the total is compared with the credit.
+	if credit.Denom != bet.Denom || !credit.Amount.Equal(bet.Amount.Add(bet.Fee)) {
+		return sdkerrors.Wrapf(types.ErrFreeBetCreditMismatch, "%s%s", credit.Amount, credit.Denom)
+	}
+
+	if err := k.orderbookKeeper.WithdrawFromPromoPool(ctx, bettorAddress, credit.Amount, credit.Denom); err != nil {
+		return sdkerrors.Wrapf(types.ErrInPromoPoolTransfer, "%s", err)
+	}
+
+	credit.BetUID = bet.UID
+	k.SetFreeBetCredit(ctx, credit)
+	k.addFreeBetCreditReserve(ctx, credit.Denom, credit.Amount.Neg())
+
+	return nil
+}
+
+// returnFreeBetStake transfers the portion of the stake of a free bet that is returned
+// to the bettor by the order book back to the promo pool, the bettor only keeps the profit.
+func (k Keeper) returnFreeBetStake(ctx sdk.Context, bet *types.Bet, amount sdkmath.Int) error {
+	if !bet.IsFreeBet() || !amount.IsPositive() {
+		return nil
+	}
+
+	bettorAddress, err := sdk.AccAddressFromBech32(bet.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if err := k.orderbookKeeper.FundPromoPool(ctx, bettorAddress, amount, bet.Denom); err != nil {
+		return sdkerrors.Wrapf(types.ErrInPromoPoolTransfer, "%s", err)
+	}
+
+	return nil
+}
+
+// restoreFreeBetCredit makes the free bet credit of a canceled or refunded bet usable again,
+// the credit is restored with the amount that is returned to the promo pool, so the
+// charged bet fee of the canceled bet is not credited again. The expired credits are
+// not restored, so the returned amount is not reserved again.
+func (k Keeper) restoreFreeBetCredit(ctx sdk.Context, bet *types.Bet, amount sdkmath.Int) {
+	if !bet.IsFreeBet() || !amount.IsPositive() {
+		return
+	}
+
+	credit, found := k.GetFreeBetCredit(ctx, bet.Creator, bet.FreeBetCreditUID)
+	if !found || credit.IsExpired(ctx.BlockTime().Unix()) {
+		return
+	}
+
+	credit.BetUID = ""
+	credit.Amount = sdkmath.MinInt(credit.Amount, amount)
+	k.SetFreeBetCredit(ctx, credit)
+	k.addFreeBetCreditReserve(ctx, credit.Denom, credit.Amount)
+}
@@ -0,0 +1,426 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	sgetypes "github.com/sge-network/sge/types"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+func fundTestPromoPool(t testing.TB, tApp *simappUtil.TestApp, ctx sdk.Context, amount int64) {
+	ticket, err := createJwtTicket(jwt.MapClaims{
+		"exp":    9999999999,
+		"iat":    7777777777,
+		"denom":  params.DefaultBondDenom,
+		"amount": sdk.NewInt(amount),
+	})
+	require.NoError(t, err)
+
+	betSrv := keeper.NewMsgServerImpl(*tApp.BetKeeper)
+	_, err = betSrv.FundPromoPool(sdk.WrapSDKContext(ctx), &types.MsgFundPromoPool{
+		Creator: simappUtil.TestParamUsers["user4"].Address.String(),
+		Ticket:  ticket,
+	})
+	require.NoError(t, err)
+}
+
+func issueTestFreeBet(t testing.TB, tApp *simappUtil.TestApp, ctx sdk.Context, amount, expiresAt int64) (types.FreeBetCredit, error) {
+	ticket, err := createJwtTicket(jwt.MapClaims{
+		"exp":        9999999999,
+		"iat":        7777777777,
+		"uid":        uuid.NewString(),
+		"address":    simappUtil.TestParamUsers["user1"].Address.String(),
+		"denom":      params.DefaultBondDenom,
+		"amount":     sdk.NewInt(amount),
+		"expires_at": expiresAt,
+	})
+	require.NoError(t, err)
+
+	betSrv := keeper.NewMsgServerImpl(*tApp.BetKeeper)
+	res, err := betSrv.IssueFreeBet(sdk.WrapSDKContext(ctx), &types.MsgIssueFreeBet{
+		Creator: simappUtil.TestParamUsers["user4"].Address.String(),
+		Ticket:  ticket,
+	})
+	if err != nil {
+		return types.FreeBetCredit{}, err
+	}
+	return res.Credit, nil
+}
+
+func wagerTestFreeBet(t testing.TB, tApp *simappUtil.TestApp, ctx sdk.Context, marketUID, creditUID string, amount int64) error {
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+	ticket, err := createJwtTicket(jwt.MapClaims{
+		"exp": 9999999999,
+		"iat": 7777777777,
+		"selected_odds": &types.BetOdds{
+			UID:               testOddsUID1,
+			MarketUID:         marketUID,
+			Value:             "2.00",
+			MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+		},
+		"kyc_data": &sgetypes.KycDataPayload{
+			Approved: true,
+			ID:       bettorAddress,
+		},
+		"odds_type": 1,
+		"all_odds":  testBetOdds,
+	})
+	require.NoError(t, err)
+
+	betSrv := keeper.NewMsgServerImpl(*tApp.BetKeeper)
+	_, err = betSrv.Wager(sdk.WrapSDKContext(ctx), &types.MsgWager{
+		Creator: bettorAddress,
+		Props: &types.WagerProps{
+			UID:              uuid.NewString(),
+			Amount:           sdk.NewInt(amount),
+			Ticket:           ticket,
+			FreeBetCreditUID: creditUID,
+		},
+	})
+	return err
+}
+
+func TestIssueFreeBet(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+
+	// the promo pool is empty
+	_, err := issueTestFreeBet(t, tApp, ctx, 1000000, 0)
+	require.ErrorContains(t, err, types.ErrInsufficientPromoPoolBalance.Error())
+
+	fundTestPromoPool(t, tApp, ctx, 5000000)
+	require.Equal(t, sdk.NewInt(5000000), tApp.OrderbookKeeper.GetPromoPoolBalance(ctx, params.DefaultBondDenom))
+
+	credit, err := issueTestFreeBet(t, tApp, ctx, 1000000, 0)
+	require.NoError(t, err)
+
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+	stored, found := k.GetFreeBetCredit(ctx, bettorAddress, credit.UID)
+	require.True(t, found)
+	require.Equal(t, credit, stored)
+	require.False(t, stored.IsUsed())
+	require.Equal(t, sdk.NewInt(1000000).String(), k.GetFreeBetCreditReserve(ctx, params.DefaultBondDenom).Amount.String())
+
+	// the balance of the promo pool is reserved for the unused credit
+	_, err = issueTestFreeBet(t, tApp, ctx, 4000001, 0)
+	require.ErrorContains(t, err, types.ErrInsufficientPromoPoolBalance.Error())
+
+	_, err = issueTestFreeBet(t, tApp, ctx, 4000000, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(5000000).String(), k.GetFreeBetCreditReserve(ctx, params.DefaultBondDenom).Amount.String())
+
+	_, err = issueTestFreeBet(t, tApp, ctx, 1000000, ctx.BlockTime().Unix()-1)
+	require.ErrorContains(t, err, types.ErrInTicketValidation.Error())
+}
+
+func TestWagerFreeBet(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		amount    int64
+		expiresAt int64
+		reuse     bool
+		err       error
+	}{
+		{
+			desc:   "success",
+			amount: 1000000,
+		},
+		{
+			desc:   "amount mismatch",
+			amount: 2000000,
+			err:    types.ErrFreeBetCreditMismatch,
+		},
+		{
+			desc:      "expired",
+			amount:    1000000,
+			expiresAt: 1,
+			err:       types.ErrFreeBetCreditExpired,
+		},
+		{
+			desc:   "already used",
+			amount: 1000000,
+			reuse:  true,
+			err:    types.ErrFreeBetCreditAlreadyUsed,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, k, ctx := setupKeeperAndApp(t)
+			marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+			fundTestPromoPool(t, tApp, ctx, 5000000)
+
+			credit, err := issueTestFreeBet(t, tApp, ctx, 1000000, 0)
+			require.NoError(t, err)
+
+			if tc.expiresAt != 0 {
+				ctx = ctx.WithBlockTime(ctx.BlockTime().AddDate(0, 0, 1))
+				credit.ExpiresAt = ctx.BlockTime().Unix() - tc.expiresAt
+				k.SetFreeBetCredit(ctx, credit)
+			}
+
+			if tc.reuse {
+				require.NoError(t, wagerTestFreeBet(t, tApp, ctx, marketUIDs[0], credit.UID, tc.amount))
+			}
+
+			bettorAddress := simappUtil.TestParamUsers["user1"].Address
+			balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+			err = wagerTestFreeBet(t, tApp, ctx, marketUIDs[0], credit.UID, tc.amount)
+			if tc.err != nil {
+				require.ErrorContains(t, err, tc.err.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			// the stake is paid by the promo pool
+			balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+			require.Equal(t, balanceBefore, balanceAfter)
+			require.Equal(t, sdk.NewInt(4000000), tApp.OrderbookKeeper.GetPromoPoolBalance(ctx, params.DefaultBondDenom))
+
+			bet, found := k.GetBet(ctx, bettorAddress.String(), 1)
+			require.True(t, found)
+			require.True(t, bet.IsFreeBet())
+
+			credit, found = k.GetFreeBetCredit(ctx, bettorAddress.String(), credit.UID)
+			require.True(t, found)
+			require.Equal(t, bet.UID, credit.BetUID)
+			require.True(t, k.GetFreeBetCreditReserve(ctx, params.DefaultBondDenom).Amount.IsZero())
+		})
+	}
+}
+
+func TestFreeBetNotAllowedForParlay(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 2)
+	fundTestPromoPool(t, tApp, ctx, 5000000)
+
+	credit, err := issueTestFreeBet(t, tApp, ctx, 1000000, 0)
+	require.NoError(t, err)
+
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+	var legs []types.WagerTicketLeg
+	for _, marketUID := range marketUIDs {
+		legs = append(legs, types.WagerTicketLeg{
+			SelectedOdds: &types.BetOdds{
+				UID:               testOddsUID1,
+				MarketUID:         marketUID,
+				Value:             "2.00",
+				MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+			},
+			AllOdds: []*types.BetOddsCompact{
+				{UID: testOddsUID1, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+				{UID: testOddsUID2, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+				{UID: testOddsUID3, MaxLossMultiplier: sdk.MustNewDecFromStr("0.1")},
+			},
+		})
+	}
+	ticket, err := createJwtTicket(jwt.MapClaims{
+		"exp":         9999999999,
+		"iat":         7777777777,
+		"parlay_legs": legs,
+		"kyc_data": &sgetypes.KycDataPayload{
+			Approved: true,
+			ID:       bettorAddress,
+		},
+		"odds_type": 1,
+	})
+	require.NoError(t, err)
+
+	_, err = keeper.NewMsgServerImpl(*k).Wager(sdk.WrapSDKContext(ctx), &types.MsgWager{
+		Creator: bettorAddress,
+		Props: &types.WagerProps{
+			UID:              uuid.NewString(),
+			Amount:           sdk.NewInt(1000000),
+			Ticket:           ticket,
+			FreeBetCreditUID: credit.UID,
+		},
+	})
+	require.ErrorIs(t, err, types.ErrFreeBetNotAllowedForParlay)
+}
+
+func TestSettleFreeBet(t *testing.T) {
+	for _, tc := range []struct {
+		desc        string
+		result      markettypes.OddsResult
+		betResult   types.Bet_Result
+		payout      int64
+		poolBalance int64
+	}{
+		{
+			desc:      "won",
+			result:    markettypes.OddsResult_ODDS_RESULT_WIN,
+			betResult: types.Bet_RESULT_WON,
+			// only the profit of odds of 2.00 is paid to the bettor
+			payout: 999900,
+			// the stake is returned to the promo pool, the bet fee is collected
+			poolBalance: 4999900,
+		},
+		{
+			desc:        "lost",
+			result:      markettypes.OddsResult_ODDS_RESULT_LOSE,
+			betResult:   types.Bet_RESULT_LOST,
+			payout:      0,
+			poolBalance: 4000000,
+		},
+		{
+			desc:      "half lost",
+			result:    markettypes.OddsResult_ODDS_RESULT_HALF_LOSE,
+			betResult: types.Bet_RESULT_HALF_LOST,
+			payout:    0,
+			// half of the stake is returned to the promo pool
+			poolBalance: 4499950,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, k, ctx := setupKeeperAndApp(t)
+			marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+			fundTestPromoPool(t, tApp, ctx, 5000000)
+
+			credit, err := issueTestFreeBet(t, tApp, ctx, 1000000, 0)
+			require.NoError(t, err)
+			require.NoError(t, wagerTestFreeBet(t, tApp, ctx, marketUIDs[0], credit.UID, 1000000))
+
+			bettorAddress := simappUtil.TestParamUsers["user1"].Address
+			balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+			resolveTestMarketWithOutcome(t, tApp, ctx, marketUIDs[0], tc.result)
+			require.NoError(t, k.BatchMarketSettlements(ctx))
+
+			bet, found := k.GetBet(ctx, bettorAddress.String(), 1)
+			require.True(t, found)
+			require.Equal(t, types.Bet_STATUS_SETTLED, bet.Status)
+			require.Equal(t, tc.betResult, bet.Result)
+
+			balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+			require.Equal(t, tc.payout, balanceAfter.Amount.Sub(balanceBefore.Amount).Int64())
+			require.Equal(t, sdk.NewInt(tc.poolBalance), tApp.OrderbookKeeper.GetPromoPoolBalance(ctx, params.DefaultBondDenom))
+		})
+	}
+}
+
+func TestRefundFreeBet(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+	fundTestPromoPool(t, tApp, ctx, 5000000)
+
+	credit, err := issueTestFreeBet(t, tApp, ctx, 1000000, 0)
+	require.NoError(t, err)
+	require.NoError(t, wagerTestFreeBet(t, tApp, ctx, marketUIDs[0], credit.UID, 1000000))
+
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address
+	balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+	resolveTestMarket(t, tApp, ctx, marketUIDs[0], markettypes.MarketStatus_MARKET_STATUS_CANCELED, nil)
+	require.NoError(t, k.BatchMarketSettlements(ctx))
+
+	// the whole stake is returned to the promo pool and the credit can be used again
+	balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+	require.Equal(t, balanceBefore, balanceAfter)
+	require.Equal(t, sdk.NewInt(5000000), tApp.OrderbookKeeper.GetPromoPoolBalance(ctx, params.DefaultBondDenom))
+
+	credit, found := k.GetFreeBetCredit(ctx, bettorAddress.String(), credit.UID)
+	require.True(t, found)
+	require.False(t, credit.IsUsed())
+}
+
+func TestCancelFreeBet(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+	fundTestPromoPool(t, tApp, ctx, 5000000)
+
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUIDs[0])
+	require.True(t, found)
+	market.StartTS = uint64(ctx.BlockTime().Unix()) + 100
+	tApp.MarketKeeper.SetMarket(ctx, market)
+
+	credit, err := issueTestFreeBet(t, tApp, ctx, 1000000, 0)
+	require.NoError(t, err)
+	require.NoError(t, wagerTestFreeBet(t, tApp, ctx, marketUIDs[0], credit.UID, 1000000))
+
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address
+	balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+	bet, found := k.GetBet(ctx, bettorAddress.String(), 1)
+	require.True(t, found)
+	require.True(t, bet.Fee.IsPositive())
+	require.NoError(t, k.CancelBet(ctx, bettorAddress.String(), bet.UID, false))
+
+	// the bet fee is not refunded, so the promo pool gets the stake back
+	// and the credit is restored without the charged fee
+	balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+	require.Equal(t, balanceBefore, balanceAfter)
+	require.Equal(t, sdk.NewInt(5000000).Sub(bet.Fee).String(), tApp.OrderbookKeeper.GetPromoPoolBalance(ctx, params.DefaultBondDenom).String())
+
+	credit, found = k.GetFreeBetCredit(ctx, bettorAddress.String(), credit.UID)
+	require.True(t, found)
+	require.False(t, credit.IsUsed())
+	require.Equal(t, bet.Amount.String(), credit.Amount.String())
+	require.Equal(t, credit.Amount.String(), k.GetFreeBetCreditReserve(ctx, params.DefaultBondDenom).Amount.String())
+}
+
+func TestExpiredFreeBetReserve(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	fundTestPromoPool(t, tApp, ctx, 1000000)
+
+	expiresAt := ctx.BlockTime().Unix() + 100
+	_, err := issueTestFreeBet(t, tApp, ctx, 1000000, expiresAt)
+	require.NoError(t, err)
+
+	// the whole promo pool is reserved for the unused credit
+	_, err = issueTestFreeBet(t, tApp, ctx, 1000000, 0)
+	require.ErrorContains(t, err, types.ErrInsufficientPromoPoolBalance.Error())
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(99 * time.Second))
+	require.NoError(t, k.ProcessExpiredFreeBetCredits(ctx))
+	require.Equal(t, "1000000", k.GetFreeBetCreditReserve(ctx, params.DefaultBondDenom).Amount.String())
+
+	// the reserve of the expired credit is released for the new credits
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(1 * time.Second))
+	require.NoError(t, k.ProcessExpiredFreeBetCredits(ctx))
+	require.True(t, k.GetFreeBetCreditReserve(ctx, params.DefaultBondDenom).Amount.IsZero())
+
+	_, err = issueTestFreeBet(t, tApp, ctx, 1000000, 0)
+	require.NoError(t, err)
+	require.Equal(t, "1000000", k.GetFreeBetCreditReserve(ctx, params.DefaultBondDenom).Amount.String())
+}
+
+func TestRefundExpiredFreeBet(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+	fundTestPromoPool(t, tApp, ctx, 5000000)
+
+	credit, err := issueTestFreeBet(t, tApp, ctx, 1000000, ctx.BlockTime().Unix()+100)
+	require.NoError(t, err)
+	require.NoError(t, wagerTestFreeBet(t, tApp, ctx, marketUIDs[0], credit.UID, 1000000))
+	require.True(t, k.GetFreeBetCreditReserve(ctx, params.DefaultBondDenom).Amount.IsZero())
+
+	// the used credit does not release any reserve at the expiry
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(200 * time.Second))
+	require.NoError(t, k.ProcessExpiredFreeBetCredits(ctx))
+	require.True(t, k.GetFreeBetCreditReserve(ctx, params.DefaultBondDenom).Amount.IsZero())
+
+	resolveTestMarket(t, tApp, ctx, marketUIDs[0], markettypes.MarketStatus_MARKET_STATUS_CANCELED, nil)
+	require.NoError(t, k.BatchMarketSettlements(ctx))
+
+	// the stake is returned to the promo pool but the expired credit is not restored
+	require.Equal(t, sdk.NewInt(5000000), tApp.OrderbookKeeper.GetPromoPoolBalance(ctx, params.DefaultBondDenom))
+	require.True(t, k.GetFreeBetCreditReserve(ctx, params.DefaultBondDenom).Amount.IsZero())
+
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+	credit, found := k.GetFreeBetCredit(ctx, bettorAddress, credit.UID)
+	require.True(t, found)
+	require.True(t, credit.IsUsed())
+
+	_, err = issueTestFreeBet(t, tApp, ctx, 5000000, 0)
+	require.NoError(t, err)
+}
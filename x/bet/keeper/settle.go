@@ -39,10 +39,13 @@ func (k Keeper) Settle(ctx sdk.Context, bettorAddressStr, betUID string) error {
 	}
 
 	if err := bet.CheckSettlementEligiblity(); err != nil {
-		// bet cancellation logic will reside here if this feature is requested
 		return err
 	}
 
+	if bet.IsParlay() {
+		return k.settleParlay(ctx, bet, uid2ID.ID)
+	}
+
 	// get the respective market for the bet
 	market, found := k.marketKeeper.GetMarket(ctx, bet.MarketUID)
 	if !found {
@@ -56,10 +59,18 @@ func (k Keeper) Settle(ctx sdk.Context, bettorAddressStr, betUID string) error {
 			return err
 		}
 
-		if err := k.orderbookKeeper.RefundBettor(ctx, bettorAddress, bet.Amount, bet.Fee, payoutProfit.TruncateInt(), bet.UID); err != nil {
+		if err := k.orderbookKeeper.RefundBettor(ctx, bettorAddress, bet.Amount, bet.Fee, payoutProfit.TruncateInt(), bet.UID, bet.Denom); err != nil {
 			return sdkerrors.Wrapf(types.ErrInOBRefund, "%s", err)
 		}
 
+		k.addBettorReturn(ctx, &bet, bet.Amount)
+
+		// the refunded stake of a free bet belongs to the promo pool and the credit can be used again
+		if err := k.returnFreeBetStake(ctx, &bet, bet.Amount.Add(bet.Fee)); err != nil {
+			return err
+		}
+		k.restoreFreeBetCredit(ctx, &bet, bet.Amount.Add(bet.Fee))
+
 		bet.Status = types.Bet_STATUS_SETTLED
 		bet.Result = types.Bet_RESULT_REFUNDED
 
@@ -77,7 +88,7 @@ func (k Keeper) Settle(ctx sdk.Context, bettorAddressStr, betUID string) error {
 		return err
 	}
 
-	if err := k.orderbookKeeper.WithdrawBetFee(ctx, sdk.MustAccAddressFromBech32(market.Creator), bet.Fee); err != nil {
+	if err := k.withdrawBetFee(ctx, &bet, sdk.MustAccAddressFromBech32(market.Creator)); err != nil {
 		return err
 	}
 
@@ -86,6 +97,42 @@ func (k Keeper) Settle(ctx sdk.Context, bettorAddressStr, betUID string) error {
 	return nil
 }
 
+// SettleOnDemand settles a single bet of the resolved markets before the batch
+// settlement of the end-blocker. The bet is removed from the pending bets of the
+// market by the settlement, so the end-blocker does not settle it again.
+func (k Keeper) SettleOnDemand(ctx sdk.Context, bettorAddressStr, betUID string) error {
+	uid2ID, found := k.GetBetID(ctx, betUID)
+	if !found {
+		return types.ErrNoMatchingBet
+	}
+
+	bet, found := k.GetBet(ctx, bettorAddressStr, uid2ID.ID)
+	if !found {
+		return types.ErrNoMatchingBet
+	}
+
+	if err := bet.CheckSettlementEligiblity(); err != nil {
+		return err
+	}
+
+	// the delayed and voided bets are not in the pending list of the markets.
+	if bet.Status != types.Bet_STATUS_PLACED {
+		return sdkerrors.Wrapf(types.ErrBetIsNotPlaced, "%s", bet.Status)
+	}
+
+	if err := k.Settle(ctx, bettorAddressStr, betUID); err != nil {
+		return err
+	}
+
+	// the parlay bet stays unsettled until the rest of its legs are resolved.
+	bet, _ = k.GetBet(ctx, bettorAddressStr, uid2ID.ID)
+	if bet.Status != types.Bet_STATUS_SETTLED {
+		return types.ErrResultNotDeclared
+	}
+
+	return nil
+}
+
 // updateSettlementState settles bet in the store
 func (k Keeper) updateSettlementState(ctx sdk.Context, bet types.Bet, betID uint64) {
 	// set current height as settlement height
@@ -114,20 +161,51 @@ func (k Keeper) settleResolved(ctx sdk.Context, bet *types.Bet) error {
 		return err
 	}
 
-	if bet.Result == types.Bet_RESULT_LOST {
-		if err := k.orderbookKeeper.BettorLoses(ctx, bettorAddress, bet.Amount, payout.TruncateInt(), bet.UID, bet.BetFulfillment, bet.MarketUID); err != nil {
+	switch bet.Result {
+	case types.Bet_RESULT_LOST, types.Bet_RESULT_HALF_LOST:
+		lossRatio := settlementRatio(bet.Result)
+		if err := k.orderbookKeeper.BettorLoses(ctx, bettorAddress, bet.Amount, payout.TruncateInt(), bet.UID, bet.BetFulfillment, bet.MarketUID, lossRatio); err != nil {
 			return sdkerrors.Wrapf(types.ErrInOBBettorLoses, "%s", err)
 		}
+		returnedAmount := types.CalculateLostReturnedAmount(bet.BetFulfillment, lossRatio)
+		k.addBettorReturn(ctx, bet, returnedAmount)
+		if err := k.returnFreeBetStake(ctx, bet, returnedAmount); err != nil {
+			return err
+		}
 		bet.Status = types.Bet_STATUS_SETTLED
-	} else if bet.Result == types.Bet_RESULT_WON {
-		if err := k.orderbookKeeper.BettorWins(ctx, bettorAddress, bet.Amount, payout.TruncateInt(), bet.UID, bet.BetFulfillment, bet.MarketUID); err != nil {
+	case types.Bet_RESULT_WON, types.Bet_RESULT_HALF_WON, types.Bet_RESULT_PUSH:
+		deadHeatFactor := sdk.OneDec()
+		if bet.HasDeadHeat() {
+			deadHeatFactor = bet.DeadHeatFactor
+		}
+
+		winRatio := settlementRatio(bet.Result)
+		if err := k.orderbookKeeper.BettorWins(ctx, bettorAddress, bet.Amount, payout.TruncateInt(), bet.UID, bet.BetFulfillment, bet.MarketUID, winRatio, deadHeatFactor); err != nil {
 			return sdkerrors.Wrapf(types.ErrInOBBettorWins, "%s", err)
 		}
+		k.addBettorReturn(ctx, bet, types.CalculateWonAmount(bet.BetFulfillment, winRatio, deadHeatFactor))
+		// the bettor of a free bet only receives the profit and the stake is returned to the promo pool
+		if err := k.returnFreeBetStake(ctx, bet, types.CalculateWonBetAmount(bet.BetFulfillment, deadHeatFactor)); err != nil {
+			return err
+		}
 		bet.Status = types.Bet_STATUS_SETTLED
 	}
 	return nil
 }
 
+// settlementRatio returns the ratio of the payout profit that is won or the ratio
+// of the bet amount that is lost by the bettor according to the bet result.
+func settlementRatio(result types.Bet_Result) sdk.Dec {
+	switch result {
+	case types.Bet_RESULT_HALF_WON, types.Bet_RESULT_HALF_LOST:
+		return sdk.NewDecWithPrec(5, 1)
+	case types.Bet_RESULT_PUSH:
+		return sdk.ZeroDec()
+	default:
+		return sdk.OneDec()
+	}
+}
+
 // BatchMarketSettlements settles bets of resolved markets
 // in batch. The markets get into account in FIFO manner.
 func (k Keeper) BatchMarketSettlements(ctx sdk.Context) error {
@@ -158,9 +236,21 @@ func (k Keeper) BatchMarketSettlements(ctx sdk.Context) error {
 		// we need to remove its uid from the list of unsettled resolved bets.
 		if !pendingBetExists {
 			k.marketKeeper.RemoveUnsettledResolvedMarket(ctx, marketUID)
-			err = k.orderbookKeeper.SetOrderBookAsUnsettledResolved(ctx, marketUID)
+
+			// the order book settlement should wait for the parlay bets
+			// that are waiting for the rest of their legs to be resolved.
+			waitingBetExists, err := k.IsAnyParlayWaitingBetForMarket(ctx, marketUID)
 			if err != nil {
-				return fmt.Errorf("could not resolve orderbook %s %s", marketUID, err)
+				return fmt.Errorf("could not check the waiting parlay bets %s %s", marketUID, err)
+			}
+
+			if waitingBetExists {
+				k.SetDeferredBookSettlement(ctx, marketUID)
+			} else {
+				err = k.orderbookKeeper.SetOrderBookAsUnsettledResolved(ctx, marketUID)
+				if err != nil {
+					return fmt.Errorf("could not resolve orderbook %s %s", marketUID, err)
+				}
 			}
 		}
 
@@ -6,7 +6,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/google/uuid"
+	"github.com/sge-network/sge/app/params"
 	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	"github.com/sge-network/sge/x/bet/keeper"
 	"github.com/sge-network/sge/x/bet/types"
 	markettypes "github.com/sge-network/sge/x/market/types"
 	"github.com/spf13/cast"
@@ -163,6 +165,7 @@ func TestSettleBet(t *testing.T) {
 					EndTS:   uint64(ctx.BlockTime().Unix()) + 1000,
 					Odds:    testMarketOdds,
 					Status:  markettypes.MarketStatus_MARKET_STATUS_ACTIVE,
+					Denom:   params.DefaultBondDenom,
 				}
 				tApp.MarketKeeper.SetMarket(ctx, resetMarket)
 
@@ -178,6 +181,7 @@ func TestSettleBet(t *testing.T) {
 				}
 
 				tc.bet.UID = betUID
+				tc.bet.Denom = params.DefaultBondDenom
 				placeTestBet(ctx, t, tApp, betUID, nil)
 				k.SetBet(ctx, *tc.bet, 1)
 			}
@@ -321,3 +325,204 @@ func TestBatchSettleBet(t *testing.T) {
 		require.NotEqual(t, 0, bet.SettlementHeight)
 	}
 }
+
+func TestSettleBetOddsOutcomes(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		outcome  markettypes.OddsResult
+		deadHeat sdk.Dec
+		result   types.Bet_Result
+	}{
+		{
+			desc:    "win",
+			outcome: markettypes.OddsResult_ODDS_RESULT_WIN,
+			result:  types.Bet_RESULT_WON,
+		},
+		{
+			desc:     "dead heat win",
+			outcome:  markettypes.OddsResult_ODDS_RESULT_WIN,
+			deadHeat: sdk.MustNewDecFromStr("0.333333333333333333"),
+			result:   types.Bet_RESULT_WON,
+		},
+		{
+			desc:    "lose",
+			outcome: markettypes.OddsResult_ODDS_RESULT_LOSE,
+			result:  types.Bet_RESULT_LOST,
+		},
+		{
+			desc:    "push",
+			outcome: markettypes.OddsResult_ODDS_RESULT_PUSH,
+			result:  types.Bet_RESULT_PUSH,
+		},
+		{
+			desc:    "half win",
+			outcome: markettypes.OddsResult_ODDS_RESULT_HALF_WIN,
+			result:  types.Bet_RESULT_HALF_WON,
+		},
+		{
+			desc:    "half lose",
+			outcome: markettypes.OddsResult_ODDS_RESULT_HALF_LOSE,
+			result:  types.Bet_RESULT_HALF_LOST,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, k, ctx := setupKeeperAndApp(t)
+			marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+			bettorAddress := simappUtil.TestParamUsers["user1"].Address
+
+			betUID := uuid.NewString()
+			placeTestBet(ctx, t, tApp, betUID, &types.BetOdds{
+				UID:               testOddsUID1,
+				MarketUID:         marketUID,
+				Value:             "1.90",
+				MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+			})
+
+			resolveTestMarketWithOutcomes(t, tApp, ctx, marketUID, &markettypes.OddsOutcome{
+				OddsUID:        testOddsUID1,
+				Result:         tc.outcome,
+				DeadHeatFactor: tc.deadHeat,
+			})
+
+			bet, found := k.GetBet(ctx, bettorAddress.String(), 1)
+			require.True(t, found)
+
+			expectedReturn := sdk.ZeroInt()
+			expectedProfit := sdk.ZeroInt()
+			for _, bf := range bet.BetFulfillment {
+				halfProfit := bf.PayoutProfit.QuoRaw(2)
+				switch tc.outcome {
+				case markettypes.OddsResult_ODDS_RESULT_WIN:
+					if tc.deadHeat.IsNil() {
+						expectedReturn = expectedReturn.Add(bf.BetAmount).Add(bf.PayoutProfit)
+						continue
+					}
+					// only the dead heat portion of the stake is paid at the full odds
+					wonAmount := tc.deadHeat.MulInt(bf.BetAmount).TruncateInt()
+					wonProfit := tc.deadHeat.MulInt(bf.PayoutProfit).TruncateInt()
+					expectedReturn = expectedReturn.Add(wonAmount).Add(wonProfit)
+					expectedProfit = expectedProfit.Add(bf.BetAmount.Sub(wonAmount)).Sub(wonProfit)
+				case markettypes.OddsResult_ODDS_RESULT_PUSH:
+					expectedReturn = expectedReturn.Add(bf.BetAmount)
+				case markettypes.OddsResult_ODDS_RESULT_HALF_WIN:
+					expectedReturn = expectedReturn.Add(bf.BetAmount).Add(halfProfit)
+				case markettypes.OddsResult_ODDS_RESULT_HALF_LOSE:
+					expectedReturn = expectedReturn.Add(bf.BetAmount.Sub(bf.BetAmount.QuoRaw(2)))
+				}
+			}
+
+			balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+			err := k.Settle(ctx, bettorAddress.String(), betUID)
+			require.NoError(t, err)
+
+			balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+			require.Equal(t, expectedReturn.String(), balanceAfter.Amount.Sub(balanceBefore.Amount).String())
+
+			bet, found = k.GetBet(ctx, bettorAddress.String(), 1)
+			require.True(t, found)
+			require.Equal(t, tc.result, bet.Result)
+
+			if !tc.deadHeat.IsNil() {
+				require.True(t, tc.deadHeat.Equal(bet.DeadHeatFactor))
+
+				actualProfit := sdk.ZeroInt()
+				for _, bf := range bet.BetFulfillment {
+					participation, found := tApp.OrderbookKeeper.GetOrderBookParticipation(ctx, marketUID, bf.ParticipationIndex)
+					require.True(t, found)
+					actualProfit = actualProfit.Add(participation.ActualProfit)
+				}
+				require.Equal(t, expectedProfit.String(), actualProfit.String())
+			}
+			require.Equal(t, types.Bet_STATUS_SETTLED, bet.Status)
+		})
+	}
+}
+
+func TestSettleBetsOnDemand(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 2)
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address
+
+	betUIDs := []string{uuid.NewString(), uuid.NewString()}
+	for _, betUID := range betUIDs {
+		placeTestBet(ctx, t, tApp, betUID, &types.BetOdds{
+			UID:               testOddsUID1,
+			MarketUID:         marketUIDs[0],
+			Value:             "1.90",
+			MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+		})
+	}
+	parlayUID := uuid.NewString()
+	placeTestParlayBet(ctx, t, tApp, parlayUID, marketUIDs)
+
+	betSrv := keeper.NewMsgServerImpl(*k)
+	settleBets := func(ctx sdk.Context, uids ...string) (*types.MsgSettleBetsResponse, error) {
+		bets := make([]types.SettleBetItem, 0, len(uids))
+		for _, uid := range uids {
+			bets = append(bets, types.SettleBetItem{UID: uid, Creator: bettorAddress.String()})
+		}
+		return betSrv.SettleBets(sdk.WrapSDKContext(ctx), &types.MsgSettleBets{
+			Creator: simappUtil.TestParamUsers["user2"].Address.String(),
+			Bets:    bets,
+		})
+	}
+
+	_, err := settleBets(ctx, betUIDs[0])
+	require.ErrorIs(t, err, types.ErrInBetSettlement)
+
+	resolveTestMarketWithOutcomes(t, tApp, ctx, marketUIDs[0], &markettypes.OddsOutcome{
+		OddsUID: testOddsUID1,
+		Result:  markettypes.OddsResult_ODDS_RESULT_WIN,
+	})
+
+	// the parlay bet can not be settled until all of its legs are resolved
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = settleBets(cacheCtx, betUIDs[0], parlayUID)
+	require.ErrorIs(t, err, types.ErrInBetSettlement)
+	require.ErrorContains(t, err, types.ErrResultNotDeclared.Error())
+
+	p := k.GetParams(ctx)
+	p.BatchSettlementCount = 1
+	k.SetParams(ctx, p)
+
+	_, err = settleBets(ctx, betUIDs...)
+	require.ErrorIs(t, err, types.ErrSettleBetsCountExceeded)
+
+	balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+	res, err := settleBets(ctx, betUIDs[0])
+	require.NoError(t, err)
+	require.Equal(t, []string{betUIDs[0]}, res.UIDs)
+
+	balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+	require.True(t, balanceAfter.Amount.GT(balanceBefore.Amount))
+
+	bet, found := k.GetBet(ctx, bettorAddress.String(), 1)
+	require.True(t, found)
+	require.Equal(t, types.Bet_STATUS_SETTLED, bet.Status)
+	require.Equal(t, types.Bet_RESULT_WON, bet.Result)
+
+	_, err = settleBets(ctx, betUIDs[0])
+	require.ErrorContains(t, err, types.ErrBetIsSettled.Error())
+
+	// the end-blocker settles the rest of the pending bets without paying the settled bet again
+	p.BatchSettlementCount = 10
+	k.SetParams(ctx, p)
+	require.NoError(t, k.BatchMarketSettlements(ctx))
+	balanceAfterBatch := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+	bet, found = k.GetBet(ctx, bettorAddress.String(), 2)
+	require.True(t, found)
+	require.Equal(t, types.Bet_STATUS_SETTLED, bet.Status)
+	require.Equal(t, types.CalculateWonAmount(bet.BetFulfillment, sdk.OneDec(), sdk.OneDec()).String(),
+		balanceAfterBatch.Amount.Sub(balanceAfter.Amount).String())
+
+	pendingBetExists, err := k.IsAnyPendingBetForMarket(ctx, marketUIDs[0])
+	require.NoError(t, err)
+	require.False(t, pendingBetExists)
+
+	settledBets, err := k.GetSettledBets(ctx)
+	require.NoError(t, err)
+	require.Len(t, settledBets, len(betUIDs))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sge-network/sge/utils"
 	"github.com/sge-network/sge/x/bet/types"
@@ -25,3 +26,62 @@ func (k Keeper) GetBetStats(ctx sdk.Context) (val types.BetStats) {
 	k.cdc.MustUnmarshal(b, &val)
 	return val
 }
+
+// SetBettorStats sets statistics of a bettor in the store
+func (k Keeper) SetBettorStats(ctx sdk.Context, stats types.BettorStats) {
+	store := k.getBettorStatsStore(ctx)
+	b := k.cdc.MustMarshal(&stats)
+	store.Set(types.BettorStatsKey(stats.Address, stats.Denom), b)
+}
+
+// GetBettorStats returns statistics of a bettor in a certain denom,
+// the volume is zero if the bettor has not wagered in the denom yet.
+func (k Keeper) GetBettorStats(ctx sdk.Context, address, denom string) (val types.BettorStats) {
+	store := k.getBettorStatsStore(ctx)
+
+	b := store.Get(types.BettorStatsKey(address, denom))
+	if b == nil {
+		return types.BettorStats{Address: address, Denom: denom, Volume: sdk.ZeroInt()}
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllBettorStats returns statistics of all of the bettors
+func (k Keeper) GetAllBettorStats(ctx sdk.Context) (list []types.BettorStats, err error) {
+	store := k.getBettorStatsStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BettorStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// updateBettorVolume adds the amount to the wagered volume of the bettor,
+// negative amount is used to revert the volume of the canceled bets.
+func (k Keeper) updateBettorVolume(ctx sdk.Context, address, denom string, amount sdkmath.Int) {
+	stats := k.GetBettorStats(ctx, address, denom)
+	stats.Volume = sdk.MaxInt(stats.Volume.Add(amount), sdk.ZeroInt())
+	k.SetBettorStats(ctx, stats)
+}
+
+// GetBettorFeeTier returns the wagered volume of the bettor in the denom
+// and the fee tier in effect for the bettor.
+func (k Keeper) GetBettorFeeTier(ctx sdk.Context, address, denom string) (sdkmath.Int, types.FeeTier, error) {
+	constraints, err := k.GetConstraints(ctx, denom)
+	if err != nil {
+		return sdkmath.Int{}, types.FeeTier{}, err
+	}
+
+	volume := k.GetBettorStats(ctx, address, denom).Volume
+	return volume, constraints.FeeTier(volume), nil
+}
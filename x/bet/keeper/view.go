@@ -35,3 +35,96 @@ func (k Keeper) getSettledBetStore(ctx sdk.Context) prefix.Store {
 	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SettledBetListPrefix)
 	return betStore
 }
+
+// getParlayWaitingBetStore returns waiting parlay bet store ready for iterating
+func (k Keeper) getParlayWaitingBetStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParlayWaitingBetListPrefix)
+	return betStore
+}
+
+// getDeferredBookSettlementStore returns deferred book settlement store ready for iterating
+func (k Keeper) getDeferredBookSettlementStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredBookSettlementListPrefix)
+	return betStore
+}
+
+// getBettorStatsStore returns bettor stats store ready for iterating
+func (k Keeper) getBettorStatsStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BettorStatsListPrefix)
+	return betStore
+}
+
+// getBettorLimitsStore returns bettor limits store ready for iterating
+func (k Keeper) getBettorLimitsStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BettorLimitsListPrefix)
+	return betStore
+}
+
+// getBettorDailyTotalsStore returns bettor daily totals store ready for iterating
+func (k Keeper) getBettorDailyTotalsStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BettorDailyTotalsListPrefix)
+	return betStore
+}
+
+// getFreeBetCreditStore returns free bet credit store ready for iterating
+func (k Keeper) getFreeBetCreditStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FreeBetCreditListPrefix)
+	return betStore
+}
+
+// getFreeBetCreditByAddressStore returns free bet credit store of a certain bettor ready for iterating
+func (k Keeper) getFreeBetCreditByAddressStore(ctx sdk.Context, address string) prefix.Store {
+	return prefix.NewStore(k.getFreeBetCreditStore(ctx), types.FreeBetCreditListByAddressPrefix(address))
+}
+
+// getFreeBetCreditReserveStore returns free bet credit reserve store ready for iterating
+func (k Keeper) getFreeBetCreditReserveStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FreeBetCreditReserveListPrefix)
+	return betStore
+}
+
+// getFreeBetCreditExpiryStore returns free bet credit expiry index store ready for iterating
+func (k Keeper) getFreeBetCreditExpiryStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FreeBetCreditExpiryListPrefix)
+	return betStore
+}
+
+// getAffiliateStore returns affiliate store ready for iterating
+func (k Keeper) getAffiliateStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AffiliateListPrefix)
+	return betStore
+}
+
+// getReferralStore returns referral store ready for iterating
+func (k Keeper) getReferralStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReferralListPrefix)
+	return betStore
+}
+
+// getAffiliateEarningsStore returns affiliate earnings store ready for iterating
+func (k Keeper) getAffiliateEarningsStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AffiliateEarningsListPrefix)
+	return betStore
+}
+
+// getAffiliateEarningsByAddressStore returns affiliate earnings store of a certain affiliate ready for iterating
+func (k Keeper) getAffiliateEarningsByAddressStore(ctx sdk.Context, address string) prefix.Store {
+	return prefix.NewStore(k.getAffiliateEarningsStore(ctx), types.AffiliateEarningsListByAddressPrefix(address))
+}
+
+// getBettorExposureStore returns bettor exposure store ready for iterating
+func (k Keeper) getBettorExposureStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BettorExposureListPrefix)
+	return betStore
+}
+
+// getBettorExposureByMarketStore returns bettor exposure store of a certain bettor and market ready for iterating
+func (k Keeper) getBettorExposureByMarketStore(ctx sdk.Context, address, marketUID string) prefix.Store {
+	return prefix.NewStore(k.getBettorExposureStore(ctx), types.BettorExposureListByMarketPrefix(address, marketUID))
+}
+
+// getDelayedBetStore returns delayed bet store ready for iterating
+func (k Keeper) getDelayedBetStore(ctx sdk.Context) prefix.Store {
+	betStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedBetListPrefix)
+	return betStore
+}
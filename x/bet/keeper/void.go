@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sge-network/sge/utils"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+// VoidBet voids a placed bet that is accepted at an erroneous price, reverts the bet
+// fulfillments from the order book and refunds the bet amount and the bet fee. A bet
+// held in the in-play bet delay is removed from the delayed bets and refunded from the
+// escrow. The bets can not be voided after the resolution of their markets.
+func (k Keeper) VoidBet(ctx sdk.Context, bettorAddressStr, betUID string) error {
+	if !utils.IsValidUID(betUID) {
+		return types.ErrInvalidBetUID
+	}
+
+	uid2ID, found := k.GetBetID(ctx, betUID)
+	if !found {
+		return types.ErrNoMatchingBet
+	}
+
+	bet, found := k.GetBet(ctx, bettorAddressStr, uid2ID.ID)
+	if !found {
+		return types.ErrNoMatchingBet
+	}
+
+	bettorAddress, err := sdk.AccAddressFromBech32(bet.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if err := bet.CheckSettlementEligiblity(); err != nil {
+		return err
+	}
+
+	if bet.Status != types.Bet_STATUS_PLACED && bet.Status != types.Bet_STATUS_PENDING {
+		return sdkerrors.Wrapf(types.ErrBetIsNotPlaced, "%s", bet.Status)
+	}
+
+	marketBets := bet.MarketBets()
+	for _, mb := range marketBets {
+		market, found := k.marketKeeper.GetMarket(ctx, mb.MarketUID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrNoMatchingMarket, "%s", mb.MarketUID)
+		}
+
+		if market.Status != markettypes.MarketStatus_MARKET_STATUS_ACTIVE &&
+			market.Status != markettypes.MarketStatus_MARKET_STATUS_INACTIVE {
+			return sdkerrors.Wrapf(types.ErrMarketIsResolved, "%s: %s", market.UID, market.Status)
+		}
+	}
+
+	if bet.Status == types.Bet_STATUS_PENDING {
+		return k.voidDelayedBet(ctx, bet, uid2ID.ID, bettorAddress)
+	}
+
+	refundAmount := sdk.ZeroInt()
+	for _, mb := range marketBets {
+		if err := k.orderbookKeeper.RevertBetFulfillment(
+			ctx, uid2ID.ID, mb.OddsUID, mb.BetFulfillment, mb.MarketUID,
+		); err != nil {
+			return sdkerrors.Wrapf(types.ErrInOBVoid, "%s", err)
+		}
+
+		stake, payout := types.FulfillmentExposure(mb.BetFulfillment)
+		refundAmount = refundAmount.Add(stake)
+
+		// the voided bet is not counted in the caps of the bettor
+		k.updateBettorExposure(ctx, bet.Creator, mb.MarketUID, mb.OddsUID, stake.Neg(), payout.Neg())
+	}
+
+	// the voided bet is refunded completely including the bet fee
+	if err := k.orderbookKeeper.RefundBettor(ctx, bettorAddress, refundAmount, bet.Fee, sdk.ZeroInt(), bet.UID, bet.Denom); err != nil {
+		return sdkerrors.Wrapf(types.ErrInOBRefund, "%s", err)
+	}
+
+	// the refunded stake of a free bet belongs to the promo pool and the credit can be used again
+	if err := k.returnFreeBetStake(ctx, &bet, refundAmount.Add(bet.Fee)); err != nil {
+		return err
+	}
+	k.restoreFreeBetCredit(ctx, &bet, refundAmount.Add(bet.Fee))
+
+	// the voided bet amount is not counted in the wagered volume of the bettor
+	if !bet.IsFreeBet() {
+		k.updateBettorVolume(ctx, bet.Creator, bet.Denom, bet.Amount.Neg())
+	}
+	k.revertBettorStake(ctx, &bet)
+
+	bet.Status = types.Bet_STATUS_ABORTED
+	bet.Result = types.Bet_RESULT_REFUNDED
+	bet.SettlementHeight = ctx.BlockHeight()
+	k.SetBet(ctx, bet, uid2ID.ID)
+
+	for _, mb := range marketBets {
+		k.RemovePendingBet(ctx, mb.MarketUID, uid2ID.ID)
+		k.RemoveParlayWaitingBet(ctx, mb.MarketUID, uid2ID.ID)
+
+		if err := k.settleDeferredBook(ctx, mb.MarketUID); err != nil {
+			return err
+		}
+	}
+
+	// voided bets are kept in the settled list to be tracked by the oracle services
+	k.SetSettledBet(ctx, types.NewSettledBet(bet.UID, bet.Creator), uid2ID.ID, ctx.BlockHeight())
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+func TestVoidBets(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Now())
+
+	// the bets of the started markets can be voided
+	marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUIDs[0])
+	require.True(t, found)
+
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address
+	balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+	betUID := uuid.NewString()
+	placeTestBet(ctx, t, tApp, betUID, &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         market.UID,
+		Value:             "4.20",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	})
+
+	betSrv := keeper.NewMsgServerImpl(*k)
+	voidBets := func(ctx sdk.Context, reason string, uids ...string) (*types.MsgVoidBetsResponse, error) {
+		bets := make([]map[string]interface{}, 0, len(uids))
+		for _, uid := range uids {
+			bets = append(bets, map[string]interface{}{"uid": uid, "creator": bettorAddress.String()})
+		}
+		ticket, err := createJwtTicket(jwt.MapClaims{
+			"exp":    9999999999,
+			"iat":    7777777777,
+			"bets":   bets,
+			"reason": reason,
+		})
+		require.NoError(t, err)
+
+		return betSrv.VoidBets(sdk.WrapSDKContext(ctx), &types.MsgVoidBets{
+			Creator: simappUtil.TestParamUsers["user2"].Address.String(),
+			Ticket:  ticket,
+		})
+	}
+
+	_, err := voidBets(ctx, "", betUID)
+	require.ErrorIs(t, err, types.ErrInTicketValidation)
+
+	// the whole message fails if any of the bets can not be voided
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = voidBets(cacheCtx, "palpable error", betUID, uuid.NewString())
+	require.ErrorIs(t, err, types.ErrInBetVoiding)
+
+	res, err := voidBets(ctx, "palpable error", betUID)
+	require.NoError(t, err)
+	require.Equal(t, []string{betUID}, res.UIDs)
+
+	bet, found := k.GetBet(ctx, bettorAddress.String(), 1)
+	require.True(t, found)
+	require.Equal(t, types.Bet_STATUS_ABORTED, bet.Status)
+	require.Equal(t, types.Bet_RESULT_REFUNDED, bet.Result)
+	require.Equal(t, ctx.BlockHeight(), bet.SettlementHeight)
+
+	pendingBets, err := k.GetPendingBets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendingBets)
+
+	settledBets, err := k.GetSettledBets(ctx)
+	require.NoError(t, err)
+	require.Len(t, settledBets, 1)
+
+	// the participations should not have any bet amount after voiding
+	participations, err := tApp.OrderbookKeeper.GetParticipationsOfOrderBook(ctx, market.UID)
+	require.NoError(t, err)
+	for _, participation := range participations {
+		require.True(t, participation.TotalBetAmount.IsZero())
+		require.True(t, participation.CurrentRoundTotalBetAmount.IsZero())
+	}
+
+	exposures, err := tApp.OrderbookKeeper.GetExposureByOrderBookAndOdds(ctx, market.UID, testOddsUID1)
+	require.NoError(t, err)
+	for _, exposure := range exposures {
+		require.True(t, exposure.Exposure.IsZero())
+		require.True(t, exposure.BetAmount.IsZero())
+	}
+
+	// the bet amount and the bet fee are refunded
+	balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+	require.Equal(t, balanceBefore.Amount, balanceAfter.Amount)
+
+	// the void reason is recorded in the events
+	var reasonFound bool
+	for _, event := range ctx.EventManager().Events() {
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "void_reason" && string(attr.Value) == "palpable error" {
+				reasonFound = true
+			}
+		}
+	}
+	require.True(t, reasonFound)
+
+	// the voided bet can not be voided again
+	err = k.VoidBet(ctx, bettorAddress.String(), betUID)
+	require.ErrorIs(t, err, types.ErrBetIsNotPlaced)
+}
+
+func TestVoidDelayedBet(t *testing.T) {
+	tApp, ctx, marketUID := setupInPlayDelay(t)
+	k := tApp.BetKeeper
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address
+	escrowAddress := tApp.AccountKeeper.GetModuleAddress(types.BetDelayEscrowFunder{}.GetModuleAcc())
+	balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+	betUID := uuid.NewString()
+	placeTestBet(ctx, t, tApp, betUID, &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUID,
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	})
+
+	betID, found := k.GetBetID(ctx, betUID)
+	require.True(t, found)
+
+	// the delayed bet is removed and refunded from the escrow
+	require.NoError(t, k.VoidBet(ctx, testCreator, betUID))
+
+	bet, found := k.GetBet(ctx, testCreator, betID.ID)
+	require.True(t, found)
+	require.Equal(t, types.Bet_STATUS_ABORTED, bet.Status)
+	require.Equal(t, types.Bet_RESULT_REFUNDED, bet.Result)
+
+	delayedBets, err := k.GetDelayedBets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, delayedBets)
+
+	require.True(t, tApp.BankKeeper.GetBalance(ctx, escrowAddress, params.DefaultBondDenom).Amount.IsZero())
+	require.Equal(t, balanceBefore.Amount, tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom).Amount)
+
+	// the voided bet is not placed after the bet delay
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+	require.NoError(t, k.ProcessDelayedBets(ctx))
+	bet, found = k.GetBet(ctx, testCreator, betID.ID)
+	require.True(t, found)
+	require.Equal(t, types.Bet_STATUS_ABORTED, bet.Status)
+}
+
+func TestVoidBetResolvedMarket(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	ctx = ctx.WithBlockTime(time.Now())
+
+	marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+	betUID := uuid.NewString()
+	placeTestBet(ctx, t, tApp, betUID, &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUID,
+		Value:             "4.20",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	})
+
+	// the bets can not be voided once the result of the market is declared
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUID)
+	require.True(t, found)
+	market.Status = markettypes.MarketStatus_MARKET_STATUS_RESULT_PENDING
+	tApp.MarketKeeper.SetMarket(ctx, market)
+
+	err := k.VoidBet(ctx, testCreator, betUID)
+	require.ErrorIs(t, err, types.ErrMarketIsResolved)
+
+	bet, found := k.GetBet(ctx, testCreator, 1)
+	require.True(t, found)
+	require.Equal(t, types.Bet_STATUS_PLACED, bet.Status)
+}
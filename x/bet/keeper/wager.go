@@ -8,59 +8,176 @@ import (
 	"github.com/spf13/cast"
 )
 
-// Wager stores a new bet in KVStore
-func (k Keeper) Wager(ctx sdk.Context, bet *types.Bet, betOdds map[string]*types.BetOddsCompact) error {
+// Wager stores a new bet in KVStore, if the minimum fill ratio is set the bet is
+// accepted with the largest amount that can be fulfilled by the order book.
+func (k Keeper) Wager(
+	ctx sdk.Context,
+	bet *types.Bet,
+	betOdds map[string]*types.BetOddsCompact,
+	minFillRatio sdk.Dec,
+) error {
+	return k.wager(ctx, bet, map[string]map[string]*types.BetOddsCompact{bet.MarketUID: betOdds}, minFillRatio)
+}
+
+// WagerParlay stores a new multi-leg (parlay) bet in KVStore,
+// the odds of each of the legs are mapped by the market uid of the leg.
+func (k Keeper) WagerParlay(ctx sdk.Context, bet *types.Bet, legOdds map[string]map[string]*types.BetOddsCompact) error {
+	if !bet.IsParlay() {
+		return types.ErrInvalidParlayLegsCount
+	}
+	// partial fulfillment is not supported for the parlay bets.
+	return k.wager(ctx, bet, legOdds, sdk.Dec{})
+}
+
+// wager processes the bet with the odds of the markets of the bet mapped by market uid.
+func (k Keeper) wager(
+	ctx sdk.Context,
+	bet *types.Bet,
+	betOdds map[string]map[string]*types.BetOddsCompact,
+	minFillRatio sdk.Dec,
+) error {
 	bettorAddress, err := sdk.AccAddressFromBech32(bet.Creator)
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
 	}
 
-	market, err := k.getMarket(ctx, bet.MarketUID)
+	if bet.IsFreeBet() {
+		if bet.IsParlay() {
+			return types.ErrFreeBetNotAllowedForParlay
+		}
+		if !minFillRatio.IsNil() && minFillRatio.IsPositive() {
+			return types.ErrPartialFillNotAllowedForFreeBet
+		}
+	}
+
+	markets, err := k.getBetMarkets(ctx, bet, betOdds)
 	if err != nil {
 		return err
 	}
 
-	// check if selected odds is valid
-	if !market.HasOdds(bet.OddsUID) {
-		return types.ErrOddsUIDNotExist
+	// the bet is placed in the accepted denom of the market(s)
+	bet.Denom = markets[0].Denom
+
+	// check minimum bet amount allowed
+	betConstraints, err := k.GetConstraints(ctx, bet.Denom)
+	if err != nil {
+		return err
 	}
 
-	if len(market.Odds) != len(betOdds) {
-		return types.ErrInsufficientOdds
+	if bet.Amount.LT(betConstraints.MinAmount) {
+		return types.ErrBetAmountIsLow
+	}
+
+	// calculate the bet fee according to the fee tier in effect for the bettor
+	volume := k.GetBettorStats(ctx, bet.Creator, bet.Denom).Volume
+	fee := betConstraints.CalculateFee(bet.Amount, volume)
+	if fee.GTE(bet.Amount) {
+		return sdkerrors.Wrapf(types.ErrBetAmountIsLow, "bet fee %s is not less than the amount", fee)
+	}
+
+	// modify the bet fee and subtracted amount
+	bet.SetFee(fee)
+
+	// check the self-exclusion and the stake and net loss limits of the bettor,
+	// the free bets are not charged from the bettor so they are not counted in the limits.
+	stakeAmount := bet.Amount
+	if bet.IsFreeBet() {
+		stakeAmount = sdk.ZeroInt()
+	}
+	if err := k.checkBettorLimits(ctx, bet.Creator, bet.Denom, stakeAmount); err != nil {
+		return err
 	}
 
-	for _, odd := range market.Odds {
-		if _, ok := betOdds[odd.UID]; !ok {
-			return sdkerrors.Wrapf(types.ErrOddsUIDNotExist, "%s", odd.UID)
+	// the credited amount is transferred from the promo pool to be charged by the order book
+	if bet.IsFreeBet() {
+		if err := k.useFreeBetCredit(ctx, bet, bettorAddress); err != nil {
+			return err
 		}
 	}
 
-	// check minimum bet amount allowed
-	betConstraints := k.GetConstraints(ctx)
+	stats := k.GetBetStats(ctx)
+	stats.Count++
+	betID := stats.Count
 
-	if bet.Amount.LT(betConstraints.MinAmount) {
-		return types.ErrBetAmountIsLow
+	bet.CreatedAt = ctx.BlockTime().Unix()
+
+	// the bets on the started markets are held until the in-play bet delay is passed
+	if params := k.GetParams(ctx); params.HasInPlayDelay() && isInPlay(ctx, markets) {
+		if err := k.delayBet(ctx, bet, betID, bettorAddress, betOdds, markets, minFillRatio, params); err != nil {
+			return err
+		}
+	} else if err := k.placeBet(ctx, bet, betID, bettorAddress, betOdds, markets, minFillRatio); err != nil {
+		return err
 	}
 
-	// modify the bet fee and subtracted amount
-	bet.SetFee(betConstraints.Fee)
+	// set bet stats
+	k.SetBetStats(ctx, stats)
+
+	return nil
+}
 
+// placeBet fulfills the bet by the order book(s) of the market(s) and stores it as a placed bet.
+func (k Keeper) placeBet(
+	ctx sdk.Context,
+	bet *types.Bet,
+	betID uint64,
+	bettorAddress sdk.AccAddress,
+	betOdds map[string]map[string]*types.BetOddsCompact,
+	markets []markettypes.Market,
+	minFillRatio sdk.Dec,
+) error {
 	// calculate payoutProfit
 	payoutProfit, err := types.CalculatePayoutProfit(bet.OddsType, bet.OddsValue, bet.Amount)
 	if err != nil {
 		return err
 	}
 
-	stats := k.GetBetStats(ctx)
-	stats.Count++
-	betID := stats.Count
+	if bet.IsParlay() {
+		if err := k.fulfillParlay(ctx, bet, betID, bettorAddress, payoutProfit, betOdds, markets); err != nil {
+			return err
+		}
+	} else {
+		// the requested amount is checked against the caps, the fulfilled amount is
+		// added to the exposure of the bettor.
+		if err := k.checkBettorCaps(
+			ctx, bet.Creator, &markets[0], bet.OddsUID, bet.Amount, bet.Amount.Add(payoutProfit.TruncateInt()),
+		); err != nil {
+			return err
+		}
 
-	betFulfillment, err := k.orderbookKeeper.ProcessWager(
-		ctx, bet.UID, bet.MarketUID, bet.OddsUID, bet.MaxLossMultiplier, bet.Amount, payoutProfit,
-		bettorAddress, bet.Fee, bet.OddsType, bet.OddsValue, betID, betOdds, market.OddsUIDS(),
-	)
-	if err != nil {
-		return sdkerrors.Wrapf(types.ErrInOBWagerProcessing, "%s", err)
+		betFulfillment, err := k.orderbookKeeper.ProcessWager(
+			ctx, bet.UID, bet.MarketUID, bet.OddsUID, bet.MaxLossMultiplier, bet.Amount, payoutProfit, minFillRatio,
+			bettorAddress, sdk.ZeroInt(), bet.OddsType, bet.OddsValue, betID, betOdds[bet.MarketUID], markets[0].OddsUIDS(),
+		)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInOBWagerProcessing, "%s", err)
+		}
+		bet.BetFulfillment = betFulfillment
+
+		stake, payout := types.FulfillmentExposure(betFulfillment)
+		k.updateBettorExposure(ctx, bet.Creator, bet.MarketUID, bet.OddsUID, stake, payout)
+	}
+
+	// the bet fee is charged after the fulfillment, the fee of a partially fulfilled
+	// bet is calculated on the fulfilled amount.
+	if fulfilledAmount := bet.FulfilledAmount(); fulfilledAmount.LT(bet.Amount) {
+		betConstraints, err := k.GetConstraints(ctx, bet.Denom)
+		if err != nil {
+			return err
+		}
+		volume := k.GetBettorStats(ctx, bet.Creator, bet.Denom).Volume
+		bet.Fee = betConstraints.CalculateFee(fulfilledAmount, volume)
+		if bet.Fee.GTE(fulfilledAmount) {
+			return sdkerrors.Wrapf(types.ErrBetAmountIsLow, "bet fee %s is not less than the fulfilled amount", bet.Fee)
+		}
+	}
+
+	// the bet amount is the actually fulfilled amount that is charged from the bettor.
+	bet.Amount = bet.FulfilledAmount()
+
+	// the bet fee of a parlay is charged once by the order book of the first leg.
+	if err := k.orderbookKeeper.FundBetFee(ctx, bettorAddress, markets[0].UID, bet.Fee); err != nil {
+		return sdkerrors.Wrapf(types.ErrInBetFeeTransfer, "%s", err)
 	}
 
 	// set bet as placed
@@ -69,17 +186,132 @@ func (k Keeper) Wager(ctx sdk.Context, bet *types.Bet, betOdds map[string]*types
 	// put bet in the result pending status
 	bet.Result = types.Bet_RESULT_PENDING
 
-	bet.CreatedAt = ctx.BlockTime().Unix()
-	bet.BetFulfillment = betFulfillment
-
 	// store bet in the module state
 	k.SetBet(ctx, *bet, betID)
 
-	// set bet as a pending bet
-	k.SetPendingBet(ctx, types.NewPendingBet(bet.UID, bet.Creator), betID, bet.MarketUID)
+	// set bet as a pending bet of all of the markets that the bet is placed on
+	for _, market := range markets {
+		k.SetPendingBet(ctx, types.NewPendingBet(bet.UID, bet.Creator, market.UID), betID, market.UID)
+	}
 
-	// set bet stats
-	k.SetBetStats(ctx, stats)
+	// add the bet amount to the wagered volume of the bettor
+	if !bet.IsFreeBet() {
+		k.updateBettorVolume(ctx, bet.Creator, bet.Denom, bet.Amount)
+	}
+
+	// add the bet amount to the rolling totals of the bettor limits
+	k.addBettorStake(ctx, bet)
+
+	return nil
+}
+
+// getBetMarkets returns the markets that the bet is placed on and validates the selected odds,
+// for single bets the result contains one market and for parlays the market of each leg in order.
+func (k Keeper) getBetMarkets(
+	ctx sdk.Context,
+	bet *types.Bet,
+	betOdds map[string]map[string]*types.BetOddsCompact,
+) ([]markettypes.Market, error) {
+	if !bet.IsParlay() {
+		market, err := k.getMarket(ctx, bet.MarketUID)
+		if err != nil {
+			return nil, err
+		}
+
+		// check if selected odds is valid
+		if !market.HasOdds(bet.OddsUID) {
+			return nil, types.ErrOddsUIDNotExist
+		}
+
+		if !market.IsOddsActive(bet.OddsUID) {
+			return nil, sdkerrors.Wrapf(types.ErrOddsIsNotActive, "%s", bet.OddsUID)
+		}
+
+		if len(market.Odds) != len(betOdds[bet.MarketUID]) {
+			return nil, types.ErrInsufficientOdds
+		}
+
+		return []markettypes.Market{market}, nil
+	}
+
+	markets := make([]markettypes.Market, 0, len(bet.Legs))
+	for _, leg := range bet.Legs {
+		market, err := k.getMarket(ctx, leg.MarketUID)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "%s", leg.MarketUID)
+		}
+
+		// check if selected odds of the leg is valid
+		if !market.HasOdds(leg.OddsUID) {
+			return nil, sdkerrors.Wrapf(types.ErrOddsUIDNotExist, "%s", leg.OddsUID)
+		}
+
+		if !market.IsOddsActive(leg.OddsUID) {
+			return nil, sdkerrors.Wrapf(types.ErrOddsIsNotActive, "%s", leg.OddsUID)
+		}
+
+		if len(market.Odds) != len(betOdds[leg.MarketUID]) {
+			return nil, sdkerrors.Wrapf(types.ErrInsufficientOdds, "%s", leg.MarketUID)
+		}
+
+		// all of the legs should be placed in the same denom
+		if len(markets) > 0 && markets[0].Denom != market.Denom {
+			return nil, sdkerrors.Wrapf(types.ErrParlayMarketsDenomMismatch, "%s, %s", markets[0].Denom, market.Denom)
+		}
+
+		markets = append(markets, market)
+	}
+
+	return markets, nil
+}
+
+// fulfillParlay fulfills the parlay bet by the order books of all of the legs, the bet amount
+// and the payout profit is divided equally between the legs and each portion is fulfilled by
+// the corresponding order book according to the combined odds of the parlay.
+func (k Keeper) fulfillParlay(
+	ctx sdk.Context,
+	bet *types.Bet,
+	betID uint64,
+	bettorAddress sdk.AccAddress,
+	payoutProfit sdk.Dec,
+	betOdds map[string]map[string]*types.BetOddsCompact,
+	markets []markettypes.Market,
+) error {
+	legsCount := sdk.NewInt(cast.ToInt64(len(bet.Legs)))
+	legAmount := bet.Amount.Quo(legsCount)
+	remainingAmount := bet.Amount
+	remainingPayoutProfit := payoutProfit
+
+	for i, leg := range bet.Legs {
+		legBetAmount := legAmount
+		legPayoutProfit := payoutProfit.QuoInt(legsCount)
+		if i == len(bet.Legs)-1 {
+			// the last leg covers the remaining amounts of the division
+			legBetAmount = remainingAmount
+			legPayoutProfit = remainingPayoutProfit
+		}
+
+		if err := k.checkBettorCaps(
+			ctx, bet.Creator, &markets[i], leg.OddsUID, legBetAmount, legBetAmount.Add(legPayoutProfit.TruncateInt()),
+		); err != nil {
+			return err
+		}
+
+		betFulfillment, err := k.orderbookKeeper.ProcessWager(
+			ctx, bet.UID, leg.MarketUID, leg.OddsUID, leg.MaxLossMultiplier, legBetAmount, legPayoutProfit, sdk.Dec{},
+			bettorAddress, sdk.ZeroInt(), bet.OddsType, bet.OddsValue, betID, betOdds[leg.MarketUID], markets[i].OddsUIDS(),
+		)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInOBWagerProcessing, "%s: %s", leg.MarketUID, err)
+		}
+		leg.BetFulfillment = betFulfillment
+
+		stake, payout := types.FulfillmentExposure(betFulfillment)
+		k.updateBettorExposure(ctx, bet.Creator, leg.MarketUID, leg.OddsUID, stake, payout)
+
+		remainingAmount = remainingAmount.Sub(legBetAmount)
+		remainingPayoutProfit = remainingPayoutProfit.Sub(legPayoutProfit)
+	}
 
 	return nil
 }
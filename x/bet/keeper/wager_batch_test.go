@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	sgetypes "github.com/sge-network/sge/types"
+	"github.com/sge-network/sge/x/bet/keeper"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func testWagerBatchProps(t testing.TB, marketUID string, amounts ...int64) []*types.WagerProps {
+	bettorAddress := simappUtil.TestParamUsers["user1"].Address.String()
+	ticket, err := createJwtTicket(jwt.MapClaims{
+		"exp": 9999999999,
+		"iat": 7777777777,
+		"selected_odds": &types.BetOdds{
+			UID:               testOddsUID1,
+			MarketUID:         marketUID,
+			Value:             "2.00",
+			MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+		},
+		"kyc_data": &sgetypes.KycDataPayload{
+			Approved: true,
+			ID:       bettorAddress,
+		},
+		"odds_type": 1,
+		"all_odds":  testBetOdds,
+	})
+	require.NoError(t, err)
+
+	props := make([]*types.WagerProps, 0, len(amounts))
+	for _, amount := range amounts {
+		props = append(props, &types.WagerProps{
+			UID:    uuid.NewString(),
+			Amount: sdk.NewInt(amount),
+			Ticket: ticket,
+		})
+	}
+	return props
+}
+
+func TestWagerBatch(t *testing.T) {
+	for _, tc := range []struct {
+		desc    string
+		mode    types.WagerBatchMode
+		amounts []int64
+		success []bool
+		err     error
+	}{
+		{
+			desc:    "atomic",
+			mode:    types.WagerBatchMode_WAGER_BATCH_MODE_ATOMIC,
+			amounts: []int64{1000000, 2000000, 3000000},
+			success: []bool{true, true, true},
+		},
+		{
+			desc:    "atomic with a failed bet",
+			mode:    types.WagerBatchMode_WAGER_BATCH_MODE_ATOMIC,
+			amounts: []int64{1000000, 500, 3000000},
+			err:     types.ErrInWagerBatch,
+		},
+		{
+			desc:    "best effort with a failed bet",
+			mode:    types.WagerBatchMode_WAGER_BATCH_MODE_BEST_EFFORT,
+			amounts: []int64{1000000, 500, 3000000},
+			success: []bool{true, false, true},
+		},
+		{
+			desc:    "count exceeded",
+			mode:    types.WagerBatchMode_WAGER_BATCH_MODE_BEST_EFFORT,
+			amounts: []int64{1000000, 1000000, 1000000, 1000000},
+			err:     types.ErrWagerBatchCountExceeded,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, k, ctx := setupKeeperAndApp(t)
+			marketUIDs := setupParlayMarkets(t, tApp, ctx, 1)
+
+			p := k.GetParams(ctx)
+			p.MaxWagerBatchCount = 3
+			k.SetParams(ctx, p)
+
+			bettorAddress := simappUtil.TestParamUsers["user1"].Address
+			balanceBefore := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+
+			props := testWagerBatchProps(t, marketUIDs[0], tc.amounts...)
+			res, err := keeper.NewMsgServerImpl(*k).WagerBatch(sdk.WrapSDKContext(ctx), &types.MsgWagerBatch{
+				Creator: bettorAddress.String(),
+				Props:   props,
+				Mode:    tc.mode,
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res.Results, len(props))
+
+			charged := sdk.ZeroInt()
+			for i, result := range res.Results {
+				require.Equal(t, props[i].UID, result.UID)
+				require.Equal(t, tc.success[i], result.Success)
+
+				_, found := k.GetBetID(ctx, props[i].UID)
+				require.Equal(t, tc.success[i], found)
+
+				if result.Success {
+					require.Empty(t, result.Error)
+					charged = charged.Add(props[i].Amount)
+				} else {
+					require.NotEmpty(t, result.Error)
+				}
+			}
+
+			// only the successful bets are charged from the bettor
+			balanceAfter := tApp.BankKeeper.GetBalance(ctx, bettorAddress, params.DefaultBondDenom)
+			require.Equal(t, charged, balanceBefore.Amount.Sub(balanceAfter.Amount))
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/google/uuid"
+	"github.com/sge-network/sge/app/params"
 	simappUtil "github.com/sge-network/sge/testutil/simapp"
 	"github.com/sge-network/sge/x/bet/types"
 	markettypes "github.com/sge-network/sge/x/market/types"
@@ -106,6 +108,7 @@ func TestWager(t *testing.T) {
 				UID:    "uid_oddsNotexist",
 				Status: markettypes.MarketStatus_MARKET_STATUS_ACTIVE,
 				EndTS:  uint64(ctx.BlockTime().Unix()) + 1000,
+				Denom:  params.DefaultBondDenom,
 				Odds: []*markettypes.Odds{
 					{UID: "odds1"},
 					{UID: "odds2"},
@@ -136,6 +139,7 @@ func TestWager(t *testing.T) {
 				UID:    "uid_lowBetAmount",
 				Status: markettypes.MarketStatus_MARKET_STATUS_ACTIVE,
 				EndTS:  uint64(ctx.BlockTime().Unix()) + 1000,
+				Denom:  params.DefaultBondDenom,
 				Odds: []*markettypes.Odds{
 					{UID: "odds1"},
 					{UID: "odds2"},
@@ -166,6 +170,7 @@ func TestWager(t *testing.T) {
 				UID:    "uid_success",
 				Status: markettypes.MarketStatus_MARKET_STATUS_ACTIVE,
 				EndTS:  uint64(ctx.BlockTime().Unix()) + 1000,
+				Denom:  params.DefaultBondDenom,
 				Odds: []*markettypes.Odds{
 					{UID: "odds1"},
 					{UID: "odds2"},
@@ -211,7 +216,7 @@ func TestWager(t *testing.T) {
 				for _, v := range tc.market.Odds {
 					oddsUIDs = append(oddsUIDs, v.UID)
 				}
-				err := tApp.OrderbookKeeper.InitiateOrderBook(ctx, tc.market.UID, oddsUIDs)
+				err := tApp.OrderbookKeeper.InitiateOrderBook(ctx, tc.market.UID, params.DefaultBondDenom, oddsUIDs)
 				require.NoError(t, err)
 
 				if tc.market.Status == markettypes.MarketStatus_MARKET_STATUS_ACTIVE {
@@ -226,7 +231,7 @@ func TestWager(t *testing.T) {
 				}
 			}
 
-			err := k.Wager(ctx, tc.bet, tc.betOdds)
+			err := k.Wager(ctx, tc.bet, tc.betOdds, sdk.Dec{})
 			if tc.err != nil {
 				require.ErrorIs(t, err, tc.err)
 				return
@@ -236,3 +241,28 @@ func TestWager(t *testing.T) {
 		})
 	}
 }
+
+func TestWagerDenomWithoutConstraints(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+	marketUID := setupParlayMarkets(t, tApp, ctx, 1)[0]
+
+	// the bets in a denom without constraints are rejected
+	market, found := tApp.MarketKeeper.GetMarket(ctx, marketUID)
+	require.True(t, found)
+	market.Denom = "uatom"
+	tApp.MarketKeeper.SetMarket(ctx, market)
+
+	err := wagerTestBet(ctx, t, tApp, uuid.NewString(), &types.BetOdds{
+		UID:               testOddsUID1,
+		MarketUID:         marketUID,
+		Value:             "1.90",
+		MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+	})
+	require.ErrorContains(t, err, types.ErrDenomConstraintsNotFound.Error())
+
+	_, err = k.BettorFeeTier(sdk.WrapSDKContext(ctx), &types.QueryBettorFeeTierRequest{
+		Address: simappUtil.TestParamUsers["user1"].Address.String(),
+		Denom:   "uatom",
+	})
+	require.Error(t, err)
+}
@@ -34,6 +34,13 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &pendingBetA)
 			cdc.MustUnmarshal(kvB.Value, &pendingBetB)
 			return fmt.Sprintf("%v\n%v", pendingBetA, pendingBetB)
+		case bytes.Equal(kvA.Key, types.ParlayWaitingBetListPrefix):
+			var waitingBetA, waitingBetB types.PendingBet
+			cdc.MustUnmarshal(kvA.Value, &waitingBetA)
+			cdc.MustUnmarshal(kvB.Value, &waitingBetB)
+			return fmt.Sprintf("%v\n%v", waitingBetA, waitingBetB)
+		case bytes.Equal(kvA.Key, types.DeferredBookSettlementListPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
 		case bytes.Equal(kvA.Key, types.SettledBetListPrefix):
 			var settledBetA, settleBetB types.SettledBet
 			cdc.MustUnmarshal(kvA.Value, &settledBetA)
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewAffiliate creates a new affiliate object
+func NewAffiliate(address string, feeShare sdk.Dec) Affiliate {
+	return Affiliate{
+		Address:  address,
+		FeeShare: feeShare,
+	}
+}
+
+// Validate validates the address and the fee share of the affiliate.
+func (affiliate *Affiliate) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(affiliate.Address); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAffiliate, "%s", err)
+	}
+
+	if affiliate.FeeShare.IsNil() || affiliate.FeeShare.IsNegative() || affiliate.FeeShare.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidAffiliate, "fee share %s should be between 0 and 1", affiliate.FeeShare)
+	}
+
+	return nil
+}
+
+// CalculateFeeShare returns the portion of the bet fee that is paid to the affiliate.
+func (affiliate *Affiliate) CalculateFeeShare(betFee sdkmath.Int) sdkmath.Int {
+	if betFee.IsNil() || affiliate.FeeShare.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return sdk.NewDecFromInt(betFee).Mul(affiliate.FeeShare).TruncateInt()
+}
+
+// NewReferral creates a new referral binding object
+func NewReferral(bettor, referrer string, createdAt int64) Referral {
+	return Referral{
+		Bettor:    bettor,
+		Referrer:  referrer,
+		CreatedAt: createdAt,
+	}
+}
+
+// ValidateReferral validates the addresses of the bettor and the referrer of a referral binding.
+func ValidateReferral(bettor, referrer string) error {
+	if _, err := sdk.AccAddressFromBech32(bettor); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidReferral, "bettor %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(referrer); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidReferral, "referrer %s", err)
+	}
+
+	if bettor == referrer {
+		return ErrSelfReferral
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/testutil/sample"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAffiliateValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		affiliate types.Affiliate
+		err       error
+	}{
+		{
+			name:      "invalid address",
+			affiliate: types.NewAffiliate("invalid_address", sdk.NewDecWithPrec(3, 1)),
+			err:       types.ErrInvalidAffiliate,
+		},
+		{
+			name:      "negative fee share",
+			affiliate: types.NewAffiliate(sample.AccAddress(), sdk.NewDecWithPrec(-3, 1)),
+			err:       types.ErrInvalidAffiliate,
+		},
+		{
+			name:      "fee share more than one",
+			affiliate: types.NewAffiliate(sample.AccAddress(), sdk.NewDecWithPrec(11, 1)),
+			err:       types.ErrInvalidAffiliate,
+		},
+		{
+			name:      "zero fee share",
+			affiliate: types.NewAffiliate(sample.AccAddress(), sdk.ZeroDec()),
+		},
+		{
+			name:      "valid",
+			affiliate: types.NewAffiliate(sample.AccAddress(), sdk.NewDecWithPrec(3, 1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.affiliate.Validate()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAffiliateCalculateFeeShare(t *testing.T) {
+	affiliate := types.NewAffiliate(sample.AccAddress(), sdk.NewDecWithPrec(333, 3))
+	require.Equal(t, sdk.NewInt(33), affiliate.CalculateFeeShare(sdk.NewInt(100)))
+	require.Equal(t, sdk.ZeroInt(), affiliate.CalculateFeeShare(sdk.NewInt(1)))
+}
+
+func TestMsgSetReferrerValidateBasic(t *testing.T) {
+	bettor := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetReferrer
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: types.MsgSetReferrer{
+				Creator:  "invalid_address",
+				Referrer: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid referrer",
+			msg: types.MsgSetReferrer{
+				Creator:  bettor,
+				Referrer: "invalid_address",
+			},
+			err: types.ErrInvalidReferral,
+		},
+		{
+			name: "self referral",
+			msg: types.MsgSetReferrer{
+				Creator:  bettor,
+				Referrer: bettor,
+			},
+			err: types.ErrSelfReferral,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgSetReferrer{
+				Creator:  bettor,
+				Referrer: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
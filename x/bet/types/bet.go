@@ -2,13 +2,15 @@ package types
 
 import (
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	markettypes "github.com/sge-network/sge/x/market/types"
 )
 
-func NewPendingBet(uid, creator string) *PendingBet {
+func NewPendingBet(uid, creator, marketUID string) *PendingBet {
 	return &PendingBet{
-		UID:     uid,
-		Creator: creator,
+		UID:       uid,
+		Creator:   creator,
+		MarketUID: marketUID,
 	}
 }
 
@@ -52,25 +54,193 @@ func (bet *Bet) SetResult(market *markettypes.Market) error {
 		return ErrResultNotDeclared
 	}
 
-	var exist bool
-	for _, wid := range market.WinnerOddsUIDs {
-		if wid == bet.OddsUID {
-			exist = true
-			break
+	bet.Result = resultOfOdds(market.OddsResult(bet.OddsUID))
+	if bet.Result == Bet_RESULT_WON {
+		bet.DeadHeatFactor = market.DeadHeatFactor(bet.OddsUID)
+	}
+	bet.Status = Bet_STATUS_RESULT_DECLARED
+	return nil
+}
+
+// HasDeadHeat returns true if the bet is won with a dead heat factor
+// and only a portion of the stake is paid at the full odds.
+func (bet *Bet) HasDeadHeat() bool {
+	return isDeadHeat(bet.DeadHeatFactor)
+}
+
+// resultOfOdds returns the bet result corresponding to the declared result of the odds.
+func resultOfOdds(oddsResult markettypes.OddsResult) Bet_Result {
+	switch oddsResult {
+	case markettypes.OddsResult_ODDS_RESULT_WIN:
+		return Bet_RESULT_WON
+	case markettypes.OddsResult_ODDS_RESULT_PUSH:
+		return Bet_RESULT_PUSH
+	case markettypes.OddsResult_ODDS_RESULT_HALF_WIN:
+		return Bet_RESULT_HALF_WON
+	case markettypes.OddsResult_ODDS_RESULT_HALF_LOSE:
+		return Bet_RESULT_HALF_LOST
+	default:
+		return Bet_RESULT_LOST
+	}
+}
+
+// IsParlay returns true if the bet is a multi-leg (parlay) bet.
+func (bet *Bet) IsParlay() bool {
+	return len(bet.Legs) > 0
+}
+
+// LegOfMarket returns the leg of the parlay bet that is placed on the market.
+func (bet *Bet) LegOfMarket(marketUID string) (*BetLeg, bool) {
+	for _, leg := range bet.Legs {
+		if leg.MarketUID == marketUID {
+			return leg, true
 		}
 	}
+	return nil, false
+}
 
-	if exist {
-		// bettor is winner
-		bet.Result = Bet_RESULT_WON
-		bet.Status = Bet_STATUS_RESULT_DECLARED
-		return nil
+// HasLostLeg returns true if any of the parlay legs is lost.
+func (bet *Bet) HasLostLeg() bool {
+	for _, leg := range bet.Legs {
+		if leg.Result == Bet_RESULT_LOST {
+			return true
+		}
 	}
+	return false
+}
 
-	// bettor is loser
-	bet.Result = Bet_RESULT_LOST
-	bet.Status = Bet_STATUS_RESULT_DECLARED
-	return nil
+// AllLegsResolved returns true if the result of all of the parlay legs is declared.
+func (bet *Bet) AllLegsResolved() bool {
+	for _, leg := range bet.Legs {
+		if leg.Result == Bet_RESULT_PENDING {
+			return false
+		}
+	}
+	return true
+}
+
+// HasHalfResultLeg returns true if any of the parlay legs is half won or half lost.
+func (bet *Bet) HasHalfResultLeg() bool {
+	for _, leg := range bet.Legs {
+		if leg.Result == Bet_RESULT_HALF_WON || leg.Result == Bet_RESULT_HALF_LOST {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDeadHeatLeg returns true if any of the legs is won with a dead heat factor.
+func (bet *Bet) HasDeadHeatLeg() bool {
+	for _, leg := range bet.Legs {
+		if leg.HasDeadHeat() {
+			return true
+		}
+	}
+	return false
+}
+
+// EffectiveParlayOdds calculates the decimal odds of the parlay bet
+// excluding the refunded legs. The odds of a half won leg is the average
+// of its odds and one, the odds of a half lost leg is one half and the
+// odds of a dead heat leg is multiplied by its dead heat factor.
+func (bet *Bet) EffectiveParlayOdds() (sdk.Dec, error) {
+	combinedOdds := sdk.OneDec()
+	for _, leg := range bet.Legs {
+		if leg.IsRefunded() {
+			continue
+		}
+
+		decimalOdds, err := CalculateDecimalOdds(leg.OddsType, leg.OddsValue)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+
+		switch leg.Result {
+		case Bet_RESULT_WON:
+			if leg.HasDeadHeat() {
+				decimalOdds = decimalOdds.Mul(leg.DeadHeatFactor)
+			}
+		case Bet_RESULT_HALF_WON:
+			decimalOdds = decimalOdds.Add(sdk.OneDec()).QuoInt64(2)
+		case Bet_RESULT_HALF_LOST:
+			decimalOdds = sdk.NewDecWithPrec(5, 1)
+		}
+		combinedOdds = combinedOdds.Mul(decimalOdds)
+	}
+
+	return combinedOdds, nil
+}
+
+// IsRefunded returns true if the stake of the leg is returned to the bettor
+// because of the cancellation of the market or a push result.
+func (leg *BetLeg) IsRefunded() bool {
+	return leg.Result == Bet_RESULT_REFUNDED || leg.Result == Bet_RESULT_PUSH
+}
+
+// SetResult sets the leg result according to the market resolution.
+func (leg *BetLeg) SetResult(market *markettypes.Market) {
+	switch market.Status {
+	case markettypes.MarketStatus_MARKET_STATUS_CANCELED,
+		markettypes.MarketStatus_MARKET_STATUS_ABORTED:
+		leg.Result = Bet_RESULT_REFUNDED
+	case markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED:
+		leg.Result = resultOfOdds(market.OddsResult(leg.OddsUID))
+		if leg.Result == Bet_RESULT_WON {
+			leg.DeadHeatFactor = market.DeadHeatFactor(leg.OddsUID)
+		}
+	}
+}
+
+// HasDeadHeat returns true if the leg is won with a dead heat factor.
+func (leg *BetLeg) HasDeadHeat() bool {
+	return isDeadHeat(leg.DeadHeatFactor)
+}
+
+// isDeadHeat returns true if the dead heat factor is set and is less than one,
+// zero value is considered as not set because empty decimal fields are decoded as zero.
+func isDeadHeat(factor sdk.Dec) bool {
+	return !factor.IsNil() && factor.IsPositive() && factor.LT(sdk.OneDec())
+}
+
+// FulfilledAmount returns the sum of the bet amount of the bet fulfillments,
+// the fulfillments of all of the legs are summed for a parlay bet.
+func (bet *Bet) FulfilledAmount() sdkmath.Int {
+	amount := sdkmath.ZeroInt()
+	for _, mb := range bet.MarketBets() {
+		for _, bf := range mb.BetFulfillment {
+			amount = amount.Add(bf.BetAmount)
+		}
+	}
+	return amount
+}
+
+// MarketBet is the selection of a bet on a single market, a single bet has one
+// market bet and a parlay bet has one per leg.
+type MarketBet struct {
+	MarketUID      string
+	OddsUID        string
+	BetFulfillment []*BetFulfillment
+}
+
+// MarketBets returns the selections of the bet on each of its markets.
+func (bet *Bet) MarketBets() []MarketBet {
+	if !bet.IsParlay() {
+		return []MarketBet{{
+			MarketUID:      bet.MarketUID,
+			OddsUID:        bet.OddsUID,
+			BetFulfillment: bet.BetFulfillment,
+		}}
+	}
+
+	marketBets := make([]MarketBet, 0, len(bet.Legs))
+	for _, leg := range bet.Legs {
+		marketBets = append(marketBets, MarketBet{
+			MarketUID:      leg.MarketUID,
+			OddsUID:        leg.OddsUID,
+			BetFulfillment: leg.BetFulfillment,
+		})
+	}
+	return marketBets
 }
 
 // SetFee calculates and sets the betting fee.
@@ -86,6 +86,14 @@ const (
 	Bet_RESULT_LOST Bet_Result = 3
 	// bet is refunded
 	Bet_RESULT_REFUNDED Bet_Result = 4
+	// bet is settled early by the bettor at an oracle-quoted price
+	Bet_RESULT_CASHED_OUT Bet_Result = 5
+	// the stake is returned to the bettor
+	Bet_RESULT_PUSH Bet_Result = 6
+	// half of the stake won by the bettor and the other half is returned
+	Bet_RESULT_HALF_WON Bet_Result = 7
+	// half of the stake lost by the bettor and the other half is returned
+	Bet_RESULT_HALF_LOST Bet_Result = 8
 )
 
 var Bet_Result_name = map[int32]string{
@@ -94,6 +102,10 @@ var Bet_Result_name = map[int32]string{
 	2: "RESULT_WON",
 	3: "RESULT_LOST",
 	4: "RESULT_REFUNDED",
+	5: "RESULT_CASHED_OUT",
+	6: "RESULT_PUSH",
+	7: "RESULT_HALF_WON",
+	8: "RESULT_HALF_LOST",
 }
 
 var Bet_Result_value = map[string]int32{
@@ -102,6 +114,10 @@ var Bet_Result_value = map[string]int32{
 	"RESULT_WON":         2,
 	"RESULT_LOST":        3,
 	"RESULT_REFUNDED":    4,
+	"RESULT_CASHED_OUT":  5,
+	"RESULT_PUSH":        6,
+	"RESULT_HALF_WON":    7,
+	"RESULT_HALF_LOST":   8,
 }
 
 func (x Bet_Result) String() string {
@@ -145,6 +161,19 @@ type Bet struct {
 	MaxLossMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_loss_multiplier,json=maxLossMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_loss_multiplier"`
 	// bet_fulfillment is the fulfillment data.
 	BetFulfillment []*BetFulfillment `protobuf:"bytes,14,rep,name=bet_fulfillment,json=betFulfillment,proto3" json:"bet_fulfillment,omitempty"`
+	// legs contains the selections of a multi-leg (parlay) bet,
+	// it is empty for single bets.
+	Legs []*BetLeg `protobuf:"bytes,15,rep,name=legs,proto3" json:"legs,omitempty"`
+	// denom is the denomination of the bet amount and fee, it is the
+	// accepted denom of the market of the bet.
+	Denom string `protobuf:"bytes,16,opt,name=denom,proto3" json:"denom,omitempty"`
+	// dead_heat_factor is the portion of the stake paid at the full odds
+	// when the odds of the bet ties with other winners of the market.
+	DeadHeatFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=dead_heat_factor,json=deadHeatFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dead_heat_factor"`
+	// free_bet_credit_uid is the universal unique identifier of the free bet
+	// credit that the bet is placed with, it is empty for the bets placed with
+	// the balance of the bettor.
+	FreeBetCreditUID string `protobuf:"bytes,18,opt,name=free_bet_credit_uid,proto3" json:"free_bet_credit_uid"`
 }
 
 func (m *Bet) Reset()         { *m = Bet{} }
@@ -257,6 +286,27 @@ func (m *Bet) GetBetFulfillment() []*BetFulfillment {
 	return nil
 }
 
+func (m *Bet) GetLegs() []*BetLeg {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
+}
+
+func (m *Bet) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Bet) GetFreeBetCreditUID() string {
+	if m != nil {
+		return m.FreeBetCreditUID
+	}
+	return ""
+}
+
 // UID2ID is the type for mapping UIDs and Sequential IDs of bets.
 type UID2ID struct {
 	// uid is the universal unique identifier assigned to the bet.
@@ -318,6 +368,9 @@ type PendingBet struct {
 	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
 	// creator is the bettor address.
 	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// market_uid is the universal unique identifier of the market
+	// that the pending bet is waiting for.
+	MarketUID string `protobuf:"bytes,3,opt,name=market_uid,proto3" json:"market_uid"`
 }
 
 func (m *PendingBet) Reset()         { *m = PendingBet{} }
@@ -367,6 +420,13 @@ func (m *PendingBet) GetCreator() string {
 	return ""
 }
 
+func (m *PendingBet) GetMarketUID() string {
+	if m != nil {
+		return m.MarketUID
+	}
+	return ""
+}
+
 // SettledBet is the type for a settled bet.
 type SettledBet struct {
 	// uid is the universal unique identifier for the bet.
@@ -437,6 +497,8 @@ type BetFulfillment struct {
 	BetAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=bet_amount,json=betAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bet_amount" yaml:"bet_amount"`
 	// payout_profit is the fulfilled profit by the participation.
 	PayoutProfit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=payout_profit,json=payoutProfit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"payout_profit" yaml:"payout_profit"`
+	// round is the round of the participation exposure that fulfilled the bet.
+	Round uint64 `protobuf:"varint,5,opt,name=round,proto3" json:"round,omitempty" yaml:"round"`
 }
 
 func (m *BetFulfillment) Reset()         { *m = BetFulfillment{} }
@@ -486,6 +548,111 @@ func (m *BetFulfillment) GetParticipationIndex() uint64 {
 	return 0
 }
 
+func (m *BetFulfillment) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+// BetLeg is a single selection of a multi-leg (parlay) bet.
+type BetLeg struct {
+	// market_uid is the universal unique identifier of
+	// the market of the leg.
+	MarketUID string `protobuf:"bytes,1,opt,name=market_uid,proto3" json:"market_uid"`
+	// odds_uid is the universal unique identifier,
+	// of the odds selected in the leg.
+	OddsUID string `protobuf:"bytes,2,opt,name=odds_uid,proto3" json:"odds_uid"`
+	// odds_type is the type of the leg odds value.
+	OddsType OddsType `protobuf:"varint,3,opt,name=odds_type,json=oddsType,proto3,enum=sgenetwork.sge.bet.OddsType" json:"odds_type,omitempty"`
+	// odds_value is the odds value of the leg.
+	OddsValue string `protobuf:"bytes,4,opt,name=odds_value,json=oddsValue,proto3" json:"odds_value,omitempty"`
+	// max_loss_multiplier is the multiplier coefficient of max loss.
+	MaxLossMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_loss_multiplier,json=maxLossMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_loss_multiplier"`
+	// result is the result of the leg, such as `won` or `lost`.
+	Result Bet_Result `protobuf:"varint,6,opt,name=result,proto3,enum=sgenetwork.sge.bet.Bet_Result" json:"result,omitempty"`
+	// bet_fulfillment is the fulfillment data of the leg order book.
+	BetFulfillment []*BetFulfillment `protobuf:"bytes,7,rep,name=bet_fulfillment,json=betFulfillment,proto3" json:"bet_fulfillment,omitempty"`
+	// dead_heat_factor is the portion of the leg stake paid at the full odds
+	// when the odds of the leg ties with other winners of the market.
+	DeadHeatFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=dead_heat_factor,json=deadHeatFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dead_heat_factor"`
+}
+
+func (m *BetLeg) Reset()         { *m = BetLeg{} }
+func (m *BetLeg) String() string { return proto.CompactTextString(m) }
+func (*BetLeg) ProtoMessage()    {}
+func (*BetLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bc076bb1a4d9f6e, []int{5}
+}
+func (m *BetLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BetLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BetLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BetLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BetLeg.Merge(m, src)
+}
+func (m *BetLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *BetLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_BetLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BetLeg proto.InternalMessageInfo
+
+func (m *BetLeg) GetMarketUID() string {
+	if m != nil {
+		return m.MarketUID
+	}
+	return ""
+}
+
+func (m *BetLeg) GetOddsUID() string {
+	if m != nil {
+		return m.OddsUID
+	}
+	return ""
+}
+
+func (m *BetLeg) GetOddsType() OddsType {
+	if m != nil {
+		return m.OddsType
+	}
+	return OddsType_ODDS_TYPE_UNSPECIFIED
+}
+
+func (m *BetLeg) GetOddsValue() string {
+	if m != nil {
+		return m.OddsValue
+	}
+	return ""
+}
+
+func (m *BetLeg) GetResult() Bet_Result {
+	if m != nil {
+		return m.Result
+	}
+	return Bet_RESULT_UNSPECIFIED
+}
+
+func (m *BetLeg) GetBetFulfillment() []*BetFulfillment {
+	if m != nil {
+		return m.BetFulfillment
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("sgenetwork.sge.bet.Bet_Status", Bet_Status_name, Bet_Status_value)
 	proto.RegisterEnum("sgenetwork.sge.bet.Bet_Result", Bet_Result_name, Bet_Result_value)
@@ -494,67 +661,82 @@ func init() {
 	proto.RegisterType((*PendingBet)(nil), "sgenetwork.sge.bet.PendingBet")
 	proto.RegisterType((*SettledBet)(nil), "sgenetwork.sge.bet.SettledBet")
 	proto.RegisterType((*BetFulfillment)(nil), "sgenetwork.sge.bet.BetFulfillment")
+	proto.RegisterType((*BetLeg)(nil), "sgenetwork.sge.bet.BetLeg")
 }
 
 func init() { proto.RegisterFile("sge/bet/bet.proto", fileDescriptor_9bc076bb1a4d9f6e) }
 
 var fileDescriptor_9bc076bb1a4d9f6e = []byte{
-	// 879 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0xe2, 0x46,
-	0x14, 0x8f, 0x71, 0x96, 0x84, 0x97, 0x84, 0x98, 0xc9, 0x6a, 0xd7, 0x4a, 0xb7, 0x80, 0x2c, 0xb5,
-	0x8a, 0x54, 0x2d, 0x48, 0x59, 0xa9, 0x52, 0xdb, 0xcb, 0x02, 0x36, 0x0d, 0x2a, 0x0b, 0x68, 0x80,
-	0x56, 0xea, 0xa1, 0x96, 0xc1, 0x13, 0xe2, 0xc6, 0x7f, 0x90, 0x67, 0xdc, 0x26, 0xe7, 0x7e, 0x80,
-	0xf6, 0x23, 0xf4, 0xe3, 0xec, 0x71, 0x8f, 0x55, 0x0f, 0x56, 0x45, 0x6e, 0x3d, 0xee, 0x27, 0xa8,
-	0x66, 0x3c, 0x21, 0x46, 0x4d, 0xab, 0x64, 0x0f, 0xc0, 0x9b, 0xdf, 0xfc, 0x7e, 0xbf, 0x37, 0xf3,
-	0xfc, 0x78, 0x86, 0x0a, 0x5d, 0x90, 0xe6, 0x8c, 0x30, 0xfe, 0x69, 0x2c, 0xe3, 0x88, 0x45, 0x08,
-	0xd1, 0x05, 0x09, 0x09, 0xfb, 0x39, 0x8a, 0x2f, 0x1b, 0x74, 0x41, 0x1a, 0x33, 0xc2, 0x8e, 0x9f,
-	0x2e, 0xa2, 0x45, 0x24, 0xb6, 0x9b, 0x3c, 0xca, 0x98, 0xc7, 0xcf, 0x6f, 0xc5, 0x91, 0xeb, 0x52,
-	0x9b, 0x5d, 0x2f, 0x49, 0xb6, 0x61, 0xfc, 0xba, 0x0b, 0x6a, 0x9b, 0x30, 0x54, 0x07, 0x35, 0xf1,
-	0x5c, 0x5d, 0xa9, 0x2b, 0x27, 0xa5, 0x76, 0x79, 0x95, 0xd6, 0xd4, 0x69, 0xcf, 0xfc, 0x3b, 0xad,
-	0x71, 0x14, 0xf3, 0x2f, 0xf4, 0x15, 0x40, 0xe0, 0xc4, 0x97, 0x84, 0xd9, 0x9c, 0x58, 0x10, 0xc4,
-	0x8f, 0x56, 0x69, 0xad, 0xf4, 0x46, 0xa0, 0x19, 0x3d, 0x47, 0xc1, 0xb9, 0x18, 0xbd, 0x82, 0x5d,
-	0x91, 0x99, 0x4b, 0x55, 0x21, 0x7d, 0xbe, 0x4a, 0x6b, 0x3b, 0x43, 0xd7, 0xa5, 0x99, 0x70, 0xbd,
-	0x8d, 0xd7, 0x11, 0xfa, 0x02, 0x4a, 0xeb, 0xe3, 0xea, 0xdb, 0x75, 0xe5, 0xa4, 0x7c, 0xfa, 0xa2,
-	0xf1, 0xef, 0x2b, 0x37, 0xb8, 0xcb, 0xe4, 0x7a, 0x49, 0x32, 0x29, 0x8f, 0xd0, 0xc7, 0x00, 0x42,
-	0xfa, 0x93, 0xe3, 0x27, 0x44, 0x7f, 0xc2, 0x33, 0x62, 0x61, 0xf6, 0x2d, 0x07, 0x50, 0x17, 0x8a,
-	0x4e, 0x10, 0x25, 0x21, 0xd3, 0x8b, 0xe2, 0x30, 0x8d, 0xb7, 0x69, 0x6d, 0xeb, 0xcf, 0xb4, 0xf6,
-	0xe9, 0xc2, 0x63, 0x17, 0xc9, 0xac, 0x31, 0x8f, 0x82, 0xe6, 0x3c, 0xa2, 0x41, 0x44, 0xe5, 0xcf,
-	0x4b, 0xea, 0x5e, 0x36, 0xf9, 0x39, 0x68, 0xa3, 0x17, 0x32, 0x2c, 0xd5, 0xe8, 0x35, 0xa8, 0xe7,
-	0x84, 0xe8, 0x3b, 0x1f, 0x64, 0xc2, 0xa5, 0xe8, 0x73, 0x28, 0x52, 0xe6, 0xb0, 0x84, 0xea, 0xbb,
-	0xe2, 0x82, 0xd5, 0xfb, 0x2e, 0xd8, 0x26, 0xac, 0x31, 0x16, 0x2c, 0x2c, 0xd9, 0x5c, 0x17, 0x13,
-	0x9a, 0xf8, 0x4c, 0x2f, 0xfd, 0xbf, 0x0e, 0x0b, 0x16, 0x96, 0x6c, 0xa4, 0xc3, 0xce, 0x3c, 0x26,
-	0x0e, 0x8b, 0x62, 0x1d, 0x44, 0x55, 0x6e, 0x97, 0xbc, 0x64, 0x22, 0x24, 0xae, 0xed, 0x30, 0x7d,
-	0xaf, 0xae, 0x9c, 0xa8, 0xb8, 0x24, 0x91, 0x16, 0x43, 0x9f, 0x41, 0x85, 0x12, 0xc6, 0x7c, 0x12,
-	0x90, 0x90, 0xd9, 0x17, 0xc4, 0x5b, 0x5c, 0x30, 0x7d, 0x5f, 0xb0, 0xb4, 0xbb, 0x8d, 0x33, 0x81,
-	0xa3, 0x1f, 0xe0, 0x28, 0x70, 0xae, 0x6c, 0x3f, 0xa2, 0xd4, 0x0e, 0x12, 0x9f, 0x79, 0x4b, 0xdf,
-	0x23, 0xb1, 0x7e, 0xf0, 0xe8, 0x3a, 0x99, 0x64, 0x8e, 0x2b, 0x81, 0x73, 0xd5, 0x8f, 0x28, 0x7d,
-	0xb3, 0x36, 0x42, 0xdf, 0xc0, 0xe1, 0x8c, 0x30, 0xfb, 0x3c, 0xf1, 0xcf, 0x3d, 0xdf, 0xe7, 0x89,
-	0xf5, 0x72, 0x5d, 0x3d, 0xd9, 0x3b, 0x35, 0xfe, 0xa3, 0x0c, 0xdd, 0x3b, 0x26, 0x2e, 0xcf, 0x36,
-	0xd6, 0xc6, 0xef, 0x0a, 0x14, 0xb3, 0xea, 0xa2, 0x67, 0x80, 0xc6, 0x93, 0xd6, 0x64, 0x3a, 0xb6,
-	0xa7, 0x83, 0xf1, 0xc8, 0xea, 0xf4, 0xba, 0x3d, 0xcb, 0xd4, 0xb6, 0x50, 0x05, 0x0e, 0x24, 0x3e,
-	0xea, 0xb7, 0x3a, 0x96, 0xa9, 0x29, 0xe8, 0x08, 0x0e, 0x25, 0xd4, 0x69, 0x0d, 0x3a, 0x56, 0xdf,
-	0x32, 0xb5, 0x02, 0x42, 0x50, 0x96, 0x60, 0xab, 0x3d, 0xc4, 0x13, 0xcb, 0xd4, 0xd4, 0x1c, 0x36,
-	0xb2, 0x06, 0x66, 0x6f, 0xf0, 0xb5, 0xb6, 0x8d, 0x8e, 0xe1, 0x99, 0xc4, 0xb0, 0x35, 0x9e, 0xf6,
-	0x27, 0xb6, 0x69, 0x75, 0xfa, 0x2d, 0x6c, 0x99, 0xda, 0x93, 0x1c, 0x7f, 0x6c, 0x4d, 0x26, 0xdc,
-	0xb7, 0x68, 0xfc, 0x08, 0xc5, 0xec, 0x39, 0xf2, 0x13, 0x4a, 0xc9, 0xe6, 0x09, 0x11, 0x94, 0x25,
-	0x7e, 0x9b, 0x45, 0x41, 0x65, 0x00, 0x89, 0x7d, 0x37, 0x1c, 0x68, 0x05, 0x74, 0x08, 0x7b, 0x72,
-	0xdd, 0x1f, 0x8e, 0x27, 0x9a, 0xca, 0xef, 0x20, 0x01, 0x6c, 0x75, 0xa7, 0x03, 0xd3, 0x32, 0xb5,
-	0x6d, 0xe3, 0x0c, 0x8a, 0xd3, 0x9e, 0x79, 0xda, 0x33, 0x1f, 0x30, 0x13, 0x5e, 0x40, 0x41, 0xce,
-	0x82, 0xed, 0xf6, 0xfe, 0x2a, 0xad, 0x15, 0xc4, 0x7e, 0xc1, 0x73, 0x71, 0xc1, 0x73, 0x8d, 0x33,
-	0x80, 0x11, 0x09, 0x5d, 0x2f, 0x5c, 0x3c, 0x6c, 0xc2, 0xe4, 0x7a, 0xb3, 0xb0, 0xd1, 0x9b, 0xc6,
-	0x14, 0x60, 0x2c, 0x7a, 0xcc, 0x7d, 0x98, 0xd3, 0x27, 0xc0, 0x1f, 0x32, 0x8b, 0x62, 0xdb, 0x71,
-	0xdd, 0x98, 0x50, 0x2a, 0x0d, 0x0f, 0x32, 0xb4, 0x95, 0x81, 0xc6, 0x2f, 0x2a, 0x94, 0x37, 0x9b,
-	0x03, 0x0d, 0xe1, 0x68, 0xe9, 0xc4, 0xcc, 0x9b, 0x7b, 0x4b, 0x27, 0x64, 0x6b, 0x79, 0x96, 0xab,
-	0xfa, 0x3e, 0xad, 0x1d, 0x5f, 0x3b, 0x81, 0xff, 0xa5, 0x71, 0x0f, 0xc9, 0xc0, 0x28, 0x87, 0xca,
-	0x1c, 0x1b, 0x86, 0xcc, 0x8b, 0x42, 0xdb, 0x0b, 0x5d, 0x72, 0x25, 0x6b, 0x76, 0x9f, 0xe1, 0x1d,
-	0x29, 0x6f, 0xc8, 0xd1, 0x1e, 0x07, 0xd1, 0x0c, 0x80, 0xf7, 0xbe, 0x9c, 0x5f, 0xd9, 0x30, 0xed,
-	0x3c, 0x6e, 0xf4, 0xbc, 0x4f, 0x6b, 0x95, 0x2c, 0xeb, 0x9d, 0x93, 0x81, 0x4b, 0x33, 0xc2, 0x5a,
-	0xd9, 0x5c, 0xbb, 0x84, 0x83, 0xa5, 0x73, 0x1d, 0x25, 0xcc, 0x5e, 0xc6, 0xd1, 0xb9, 0xc7, 0xc4,
-	0xf4, 0x2d, 0xb5, 0xbb, 0x8f, 0x4e, 0xf3, 0xf4, 0xf6, 0x72, 0x39, 0x33, 0x03, 0xef, 0x67, 0xeb,
-	0x91, 0x58, 0xb6, 0x5f, 0xbf, 0x5d, 0x55, 0x95, 0x77, 0xab, 0xaa, 0xf2, 0xd7, 0xaa, 0xaa, 0xfc,
-	0x76, 0x53, 0xdd, 0x7a, 0x77, 0x53, 0xdd, 0xfa, 0xe3, 0xa6, 0xba, 0xf5, 0x7d, 0x3e, 0x0f, 0x5d,
-	0x90, 0x97, 0xf2, 0x8f, 0xcd, 0xe3, 0xe6, 0x95, 0x78, 0x9d, 0x89, 0x5c, 0xb3, 0xa2, 0x78, 0x97,
-	0xbd, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xfa, 0xa0, 0x08, 0x67, 0x23, 0x07, 0x00, 0x00,
+	// 1095 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0xe3, 0xc4, 0x6d, 0xde, 0xb6, 0xa9, 0x33, 0x2d, 0xbb, 0x56, 0x59, 0xe2, 0xca, 0x12,
+	0xab, 0x4a, 0xab, 0x4d, 0xa5, 0x5d, 0x09, 0x09, 0xb8, 0x6c, 0x12, 0x3b, 0x24, 0x22, 0x9b, 0x44,
+	0x93, 0x04, 0x10, 0x12, 0x58, 0x4e, 0x3c, 0x71, 0xad, 0x3a, 0x76, 0x64, 0x4f, 0xa0, 0xfd, 0x01,
+	0xdc, 0x91, 0xf8, 0x03, 0xdc, 0xf9, 0x0d, 0xdc, 0xf7, 0xb8, 0x47, 0xc4, 0xc1, 0x42, 0xd9, 0x1b,
+	0xc7, 0xfe, 0x02, 0x34, 0x63, 0xb7, 0x75, 0x96, 0x14, 0xb5, 0x65, 0x0f, 0x6d, 0x66, 0xbe, 0xf9,
+	0xbe, 0xef, 0xcd, 0x3c, 0xf9, 0xcd, 0x1b, 0x28, 0x47, 0x0e, 0x39, 0x1e, 0x13, 0xca, 0xfe, 0xaa,
+	0xf3, 0x30, 0xa0, 0x01, 0x42, 0x91, 0x43, 0x7c, 0x42, 0x7f, 0x0c, 0xc2, 0xd3, 0x6a, 0xe4, 0x90,
+	0xea, 0x98, 0xd0, 0x83, 0x7d, 0x27, 0x70, 0x02, 0xbe, 0x7c, 0xcc, 0x46, 0x09, 0xf3, 0xe0, 0xd1,
+	0xa5, 0x38, 0xb0, 0xed, 0xc8, 0xa4, 0xe7, 0x73, 0x92, 0x2c, 0x68, 0x4b, 0x00, 0xb1, 0x4e, 0x28,
+	0x3a, 0x04, 0x71, 0xe1, 0xda, 0x8a, 0x70, 0x28, 0x1c, 0x15, 0xeb, 0xa5, 0x65, 0xac, 0x8a, 0xa3,
+	0xb6, 0xfe, 0x77, 0xac, 0x32, 0x14, 0xb3, 0x7f, 0xe8, 0x73, 0x80, 0x99, 0x15, 0x9e, 0x12, 0x6a,
+	0x32, 0x62, 0x8e, 0x13, 0x3f, 0x5c, 0xc6, 0x6a, 0xf1, 0x15, 0x47, 0x13, 0x7a, 0x86, 0x82, 0x33,
+	0x63, 0xf4, 0x02, 0xb6, 0x78, 0x64, 0x26, 0x15, 0xb9, 0xf4, 0xd1, 0x32, 0x56, 0x37, 0x7b, 0xb6,
+	0x1d, 0x25, 0xc2, 0xab, 0x65, 0x7c, 0x35, 0x42, 0x9f, 0x42, 0xf1, 0x6a, 0xbb, 0x4a, 0xfe, 0x50,
+	0x38, 0x2a, 0x3d, 0x7f, 0x5c, 0xfd, 0xf7, 0x91, 0xab, 0xcc, 0x65, 0x78, 0x3e, 0x27, 0x89, 0x94,
+	0x8d, 0xd0, 0x47, 0x00, 0x5c, 0xfa, 0x83, 0xe5, 0x2d, 0x88, 0x52, 0x60, 0x11, 0x31, 0x37, 0xfb,
+	0x8a, 0x01, 0xa8, 0x09, 0x92, 0x35, 0x0b, 0x16, 0x3e, 0x55, 0x24, 0xbe, 0x99, 0xea, 0xeb, 0x58,
+	0xdd, 0xf8, 0x33, 0x56, 0x9f, 0x38, 0x2e, 0x3d, 0x59, 0x8c, 0xab, 0x93, 0x60, 0x76, 0x3c, 0x09,
+	0xa2, 0x59, 0x10, 0xa5, 0x3f, 0xcf, 0x22, 0xfb, 0xf4, 0x98, 0xed, 0x23, 0xaa, 0xb6, 0x7d, 0x8a,
+	0x53, 0x35, 0x7a, 0x09, 0xe2, 0x94, 0x10, 0x65, 0xf3, 0x5e, 0x26, 0x4c, 0x8a, 0x3e, 0x01, 0x29,
+	0xa2, 0x16, 0x5d, 0x44, 0xca, 0x16, 0x3f, 0x60, 0x65, 0xdd, 0x01, 0xeb, 0x84, 0x56, 0x07, 0x9c,
+	0x85, 0x53, 0x36, 0xd3, 0x85, 0x24, 0x5a, 0x78, 0x54, 0x29, 0xfe, 0xb7, 0x0e, 0x73, 0x16, 0x4e,
+	0xd9, 0x48, 0x81, 0xcd, 0x49, 0x48, 0x2c, 0x1a, 0x84, 0x0a, 0xf0, 0xac, 0x5c, 0x4e, 0x59, 0xca,
+	0xf8, 0x90, 0xd8, 0xa6, 0x45, 0x95, 0x07, 0x87, 0xc2, 0x91, 0x88, 0x8b, 0x29, 0x52, 0xa3, 0xe8,
+	0x29, 0x94, 0x23, 0x42, 0xa9, 0x47, 0x66, 0xc4, 0xa7, 0xe6, 0x09, 0x71, 0x9d, 0x13, 0xaa, 0x6c,
+	0x73, 0x96, 0x7c, 0xbd, 0xd0, 0xe2, 0x38, 0xfa, 0x1e, 0xf6, 0x66, 0xd6, 0x99, 0xe9, 0x05, 0x51,
+	0x64, 0xce, 0x16, 0x1e, 0x75, 0xe7, 0x9e, 0x4b, 0x42, 0x65, 0xe7, 0xce, 0x79, 0xd2, 0xc9, 0x04,
+	0x97, 0x67, 0xd6, 0x59, 0x27, 0x88, 0xa2, 0x57, 0x57, 0x46, 0xe8, 0x4b, 0xd8, 0x1d, 0x13, 0x6a,
+	0x4e, 0x17, 0xde, 0xd4, 0xf5, 0x3c, 0x16, 0x58, 0x29, 0x1d, 0x8a, 0x47, 0x0f, 0x9e, 0x6b, 0x37,
+	0xa4, 0xa1, 0x79, 0xcd, 0xc4, 0xa5, 0xf1, 0xca, 0x1c, 0x55, 0x21, 0xef, 0x11, 0x27, 0x52, 0x76,
+	0xb9, 0xc3, 0xc1, 0x0d, 0x0e, 0x1d, 0xe2, 0x60, 0xce, 0x43, 0xfb, 0x50, 0xb0, 0x89, 0x1f, 0xcc,
+	0x14, 0x99, 0x27, 0x30, 0x99, 0xa0, 0x6f, 0x40, 0xb6, 0x89, 0x65, 0x9b, 0x27, 0xc4, 0xa2, 0xe6,
+	0xd4, 0x9a, 0xb0, 0x0c, 0x97, 0xef, 0x75, 0xde, 0x12, 0xf3, 0x69, 0x11, 0x8b, 0x36, 0xb9, 0x0b,
+	0xfa, 0x0e, 0xf6, 0xa6, 0x21, 0x21, 0x26, 0x3b, 0xf1, 0x24, 0x24, 0xb6, 0x9b, 0x54, 0x20, 0xe2,
+	0xe6, 0x4f, 0x97, 0xb1, 0x2a, 0x37, 0x43, 0x42, 0xea, 0x84, 0x36, 0xf8, 0x62, 0x52, 0x4f, 0xeb,
+	0x24, 0x78, 0x1d, 0xa8, 0xfd, 0x2a, 0x80, 0x94, 0x7c, 0x5c, 0xe8, 0x21, 0xa0, 0xc1, 0xb0, 0x36,
+	0x1c, 0x0d, 0xcc, 0x51, 0x77, 0xd0, 0x37, 0x1a, 0xed, 0x66, 0xdb, 0xd0, 0xe5, 0x0d, 0x54, 0x86,
+	0x9d, 0x14, 0xef, 0x77, 0x6a, 0x0d, 0x43, 0x97, 0x05, 0xb4, 0x07, 0xbb, 0x29, 0xd4, 0xa8, 0x75,
+	0x1b, 0x46, 0xc7, 0xd0, 0xe5, 0x1c, 0x42, 0x50, 0x4a, 0xc1, 0x5a, 0xbd, 0x87, 0x87, 0x86, 0x2e,
+	0x8b, 0x19, 0xac, 0x6f, 0x74, 0xf5, 0x76, 0xf7, 0x0b, 0x39, 0x8f, 0x0e, 0xe0, 0x61, 0x8a, 0x61,
+	0x63, 0x30, 0xea, 0x0c, 0x4d, 0xdd, 0x68, 0x74, 0x6a, 0xd8, 0xd0, 0xe5, 0x42, 0x86, 0x3f, 0x30,
+	0x86, 0x43, 0xe6, 0x2b, 0x69, 0xbf, 0x0b, 0x20, 0x25, 0xdf, 0x31, 0xdb, 0x62, 0xaa, 0x59, 0xdd,
+	0x22, 0x82, 0x52, 0x8a, 0x5f, 0x86, 0x11, 0x50, 0x09, 0x20, 0xc5, 0xbe, 0xee, 0x75, 0xe5, 0x1c,
+	0xda, 0x85, 0x07, 0xe9, 0xbc, 0xd3, 0x1b, 0x0c, 0x65, 0x91, 0x1d, 0x22, 0x05, 0xb0, 0xd1, 0x1c,
+	0x75, 0x75, 0x43, 0x97, 0xf3, 0xe8, 0x03, 0x28, 0xa7, 0x60, 0xa3, 0x36, 0x68, 0x19, 0xba, 0xd9,
+	0x1b, 0x0d, 0xe5, 0x42, 0x46, 0xdc, 0x1f, 0x0d, 0x5a, 0xb2, 0x94, 0x11, 0xb7, 0x6a, 0x9d, 0x26,
+	0x0f, 0xb1, 0x89, 0xf6, 0x41, 0xce, 0x82, 0x3c, 0xce, 0x96, 0xd6, 0x02, 0x69, 0xd4, 0xd6, 0x9f,
+	0xb7, 0xf5, 0x5b, 0x5c, 0xb3, 0x8f, 0x21, 0x97, 0x5e, 0xaf, 0xf9, 0xfa, 0xf6, 0x32, 0x56, 0x73,
+	0x7c, 0x3d, 0xe7, 0xda, 0x38, 0xe7, 0xda, 0xda, 0x4f, 0x02, 0x40, 0x9f, 0xf8, 0xb6, 0xeb, 0x3b,
+	0xb7, 0xbb, 0xb5, 0x33, 0xf5, 0x9e, 0x5b, 0xad, 0xf7, 0xd5, 0xfb, 0x5c, 0xbc, 0xd3, 0x7d, 0xae,
+	0x8d, 0x00, 0x06, 0xbc, 0xe8, 0xed, 0xdb, 0x6d, 0xe3, 0x63, 0x60, 0x55, 0x47, 0x83, 0xd0, 0xb4,
+	0x6c, 0x3b, 0x24, 0x51, 0x94, 0xee, 0x66, 0x27, 0x41, 0x6b, 0x09, 0xa8, 0xfd, 0x26, 0x42, 0x69,
+	0xb5, 0x5a, 0x51, 0x0f, 0xf6, 0xe6, 0x56, 0x48, 0xdd, 0x89, 0x3b, 0xb7, 0x7c, 0x7a, 0x25, 0x4f,
+	0x62, 0x55, 0x2e, 0x62, 0xf5, 0xe0, 0xdc, 0x9a, 0x79, 0x9f, 0x69, 0x6b, 0x48, 0x1a, 0x46, 0x19,
+	0x34, 0x8d, 0xb1, 0x62, 0x48, 0xdd, 0xc0, 0x37, 0x5d, 0xdf, 0x26, 0x67, 0x69, 0xc6, 0xd7, 0x19,
+	0x5e, 0x93, 0xb2, 0x86, 0x0c, 0x6d, 0x33, 0x10, 0x8d, 0x01, 0x58, 0x49, 0xa5, 0x0d, 0x25, 0x49,
+	0x64, 0xe3, 0x6e, 0xbd, 0xe0, 0x22, 0x56, 0xcb, 0x49, 0xd4, 0x6b, 0x27, 0x0d, 0x17, 0xc7, 0x84,
+	0xd6, 0xf8, 0x18, 0x9d, 0xc2, 0xce, 0xdc, 0x3a, 0x0f, 0x16, 0xd4, 0x9c, 0x87, 0xc1, 0xd4, 0xa5,
+	0xbc, 0x1d, 0x16, 0xeb, 0xcd, 0x3b, 0x87, 0xd9, 0xbf, 0x3c, 0x5c, 0xc6, 0x4c, 0xc3, 0xdb, 0xc9,
+	0xbc, 0xcf, 0xa7, 0xe8, 0x09, 0x14, 0xc2, 0x60, 0xe1, 0xdb, 0xbc, 0x6f, 0xe6, 0xeb, 0xf2, 0x45,
+	0xac, 0x6e, 0x27, 0x32, 0x0e, 0x6b, 0x38, 0x59, 0xd6, 0x7e, 0xc9, 0x83, 0x94, 0xdc, 0x8c, 0xef,
+	0x7c, 0x4c, 0xc2, 0xfd, 0x1f, 0x07, 0xb9, 0x7b, 0x3d, 0x0e, 0xc4, 0xff, 0xf1, 0x38, 0xc8, 0xbf,
+	0xfb, 0x38, 0xb8, 0xa1, 0x79, 0x15, 0xde, 0x57, 0xf3, 0xba, 0x6e, 0xdd, 0xd2, 0x9d, 0x5a, 0xf7,
+	0x9a, 0xa6, 0xb7, 0x79, 0xef, 0xa6, 0xb7, 0xae, 0x5d, 0x6d, 0xbd, 0x8f, 0x76, 0x55, 0x7f, 0xf9,
+	0x7a, 0x59, 0x11, 0xde, 0x2c, 0x2b, 0xc2, 0x5f, 0xcb, 0x8a, 0xf0, 0xf3, 0xdb, 0xca, 0xc6, 0x9b,
+	0xb7, 0x95, 0x8d, 0x3f, 0xde, 0x56, 0x36, 0xbe, 0xcd, 0x3a, 0x46, 0x0e, 0x79, 0x96, 0x6e, 0x99,
+	0x8d, 0x8f, 0xcf, 0xf8, 0xeb, 0x94, 0xbb, 0x8e, 0x25, 0xfe, 0x34, 0x7d, 0xf1, 0xcf, 0x00, 0xcf,
+	0xd7, 0x18, 0xa8, 0xf2, 0x0a, 0x00, 0x00,
 }
 
 func (m *Bet) Marshal() (dAtA []byte, err error) {
@@ -577,6 +759,50 @@ func (m *Bet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FreeBetCreditUID) > 0 {
+		i -= len(m.FreeBetCreditUID)
+		copy(dAtA[i:], m.FreeBetCreditUID)
+		i = encodeVarintBet(dAtA, i, uint64(len(m.FreeBetCreditUID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size := m.DeadHeatFactor.Size()
+		i -= size
+		if _, err := m.DeadHeatFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBet(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBet(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Legs) > 0 {
+		for iNdEx := len(m.Legs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Legs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBet(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.BetFulfillment) > 0 {
 		for iNdEx := len(m.BetFulfillment) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -739,6 +965,13 @@ func (m *PendingBet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MarketUID) > 0 {
+		i -= len(m.MarketUID)
+		copy(dAtA[i:], m.MarketUID)
+		i = encodeVarintBet(dAtA, i, uint64(len(m.MarketUID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -813,6 +1046,11 @@ func (m *BetFulfillment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Round != 0 {
+		i = encodeVarintBet(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.PayoutProfit.Size()
 		i -= size
@@ -848,6 +1086,94 @@ func (m *BetFulfillment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BetLeg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BetLeg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BetLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DeadHeatFactor.Size()
+		i -= size
+		if _, err := m.DeadHeatFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBet(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.BetFulfillment) > 0 {
+		for iNdEx := len(m.BetFulfillment) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BetFulfillment[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBet(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Result != 0 {
+		i = encodeVarintBet(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxLossMultiplier.Size()
+		i -= size
+		if _, err := m.MaxLossMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBet(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.OddsValue) > 0 {
+		i -= len(m.OddsValue)
+		copy(dAtA[i:], m.OddsValue)
+		i = encodeVarintBet(dAtA, i, uint64(len(m.OddsValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OddsType != 0 {
+		i = encodeVarintBet(dAtA, i, uint64(m.OddsType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OddsUID) > 0 {
+		i -= len(m.OddsUID)
+		copy(dAtA[i:], m.OddsUID)
+		i = encodeVarintBet(dAtA, i, uint64(len(m.OddsUID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketUID) > 0 {
+		i -= len(m.MarketUID)
+		copy(dAtA[i:], m.MarketUID)
+		i = encodeVarintBet(dAtA, i, uint64(len(m.MarketUID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBet(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBet(v)
 	base := offset
@@ -912,6 +1238,22 @@ func (m *Bet) Size() (n int) {
 			n += 1 + l + sovBet(uint64(l))
 		}
 	}
+	if len(m.Legs) > 0 {
+		for _, e := range m.Legs {
+			l = e.Size()
+			n += 1 + l + sovBet(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 2 + l + sovBet(uint64(l))
+	}
+	l = m.DeadHeatFactor.Size()
+	n += 2 + l + sovBet(uint64(l))
+	l = len(m.FreeBetCreditUID)
+	if l > 0 {
+		n += 2 + l + sovBet(uint64(l))
+	}
 	return n
 }
 
@@ -945,6 +1287,10 @@ func (m *PendingBet) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBet(uint64(l))
 	}
+	l = len(m.MarketUID)
+	if l > 0 {
+		n += 1 + l + sovBet(uint64(l))
+	}
 	return n
 }
 
@@ -982,6 +1328,46 @@ func (m *BetFulfillment) Size() (n int) {
 	n += 1 + l + sovBet(uint64(l))
 	l = m.PayoutProfit.Size()
 	n += 1 + l + sovBet(uint64(l))
+	if m.Round != 0 {
+		n += 1 + sovBet(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *BetLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketUID)
+	if l > 0 {
+		n += 1 + l + sovBet(uint64(l))
+	}
+	l = len(m.OddsUID)
+	if l > 0 {
+		n += 1 + l + sovBet(uint64(l))
+	}
+	if m.OddsType != 0 {
+		n += 1 + sovBet(uint64(m.OddsType))
+	}
+	l = len(m.OddsValue)
+	if l > 0 {
+		n += 1 + l + sovBet(uint64(l))
+	}
+	l = m.MaxLossMultiplier.Size()
+	n += 1 + l + sovBet(uint64(l))
+	if m.Result != 0 {
+		n += 1 + sovBet(uint64(m.Result))
+	}
+	if len(m.BetFulfillment) > 0 {
+		for _, e := range m.BetFulfillment {
+			l = e.Size()
+			n += 1 + l + sovBet(uint64(l))
+		}
+	}
+	l = m.DeadHeatFactor.Size()
+	n += 1 + l + sovBet(uint64(l))
 	return n
 }
 
@@ -1411,21 +1797,153 @@ func (m *Bet) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBet(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBet
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Legs", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Legs = append(m.Legs, &BetLeg{})
+			if err := m.Legs[len(m.Legs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadHeatFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeadHeatFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeBetCreditUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreeBetCreditUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -1626,6 +2144,38 @@ func (m *PendingBet) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBet(dAtA[iNdEx:])
@@ -1909,6 +2459,311 @@ func (m *BetFulfillment) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BetLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BetLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BetLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsType", wireType)
+			}
+			m.OddsType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OddsType |= OddsType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLossMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLossMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= Bet_Result(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetFulfillment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BetFulfillment = append(m.BetFulfillment, &BetFulfillment{})
+			if err := m.BetFulfillment[len(m.BetFulfillment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadHeatFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeadHeatFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBet(dAtA[iNdEx:])
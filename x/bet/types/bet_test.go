@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sge-network/sge/x/bet/types"
 	markettypes "github.com/sge-network/sge/x/market/types"
 	"github.com/stretchr/testify/require"
@@ -49,11 +50,12 @@ func TestCheckSettlementEligiblity(t *testing.T) {
 
 func TestProcessBetResultAndStatus(t *testing.T) {
 	tcs := []struct {
-		desc   string
-		bet    *types.Bet
-		market markettypes.Market
-		err    error
-		result types.Bet_Result
+		desc     string
+		bet      *types.Bet
+		market   markettypes.Market
+		err      error
+		result   types.Bet_Result
+		deadHeat bool
 	}{
 		{
 			desc: "not declared",
@@ -83,6 +85,61 @@ func TestProcessBetResultAndStatus(t *testing.T) {
 			bet:    &types.Bet{},
 			result: types.Bet_RESULT_LOST,
 		},
+		{
+			desc: "push",
+			market: markettypes.Market{
+				Status: markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+				OddsOutcomes: []*markettypes.OddsOutcome{
+					{OddsUID: "oddsUID", Result: markettypes.OddsResult_ODDS_RESULT_PUSH},
+				},
+			},
+			bet: &types.Bet{
+				OddsUID: "oddsUID",
+			},
+			result: types.Bet_RESULT_PUSH,
+		},
+		{
+			desc: "half won",
+			market: markettypes.Market{
+				Status: markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+				OddsOutcomes: []*markettypes.OddsOutcome{
+					{OddsUID: "oddsUID", Result: markettypes.OddsResult_ODDS_RESULT_HALF_WIN},
+				},
+			},
+			bet: &types.Bet{
+				OddsUID: "oddsUID",
+			},
+			result: types.Bet_RESULT_HALF_WON,
+		},
+		{
+			desc: "half lost",
+			market: markettypes.Market{
+				Status:         markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+				WinnerOddsUIDs: []string{"winnerOddsUID"},
+				OddsOutcomes: []*markettypes.OddsOutcome{
+					{OddsUID: "oddsUID", Result: markettypes.OddsResult_ODDS_RESULT_HALF_LOSE},
+				},
+			},
+			bet: &types.Bet{
+				OddsUID: "oddsUID",
+			},
+			result: types.Bet_RESULT_HALF_LOST,
+		},
+		{
+			desc: "won in dead heat",
+			market: markettypes.Market{
+				Status: markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+				OddsOutcomes: []*markettypes.OddsOutcome{
+					{OddsUID: "oddsUID", Result: markettypes.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.NewDecWithPrec(5, 1)},
+					{OddsUID: "tiedOddsUID", Result: markettypes.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.NewDecWithPrec(5, 1)},
+				},
+			},
+			bet: &types.Bet{
+				OddsUID: "oddsUID",
+			},
+			result:   types.Bet_RESULT_WON,
+			deadHeat: true,
+		},
 	}
 	for _, tc := range tcs {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -93,7 +150,26 @@ func TestProcessBetResultAndStatus(t *testing.T) {
 				require.Nil(t, err)
 				require.Equal(t, tc.bet.Result, tc.result)
 				require.Equal(t, tc.bet.Status, types.Bet_STATUS_RESULT_DECLARED)
+				require.Equal(t, tc.deadHeat, tc.bet.HasDeadHeat())
 			}
 		})
 	}
 }
+
+func TestMarketBets(t *testing.T) {
+	fulfillment := []*types.BetFulfillment{{BetAmount: sdk.NewInt(10), PayoutProfit: sdk.NewInt(5)}}
+
+	single := &types.Bet{MarketUID: "market", OddsUID: "odds", BetFulfillment: fulfillment}
+	require.Equal(t, []types.MarketBet{
+		{MarketUID: "market", OddsUID: "odds", BetFulfillment: fulfillment},
+	}, single.MarketBets())
+
+	parlay := &types.Bet{Legs: []*types.BetLeg{
+		{MarketUID: "market1", OddsUID: "odds1", BetFulfillment: fulfillment},
+		{MarketUID: "market2", OddsUID: "odds2"},
+	}}
+	require.Equal(t, []types.MarketBet{
+		{MarketUID: "market1", OddsUID: "odds1", BetFulfillment: fulfillment},
+		{MarketUID: "market2", OddsUID: "odds2"},
+	}, parlay.MarketBets())
+}
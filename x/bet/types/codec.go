@@ -14,12 +14,36 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgWager{}, "bet/Wager")
+	legacy.RegisterAminoMsg(cdc, &MsgWagerBatch{}, "bet/WagerBatch")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelBet{}, "bet/CancelBet")
+	legacy.RegisterAminoMsg(cdc, &MsgCashOut{}, "bet/CashOut")
+	legacy.RegisterAminoMsg(cdc, &MsgVoidBets{}, "bet/VoidBets")
+	legacy.RegisterAminoMsg(cdc, &MsgSettleBets{}, "bet/SettleBets")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSelfExclusion{}, "bet/SetSelfExclusion")
+	legacy.RegisterAminoMsg(cdc, &MsgSetBettorLimit{}, "bet/SetBettorLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgFundPromoPool{}, "bet/FundPromoPool")
+	legacy.RegisterAminoMsg(cdc, &MsgIssueFreeBet{}, "bet/IssueFreeBet")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAffiliate{}, "bet/SetAffiliate")
+	legacy.RegisterAminoMsg(cdc, &MsgSetReferrer{}, "bet/SetReferrer")
+	legacy.RegisterAminoMsg(cdc, &MsgBindReferrer{}, "bet/BindReferrer")
 }
 
 // RegisterInterfaces registers the module interface types
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgWager{},
+		&MsgWagerBatch{},
+		&MsgCancelBet{},
+		&MsgCashOut{},
+		&MsgVoidBets{},
+		&MsgSettleBets{},
+		&MsgSetSelfExclusion{},
+		&MsgSetBettorLimit{},
+		&MsgFundPromoPool{},
+		&MsgIssueFreeBet{},
+		&MsgSetAffiliate{},
+		&MsgSetReferrer{},
+		&MsgBindReferrer{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeTier returns the fee tier in effect for the wagered volume of the bettor,
+// the fee rate of the constraints is in effect if the volume does not reach any of the tiers.
+func (c Constraints) FeeTier(volume sdkmath.Int) FeeTier {
+	tier := FeeTier{MinVolume: sdk.ZeroInt(), FeeRate: c.FeeRate}
+	for _, t := range c.FeeTiers {
+		if volume.GTE(t.MinVolume) {
+			tier = t
+		}
+	}
+	return tier
+}
+
+// CalculateFee calculates the bet fee of the amount according to the flat fee,
+// the fee rate of the tier in effect for the wagered volume and the fee caps.
+func (c Constraints) CalculateFee(amount, volume sdkmath.Int) sdkmath.Int {
+	fee := c.Fee.Add(c.FeeTier(volume).FeeRate.MulInt(amount).TruncateInt())
+
+	if fee.LT(c.MinFee) {
+		fee = c.MinFee
+	}
+
+	if c.MaxFee.IsPositive() && fee.GT(c.MaxFee) {
+		fee = c.MaxFee
+	}
+
+	return fee
+}
@@ -30,6 +30,17 @@ type Constraints struct {
 	MinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
 	// fee is the fee that the bettor needs to pay to bet.
 	Fee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee"`
+	// fee_rate is the ratio of the bet amount that the bettor needs to pay
+	// as fee in addition to the flat fee.
+	FeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=fee_rate,json=feeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_rate"`
+	// min_fee is the minimum fee that the bettor needs to pay to bet.
+	MinFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee"`
+	// max_fee is the maximum fee that the bettor needs to pay to bet,
+	// zero value means there is no cap.
+	MaxFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_fee"`
+	// fee_tiers is the list of the bettor volume tiers, the fee rate of the
+	// highest reached tier is used instead of the fee rate of the constraints.
+	FeeTiers []FeeTier `protobuf:"bytes,6,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers"`
 }
 
 func (m *Constraints) Reset()         { *m = Constraints{} }
@@ -65,29 +76,147 @@ func (m *Constraints) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Constraints proto.InternalMessageInfo
 
+func (m *Constraints) GetFeeTiers() []FeeTier {
+	if m != nil {
+		return m.FeeTiers
+	}
+	return nil
+}
+
+// DenomConstraints is the bet constraints of the bets in a denom, the amounts
+// of the constraints are in the denom.
+type DenomConstraints struct {
+	// denom is the denom of the bets that the constraints are applied to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// constraints is the bet constraints of the denom.
+	Constraints Constraints `protobuf:"bytes,2,opt,name=constraints,proto3" json:"constraints"`
+}
+
+func (m *DenomConstraints) Reset()         { *m = DenomConstraints{} }
+func (m *DenomConstraints) String() string { return proto.CompactTextString(m) }
+func (*DenomConstraints) ProtoMessage()    {}
+func (*DenomConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_902ec3e683a9aac6, []int{1}
+}
+func (m *DenomConstraints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomConstraints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomConstraints.Merge(m, src)
+}
+func (m *DenomConstraints) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomConstraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomConstraints proto.InternalMessageInfo
+
+func (m *DenomConstraints) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomConstraints) GetConstraints() Constraints {
+	if m != nil {
+		return m.Constraints
+	}
+	return Constraints{}
+}
+
+// FeeTier is the fee rate applied to the bets of the bettors that their
+// wagered volume reaches the minimum volume of the tier.
+type FeeTier struct {
+	// min_volume is the minimum wagered volume of the bettor in the bet denom.
+	MinVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=min_volume,json=minVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_volume"`
+	// fee_rate is the ratio of the bet amount that the bettor needs to pay
+	// as fee in addition to the flat fee.
+	FeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_rate,json=feeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_rate"`
+}
+
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_902ec3e683a9aac6, []int{2}
+}
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
+}
+func (m *FeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Constraints)(nil), "sgenetwork.sge.bet.Constraints")
+	proto.RegisterType((*DenomConstraints)(nil), "sgenetwork.sge.bet.DenomConstraints")
+	proto.RegisterType((*FeeTier)(nil), "sgenetwork.sge.bet.FeeTier")
 }
 
 func init() { proto.RegisterFile("sge/bet/constraints.proto", fileDescriptor_902ec3e683a9aac6) }
 
 var fileDescriptor_902ec3e683a9aac6 = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x4e, 0x4f, 0xd5,
-	0x4f, 0x4a, 0x2d, 0xd1, 0x4f, 0xce, 0xcf, 0x2b, 0x2e, 0x29, 0x4a, 0xcc, 0xcc, 0x2b, 0x29, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2a, 0x4e, 0x4f, 0xcd, 0x4b, 0x2d, 0x29, 0xcf, 0x2f,
-	0xca, 0xd6, 0x2b, 0x4e, 0x4f, 0xd5, 0x4b, 0x4a, 0x2d, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x4a, 0xf3, 0x18, 0xb9, 0xb8, 0x9d, 0x11, 0xfa, 0x85, 0x7c,
-	0xb9, 0xb8, 0x72, 0x33, 0xf3, 0xe2, 0x13, 0x73, 0xf3, 0x4b, 0xf3, 0x4a, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x38, 0x9d, 0xf4, 0x4e, 0xdc, 0x93, 0x67, 0xb8, 0x75, 0x4f, 0x5e, 0x2d, 0x3d, 0xb3, 0x24,
-	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x18, 0x4a, 0xe9,
-	0x16, 0xa7, 0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0xeb, 0x79, 0xe6, 0x95, 0x04, 0x71, 0xe6,
-	0x66, 0xe6, 0x39, 0x82, 0x0d, 0x10, 0x72, 0xe0, 0x62, 0x4e, 0x4b, 0x4d, 0x95, 0x60, 0x22, 0xcb,
-	0x1c, 0x90, 0x56, 0x27, 0x87, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48,
-	0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x42,
-	0x36, 0xa6, 0x38, 0x3d, 0x55, 0x17, 0xea, 0x61, 0x10, 0x5b, 0xbf, 0x02, 0x1c, 0x30, 0x60, 0xa3,
-	0x92, 0xd8, 0xc0, 0x3e, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x30, 0xdb, 0xa2, 0x4c, 0x30,
-	0x01, 0x00, 0x00,
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xbd, 0x6e, 0xe2, 0x40,
+	0x10, 0xc7, 0x6d, 0xcc, 0xe7, 0xba, 0x39, 0x59, 0x14, 0xbe, 0x3b, 0xc9, 0x20, 0x8a, 0x13, 0x0d,
+	0x6b, 0x89, 0xeb, 0x4f, 0x1c, 0x87, 0x40, 0x14, 0xd7, 0x58, 0xa7, 0x2b, 0xd2, 0x20, 0xdb, 0x8c,
+	0x1d, 0x8b, 0xec, 0x2e, 0xf1, 0x2e, 0x09, 0x79, 0x8b, 0xbc, 0x43, 0x1e, 0x23, 0x2f, 0x40, 0x49,
+	0x19, 0xa5, 0x40, 0x11, 0xbc, 0x48, 0xb4, 0x6b, 0x47, 0x71, 0x94, 0x34, 0x81, 0x6a, 0x67, 0x76,
+	0x77, 0x7e, 0x33, 0xa3, 0xff, 0x0c, 0xfa, 0xca, 0x63, 0x70, 0x03, 0x10, 0x6e, 0xc8, 0x28, 0x17,
+	0xa9, 0x9f, 0x50, 0xc1, 0xf1, 0x32, 0x65, 0x82, 0x59, 0x16, 0x8f, 0x81, 0x82, 0xb8, 0x66, 0xe9,
+	0x02, 0xf3, 0x18, 0x70, 0x00, 0xe2, 0x5b, 0x33, 0x66, 0x31, 0x53, 0xcf, 0xae, 0xb4, 0xb2, 0x9f,
+	0x9d, 0x7b, 0x03, 0x99, 0x7f, 0x5e, 0xe3, 0xad, 0xbf, 0x08, 0x91, 0x84, 0xce, 0x7c, 0xc2, 0x56,
+	0x54, 0xd8, 0x7a, 0x5b, 0xef, 0x36, 0x86, 0x78, 0xb3, 0x6b, 0x69, 0x8f, 0xbb, 0xd6, 0x8f, 0x38,
+	0x11, 0xe7, 0xab, 0x00, 0x87, 0x8c, 0xb8, 0x21, 0xe3, 0x84, 0xf1, 0xfc, 0xe8, 0xf1, 0xf9, 0xc2,
+	0x15, 0x37, 0x4b, 0xe0, 0x78, 0x4a, 0x85, 0xd7, 0x20, 0x09, 0xfd, 0xad, 0x00, 0xd6, 0x00, 0x19,
+	0x11, 0x80, 0x5d, 0x3a, 0x8a, 0x23, 0x43, 0xad, 0x29, 0xaa, 0x47, 0x00, 0xb3, 0xd4, 0x17, 0x60,
+	0x1b, 0x9f, 0xc6, 0x8c, 0x20, 0xf4, 0x6a, 0x11, 0x80, 0xe7, 0x0b, 0xb0, 0x26, 0xa8, 0x26, 0x7b,
+	0x93, 0x05, 0x95, 0x8f, 0x2a, 0xa8, 0x4a, 0x12, 0x3a, 0x86, 0x0c, 0xe4, 0xaf, 0x15, 0xa8, 0x72,
+	0x24, 0xc8, 0x5f, 0x4b, 0xd0, 0x2f, 0xd4, 0x90, 0xcd, 0x89, 0x04, 0x52, 0x6e, 0x57, 0xdb, 0x46,
+	0xd7, 0xec, 0x7f, 0xc7, 0xef, 0xb5, 0xc3, 0x63, 0x80, 0x7f, 0x09, 0xa4, 0xc3, 0xb2, 0xcc, 0xe3,
+	0xd5, 0xa3, 0xcc, 0xe5, 0x9d, 0x4b, 0xf4, 0x65, 0x04, 0x94, 0x91, 0xa2, 0x82, 0x4d, 0x54, 0x99,
+	0xcb, 0xbb, 0x4c, 0x3c, 0x2f, 0x73, 0xac, 0x09, 0x32, 0x0b, 0x63, 0xa2, 0x04, 0x31, 0xfb, 0xad,
+	0x8f, 0x72, 0x15, 0x58, 0x79, 0xbe, 0x62, 0x64, 0xe7, 0x4e, 0x47, 0xb5, 0xbc, 0x9c, 0x97, 0x61,
+	0xb9, 0x62, 0x17, 0x2b, 0x02, 0x27, 0x0c, 0xcb, 0x7f, 0x05, 0x78, 0x23, 0x75, 0xe9, 0x24, 0xa9,
+	0x87, 0x83, 0xcd, 0xde, 0xd1, 0xb7, 0x7b, 0x47, 0x7f, 0xda, 0x3b, 0xfa, 0xed, 0xc1, 0xd1, 0xb6,
+	0x07, 0x47, 0x7b, 0x38, 0x38, 0xda, 0x59, 0x11, 0xc5, 0x63, 0xe8, 0xe5, 0xed, 0x4b, 0xdb, 0x5d,
+	0xab, 0x75, 0x52, 0xb8, 0xa0, 0xaa, 0xf6, 0xe3, 0xe7, 0xf3, 0x00, 0x8b, 0x9f, 0x2b, 0x74, 0x66,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Constraints) Marshal() (dAtA []byte, err error) {
@@ -110,6 +239,50 @@ func (m *Constraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeTiers) > 0 {
+		for iNdEx := len(m.FeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConstraints(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConstraints(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConstraints(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConstraints(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.Fee.Size()
 		i -= size
@@ -133,6 +306,89 @@ func (m *Constraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomConstraints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomConstraints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConstraints(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintConstraints(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConstraints(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinVolume.Size()
+		i -= size
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConstraints(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConstraints(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConstraints(v)
 	base := offset
@@ -154,6 +410,46 @@ func (m *Constraints) Size() (n int) {
 	n += 1 + l + sovConstraints(uint64(l))
 	l = m.Fee.Size()
 	n += 1 + l + sovConstraints(uint64(l))
+	l = m.FeeRate.Size()
+	n += 1 + l + sovConstraints(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovConstraints(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovConstraints(uint64(l))
+	if len(m.FeeTiers) > 0 {
+		for _, e := range m.FeeTiers {
+			l = e.Size()
+			n += 1 + l + sovConstraints(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomConstraints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovConstraints(uint64(l))
+	}
+	l = m.Constraints.Size()
+	n += 1 + l + sovConstraints(uint64(l))
+	return n
+}
+
+func (m *FeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinVolume.Size()
+	n += 1 + l + sovConstraints(uint64(l))
+	l = m.FeeRate.Size()
+	n += 1 + l + sovConstraints(uint64(l))
 	return n
 }
 
@@ -260,6 +556,375 @@ func (m *Constraints) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTiers = append(m.FeeTiers, FeeTier{})
+			if err := m.FeeTiers[len(m.FeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConstraints(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomConstraints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConstraints
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomConstraints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConstraints(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConstraints
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConstraints
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConstraints
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConstraints(dAtA[iNdEx:])
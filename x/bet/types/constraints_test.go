@@ -0,0 +1,216 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sge-network/sge/app/params"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraintsCalculateFee(t *testing.T) {
+	tieredConstraints := types.Constraints{
+		Fee:     sdk.NewInt(100),
+		FeeRate: sdk.NewDecWithPrec(2, 2),
+		MinFee:  sdk.ZeroInt(),
+		MaxFee:  sdk.ZeroInt(),
+		FeeTiers: []types.FeeTier{
+			{MinVolume: sdk.NewInt(10000000), FeeRate: sdk.NewDecWithPrec(1, 2)},
+			{MinVolume: sdk.NewInt(100000000), FeeRate: sdk.NewDecWithPrec(5, 3)},
+		},
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		constraints types.Constraints
+		amount      sdkmath.Int
+		volume      sdkmath.Int
+		fee         sdkmath.Int
+	}{
+		{
+			desc:        "flat fee",
+			constraints: types.DefaultParams().Constraints,
+			amount:      sdk.NewInt(1000000),
+			volume:      sdk.ZeroInt(),
+			fee:         sdk.NewInt(100),
+		},
+		{
+			desc: "percentage of the amount",
+			constraints: types.Constraints{
+				Fee:     sdk.ZeroInt(),
+				FeeRate: sdk.NewDecWithPrec(2, 2),
+				MinFee:  sdk.ZeroInt(),
+				MaxFee:  sdk.ZeroInt(),
+			},
+			amount: sdk.NewInt(1000000),
+			volume: sdk.ZeroInt(),
+			fee:    sdk.NewInt(20000),
+		},
+		{
+			desc: "minimum fee cap",
+			constraints: types.Constraints{
+				Fee:     sdk.ZeroInt(),
+				FeeRate: sdk.NewDecWithPrec(2, 2),
+				MinFee:  sdk.NewInt(50000),
+				MaxFee:  sdk.ZeroInt(),
+			},
+			amount: sdk.NewInt(1000000),
+			volume: sdk.ZeroInt(),
+			fee:    sdk.NewInt(50000),
+		},
+		{
+			desc: "maximum fee cap",
+			constraints: types.Constraints{
+				Fee:     sdk.ZeroInt(),
+				FeeRate: sdk.NewDecWithPrec(2, 2),
+				MinFee:  sdk.ZeroInt(),
+				MaxFee:  sdk.NewInt(10000),
+			},
+			amount: sdk.NewInt(1000000),
+			volume: sdk.ZeroInt(),
+			fee:    sdk.NewInt(10000),
+		},
+		{
+			desc:        "volume below the tiers",
+			constraints: tieredConstraints,
+			amount:      sdk.NewInt(1000000),
+			volume:      sdk.NewInt(9999999),
+			fee:         sdk.NewInt(20100),
+		},
+		{
+			desc:        "first tier",
+			constraints: tieredConstraints,
+			amount:      sdk.NewInt(1000000),
+			volume:      sdk.NewInt(10000000),
+			fee:         sdk.NewInt(10100),
+		},
+		{
+			desc:        "highest tier",
+			constraints: tieredConstraints,
+			amount:      sdk.NewInt(1000000),
+			volume:      sdk.NewInt(500000000),
+			fee:         sdk.NewInt(5100),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.fee.String(), tc.constraints.CalculateFee(tc.amount, tc.volume).String())
+		})
+	}
+}
+
+func TestParamsValidateConstraints(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		modify func(c *types.Constraints)
+		valid  bool
+	}{
+		{
+			desc:   "default",
+			modify: func(c *types.Constraints) {},
+			valid:  true,
+		},
+		{
+			desc: "valid fee tiers",
+			modify: func(c *types.Constraints) {
+				c.FeeRate = sdk.NewDecWithPrec(2, 2)
+				c.MinFee = sdk.NewInt(100)
+				c.MaxFee = sdk.NewInt(100000)
+				c.FeeTiers = []types.FeeTier{
+					{MinVolume: sdk.NewInt(10000000), FeeRate: sdk.NewDecWithPrec(1, 2)},
+					{MinVolume: sdk.NewInt(100000000), FeeRate: sdk.NewDecWithPrec(5, 3)},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "fee rate not less than one",
+			modify: func(c *types.Constraints) {
+				c.FeeRate = sdk.OneDec()
+			},
+		},
+		{
+			desc: "negative fee rate",
+			modify: func(c *types.Constraints) {
+				c.FeeRate = sdk.NewDecWithPrec(-1, 2)
+			},
+		},
+		{
+			desc: "maximum fee less than minimum",
+			modify: func(c *types.Constraints) {
+				c.MinFee = sdk.NewInt(100)
+				c.MaxFee = sdk.NewInt(10)
+			},
+		},
+		{
+			desc: "unsorted fee tiers",
+			modify: func(c *types.Constraints) {
+				c.FeeTiers = []types.FeeTier{
+					{MinVolume: sdk.NewInt(100000000), FeeRate: sdk.NewDecWithPrec(5, 3)},
+					{MinVolume: sdk.NewInt(10000000), FeeRate: sdk.NewDecWithPrec(1, 2)},
+				}
+			},
+		},
+		{
+			desc: "zero tier volume",
+			modify: func(c *types.Constraints) {
+				c.FeeTiers = []types.FeeTier{
+					{MinVolume: sdk.ZeroInt(), FeeRate: sdk.NewDecWithPrec(1, 2)},
+				}
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params.Constraints)
+
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsConstraintsOfDenom(t *testing.T) {
+	p := types.DefaultParams()
+	atomConstraints := types.Constraints{
+		MinAmount: sdk.NewInt(10),
+		Fee:       sdk.NewInt(1),
+		FeeRate:   sdk.ZeroDec(),
+		MinFee:    sdk.ZeroInt(),
+		MaxFee:    sdk.ZeroInt(),
+	}
+	p.DenomConstraints = []types.DenomConstraints{{Denom: "uatom", Constraints: atomConstraints}}
+	require.NoError(t, p.Validate())
+
+	// the constraints of the params are applied to the bond denom
+	constraints, found := p.ConstraintsOfDenom(params.DefaultBondDenom)
+	require.True(t, found)
+	require.Equal(t, p.Constraints, constraints)
+
+	constraints, found = p.ConstraintsOfDenom("uatom")
+	require.True(t, found)
+	require.Equal(t, atomConstraints, constraints)
+
+	_, found = p.ConstraintsOfDenom("uosmo")
+	require.False(t, found)
+
+	// the bond denom and the duplicate denoms are not accepted
+	p.DenomConstraints = []types.DenomConstraints{{Denom: params.DefaultBondDenom, Constraints: atomConstraints}}
+	require.Error(t, p.Validate())
+
+	p.DenomConstraints = []types.DenomConstraints{
+		{Denom: "uatom", Constraints: atomConstraints},
+		{Denom: "uatom", Constraints: atomConstraints},
+	}
+	require.Error(t, p.Validate())
+
+	// the constraints of the denoms are validated
+	atomConstraints.MinAmount = sdk.ZeroInt()
+	p.DenomConstraints = []types.DenomConstraints{{Denom: "uatom", Constraints: atomConstraints}}
+	require.Error(t, p.Validate())
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+)
+
+// NewDelayedBet creates a new delayed bet object
+func NewDelayedBet(
+	uid, creator string,
+	acceptHeight, acceptTS int64,
+	minFillRatio sdk.Dec,
+	marketOdds []DelayedBetMarketOdds,
+) DelayedBet {
+	if minFillRatio.IsNil() {
+		minFillRatio = sdk.ZeroDec()
+	}
+	return DelayedBet{
+		UID:          uid,
+		Creator:      creator,
+		AcceptHeight: acceptHeight,
+		AcceptTS:     acceptTS,
+		MinFillRatio: minFillRatio,
+		MarketOdds:   marketOdds,
+	}
+}
+
+// Validate validates the uid, creator and the odds of the delayed bet.
+func (delayed *DelayedBet) Validate() error {
+	if !utils.IsValidUID(delayed.UID) {
+		return ErrInvalidBetUID
+	}
+
+	if _, err := sdk.AccAddressFromBech32(delayed.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if delayed.MinFillRatio.IsNil() || delayed.MinFillRatio.IsNegative() || delayed.MinFillRatio.GT(sdk.OneDec()) {
+		return ErrInvalidMinFillRatio
+	}
+
+	if len(delayed.MarketOdds) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidDelayedBet, "no market odds")
+	}
+
+	for _, marketOdds := range delayed.MarketOdds {
+		if !utils.IsValidUID(marketOdds.MarketUID) {
+			return sdkerrors.Wrapf(ErrInvalidDelayedBet, "invalid market uid %s", marketOdds.MarketUID)
+		}
+	}
+
+	return nil
+}
+
+// IsDue returns true if the bet delay is passed at the given block height and time.
+func (delayed *DelayedBet) IsDue(height, blockTime int64) bool {
+	return delayed.AcceptHeight <= height && delayed.AcceptTS <= blockTime
+}
+
+// BetOdds returns the odds of the ticket of the delayed bet mapped by the market uid and odds uid.
+func (delayed *DelayedBet) BetOdds() map[string]map[string]*BetOddsCompact {
+	betOdds := make(map[string]map[string]*BetOddsCompact, len(delayed.MarketOdds))
+	for _, marketOdds := range delayed.MarketOdds {
+		odds := make(map[string]*BetOddsCompact, len(marketOdds.Odds))
+		for _, o := range marketOdds.Odds {
+			odds[o.UID] = o
+		}
+		betOdds[marketOdds.MarketUID] = odds
+	}
+	return betOdds
+}
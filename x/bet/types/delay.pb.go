@@ -0,0 +1,809 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sge/bet/delay.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DelayedBet is a bet on an in-play market that is waiting for the bet delay
+// to be fulfilled by the order book or to be rejected.
+type DelayedBet struct {
+	// uid is the universal unique identifier of the bet.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// creator is the bettor address.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// accept_height is the block height that the bet is processed at or after.
+	AcceptHeight int64 `protobuf:"varint,3,opt,name=accept_height,json=acceptHeight,proto3" json:"accept_height,omitempty"`
+	// accept_ts is the timestamp that the bet is processed at or after.
+	AcceptTS int64 `protobuf:"varint,4,opt,name=accept_ts,proto3" json:"accept_ts"`
+	// min_fill_ratio is the minimum ratio of the bet amount to be fulfilled,
+	// zero means the whole amount should be fulfilled.
+	MinFillRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_fill_ratio,json=minFillRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fill_ratio"`
+	// market_odds contains the odds of the ticket of each of the markets of the
+	// bet.
+	MarketOdds []DelayedBetMarketOdds `protobuf:"bytes,6,rep,name=market_odds,json=marketOdds,proto3" json:"market_odds"`
+}
+
+func (m *DelayedBet) Reset()         { *m = DelayedBet{} }
+func (m *DelayedBet) String() string { return proto.CompactTextString(m) }
+func (*DelayedBet) ProtoMessage()    {}
+func (*DelayedBet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15600d535ebb1657, []int{0}
+}
+func (m *DelayedBet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedBet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedBet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelayedBet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedBet.Merge(m, src)
+}
+func (m *DelayedBet) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedBet) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedBet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedBet proto.InternalMessageInfo
+
+func (m *DelayedBet) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *DelayedBet) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *DelayedBet) GetAcceptHeight() int64 {
+	if m != nil {
+		return m.AcceptHeight
+	}
+	return 0
+}
+
+func (m *DelayedBet) GetAcceptTS() int64 {
+	if m != nil {
+		return m.AcceptTS
+	}
+	return 0
+}
+
+func (m *DelayedBet) GetMarketOdds() []DelayedBetMarketOdds {
+	if m != nil {
+		return m.MarketOdds
+	}
+	return nil
+}
+
+// DelayedBetMarketOdds is the odds of a market in the ticket of a delayed
+// bet.
+type DelayedBetMarketOdds struct {
+	// market_uid is the universal unique identifier of the market.
+	MarketUID string `protobuf:"bytes,1,opt,name=market_uid,proto3" json:"market_uid"`
+	// odds is the list of the odds of the market.
+	Odds []*BetOddsCompact `protobuf:"bytes,2,rep,name=odds,proto3" json:"odds,omitempty"`
+}
+
+func (m *DelayedBetMarketOdds) Reset()         { *m = DelayedBetMarketOdds{} }
+func (m *DelayedBetMarketOdds) String() string { return proto.CompactTextString(m) }
+func (*DelayedBetMarketOdds) ProtoMessage()    {}
+func (*DelayedBetMarketOdds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15600d535ebb1657, []int{1}
+}
+func (m *DelayedBetMarketOdds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedBetMarketOdds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedBetMarketOdds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelayedBetMarketOdds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedBetMarketOdds.Merge(m, src)
+}
+func (m *DelayedBetMarketOdds) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedBetMarketOdds) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedBetMarketOdds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedBetMarketOdds proto.InternalMessageInfo
+
+func (m *DelayedBetMarketOdds) GetMarketUID() string {
+	if m != nil {
+		return m.MarketUID
+	}
+	return ""
+}
+
+func (m *DelayedBetMarketOdds) GetOdds() []*BetOddsCompact {
+	if m != nil {
+		return m.Odds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DelayedBet)(nil), "sgenetwork.sge.bet.DelayedBet")
+	proto.RegisterType((*DelayedBetMarketOdds)(nil), "sgenetwork.sge.bet.DelayedBetMarketOdds")
+}
+
+func init() { proto.RegisterFile("sge/bet/delay.proto", fileDescriptor_15600d535ebb1657) }
+
+var fileDescriptor_15600d535ebb1657 = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x1c, 0xc5, 0x37, 0x9b, 0xb5, 0xba, 0xd3, 0xda, 0xc3, 0x58, 0x24, 0x56, 0x48, 0x96, 0x15, 0x24,
+	0x97, 0x4e, 0x40, 0x41, 0x0f, 0x5e, 0x34, 0x2e, 0xa2, 0x07, 0x29, 0x8c, 0xf5, 0xe2, 0x25, 0x24,
+	0x99, 0xbf, 0xb3, 0xc3, 0x26, 0x3b, 0x21, 0x33, 0x45, 0xfb, 0x19, 0xbc, 0xf8, 0xb1, 0x7a, 0x11,
+	0x7a, 0x14, 0x0f, 0x41, 0xb2, 0xb7, 0x7e, 0x0a, 0x99, 0x49, 0x6a, 0x16, 0xba, 0x97, 0xe4, 0xe5,
+	0xe5, 0x37, 0xff, 0x79, 0x3c, 0xfe, 0xe8, 0x81, 0xe2, 0x10, 0x65, 0xa0, 0x23, 0x06, 0x45, 0x7a,
+	0x41, 0xaa, 0x5a, 0x6a, 0x89, 0xb1, 0xe2, 0xb0, 0x06, 0xfd, 0x4d, 0xd6, 0x2b, 0xa2, 0x38, 0x90,
+	0x0c, 0xf4, 0xf1, 0x11, 0x97, 0x5c, 0xda, 0xdf, 0x91, 0x51, 0x1d, 0x79, 0xfc, 0xf0, 0xe6, 0x78,
+	0x06, 0x3a, 0x91, 0x8c, 0xa9, 0xce, 0x9f, 0xff, 0x1a, 0x23, 0xb4, 0x30, 0x13, 0x81, 0xc5, 0xa0,
+	0xf1, 0x0c, 0xb9, 0xe7, 0x82, 0x79, 0xce, 0xcc, 0x09, 0xa7, 0xf1, 0x61, 0xdb, 0x04, 0xee, 0xe7,
+	0x0f, 0x8b, 0xeb, 0x26, 0x30, 0x2e, 0x35, 0x0f, 0xec, 0xa1, 0xbb, 0x79, 0x0d, 0xa9, 0x96, 0xb5,
+	0x37, 0x36, 0x14, 0xbd, 0xf9, 0xc4, 0x4f, 0xd0, 0xfd, 0x34, 0xcf, 0xa1, 0xd2, 0xc9, 0x12, 0x04,
+	0x5f, 0x6a, 0xcf, 0x9d, 0x39, 0xa1, 0x4b, 0x0f, 0x3a, 0xf3, 0xbd, 0xf5, 0xf0, 0x4b, 0x34, 0xed,
+	0x21, 0xad, 0xbc, 0x89, 0x01, 0xe2, 0x47, 0x6d, 0x13, 0xdc, 0x7b, 0x63, 0xcd, 0xb3, 0x4f, 0xd7,
+	0x4d, 0x30, 0x00, 0x74, 0x90, 0xf8, 0x0c, 0x1d, 0x96, 0x62, 0x9d, 0x7c, 0x15, 0x45, 0x91, 0xd4,
+	0xa9, 0x16, 0xd2, 0xbb, 0x63, 0x43, 0x92, 0xcb, 0x26, 0x18, 0xfd, 0x69, 0x82, 0xa7, 0x5c, 0xe8,
+	0xe5, 0x79, 0x46, 0x72, 0x59, 0x46, 0xb9, 0x54, 0xa5, 0x54, 0xfd, 0xeb, 0x44, 0xb1, 0x55, 0xa4,
+	0x2f, 0x2a, 0x50, 0x64, 0x01, 0x39, 0x3d, 0x28, 0xc5, 0xfa, 0x9d, 0x28, 0x0a, 0x6a, 0x66, 0xe0,
+	0x53, 0xb4, 0x5f, 0xa6, 0xf5, 0xaa, 0xef, 0xc4, 0xdb, 0x9b, 0xb9, 0xe1, 0xfe, 0xb3, 0x90, 0xdc,
+	0xae, 0x95, 0x0c, 0x25, 0x7d, 0xb4, 0x07, 0x4e, 0x19, 0x53, 0xf1, 0xc4, 0x5c, 0x4e, 0x51, 0xf9,
+	0xdf, 0x99, 0xff, 0x70, 0xd0, 0xd1, 0x2e, 0x14, 0xbf, 0x42, 0x3d, 0x96, 0x0c, 0x05, 0x3f, 0x6e,
+	0x9b, 0x60, 0xda, 0x31, 0x5d, 0xcd, 0x5b, 0x08, 0xdd, 0xd2, 0xf8, 0x05, 0x9a, 0xd8, 0x7c, 0x63,
+	0x9b, 0x6f, 0xbe, 0x2b, 0x5f, 0xdc, 0xdd, 0xf3, 0x56, 0x96, 0x55, 0x9a, 0x6b, 0x6a, 0xf9, 0xf8,
+	0xf5, 0x65, 0xeb, 0x3b, 0x57, 0xad, 0xef, 0xfc, 0x6d, 0x7d, 0xe7, 0xe7, 0xc6, 0x1f, 0x5d, 0x6d,
+	0xfc, 0xd1, 0xef, 0x8d, 0x3f, 0xfa, 0xb2, 0x5d, 0x97, 0xe2, 0x70, 0xd2, 0x8f, 0x33, 0x3a, 0xfa,
+	0x6e, 0x17, 0xc5, 0x56, 0x96, 0xed, 0xd9, 0x35, 0x79, 0xfe, 0x6f, 0x00, 0x9a, 0x80, 0x8c, 0x43,
+	0x7f, 0x02, 0x00, 0x00,
+}
+
+func (m *DelayedBet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedBet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedBet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketOdds) > 0 {
+		for iNdEx := len(m.MarketOdds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketOdds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.MinFillRatio.Size()
+		i -= size
+		if _, err := m.MinFillRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelay(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.AcceptTS != 0 {
+		i = encodeVarintDelay(dAtA, i, uint64(m.AcceptTS))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AcceptHeight != 0 {
+		i = encodeVarintDelay(dAtA, i, uint64(m.AcceptHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintDelay(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintDelay(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedBetMarketOdds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedBetMarketOdds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedBetMarketOdds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Odds) > 0 {
+		for iNdEx := len(m.Odds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Odds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MarketUID) > 0 {
+		i -= len(m.MarketUID)
+		copy(dAtA[i:], m.MarketUID)
+		i = encodeVarintDelay(dAtA, i, uint64(len(m.MarketUID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelay(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelay(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelayedBet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovDelay(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovDelay(uint64(l))
+	}
+	if m.AcceptHeight != 0 {
+		n += 1 + sovDelay(uint64(m.AcceptHeight))
+	}
+	if m.AcceptTS != 0 {
+		n += 1 + sovDelay(uint64(m.AcceptTS))
+	}
+	l = m.MinFillRatio.Size()
+	n += 1 + l + sovDelay(uint64(l))
+	if len(m.MarketOdds) > 0 {
+		for _, e := range m.MarketOdds {
+			l = e.Size()
+			n += 1 + l + sovDelay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelayedBetMarketOdds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketUID)
+	if l > 0 {
+		n += 1 + l + sovDelay(uint64(l))
+	}
+	if len(m.Odds) > 0 {
+		for _, e := range m.Odds {
+			l = e.Size()
+			n += 1 + l + sovDelay(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDelay(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelay(x uint64) (n int) {
+	return sovDelay(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelayedBet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedBet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedBet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptHeight", wireType)
+			}
+			m.AcceptHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptTS", wireType)
+			}
+			m.AcceptTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptTS |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFillRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFillRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketOdds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketOdds = append(m.MarketOdds, DelayedBetMarketOdds{})
+			if err := m.MarketOdds[len(m.MarketOdds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedBetMarketOdds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedBetMarketOdds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedBetMarketOdds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Odds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Odds = append(m.Odds, &BetOddsCompact{})
+			if err := m.Odds[len(m.Odds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDelay(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelay
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelay
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelay
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelay
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelay
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelay        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelay          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelay = fmt.Errorf("proto: unexpected end of group")
+)
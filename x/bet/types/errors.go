@@ -35,7 +35,7 @@ var (
 	ErrFractionalOddsIncorrectFormat        = sdkerrors.Register(ModuleName, 2025, "incorrect format of fractional odds value")
 	ErrMoneylineOddsIncorrectFormat         = sdkerrors.Register(ModuleName, 2026, "incorrect format of moneyline odds value")
 	ErrOddsDataNotFound                     = sdkerrors.Register(ModuleName, 2027, "odds does not exist in ticket payload")
-	ErrInvalidOddsType                      = sdkerrors.Register(ModuleName, 2028, "valid odds type should be provided, 1: decimal, 2: fractional, 3: moneyline")
+	ErrInvalidOddsType                      = sdkerrors.Register(ModuleName, 2028, "valid odds type should be provided, 1: decimal, 2: fractional, 3: moneyline, 4: hong kong, 5: indonesian, 6: malay")
 	ErrUserKycFailed                        = sdkerrors.Register(ModuleName, 2029, "the bettor failed the KYC Validation")
 	ErrCanNotQueryLargeNumberOfBets         = sdkerrors.Register(ModuleName, 2030, "large amount of bets requested")
 	ErrDecimalOddsShouldBePositive          = sdkerrors.Register(ModuleName, 2031, "decimal odds value should be positive")
@@ -46,6 +46,75 @@ var (
 	ErrMaxLossMultiplierCanNotBeZero        = sdkerrors.Register(ModuleName, 2036, "max loss multiplier cannot be nil or zero")
 	ErrMaxLossMultiplierCanNotBeMoreThanOne = sdkerrors.Register(ModuleName, 2037, "max loss multiplier cannot be more than one")
 	ErrInsufficientOdds                     = sdkerrors.Register(ModuleName, 2038, "market odds length not same as odds sent in wager")
+	ErrInvalidParlayLegsCount               = sdkerrors.Register(ModuleName, 2039, "parlay legs count should be between 2 and 10")
+	ErrDuplicateParlayMarket                = sdkerrors.Register(ModuleName, 2040, "parlay legs should be placed on different markets")
+	ErrParlayWithSingleOdds                 = sdkerrors.Register(ModuleName, 2041, "selected odds and all odds should be empty for parlay bets")
+	ErrMarketIsStarted                      = sdkerrors.Register(ModuleName, 2042, "bet can not be canceled after the market start")
+	ErrBetIsNotPlaced                       = sdkerrors.Register(ModuleName, 2043, "bet is not in placed status")
+	ErrInOBCancellation                     = sdkerrors.Register(ModuleName, 2044, "internal error in reverting the bet fulfillments in the order book")
+	ErrInBetCancellation                    = sdkerrors.Register(ModuleName, 2045, "bet cancellation failed")
+	ErrMarketIsResolved                     = sdkerrors.Register(ModuleName, 2046, "market of the bet is already resolved")
+	ErrInvalidCashOutAmount                 = sdkerrors.Register(ModuleName, 2047, "cash-out amount should be positive and not more than the bet amount plus payout profit")
+	ErrInOBCashOut                          = sdkerrors.Register(ModuleName, 2048, "internal error in cashing out the bet in the order book")
+	ErrInBetCashOut                         = sdkerrors.Register(ModuleName, 2049, "bet cash-out failed")
+	ErrInvalidMinFillRatio                  = sdkerrors.Register(ModuleName, 2050, "minimum fill ratio should be more than zero and not more than one")
+	ErrPartialFillNotAllowedForParlay       = sdkerrors.Register(ModuleName, 2051, "partial fulfillment is not allowed for parlay bets")
+	ErrParlayMarketsDenomMismatch           = sdkerrors.Register(ModuleName, 2052, "markets of the parlay legs should accept the same denom")
+	ErrBettorSelfExcluded                   = sdkerrors.Register(ModuleName, 2053, "bettor is self-excluded from wagering")
+	ErrBettorLimitExceeded                  = sdkerrors.Register(ModuleName, 2054, "bet amount exceeds the limit of the bettor")
+	ErrInvalidSelfExclusion                 = sdkerrors.Register(ModuleName, 2055, "self-exclusion end time should be in the future")
+	ErrSelfExclusionCannotBeShortened       = sdkerrors.Register(ModuleName, 2056, "self-exclusion can not be shortened before it ends")
+	ErrInvalidBettorLimit                   = sdkerrors.Register(ModuleName, 2057, "invalid bettor limit")
+	ErrBettorLimitNotFound                  = sdkerrors.Register(ModuleName, 2058, "bettor limit not found")
+	ErrInSetSelfExclusion                   = sdkerrors.Register(ModuleName, 2059, "setting self-exclusion failed")
+	ErrInSetBettorLimit                     = sdkerrors.Register(ModuleName, 2060, "setting bettor limit failed")
+	ErrHongKongOddsIncorrectFormat          = sdkerrors.Register(ModuleName, 2061, "incorrect format of hong kong odds value")
+	ErrHongKongOddsShouldBePositive         = sdkerrors.Register(ModuleName, 2062, "hong kong odds value should be positive")
+	ErrIndonesianOddsIncorrectFormat        = sdkerrors.Register(ModuleName, 2063, "incorrect format of indonesian odds value")
+	ErrIndonesianOddsOutOfRange             = sdkerrors.Register(ModuleName, 2064, "absolute value of indonesian odds can not be less than 1")
+	ErrMalayOddsIncorrectFormat             = sdkerrors.Register(ModuleName, 2065, "incorrect format of malay odds value")
+	ErrMalayOddsOutOfRange                  = sdkerrors.Register(ModuleName, 2066, "malay odds value should not be zero and its absolute value can not be more than 1")
+	ErrOddsValueNotConvertible              = sdkerrors.Register(ModuleName, 2067, "profit of the odds value is too small to be converted to other odds types")
+	ErrInvalidMinOdds                       = sdkerrors.Register(ModuleName, 2068, "invalid minimum acceptable odds")
+	ErrOddsLowerThanMinOdds                 = sdkerrors.Register(ModuleName, 2069, "odds of the ticket is lower than the minimum acceptable odds of the bettor")
+	ErrInFundPromoPool                      = sdkerrors.Register(ModuleName, 2070, "funding the promo pool failed")
+	ErrInIssueFreeBet                       = sdkerrors.Register(ModuleName, 2071, "issuing free bet credit failed")
+	ErrInvalidFreeBetCredit                 = sdkerrors.Register(ModuleName, 2072, "invalid free bet credit")
+	ErrFreeBetCreditAlreadyExists           = sdkerrors.Register(ModuleName, 2073, "free bet credit with the same uid already exists")
+	ErrFreeBetCreditNotFound                = sdkerrors.Register(ModuleName, 2074, "free bet credit not found")
+	ErrFreeBetCreditAlreadyUsed             = sdkerrors.Register(ModuleName, 2075, "free bet credit is already used")
+	ErrFreeBetCreditExpired                 = sdkerrors.Register(ModuleName, 2076, "free bet credit is expired")
+	ErrFreeBetCreditMismatch                = sdkerrors.Register(ModuleName, 2077, "wager amount or denom is not equal to the free bet credit")
+	ErrFreeBetNotAllowedForParlay           = sdkerrors.Register(ModuleName, 2078, "free bet credit is not allowed for parlay bets")
+	ErrPartialFillNotAllowedForFreeBet      = sdkerrors.Register(ModuleName, 2079, "partial fulfillment is not allowed for free bets")
+	ErrCashOutNotAllowedForFreeBet          = sdkerrors.Register(ModuleName, 2080, "cash-out is not allowed for free bets")
+	ErrInsufficientPromoPoolBalance         = sdkerrors.Register(ModuleName, 2081, "insufficient promo pool balance")
+	ErrInPromoPoolTransfer                  = sdkerrors.Register(ModuleName, 2082, "promo pool transfer failed")
+	ErrInSetAffiliate                       = sdkerrors.Register(ModuleName, 2083, "setting the affiliate failed")
+	ErrInSetReferrer                        = sdkerrors.Register(ModuleName, 2084, "setting the referrer failed")
+	ErrInvalidAffiliate                     = sdkerrors.Register(ModuleName, 2085, "invalid affiliate")
+	ErrInvalidReferral                      = sdkerrors.Register(ModuleName, 2086, "invalid referral")
+	ErrAffiliateNotFound                    = sdkerrors.Register(ModuleName, 2087, "referrer is not a registered affiliate")
+	ErrReferrerAlreadySet                   = sdkerrors.Register(ModuleName, 2088, "referrer of the bettor is already set")
+	ErrSelfReferral                         = sdkerrors.Register(ModuleName, 2089, "bettor can not refer itself")
+	ErrInWagerBatch                         = sdkerrors.Register(ModuleName, 2090, "batch wager failed")
+	ErrInvalidWagerBatch                    = sdkerrors.Register(ModuleName, 2091, "invalid batch wager")
+	ErrWagerBatchCountExceeded              = sdkerrors.Register(ModuleName, 2092, "count of the bets of the batch wager is more than the maximum allowed")
+	ErrBettorStakeCapExceeded               = sdkerrors.Register(ModuleName, 2093, "total stake of the bettor exceeds the cap")
+	ErrBettorPayoutCapExceeded              = sdkerrors.Register(ModuleName, 2094, "total potential payout of the bettor exceeds the cap")
+	ErrInBetDelayEscrowTransfer             = sdkerrors.Register(ModuleName, 2095, "bet delay escrow transfer failed")
+	ErrOddsMovedDuringDelay                 = sdkerrors.Register(ModuleName, 2096, "odds is moved during the bet delay")
+	ErrInvalidDelayedBet                    = sdkerrors.Register(ModuleName, 2097, "invalid delayed bet")
+	ErrInOBVoid                             = sdkerrors.Register(ModuleName, 2098, "internal error in voiding the bet fulfillments in the order book")
+	ErrInBetVoiding                         = sdkerrors.Register(ModuleName, 2099, "bet voiding failed")
+	ErrNoBetsToVoid                         = sdkerrors.Register(ModuleName, 2100, "no bets to be voided")
+	ErrEmptyVoidReason                      = sdkerrors.Register(ModuleName, 2101, "void reason can not be empty")
+	ErrNoBetsToSettle                       = sdkerrors.Register(ModuleName, 2102, "no bets to be settled")
+	ErrSettleBetsCountExceeded              = sdkerrors.Register(ModuleName, 2103, "count of the bets to be settled is more than the batch settlement count")
+	ErrInBetSettlement                      = sdkerrors.Register(ModuleName, 2104, "bet settlement failed")
+	ErrOddsIsNotActive                      = sdkerrors.Register(ModuleName, 2105, "bets are not accepted on the suspended or removed odds")
+	ErrInBetFeeTransfer                     = sdkerrors.Register(ModuleName, 2106, "bet fee transfer failed")
+	ErrDenomConstraintsNotFound             = sdkerrors.Register(ModuleName, 2107, "bet constraints of the denom not found")
 )
 
 // x/bet module sentinel error text
@@ -53,6 +122,8 @@ const (
 	ErrTextInvalidParamType                                  = "invalid parameter type"
 	ErrTextBatchSettlementCountMustBePositive                = "batch settlement count should be a positive number"
 	ErrTextMaxBetUIDQueryCountMustBePositive                 = "max bet by uid query count should be a positive number"
+	ErrTextMaxWagerBatchCountMustBePositive                  = "max wager batch count should be a positive number"
+	ErrTextDelayedBetBatchCountMustBePositive                = "delayed bet batch count should be a positive number"
 	ErrTextInitGenesisFailedBecauseOfMissingBetID            = "no bet id found for the bet with uuid"
 	ErrTextInitGenesisFailedBecauseOfNotEqualStats           = "bet list items count is not equal to stats count"
 	ErrTextInitGenesisFailedBetCountNotEqualActiveAndSettled = "sum of active and settled list items count is not equal to bet list items count"
@@ -5,4 +5,25 @@ const (
 
 	attributeKeyBetUID     = "bet_uid"
 	attributeKeyBetCreator = "bet_creator"
+
+	attributeKeyCashOutAmount = "cash_out_amount"
+
+	attributeKeyVoidReason = "void_reason"
+
+	attributeKeySelfExcludedUntil = "self_excluded_until"
+	attributeKeyLimitType         = "limit_type"
+	attributeKeyLimitPeriod       = "limit_period"
+	attributeKeyLimitDenom        = "limit_denom"
+	attributeKeyLimitAmount       = "limit_amount"
+
+	attributeKeyPromoPoolDenom  = "promo_pool_denom"
+	attributeKeyPromoPoolAmount = "promo_pool_amount"
+	attributeKeyFreeBetUID      = "free_bet_uid"
+	attributeKeyFreeBetAddress  = "free_bet_address"
+	attributeKeyFreeBetAmount   = "free_bet_amount"
+
+	attributeKeyAffiliateAddress  = "affiliate_address"
+	attributeKeyAffiliateFeeShare = "affiliate_fee_share"
+	attributeKeyReferralBettor    = "referral_bettor"
+	attributeKeyReferralReferrer  = "referral_referrer"
 )
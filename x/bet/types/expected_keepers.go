@@ -41,6 +41,7 @@ type OrderbookKeeper interface {
 		maxLossMultiplier sdk.Dec,
 		betAmount sdkmath.Int,
 		payoutProfit sdk.Dec,
+		minFillRatio sdk.Dec,
 		bettorAddress sdk.AccAddress,
 		betFee sdkmath.Int,
 		oddsType OddsType,
@@ -54,6 +55,7 @@ type OrderbookKeeper interface {
 		bettorAddress sdk.AccAddress,
 		betAmount, betFee, payout sdkmath.Int,
 		uniqueLock string,
+		denom string,
 	) error
 	BettorWins(
 		ctx sdk.Context,
@@ -62,6 +64,7 @@ type OrderbookKeeper interface {
 		uniqueLock string,
 		fulfillment []*BetFulfillment,
 		bookUID string,
+		winRatio, deadHeatFactor sdk.Dec,
 	) error
 	BettorLoses(
 		ctx sdk.Context,
@@ -70,7 +73,30 @@ type OrderbookKeeper interface {
 		uniqueLock string,
 		fulfillment []*BetFulfillment,
 		bookUID string,
+		lossRatio sdk.Dec,
+	) error
+	RevertBetFulfillment(
+		ctx sdk.Context,
+		betID uint64,
+		oddsUID string,
+		fulfillment []*BetFulfillment,
+		bookUID string,
 	) error
 	SetOrderBookAsUnsettledResolved(ctx sdk.Context, orderBookUID string) error
-	WithdrawBetFee(ctx sdk.Context, marketCreator sdk.AccAddress, betFee sdkmath.Int) error
+	CashOutBettor(
+		ctx sdk.Context,
+		bettorAddress sdk.AccAddress,
+		betID uint64,
+		oddsUID string,
+		cashOutAmount sdkmath.Int,
+		fulfillment []*BetFulfillment,
+		bookUID string,
+	) error
+	WithdrawBetFee(ctx sdk.Context, marketCreator sdk.AccAddress, betFee sdkmath.Int, denom string) error
+	FundBetFee(ctx sdk.Context, bettorAddress sdk.AccAddress, bookUID string, betFee sdkmath.Int) error
+	FundPromoPool(ctx sdk.Context, funderAddress sdk.AccAddress, amount sdkmath.Int, denom string) error
+	WithdrawFromPromoPool(ctx sdk.Context, receiverAddress sdk.AccAddress, amount sdkmath.Int, denom string) error
+	GetPromoPoolBalance(ctx sdk.Context, denom string) sdkmath.Int
+	FundBetDelayEscrow(ctx sdk.Context, bettorAddress sdk.AccAddress, amount sdkmath.Int, denom string) error
+	WithdrawFromBetDelayEscrow(ctx sdk.Context, bettorAddress sdk.AccAddress, amount sdkmath.Int, denom string) error
 }
@@ -0,0 +1,91 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+	markettypes "github.com/sge-network/sge/x/market/types"
+)
+
+// NewBettorExposure creates a new bettor exposure object with zero totals
+func NewBettorExposure(address, marketUID, oddsUID string) BettorExposure {
+	return BettorExposure{
+		Address:   address,
+		MarketUID: marketUID,
+		OddsUID:   oddsUID,
+		Stake:     sdk.ZeroInt(),
+		Payout:    sdk.ZeroInt(),
+	}
+}
+
+// Validate validates the address, uids and the totals of the bettor exposure.
+func (exposure *BettorExposure) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(exposure.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if !utils.IsValidUID(exposure.MarketUID) || !utils.IsValidUID(exposure.OddsUID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid market or odds uid")
+	}
+
+	if exposure.Stake.IsNil() || exposure.Stake.IsNegative() ||
+		exposure.Payout.IsNil() || exposure.Payout.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stake and payout should not be negative")
+	}
+
+	return nil
+}
+
+// Add adds the stake and the potential payout to the totals, the totals can not go below zero.
+func (exposure *BettorExposure) Add(stake, payout sdkmath.Int) {
+	exposure.Stake = sdk.MaxInt(exposure.Stake.Add(stake), sdk.ZeroInt())
+	exposure.Payout = sdk.MaxInt(exposure.Payout.Add(payout), sdk.ZeroInt())
+}
+
+// FulfillmentExposure returns the stake and the potential payout of the bet fulfillments.
+func FulfillmentExposure(fulfillments []*BetFulfillment) (stake, payout sdkmath.Int) {
+	stake, payout = sdk.ZeroInt(), sdk.ZeroInt()
+	for _, bf := range fulfillments {
+		stake = stake.Add(bf.BetAmount)
+		payout = payout.Add(bf.BetAmount).Add(bf.PayoutProfit)
+	}
+	return stake, payout
+}
+
+// CheckBettorCaps checks the totals of the bettor including the new bet against the caps.
+func CheckBettorCaps(caps *markettypes.BettorCaps, stake, payout sdkmath.Int) error {
+	if caps.HasMaxStake() && stake.GT(caps.MaxStake) {
+		return sdkerrors.Wrapf(ErrBettorStakeCapExceeded, "%s > %s", stake, caps.MaxStake)
+	}
+
+	if caps.HasMaxPayout() && payout.GT(caps.MaxPayout) {
+		return sdkerrors.Wrapf(ErrBettorPayoutCapExceeded, "%s > %s", payout, caps.MaxPayout)
+	}
+
+	return nil
+}
+
+// NewRemainingBettorCaps creates the remaining amounts of the caps for the totals of the bettor.
+func NewRemainingBettorCaps(caps *markettypes.BettorCaps, stake, payout sdkmath.Int) RemainingBettorCaps {
+	remaining := RemainingBettorCaps{
+		Stake:           stake,
+		Payout:          payout,
+		MaxStake:        sdk.ZeroInt(),
+		MaxPayout:       sdk.ZeroInt(),
+		RemainingStake:  sdk.ZeroInt(),
+		RemainingPayout: sdk.ZeroInt(),
+	}
+
+	if caps.HasMaxStake() {
+		remaining.MaxStake = caps.MaxStake
+		remaining.RemainingStake = sdk.MaxInt(caps.MaxStake.Sub(stake), sdk.ZeroInt())
+	}
+
+	if caps.HasMaxPayout() {
+		remaining.MaxPayout = caps.MaxPayout
+		remaining.RemainingPayout = sdk.MaxInt(caps.MaxPayout.Sub(payout), sdk.ZeroInt())
+	}
+
+	return remaining
+}
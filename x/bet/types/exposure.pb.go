@@ -0,0 +1,945 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sge/bet/exposure.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BettorExposure is the cumulative stake and potential payout of the bets of
+// a bettor on a certain odds of a market, it is used to apply the bettor caps
+// of the market and the odds.
+type BettorExposure struct {
+	// address is the bettor address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// market_uid is the universal unique identifier of the market.
+	MarketUID string `protobuf:"bytes,2,opt,name=market_uid,proto3" json:"market_uid"`
+	// odds_uid is the universal unique identifier of the odds.
+	OddsUID string `protobuf:"bytes,3,opt,name=odds_uid,proto3" json:"odds_uid"`
+	// stake is the total stake of the bets.
+	Stake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=stake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"stake"`
+	// payout is the total potential payout of the bets, stake included.
+	Payout github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=payout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"payout"`
+}
+
+func (m *BettorExposure) Reset()         { *m = BettorExposure{} }
+func (m *BettorExposure) String() string { return proto.CompactTextString(m) }
+func (*BettorExposure) ProtoMessage()    {}
+func (*BettorExposure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60e67ebb4683a11d, []int{0}
+}
+func (m *BettorExposure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BettorExposure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BettorExposure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BettorExposure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BettorExposure.Merge(m, src)
+}
+func (m *BettorExposure) XXX_Size() int {
+	return m.Size()
+}
+func (m *BettorExposure) XXX_DiscardUnknown() {
+	xxx_messageInfo_BettorExposure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BettorExposure proto.InternalMessageInfo
+
+func (m *BettorExposure) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BettorExposure) GetMarketUID() string {
+	if m != nil {
+		return m.MarketUID
+	}
+	return ""
+}
+
+func (m *BettorExposure) GetOddsUID() string {
+	if m != nil {
+		return m.OddsUID
+	}
+	return ""
+}
+
+// RemainingBettorCaps is the totals of a bettor and the remaining amounts
+// to the caps of a market or an odds, the remaining amount is zero if the cap
+// is not set.
+type RemainingBettorCaps struct {
+	// stake is the total stake of the bettor.
+	Stake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=stake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"stake"`
+	// payout is the total potential payout of the bettor.
+	Payout github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=payout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"payout"`
+	// max_stake is the stake cap, zero means there is no cap.
+	MaxStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_stake,json=maxStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_stake"`
+	// max_payout is the potential payout cap, zero means there is no cap.
+	MaxPayout github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_payout,json=maxPayout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_payout"`
+	// remaining_stake is the stake that the bettor can still wager.
+	RemainingStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=remaining_stake,json=remainingStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_stake"`
+	// remaining_payout is the potential payout that the bettor can still win.
+	RemainingPayout github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=remaining_payout,json=remainingPayout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_payout"`
+}
+
+func (m *RemainingBettorCaps) Reset()         { *m = RemainingBettorCaps{} }
+func (m *RemainingBettorCaps) String() string { return proto.CompactTextString(m) }
+func (*RemainingBettorCaps) ProtoMessage()    {}
+func (*RemainingBettorCaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60e67ebb4683a11d, []int{1}
+}
+func (m *RemainingBettorCaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemainingBettorCaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemainingBettorCaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemainingBettorCaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemainingBettorCaps.Merge(m, src)
+}
+func (m *RemainingBettorCaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemainingBettorCaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemainingBettorCaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemainingBettorCaps proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BettorExposure)(nil), "sgenetwork.sge.bet.BettorExposure")
+	proto.RegisterType((*RemainingBettorCaps)(nil), "sgenetwork.sge.bet.RemainingBettorCaps")
+}
+
+func init() { proto.RegisterFile("sge/bet/exposure.proto", fileDescriptor_60e67ebb4683a11d) }
+
+var fileDescriptor_60e67ebb4683a11d = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3d, 0x6b, 0xdb, 0x40,
+	0x18, 0xc7, 0x25, 0xbb, 0x7e, 0xbb, 0xc1, 0x2d, 0xd7, 0xd2, 0x8a, 0x16, 0xa4, 0xe2, 0xa1, 0x74,
+	0xb1, 0x34, 0x78, 0xec, 0x52, 0x1c, 0x27, 0x60, 0x82, 0x49, 0x50, 0x08, 0x21, 0x59, 0xcc, 0xc9,
+	0x77, 0x5c, 0x84, 0x90, 0x4e, 0xe8, 0x4e, 0x44, 0xfe, 0x16, 0xf9, 0x1c, 0xf9, 0x24, 0x1e, 0x3d,
+	0x86, 0x0c, 0x22, 0xc8, 0x5b, 0xd6, 0x7c, 0x81, 0xa0, 0x3b, 0xf9, 0x65, 0x56, 0x32, 0xe9, 0xd1,
+	0x3d, 0xf7, 0xff, 0xf1, 0xe3, 0x1e, 0x1e, 0xf0, 0x9d, 0x53, 0xe2, 0x78, 0x44, 0x38, 0x24, 0x8b,
+	0x19, 0x4f, 0x13, 0x62, 0xc7, 0x09, 0x13, 0x0c, 0x42, 0x4e, 0x49, 0x44, 0xc4, 0x1d, 0x4b, 0x02,
+	0x9b, 0x53, 0x62, 0x7b, 0x44, 0xfc, 0xfc, 0x46, 0x19, 0x65, 0xb2, 0xed, 0x94, 0x95, 0xba, 0x39,
+	0x78, 0x68, 0x80, 0xfe, 0x98, 0x08, 0xc1, 0x92, 0xe3, 0x0a, 0x01, 0x0d, 0xd0, 0x41, 0x18, 0x27,
+	0x84, 0x73, 0x43, 0xff, 0xad, 0xff, 0xed, 0xb9, 0xdb, 0x5f, 0xf8, 0x0f, 0x80, 0x10, 0x25, 0x01,
+	0x11, 0xf3, 0xd4, 0xc7, 0x46, 0xa3, 0x6c, 0x8e, 0x7f, 0x15, 0xb9, 0xd5, 0x9b, 0xc9, 0xd3, 0xcb,
+	0xe9, 0xe4, 0x25, 0xb7, 0x0e, 0xae, 0xb8, 0x07, 0x35, 0x1c, 0x81, 0x2e, 0xc3, 0x98, 0xcb, 0x68,
+	0x53, 0x46, 0x7f, 0x14, 0xb9, 0xd5, 0x39, 0xc3, 0x98, 0xab, 0xe0, 0xae, 0xed, 0xee, 0x2a, 0x38,
+	0x01, 0x2d, 0x2e, 0x50, 0x40, 0x8c, 0x4f, 0x32, 0x61, 0xaf, 0x72, 0x4b, 0x7b, 0xca, 0xad, 0x3f,
+	0xd4, 0x17, 0xb7, 0xa9, 0x67, 0x2f, 0x58, 0xe8, 0x2c, 0x18, 0x0f, 0x19, 0xaf, 0x3e, 0x43, 0x8e,
+	0x03, 0x47, 0x2c, 0x63, 0xc2, 0xed, 0x69, 0x24, 0x5c, 0x15, 0x86, 0x27, 0xa0, 0x1d, 0xa3, 0x25,
+	0x4b, 0x85, 0xd1, 0xaa, 0x85, 0xa9, 0xd2, 0x83, 0xd7, 0x26, 0xf8, 0xea, 0x92, 0x10, 0xf9, 0x91,
+	0x1f, 0x51, 0xf5, 0x6a, 0x47, 0x28, 0xe6, 0x7b, 0x4b, 0xfd, 0x63, 0x2c, 0x1b, 0xef, 0xb1, 0x84,
+	0xa7, 0xa0, 0x17, 0xa2, 0x6c, 0xae, 0x8c, 0x9a, 0xb5, 0x50, 0xdd, 0x10, 0x65, 0x17, 0x52, 0x6a,
+	0x56, 0x8e, 0x3c, 0x9b, 0x57, 0x62, 0xf5, 0xa6, 0x50, 0xea, 0x9c, 0x2b, 0xb7, 0x2b, 0xf0, 0x39,
+	0xd9, 0x3e, 0x60, 0x65, 0x58, 0x6f, 0x24, 0xfd, 0x1d, 0x46, 0x79, 0x5e, 0x83, 0x2f, 0x7b, 0x70,
+	0x65, 0xdb, 0xae, 0x45, 0xde, 0x0b, 0x2a, 0xe7, 0xf1, 0xff, 0x55, 0x61, 0xea, 0xeb, 0xc2, 0xd4,
+	0x9f, 0x0b, 0x53, 0xbf, 0xdf, 0x98, 0xda, 0x7a, 0x63, 0x6a, 0x8f, 0x1b, 0x53, 0xbb, 0x39, 0x44,
+	0x72, 0x4a, 0x86, 0xd5, 0xca, 0x95, 0xb5, 0x93, 0xc9, 0xbd, 0x94, 0x58, 0xaf, 0x2d, 0x77, 0x6d,
+	0xf4, 0x36, 0x00, 0x8b, 0x17, 0xfa, 0xb8, 0xaf, 0x03, 0x00, 0x00,
+}
+
+func (m *BettorExposure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BettorExposure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BettorExposure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Payout.Size()
+		i -= size
+		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.OddsUID) > 0 {
+		i -= len(m.OddsUID)
+		copy(dAtA[i:], m.OddsUID)
+		i = encodeVarintExposure(dAtA, i, uint64(len(m.OddsUID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarketUID) > 0 {
+		i -= len(m.MarketUID)
+		copy(dAtA[i:], m.MarketUID)
+		i = encodeVarintExposure(dAtA, i, uint64(len(m.MarketUID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintExposure(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemainingBettorCaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemainingBettorCaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemainingBettorCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingPayout.Size()
+		i -= size
+		if _, err := m.RemainingPayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RemainingStake.Size()
+		i -= size
+		if _, err := m.RemainingStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPayout.Size()
+		i -= size
+		if _, err := m.MaxPayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxStake.Size()
+		i -= size
+		if _, err := m.MaxStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Payout.Size()
+		i -= size
+		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExposure(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExposure(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExposure(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BettorExposure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovExposure(uint64(l))
+	}
+	l = len(m.MarketUID)
+	if l > 0 {
+		n += 1 + l + sovExposure(uint64(l))
+	}
+	l = len(m.OddsUID)
+	if l > 0 {
+		n += 1 + l + sovExposure(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	l = m.Payout.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	return n
+}
+
+func (m *RemainingBettorCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stake.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	l = m.Payout.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	l = m.MaxStake.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	l = m.MaxPayout.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	l = m.RemainingStake.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	l = m.RemainingPayout.Size()
+	n += 1 + l + sovExposure(uint64(l))
+	return n
+}
+
+func sovExposure(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExposure(x uint64) (n int) {
+	return sovExposure(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BettorExposure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExposure
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BettorExposure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BettorExposure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExposure(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemainingBettorCaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExposure
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemainingBettorCaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemainingBettorCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExposure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExposure(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExposure
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExposure(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExposure
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExposure
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExposure
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExposure
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExposure
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExposure        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExposure          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExposure = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,3 +5,15 @@ type BetFeeCollectorFunder struct{}
 func (BetFeeCollectorFunder) GetModuleAcc() string {
 	return betFeeCollector
 }
+
+type BetPromoPoolFunder struct{}
+
+func (BetPromoPoolFunder) GetModuleAcc() string {
+	return betPromoPool
+}
+
+type BetDelayEscrowFunder struct{}
+
+func (BetDelayEscrowFunder) GetModuleAcc() string {
+	return betDelayEscrow
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sge-network/sge/utils"
 )
 
@@ -12,12 +13,23 @@ const DefaultUID uint64 = 1
 // DefaultGenesis returns the default  genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		BetList:        []Bet{},
-		PendingBetList: []PendingBet{},
-		SettledBetList: []SettledBet{},
-		Uid2IdList:     []UID2ID{},
-		Stats:          BetStats{},
-		Params:         DefaultParams(),
+		BetList:                    []Bet{},
+		PendingBetList:             []PendingBet{},
+		SettledBetList:             []SettledBet{},
+		Uid2IdList:                 []UID2ID{},
+		Stats:                      BetStats{},
+		Params:                     DefaultParams(),
+		ParlayWaitingBetList:       []PendingBet{},
+		DeferredBookSettlementList: []string{},
+		BettorStatsList:            []BettorStats{},
+		BettorLimitsList:           []BettorLimits{},
+		BettorDailyTotalsList:      []BettorDailyTotals{},
+		FreeBetCreditList:          []FreeBetCredit{},
+		AffiliateList:              []Affiliate{},
+		ReferralList:               []Referral{},
+		AffiliateEarningsList:      []AffiliateEarnings{},
+		BettorExposureList:         []BettorExposure{},
+		DelayedBetList:             []DelayedBet{},
 	}
 }
 
@@ -34,7 +46,19 @@ func (gs GenesisState) Validate() error {
 		)
 	}
 
-	activeAndSettledCount := uint64(len(gs.PendingBetList)) + uint64(len(gs.SettledBetList))
+	// parlay bets have a pending or waiting record per each of the legs
+	activeBetUIDs := make(map[string]struct{})
+	for _, active := range gs.PendingBetList {
+		activeBetUIDs[active.UID] = struct{}{}
+	}
+	for _, waiting := range gs.ParlayWaitingBetList {
+		activeBetUIDs[waiting.UID] = struct{}{}
+	}
+	for _, delayed := range gs.DelayedBetList {
+		activeBetUIDs[delayed.UID] = struct{}{}
+	}
+
+	activeAndSettledCount := uint64(len(activeBetUIDs)) + uint64(len(gs.SettledBetList))
 	if activeAndSettledCount != betCount {
 		return fmt.Errorf(
 			"%s: %d <> %d",
@@ -55,6 +79,14 @@ func (gs GenesisState) Validate() error {
 		betUIDMap[uid] = struct{}{}
 	}
 
+	activeBetList := append(append([]PendingBet{}, gs.PendingBetList...), gs.ParlayWaitingBetList...)
+	for _, delayed := range gs.DelayedBetList {
+		if err := delayed.Validate(); err != nil {
+			return fmt.Errorf("invalid delayed bet %s: %s", delayed.UID, err)
+		}
+		activeBetList = append(activeBetList, *NewPendingBet(delayed.UID, delayed.Creator, ""))
+	}
+
 	// Set all the bets
 	for _, bet := range gs.BetList {
 		var id uint64
@@ -75,7 +107,7 @@ func (gs GenesisState) Validate() error {
 		}
 
 		isActive := false
-		for _, active := range gs.PendingBetList {
+		for _, active := range activeBetList {
 			if active.UID == bet.UID {
 				if bet.SettlementHeight != 0 {
 					return fmt.Errorf(
@@ -107,5 +139,145 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	bettorStatsMap := make(map[string]struct{})
+	for _, bettorStats := range gs.BettorStatsList {
+		if _, err := sdk.AccAddressFromBech32(bettorStats.Address); err != nil {
+			return fmt.Errorf("invalid bettor stats address %s: %s", bettorStats.Address, err)
+		}
+
+		if err := sdk.ValidateDenom(bettorStats.Denom); err != nil {
+			return fmt.Errorf("invalid bettor stats denom %s: %s", bettorStats.Denom, err)
+		}
+
+		if bettorStats.Volume.IsNil() || bettorStats.Volume.IsNegative() {
+			return fmt.Errorf("invalid bettor stats volume %s: %s", bettorStats.Address, bettorStats.Volume)
+		}
+
+		key := string(BettorStatsKey(bettorStats.Address, bettorStats.Denom))
+		if _, ok := bettorStatsMap[key]; ok {
+			return fmt.Errorf("duplicated bettor stats %s %s", bettorStats.Address, bettorStats.Denom)
+		}
+		bettorStatsMap[key] = struct{}{}
+	}
+
+	bettorLimitsMap := make(map[string]struct{})
+	for _, bettorLimits := range gs.BettorLimitsList {
+		if _, err := sdk.AccAddressFromBech32(bettorLimits.Address); err != nil {
+			return fmt.Errorf("invalid bettor limits address %s: %s", bettorLimits.Address, err)
+		}
+
+		if _, ok := bettorLimitsMap[bettorLimits.Address]; ok {
+			return fmt.Errorf("duplicated bettor limits %s", bettorLimits.Address)
+		}
+		bettorLimitsMap[bettorLimits.Address] = struct{}{}
+
+		for _, limit := range bettorLimits.Limits {
+			if err := ValidateLimitProps(limit.LimitType, limit.Period, limit.Denom); err != nil {
+				return fmt.Errorf("invalid bettor limit %s: %s", bettorLimits.Address, err)
+			}
+
+			if limit.Amount.IsNil() || !limit.Amount.IsPositive() ||
+				limit.PendingAmount.IsNil() || limit.PendingAmount.IsNegative() {
+				return fmt.Errorf("invalid bettor limit amount %s: %s", bettorLimits.Address, limit.Amount)
+			}
+		}
+	}
+
+	bettorDailyTotalsMap := make(map[string]struct{})
+	for _, totals := range gs.BettorDailyTotalsList {
+		if _, err := sdk.AccAddressFromBech32(totals.Address); err != nil {
+			return fmt.Errorf("invalid bettor daily totals address %s: %s", totals.Address, err)
+		}
+
+		if err := sdk.ValidateDenom(totals.Denom); err != nil {
+			return fmt.Errorf("invalid bettor daily totals denom %s: %s", totals.Denom, err)
+		}
+
+		if totals.Stake.IsNil() || totals.Stake.IsNegative() || totals.NetLoss.IsNil() {
+			return fmt.Errorf("invalid bettor daily totals %s: %d", totals.Address, totals.Day)
+		}
+
+		key := string(BettorDailyTotalsKey(totals.Address, totals.Denom, totals.Day))
+		if _, ok := bettorDailyTotalsMap[key]; ok {
+			return fmt.Errorf("duplicated bettor daily totals %s %s %d", totals.Address, totals.Denom, totals.Day)
+		}
+		bettorDailyTotalsMap[key] = struct{}{}
+	}
+
+	freeBetCreditMap := make(map[string]struct{})
+	for _, credit := range gs.FreeBetCreditList {
+		if err := credit.Validate(); err != nil {
+			return fmt.Errorf("invalid free bet credit %s: %s", credit.UID, err)
+		}
+
+		key := string(FreeBetCreditKey(credit.Address, credit.UID))
+		if _, ok := freeBetCreditMap[key]; ok {
+			return fmt.Errorf("duplicated free bet credit %s %s", credit.Address, credit.UID)
+		}
+		freeBetCreditMap[key] = struct{}{}
+	}
+
+	affiliateMap := make(map[string]struct{})
+	for _, affiliate := range gs.AffiliateList {
+		if err := affiliate.Validate(); err != nil {
+			return fmt.Errorf("invalid affiliate %s: %s", affiliate.Address, err)
+		}
+
+		if _, ok := affiliateMap[affiliate.Address]; ok {
+			return fmt.Errorf("duplicated affiliate %s", affiliate.Address)
+		}
+		affiliateMap[affiliate.Address] = struct{}{}
+	}
+
+	referralMap := make(map[string]struct{})
+	for _, referral := range gs.ReferralList {
+		if err := ValidateReferral(referral.Bettor, referral.Referrer); err != nil {
+			return fmt.Errorf("invalid referral %s: %s", referral.Bettor, err)
+		}
+
+		if _, ok := affiliateMap[referral.Referrer]; !ok {
+			return fmt.Errorf("referrer %s of the bettor %s is not an affiliate", referral.Referrer, referral.Bettor)
+		}
+
+		if _, ok := referralMap[referral.Bettor]; ok {
+			return fmt.Errorf("duplicated referral %s", referral.Bettor)
+		}
+		referralMap[referral.Bettor] = struct{}{}
+	}
+
+	affiliateEarningsMap := make(map[string]struct{})
+	for _, earnings := range gs.AffiliateEarningsList {
+		if _, err := sdk.AccAddressFromBech32(earnings.Address); err != nil {
+			return fmt.Errorf("invalid affiliate earnings address %s: %s", earnings.Address, err)
+		}
+
+		if err := sdk.ValidateDenom(earnings.Denom); err != nil {
+			return fmt.Errorf("invalid affiliate earnings denom %s: %s", earnings.Denom, err)
+		}
+
+		if earnings.Amount.IsNil() || earnings.Amount.IsNegative() {
+			return fmt.Errorf("invalid affiliate earnings amount %s %s", earnings.Address, earnings.Denom)
+		}
+
+		key := string(AffiliateEarningsKey(earnings.Address, earnings.Denom))
+		if _, ok := affiliateEarningsMap[key]; ok {
+			return fmt.Errorf("duplicated affiliate earnings %s %s", earnings.Address, earnings.Denom)
+		}
+		affiliateEarningsMap[key] = struct{}{}
+	}
+
+	bettorExposureMap := make(map[string]struct{})
+	for _, exposure := range gs.BettorExposureList {
+		if err := exposure.Validate(); err != nil {
+			return fmt.Errorf("invalid bettor exposure %s: %s", exposure.Address, err)
+		}
+
+		key := string(BettorExposureKey(exposure.Address, exposure.MarketUID, exposure.OddsUID))
+		if _, ok := bettorExposureMap[key]; ok {
+			return fmt.Errorf("duplicated bettor exposure %s %s %s", exposure.Address, exposure.MarketUID, exposure.OddsUID)
+		}
+		bettorExposureMap[key] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
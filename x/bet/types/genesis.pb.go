@@ -37,6 +37,34 @@ type GenesisState struct {
 	Uid2IdList []UID2ID `protobuf:"bytes,5,rep,name=uid2id_list,json=uid2idList,proto3" json:"uid2id_list"`
 	// stats contains statistics in the genesis init.
 	Stats BetStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats"`
+	// parlay_waiting_bet_list contains the parlay bets waiting for the
+	// resolution of the rest of their legs in the genesis init.
+	ParlayWaitingBetList []PendingBet `protobuf:"bytes,7,rep,name=parlay_waiting_bet_list,json=parlayWaitingBetList,proto3" json:"parlay_waiting_bet_list"`
+	// deferred_book_settlement_list contains the market uids that their order
+	// book settlement is deferred because of the waiting parlay bets.
+	DeferredBookSettlementList []string `protobuf:"bytes,8,rep,name=deferred_book_settlement_list,json=deferredBookSettlementList,proto3" json:"deferred_book_settlement_list,omitempty"`
+	// bettor_stats_list contains the wagered volume of the bettors.
+	BettorStatsList []BettorStats `protobuf:"bytes,9,rep,name=bettor_stats_list,json=bettorStatsList,proto3" json:"bettor_stats_list"`
+	// bettor_limits_list contains the responsible gambling settings of the
+	// bettors.
+	BettorLimitsList []BettorLimits `protobuf:"bytes,10,rep,name=bettor_limits_list,json=bettorLimitsList,proto3" json:"bettor_limits_list"`
+	// bettor_daily_totals_list contains the daily totals of the bettors
+	// used for the rolling totals of the limits.
+	BettorDailyTotalsList []BettorDailyTotals `protobuf:"bytes,11,rep,name=bettor_daily_totals_list,json=bettorDailyTotalsList,proto3" json:"bettor_daily_totals_list"`
+	// free_bet_credit_list contains the free bet credits of the bettors.
+	FreeBetCreditList []FreeBetCredit `protobuf:"bytes,12,rep,name=free_bet_credit_list,json=freeBetCreditList,proto3" json:"free_bet_credit_list"`
+	// affiliate_list contains the affiliates and their bet fee shares.
+	AffiliateList []Affiliate `protobuf:"bytes,13,rep,name=affiliate_list,json=affiliateList,proto3" json:"affiliate_list"`
+	// referral_list contains the referral bindings of the bettors.
+	ReferralList []Referral `protobuf:"bytes,14,rep,name=referral_list,json=referralList,proto3" json:"referral_list"`
+	// affiliate_earnings_list contains the accrued earnings of the affiliates.
+	AffiliateEarningsList []AffiliateEarnings `protobuf:"bytes,15,rep,name=affiliate_earnings_list,json=affiliateEarningsList,proto3" json:"affiliate_earnings_list"`
+	// bettor_exposure_list contains the cumulative stake and potential payout
+	// of the bettors on the odds of the markets.
+	BettorExposureList []BettorExposure `protobuf:"bytes,16,rep,name=bettor_exposure_list,json=bettorExposureList,proto3" json:"bettor_exposure_list"`
+	// delayed_bet_list contains the bets on the in-play markets that are
+	// waiting for the bet delay.
+	DelayedBetList []DelayedBet `protobuf:"bytes,17,rep,name=delayed_bet_list,json=delayedBetList,proto3" json:"delayed_bet_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -114,6 +142,83 @@ func (m *GenesisState) GetStats() BetStats {
 	return BetStats{}
 }
 
+func (m *GenesisState) GetParlayWaitingBetList() []PendingBet {
+	if m != nil {
+		return m.ParlayWaitingBetList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeferredBookSettlementList() []string {
+	if m != nil {
+		return m.DeferredBookSettlementList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBettorStatsList() []BettorStats {
+	if m != nil {
+		return m.BettorStatsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBettorLimitsList() []BettorLimits {
+	if m != nil {
+		return m.BettorLimitsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBettorDailyTotalsList() []BettorDailyTotals {
+	if m != nil {
+		return m.BettorDailyTotalsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFreeBetCreditList() []FreeBetCredit {
+	if m != nil {
+		return m.FreeBetCreditList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAffiliateList() []Affiliate {
+	if m != nil {
+		return m.AffiliateList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferralList() []Referral {
+	if m != nil {
+		return m.ReferralList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAffiliateEarningsList() []AffiliateEarnings {
+	if m != nil {
+		return m.AffiliateEarningsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBettorExposureList() []BettorExposure {
+	if m != nil {
+		return m.BettorExposureList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelayedBetList() []DelayedBet {
+	if m != nil {
+		return m.DelayedBetList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sgenetwork.sge.bet.GenesisState")
 }
@@ -121,29 +226,49 @@ func init() {
 func init() { proto.RegisterFile("sge/bet/genesis.proto", fileDescriptor_6c49ebc0f2678a09) }
 
 var fileDescriptor_6c49ebc0f2678a09 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x93, 0xaf, 0x7f, 0x3e, 0x99, 0x8a, 0x68, 0xac, 0x58, 0x82, 0x8c, 0xc5, 0x85, 0x74,
-	0x63, 0x02, 0x71, 0xd3, 0xa5, 0x96, 0x82, 0x14, 0x44, 0xc4, 0xe2, 0xc6, 0x4d, 0xc9, 0x98, 0xcb,
-	0x38, 0xd8, 0x76, 0x42, 0xe7, 0x16, 0xf5, 0x2d, 0x7c, 0x03, 0x5f, 0xa7, 0xcb, 0x2e, 0x5d, 0x89,
-	0xb4, 0x2f, 0x22, 0x99, 0x99, 0xb4, 0x82, 0xe9, 0xee, 0xe6, 0xe4, 0x9c, 0xdf, 0x1d, 0xce, 0x25,
-	0x07, 0x8a, 0x43, 0xc8, 0x00, 0x43, 0x0e, 0x63, 0x50, 0x42, 0x05, 0xe9, 0x44, 0xa2, 0xf4, 0x3c,
-	0x95, 0x7d, 0xe3, 0x8b, 0x9c, 0x3c, 0x07, 0x8a, 0x43, 0xc0, 0x00, 0xfd, 0x3a, 0x97, 0x5c, 0xea,
-	0xdf, 0x61, 0x36, 0x19, 0xa7, 0x5f, 0xcf, 0x01, 0x69, 0x3c, 0x89, 0x47, 0x36, 0xef, 0xef, 0xe5,
-	0x2a, 0x03, 0xb4, 0xd2, 0x7e, 0x2e, 0x29, 0x8c, 0xd1, 0xfa, 0x4e, 0x3e, 0x4a, 0x64, 0xfb, 0xca,
-	0x6c, 0xee, 0x63, 0x8c, 0xe0, 0xb5, 0x49, 0xd5, 0x80, 0x1a, 0x6e, 0xd3, 0x6d, 0xd5, 0x22, 0x3f,
-	0xf8, 0xfb, 0x92, 0xe0, 0x56, 0x3b, 0x3a, 0xe5, 0xd9, 0xd7, 0xb1, 0x73, 0x67, 0xfd, 0x5e, 0x9b,
-	0x6c, 0x31, 0xc0, 0xc1, 0x50, 0x28, 0x6c, 0xfc, 0x6b, 0x96, 0x5a, 0xb5, 0xe8, 0xb0, 0x28, 0xdb,
-	0x01, 0xb4, 0xc1, 0xff, 0x0c, 0xf0, 0x5a, 0x28, 0xf4, 0x6e, 0xc8, 0x6e, 0x0a, 0xe3, 0x44, 0x8c,
-	0xf9, 0x60, 0x45, 0x28, 0x69, 0x02, 0x2d, 0xdc, 0x6e, 0xbc, 0x6b, 0xd0, 0x4e, 0xba, 0x52, 0x72,
-	0x9e, 0x02, 0xc4, 0x21, 0x24, 0x6b, 0x5e, 0x79, 0x33, 0xaf, 0x6f, 0xbc, 0xbf, 0x78, 0x6a, 0xa5,
-	0x68, 0xde, 0x25, 0xa9, 0x4d, 0x45, 0x12, 0x89, 0xc4, 0xa0, 0x2a, 0x1a, 0x55, 0x58, 0xcc, 0x7d,
-	0xaf, 0x1b, 0xf5, 0xba, 0x16, 0x43, 0x4c, 0x48, 0x23, 0xda, 0xa4, 0xa2, 0x6b, 0x6f, 0x54, 0x75,
-	0xab, 0x47, 0x1b, 0x9a, 0xc9, 0x6e, 0x90, 0xf7, 0x6a, 0x02, 0x9d, 0x8b, 0xd9, 0x82, 0xba, 0xf3,
-	0x05, 0x75, 0xbf, 0x17, 0xd4, 0x7d, 0x5f, 0x52, 0x67, 0xbe, 0xa4, 0xce, 0xe7, 0x92, 0x3a, 0x0f,
-	0xa7, 0x5c, 0xe0, 0xd3, 0x94, 0x05, 0x8f, 0x72, 0x14, 0x2a, 0x0e, 0x67, 0x96, 0x97, 0xcd, 0xe1,
-	0xab, 0xbe, 0x34, 0xbe, 0xa5, 0xa0, 0x58, 0x55, 0x9f, 0xfa, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0xe5, 0xda, 0xaa, 0x89, 0x6b, 0x02, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x6f, 0x13, 0x3f,
+	0x10, 0xc6, 0x93, 0x7f, 0xfa, 0xea, 0xf4, 0x2d, 0xfb, 0x4f, 0x49, 0x14, 0xd1, 0x34, 0x54, 0x02,
+	0xf5, 0x42, 0x22, 0x85, 0x4b, 0x8f, 0x34, 0xa4, 0x54, 0x45, 0x15, 0x82, 0xb6, 0x08, 0x54, 0x0e,
+	0x91, 0xb7, 0x3b, 0x59, 0xac, 0x6e, 0xe2, 0x95, 0x3d, 0x55, 0x9b, 0x6f, 0xc1, 0xc7, 0xea, 0x09,
+	0xf5, 0xc8, 0x09, 0xa1, 0xf6, 0x8b, 0xa0, 0x1d, 0xdb, 0x9b, 0x34, 0x64, 0x0f, 0xdc, 0x36, 0x8f,
+	0x9f, 0xe7, 0x37, 0xf6, 0x38, 0x1e, 0xb6, 0xa9, 0x43, 0x68, 0xf9, 0x80, 0xad, 0x10, 0x86, 0xa0,
+	0x85, 0x6e, 0xc6, 0x4a, 0xa2, 0xf4, 0x3c, 0x9d, 0xfc, 0xc6, 0x6b, 0xa9, 0x2e, 0x9b, 0x3a, 0x84,
+	0xa6, 0x0f, 0x58, 0x2b, 0x87, 0x32, 0x94, 0xb4, 0xdc, 0x4a, 0xbe, 0x8c, 0xb3, 0x56, 0x76, 0x80,
+	0x98, 0x2b, 0x3e, 0xb0, 0xf9, 0x5a, 0xc9, 0xa9, 0x3e, 0xa0, 0x95, 0xfe, 0x77, 0x92, 0x46, 0x8e,
+	0x7a, 0x3a, 0x1d, 0x89, 0x81, 0x40, 0x3d, 0x6d, 0x8d, 0x95, 0x1c, 0xb8, 0x42, 0x15, 0x27, 0xf2,
+	0x7e, 0x5f, 0x44, 0x82, 0x23, 0xd8, 0x85, 0x27, 0x6e, 0x01, 0x6e, 0x62, 0xa9, 0xaf, 0x14, 0x4c,
+	0x53, 0x02, 0x88, 0xf8, 0xc8, 0x88, 0x3b, 0x3f, 0x18, 0x5b, 0x39, 0x34, 0x47, 0x3d, 0x45, 0x8e,
+	0xe0, 0xed, 0xb1, 0x05, 0xb3, 0xf3, 0x6a, 0xbe, 0x91, 0xdf, 0x2d, 0xb6, 0x6b, 0xcd, 0xbf, 0x8f,
+	0xde, 0xfc, 0x40, 0x8e, 0xce, 0xdc, 0xed, 0xaf, 0xed, 0xdc, 0x89, 0xf5, 0x7b, 0x7b, 0x6c, 0xc9,
+	0x07, 0xec, 0x45, 0x42, 0x63, 0xf5, 0xbf, 0x46, 0x61, 0xb7, 0xd8, 0xae, 0xcc, 0xca, 0x76, 0x00,
+	0x6d, 0x70, 0xd1, 0x07, 0x3c, 0x16, 0x1a, 0xbd, 0xf7, 0x6c, 0x23, 0x86, 0x61, 0x20, 0x86, 0x61,
+	0x2f, 0x25, 0x14, 0x88, 0x50, 0x9f, 0x59, 0xdd, 0x78, 0xc7, 0xa0, 0xb5, 0x38, 0x55, 0x1c, 0x4f,
+	0x03, 0x62, 0x04, 0xc1, 0x98, 0x37, 0x97, 0xcd, 0x3b, 0x35, 0xde, 0x09, 0x9e, 0x4e, 0x15, 0xe2,
+	0xed, 0xb3, 0xe2, 0x95, 0x08, 0xda, 0x22, 0x30, 0xa8, 0xf9, 0x46, 0x21, 0xab, 0x31, 0x9f, 0x8e,
+	0xba, 0xed, 0xa3, 0xae, 0xc5, 0x30, 0x13, 0x22, 0xc4, 0x1e, 0x9b, 0xa7, 0x7b, 0xae, 0x2e, 0x50,
+	0x57, 0x9f, 0x66, 0x74, 0x26, 0xb9, 0x03, 0xd7, 0x57, 0x13, 0xf0, 0xbe, 0xb2, 0x4a, 0xcc, 0x55,
+	0xc4, 0x47, 0xbd, 0x6b, 0x2e, 0xf0, 0x51, 0x8f, 0x16, 0xff, 0xa1, 0x47, 0x65, 0x03, 0xf9, 0x6c,
+	0x18, 0xe3, 0x93, 0x6d, 0x05, 0xd0, 0x07, 0xa5, 0x92, 0x56, 0x49, 0x79, 0xd9, 0x33, 0x27, 0x1f,
+	0xc0, 0xd0, 0x96, 0x58, 0x6a, 0x14, 0x76, 0x97, 0x4f, 0x6a, 0xce, 0xd4, 0x91, 0xf2, 0xf2, 0x34,
+	0xb5, 0x10, 0xe2, 0x23, 0x2b, 0xf9, 0x80, 0x28, 0x55, 0x8f, 0xf6, 0x6b, 0x62, 0xcb, 0xb4, 0xb3,
+	0xed, 0x8c, 0x53, 0xa2, 0x54, 0x93, 0x07, 0x5d, 0xf7, 0xc7, 0x12, 0x21, 0xcf, 0x98, 0x67, 0x91,
+	0xe6, 0x19, 0x18, 0x26, 0x23, 0x66, 0x23, 0x9b, 0x79, 0x4c, 0x66, 0x0b, 0xdd, 0xf0, 0x27, 0x34,
+	0xa2, 0x06, 0xac, 0x6a, 0xa9, 0x01, 0x17, 0xd1, 0xa8, 0x87, 0x12, 0x79, 0x64, 0xd9, 0x45, 0x62,
+	0x3f, 0xcf, 0x66, 0x77, 0x93, 0xc8, 0x19, 0x25, 0x6c, 0x81, 0x4d, 0x7f, 0x7a, 0x81, 0xaa, 0x7c,
+	0x61, 0xe5, 0xbe, 0x02, 0xa0, 0x4b, 0xba, 0x50, 0x10, 0x08, 0xdb, 0xc8, 0x15, 0xaa, 0xf0, 0x6c,
+	0x56, 0x85, 0xb7, 0x0a, 0xa0, 0x03, 0xf8, 0x86, 0xdc, 0x96, 0x5e, 0xea, 0x4f, 0x8a, 0x44, 0x7e,
+	0xc7, 0xd6, 0xd2, 0xa7, 0x6e, 0x98, 0xab, 0xc4, 0xdc, 0x9a, 0xc5, 0xdc, 0x77, 0x4e, 0xcb, 0x5b,
+	0x4d, 0xa3, 0xc4, 0x3a, 0x64, 0xab, 0x8a, 0xae, 0x94, 0x47, 0x06, 0xb5, 0xd6, 0x28, 0x64, 0xfd,
+	0x2d, 0x4f, 0xac, 0xd1, 0x92, 0x56, 0x5c, 0x90, 0x40, 0x17, 0xac, 0x32, 0xde, 0x14, 0x70, 0x35,
+	0x14, 0xc3, 0xd0, 0xf6, 0x74, 0x3d, 0xbb, 0xa7, 0xe9, 0xee, 0x0e, 0x6c, 0xc2, 0xf5, 0x94, 0x4f,
+	0x2f, 0x50, 0x91, 0x73, 0x56, 0xb6, 0x37, 0xe7, 0x46, 0x9a, 0xa9, 0xb0, 0x41, 0x15, 0x76, 0xb2,
+	0x6f, 0xed, 0xc0, 0xda, 0x2d, 0xde, 0xf3, 0x1f, 0xa9, 0x6e, 0x56, 0xd0, 0x3c, 0x9c, 0x9c, 0x15,
+	0xa5, 0xec, 0x77, 0xd5, 0x35, 0xde, 0x89, 0x59, 0x11, 0xa4, 0x4a, 0xc2, 0xeb, 0xbc, 0xbe, 0xbd,
+	0xaf, 0xe7, 0xef, 0xee, 0xeb, 0xf9, 0xdf, 0xf7, 0xf5, 0xfc, 0xf7, 0x87, 0x7a, 0xee, 0xee, 0xa1,
+	0x9e, 0xfb, 0xf9, 0x50, 0xcf, 0x9d, 0xbf, 0x08, 0x05, 0x7e, 0xbb, 0xf2, 0x9b, 0x17, 0x72, 0xd0,
+	0xd2, 0x21, 0xbc, 0xb4, 0xe8, 0xe4, 0xbb, 0x75, 0x43, 0x83, 0x19, 0x47, 0x31, 0x68, 0x7f, 0x81,
+	0x26, 0xf3, 0xab, 0x3f, 0x03, 0x00, 0x9d, 0xcf, 0xc5, 0x48, 0x8b, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -166,6 +291,159 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelayedBetList) > 0 {
+		for iNdEx := len(m.DelayedBetList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedBetList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.BettorExposureList) > 0 {
+		for iNdEx := len(m.BettorExposureList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BettorExposureList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AffiliateEarningsList) > 0 {
+		for iNdEx := len(m.AffiliateEarningsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AffiliateEarningsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ReferralList) > 0 {
+		for iNdEx := len(m.ReferralList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferralList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AffiliateList) > 0 {
+		for iNdEx := len(m.AffiliateList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AffiliateList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.FreeBetCreditList) > 0 {
+		for iNdEx := len(m.FreeBetCreditList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreeBetCreditList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.BettorDailyTotalsList) > 0 {
+		for iNdEx := len(m.BettorDailyTotalsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BettorDailyTotalsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.BettorLimitsList) > 0 {
+		for iNdEx := len(m.BettorLimitsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BettorLimitsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BettorStatsList) > 0 {
+		for iNdEx := len(m.BettorStatsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BettorStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DeferredBookSettlementList) > 0 {
+		for iNdEx := len(m.DeferredBookSettlementList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeferredBookSettlementList[iNdEx])
+			copy(dAtA[i:], m.DeferredBookSettlementList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeferredBookSettlementList[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ParlayWaitingBetList) > 0 {
+		for iNdEx := len(m.ParlayWaitingBetList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParlayWaitingBetList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -290,6 +568,72 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Stats.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ParlayWaitingBetList) > 0 {
+		for _, e := range m.ParlayWaitingBetList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeferredBookSettlementList) > 0 {
+		for _, s := range m.DeferredBookSettlementList {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BettorStatsList) > 0 {
+		for _, e := range m.BettorStatsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BettorLimitsList) > 0 {
+		for _, e := range m.BettorLimitsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BettorDailyTotalsList) > 0 {
+		for _, e := range m.BettorDailyTotalsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FreeBetCreditList) > 0 {
+		for _, e := range m.FreeBetCreditList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AffiliateList) > 0 {
+		for _, e := range m.AffiliateList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReferralList) > 0 {
+		for _, e := range m.ReferralList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AffiliateEarningsList) > 0 {
+		for _, e := range m.AffiliateEarningsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BettorExposureList) > 0 {
+		for _, e := range m.BettorExposureList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelayedBetList) > 0 {
+		for _, e := range m.DelayedBetList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -530,6 +874,378 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParlayWaitingBetList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParlayWaitingBetList = append(m.ParlayWaitingBetList, PendingBet{})
+			if err := m.ParlayWaitingBetList[len(m.ParlayWaitingBetList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredBookSettlementList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeferredBookSettlementList = append(m.DeferredBookSettlementList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BettorStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BettorStatsList = append(m.BettorStatsList, BettorStats{})
+			if err := m.BettorStatsList[len(m.BettorStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BettorLimitsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BettorLimitsList = append(m.BettorLimitsList, BettorLimits{})
+			if err := m.BettorLimitsList[len(m.BettorLimitsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BettorDailyTotalsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BettorDailyTotalsList = append(m.BettorDailyTotalsList, BettorDailyTotals{})
+			if err := m.BettorDailyTotalsList[len(m.BettorDailyTotalsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeBetCreditList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreeBetCreditList = append(m.FreeBetCreditList, FreeBetCredit{})
+			if err := m.FreeBetCreditList[len(m.FreeBetCreditList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AffiliateList = append(m.AffiliateList, Affiliate{})
+			if err := m.AffiliateList[len(m.AffiliateList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralList = append(m.ReferralList, Referral{})
+			if err := m.ReferralList[len(m.ReferralList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffiliateEarningsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AffiliateEarningsList = append(m.AffiliateEarningsList, AffiliateEarnings{})
+			if err := m.AffiliateEarningsList[len(m.AffiliateEarningsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BettorExposureList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BettorExposureList = append(m.BettorExposureList, BettorExposure{})
+			if err := m.BettorExposureList[len(m.BettorExposureList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedBetList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedBetList = append(m.DelayedBetList, DelayedBet{})
+			if err := m.DelayedBetList[len(m.DelayedBetList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
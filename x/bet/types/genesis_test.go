@@ -3,7 +3,9 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
+	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/x/bet/types"
 	"github.com/stretchr/testify/require"
 )
@@ -57,6 +59,19 @@ func TestGenesisState_Validate(t *testing.T) {
 			Count: 2,
 		},
 		Params: types.DefaultParams(),
+		BettorStatsList: []types.BettorStats{
+			{
+				Address: testAddress,
+				Denom:   params.DefaultBondDenom,
+				Volume:  sdk.NewInt(1000),
+			},
+		},
+	}
+
+	withBettorStats := func(bettorStats ...types.BettorStats) *types.GenesisState {
+		genState := *validState
+		genState.BettorStatsList = bettorStats
+		return &genState
 	}
 
 	for _, tc := range []struct {
@@ -92,6 +107,28 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated bettor stats",
+			genState: withBettorStats(
+				types.BettorStats{Address: testAddress, Denom: params.DefaultBondDenom, Volume: sdk.NewInt(1000)},
+				types.BettorStats{Address: testAddress, Denom: params.DefaultBondDenom, Volume: sdk.NewInt(2000)},
+			),
+			valid: false,
+		},
+		{
+			desc: "negative bettor volume",
+			genState: withBettorStats(
+				types.BettorStats{Address: testAddress, Denom: params.DefaultBondDenom, Volume: sdk.NewInt(-1)},
+			),
+			valid: false,
+		},
+		{
+			desc: "invalid bettor stats denom",
+			genState: withBettorStats(
+				types.BettorStats{Address: testAddress, Denom: "1usge", Volume: sdk.NewInt(1000)},
+			),
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
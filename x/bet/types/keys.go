@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/binary"
 
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/sge-network/sge/utils"
 )
 
@@ -28,6 +29,14 @@ const (
 const (
 	// betFeeCollector is the module account name for the bet fee collection module account.
 	betFeeCollector = "bet_fee_collector"
+
+	// betPromoPool is the module account name for the promo pool module account
+	// that funds the free bets.
+	betPromoPool = "bet_promo_pool"
+
+	// betDelayEscrow is the module account name for the escrow module account
+	// that holds the stake of the delayed bets.
+	betDelayEscrow = "bet_delay_escrow"
 )
 
 var (
@@ -41,6 +50,36 @@ var (
 	PendingBetListPrefix = []byte{0x03}
 	// SettledBetListPrefix is the prefix to retrieve all settled bets
 	SettledBetListPrefix = []byte{0x04}
+	// ParlayWaitingBetListPrefix is the prefix to retrieve all parlay bets
+	// waiting for the resolution of the rest of their legs
+	ParlayWaitingBetListPrefix = []byte{0x05}
+	// DeferredBookSettlementListPrefix is the prefix to retrieve all markets
+	// that their order book settlement is deferred
+	DeferredBookSettlementListPrefix = []byte{0x06}
+	// BettorStatsListPrefix is the prefix to retrieve all bettor statistics
+	BettorStatsListPrefix = []byte{0x07}
+	// BettorLimitsListPrefix is the prefix to retrieve all bettor limits
+	BettorLimitsListPrefix = []byte{0x08}
+	// BettorDailyTotalsListPrefix is the prefix to retrieve all bettor daily totals
+	BettorDailyTotalsListPrefix = []byte{0x09}
+	// FreeBetCreditListPrefix is the prefix to retrieve all free bet credits
+	FreeBetCreditListPrefix = []byte{0x0A}
+	// AffiliateListPrefix is the prefix to retrieve all affiliates
+	AffiliateListPrefix = []byte{0x0B}
+	// ReferralListPrefix is the prefix to retrieve all referral bindings
+	ReferralListPrefix = []byte{0x0C}
+	// AffiliateEarningsListPrefix is the prefix to retrieve all affiliate earnings
+	AffiliateEarningsListPrefix = []byte{0x0D}
+	// BettorExposureListPrefix is the prefix to retrieve all bettor exposures
+	BettorExposureListPrefix = []byte{0x0E}
+	// DelayedBetListPrefix is the prefix to retrieve all delayed bets
+	DelayedBetListPrefix = []byte{0x0F}
+	// FreeBetCreditReserveListPrefix is the prefix to retrieve all reserved
+	// amounts of the unused free bet credits
+	FreeBetCreditReserveListPrefix = []byte{0x10}
+	// FreeBetCreditExpiryListPrefix is the prefix to retrieve the free bet
+	// credits ordered by their expiry timestamp
+	FreeBetCreditExpiryListPrefix = []byte{0x11}
 )
 
 // BetListByCreatorPrefix returns prefix of the certain creator bet list.
@@ -76,3 +115,97 @@ func SettledBetListOfBlockHeightPrefix(blockHeight int64) []byte {
 func SettledBetOfMarketKey(blockHeight int64, id uint64) []byte {
 	return append(utils.Int64ToBytes(blockHeight), utils.Uint64ToBytes(id)...)
 }
+
+// ParlayWaitingBetListOfMarketPrefix returns prefix of
+// waiting parlay bet list of a certain market.
+func ParlayWaitingBetListOfMarketPrefix(marketID string) []byte {
+	return append(ParlayWaitingBetListPrefix, utils.StrBytes(marketID)...)
+}
+
+// BettorStatsKey returns the key of the statistics of a bettor in a certain denom,
+// the address is length prefixed to prevent the key collision of the variable length values.
+func BettorStatsKey(bettorAddress, denom string) []byte {
+	return append(address.MustLengthPrefix(utils.StrBytes(bettorAddress)), utils.StrBytes(denom)...)
+}
+
+// BettorDailyTotalsPrefix returns the prefix of the daily totals of a bettor in a certain denom,
+// the address and denom are length prefixed to prevent the key collision of the variable length values.
+func BettorDailyTotalsPrefix(bettorAddress, denom string) []byte {
+	return append(address.MustLengthPrefix(utils.StrBytes(bettorAddress)), address.MustLengthPrefix(utils.StrBytes(denom))...)
+}
+
+// BettorDailyTotalsKey returns the key of the totals of a bettor in a certain denom and day.
+func BettorDailyTotalsKey(bettorAddress, denom string, day uint64) []byte {
+	return append(BettorDailyTotalsPrefix(bettorAddress, denom), utils.Uint64ToBytes(day)...)
+}
+
+// FreeBetCreditListByAddressPrefix returns the prefix of the free bet credits of a bettor,
+// the address is length prefixed to prevent the key collision of the variable length values.
+func FreeBetCreditListByAddressPrefix(bettorAddress string) []byte {
+	return address.MustLengthPrefix(utils.StrBytes(bettorAddress))
+}
+
+// FreeBetCreditKey returns the key of a certain free bet credit of a bettor.
+func FreeBetCreditKey(bettorAddress, uid string) []byte {
+	return append(FreeBetCreditListByAddressPrefix(bettorAddress), utils.StrBytes(uid)...)
+}
+
+// DelayedBetKey returns the key of a delayed bet, the delayed bets are ordered by
+// the accept height and timestamp to iterate only the bets that their delay is passed.
+func DelayedBetKey(acceptHeight, acceptTS int64, id uint64) []byte {
+	return append(DelayedBetListOfAcceptHeightPrefix(acceptHeight), append(utils.Int64ToBytes(acceptTS), utils.Uint64ToBytes(id)...)...)
+}
+
+// DelayedBetListOfAcceptHeightPrefix returns the prefix of the delayed bets
+// that are accepted at a certain block height.
+func DelayedBetListOfAcceptHeightPrefix(acceptHeight int64) []byte {
+	return utils.Int64ToBytes(acceptHeight)
+}
+
+// ParseDelayedBetKey returns the accept timestamp and the bet id of a delayed bet key.
+func ParseDelayedBetKey(key []byte) (acceptTS int64, id uint64) {
+	return utils.Int64FromBytes(key[8:16]), utils.Uint64FromBytes(key[16:24])
+}
+
+// FreeBetCreditReserveKey returns the key of the reserved amount of the unused free bet credits of a denom.
+func FreeBetCreditReserveKey(denom string) []byte {
+	return utils.StrBytes(denom)
+}
+
+// FreeBetCreditExpiryKey returns the key of a free bet credit in the expiry index, the
+// credits are ordered by the expiry timestamp to iterate only the expired credits.
+func FreeBetCreditExpiryKey(expiresAt int64, bettorAddress, uid string) []byte {
+	return append(utils.Int64ToBytes(expiresAt), FreeBetCreditKey(bettorAddress, uid)...)
+}
+
+// AffiliateKey returns the key of an affiliate.
+func AffiliateKey(affiliateAddress string) []byte {
+	return utils.StrBytes(affiliateAddress)
+}
+
+// ReferralKey returns the key of the referral binding of a bettor.
+func ReferralKey(bettorAddress string) []byte {
+	return utils.StrBytes(bettorAddress)
+}
+
+// AffiliateEarningsListByAddressPrefix returns the prefix of the earnings of an affiliate,
+// the address is length prefixed to prevent the key collision of the variable length values.
+func AffiliateEarningsListByAddressPrefix(affiliateAddress string) []byte {
+	return address.MustLengthPrefix(utils.StrBytes(affiliateAddress))
+}
+
+// AffiliateEarningsKey returns the key of the earnings of an affiliate in a certain denom.
+func AffiliateEarningsKey(affiliateAddress, denom string) []byte {
+	return append(AffiliateEarningsListByAddressPrefix(affiliateAddress), utils.StrBytes(denom)...)
+}
+
+// BettorExposureListByMarketPrefix returns the prefix of the exposures of a bettor on the odds of a market,
+// the address and market uid are length prefixed to prevent the key collision of the variable length values.
+func BettorExposureListByMarketPrefix(bettorAddress, marketUID string) []byte {
+	return append(address.MustLengthPrefix(utils.StrBytes(bettorAddress)), address.MustLengthPrefix(utils.StrBytes(marketUID))...)
+}
+
+// BettorExposureKey returns the key of the exposure of a bettor on a certain odds of a market.
+func BettorExposureKey(bettorAddress, marketUID, oddsUID string) []byte {
+	return append(BettorExposureListByMarketPrefix(bettorAddress, marketUID), utils.StrBytes(oddsUID)...)
+}
@@ -0,0 +1,222 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+)
+
+const (
+	// secondsPerDay is the length of a day of the daily totals in seconds.
+	secondsPerDay = 24 * 60 * 60
+
+	// MaxLimitPeriodDays is the count of the days of the longest limit period,
+	// the daily totals older than this are not needed anymore.
+	MaxLimitPeriodDays = 30
+)
+
+// DayOfTimestamp returns the number of days since the unix epoch of a unix timestamp.
+func DayOfTimestamp(ts int64) uint64 {
+	if ts < 0 {
+		return 0
+	}
+	return cast.ToUint64(ts / secondsPerDay)
+}
+
+// Days returns the count of the days of the rolling period.
+func (p LimitPeriod) Days() uint64 {
+	switch p {
+	case LimitPeriod_LIMIT_PERIOD_DAILY:
+		return 1
+	case LimitPeriod_LIMIT_PERIOD_WEEKLY:
+		return 7
+	case LimitPeriod_LIMIT_PERIOD_MONTHLY:
+		return MaxLimitPeriodDays
+	default:
+		return 0
+	}
+}
+
+// ValidateLimitProps validates the type, period and denom of a bettor limit.
+func ValidateLimitProps(limitType LimitType, period LimitPeriod, denom string) error {
+	if limitType != LimitType_LIMIT_TYPE_STAKE && limitType != LimitType_LIMIT_TYPE_NET_LOSS {
+		return sdkerrors.Wrapf(ErrInvalidBettorLimit, "limit type %s", limitType)
+	}
+
+	if period.Days() == 0 {
+		return sdkerrors.Wrapf(ErrInvalidBettorLimit, "limit period %s", period)
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBettorLimit, "%s", err)
+	}
+
+	return nil
+}
+
+// HasPendingChange returns true if the limit has a loosened amount
+// waiting for the cooling-off period.
+func (l BettorLimit) HasPendingChange() bool {
+	return l.PendingEffectiveAt != 0
+}
+
+// EffectiveAmount returns the limited amount in effect at the given time,
+// zero means the limit is removed.
+func (l BettorLimit) EffectiveAmount(now int64) sdkmath.Int {
+	if l.HasPendingChange() && now >= l.PendingEffectiveAt {
+		return l.PendingAmount
+	}
+	return l.Amount
+}
+
+// applyPendingChange applies the pending amount if the cooling-off period is passed.
+func (l *BettorLimit) applyPendingChange(now int64) {
+	if l.HasPendingChange() && now >= l.PendingEffectiveAt {
+		l.Amount = l.PendingAmount
+		l.clearPendingChange()
+	}
+}
+
+// clearPendingChange removes the pending amount of the limit.
+func (l *BettorLimit) clearPendingChange() {
+	l.PendingAmount = sdk.ZeroInt()
+	l.PendingEffectiveAt = 0
+}
+
+// HasLimitOfDenom returns true if the bettor has a limit in the given denom at the given time,
+// a limit that is loosened or removed after the cooling-off period is counted until it takes effect.
+func (bl BettorLimits) HasLimitOfDenom(denom string, now int64) bool {
+	for _, limit := range bl.Limits {
+		if limit.Denom == denom && limit.EffectiveAmount(now).IsPositive() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSelfExcluded returns true if the bettor is excluded from wagering at the given time.
+func (bl BettorLimits) IsSelfExcluded(now int64) bool {
+	return now < bl.SelfExcludedUntil
+}
+
+// SetSelfExclusion extends the self-exclusion of the bettor until the given time,
+// the self-exclusion can not be shortened before it ends.
+func (bl *BettorLimits) SetSelfExclusion(until, now int64) error {
+	if until <= now {
+		return sdkerrors.Wrapf(ErrInvalidSelfExclusion, "%d", until)
+	}
+
+	if bl.IsSelfExcluded(now) && until < bl.SelfExcludedUntil {
+		return sdkerrors.Wrapf(ErrSelfExclusionCannotBeShortened, "%d", bl.SelfExcludedUntil)
+	}
+
+	bl.SelfExcludedUntil = until
+	return nil
+}
+
+// ApplyPendingChanges applies the pending amounts of the limits that their cooling-off
+// period is passed and removes the limits that their amount is zero.
+func (bl *BettorLimits) ApplyPendingChanges(now int64) {
+	limits := bl.Limits[:0]
+	for _, limit := range bl.Limits {
+		limit.applyPendingChange(now)
+		if limit.Amount.IsPositive() {
+			limits = append(limits, limit)
+		}
+	}
+	bl.Limits = limits
+}
+
+// SetLimit sets the limited amount of the given type, period and denom. A tightened limit
+// takes effect immediately while a loosened or removed limit takes effect after the cooling-off
+// period, zero amount removes the limit. The resulting limit is returned.
+func (bl *BettorLimits) SetLimit(
+	limitType LimitType,
+	period LimitPeriod,
+	denom string,
+	amount sdkmath.Int,
+	now, coolingOffPeriod int64,
+) (BettorLimit, error) {
+	bl.ApplyPendingChanges(now)
+
+	for i := range bl.Limits {
+		limit := &bl.Limits[i]
+		if limit.LimitType != limitType || limit.Period != period || limit.Denom != denom {
+			continue
+		}
+
+		switch {
+		case amount.IsPositive() && amount.LTE(limit.Amount):
+			// tightened limit takes effect immediately and cancels any pending loosening.
+			limit.Amount = amount
+			limit.clearPendingChange()
+		default:
+			limit.PendingAmount = amount
+			limit.PendingEffectiveAt = now + coolingOffPeriod
+			// no cooling-off period is set, so the loosened limit takes effect immediately.
+			limit.applyPendingChange(now)
+		}
+
+		result := *limit
+		if limit.Amount.IsZero() {
+			bl.Limits = append(bl.Limits[:i], bl.Limits[i+1:]...)
+		}
+		return result, nil
+	}
+
+	if !amount.IsPositive() {
+		return BettorLimit{}, sdkerrors.Wrapf(ErrBettorLimitNotFound, "%s %s %s", limitType, period, denom)
+	}
+
+	limit := BettorLimit{
+		LimitType:     limitType,
+		Period:        period,
+		Denom:         denom,
+		Amount:        amount,
+		PendingAmount: sdk.ZeroInt(),
+	}
+	bl.Limits = append(bl.Limits, limit)
+
+	return limit, nil
+}
+
+// CheckWager checks the self-exclusion and the limits of the bettor for a new bet, the stake
+// and net loss totals are the rolling totals of each of the limit periods, the bet amount is
+// considered as a loss in the net loss limits as the worst case of the bet result.
+func (bl BettorLimits) CheckWager(
+	denom string,
+	amount sdkmath.Int,
+	now int64,
+	totals func(period LimitPeriod) (stake, netLoss sdkmath.Int),
+) error {
+	if bl.IsSelfExcluded(now) {
+		return sdkerrors.Wrapf(ErrBettorSelfExcluded, "until %d", bl.SelfExcludedUntil)
+	}
+
+	for _, limit := range bl.Limits {
+		if limit.Denom != denom {
+			continue
+		}
+
+		limitAmount := limit.EffectiveAmount(now)
+		if !limitAmount.IsPositive() {
+			continue
+		}
+
+		stake, netLoss := totals(limit.Period)
+		total := stake
+		if limit.LimitType == LimitType_LIMIT_TYPE_NET_LOSS {
+			total = netLoss
+		}
+
+		if total.Add(amount).GT(limitAmount) {
+			return sdkerrors.Wrapf(
+				ErrBettorLimitExceeded, "%s %s limit %s%s, current total %s",
+				limit.Period, limit.LimitType, limitAmount, denom, total,
+			)
+		}
+	}
+
+	return nil
+}
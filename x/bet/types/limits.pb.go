@@ -0,0 +1,1253 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sge/bet/limits.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LimitType is the type of the responsible gambling limit.
+type LimitType int32
+
+const (
+	// unspecified limit type.
+	LimitType_LIMIT_TYPE_UNSPECIFIED LimitType = 0
+	// the total wagered amount is limited.
+	LimitType_LIMIT_TYPE_STAKE LimitType = 1
+	// the total wagered amount minus the total returned amount is limited.
+	LimitType_LIMIT_TYPE_NET_LOSS LimitType = 2
+)
+
+var LimitType_name = map[int32]string{
+	0: "LIMIT_TYPE_UNSPECIFIED",
+	1: "LIMIT_TYPE_STAKE",
+	2: "LIMIT_TYPE_NET_LOSS",
+}
+
+var LimitType_value = map[string]int32{
+	"LIMIT_TYPE_UNSPECIFIED": 0,
+	"LIMIT_TYPE_STAKE":       1,
+	"LIMIT_TYPE_NET_LOSS":    2,
+}
+
+func (x LimitType) String() string {
+	return proto.EnumName(LimitType_name, int32(x))
+}
+
+func (LimitType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0117a5d1cc40fe27, []int{0}
+}
+
+// LimitPeriod is the rolling period that the limit is applied to.
+type LimitPeriod int32
+
+const (
+	// unspecified limit period.
+	LimitPeriod_LIMIT_PERIOD_UNSPECIFIED LimitPeriod = 0
+	// the last day.
+	LimitPeriod_LIMIT_PERIOD_DAILY LimitPeriod = 1
+	// the last seven days.
+	LimitPeriod_LIMIT_PERIOD_WEEKLY LimitPeriod = 2
+	// the last thirty days.
+	LimitPeriod_LIMIT_PERIOD_MONTHLY LimitPeriod = 3
+)
+
+var LimitPeriod_name = map[int32]string{
+	0: "LIMIT_PERIOD_UNSPECIFIED",
+	1: "LIMIT_PERIOD_DAILY",
+	2: "LIMIT_PERIOD_WEEKLY",
+	3: "LIMIT_PERIOD_MONTHLY",
+}
+
+var LimitPeriod_value = map[string]int32{
+	"LIMIT_PERIOD_UNSPECIFIED": 0,
+	"LIMIT_PERIOD_DAILY":       1,
+	"LIMIT_PERIOD_WEEKLY":      2,
+	"LIMIT_PERIOD_MONTHLY":     3,
+}
+
+func (x LimitPeriod) String() string {
+	return proto.EnumName(LimitPeriod_name, int32(x))
+}
+
+func (LimitPeriod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0117a5d1cc40fe27, []int{1}
+}
+
+// BettorLimit is a stake or net loss limit of a bettor in a certain denom
+// for a rolling period.
+type BettorLimit struct {
+	// limit_type is the type of the limit.
+	LimitType LimitType `protobuf:"varint,1,opt,name=limit_type,json=limitType,proto3,enum=sgenetwork.sge.bet.LimitType" json:"limit_type,omitempty"`
+	// period is the rolling period of the limit.
+	Period LimitPeriod `protobuf:"varint,2,opt,name=period,proto3,enum=sgenetwork.sge.bet.LimitPeriod" json:"period,omitempty"`
+	// denom is the denomination of the limited amount.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the limited amount in effect.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// pending_amount is the loosened amount waiting for the cooling-off period,
+	// zero means the limit is removed after the cooling-off period.
+	PendingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=pending_amount,json=pendingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pending_amount"`
+	// pending_effective_at is the unix timestamp that the pending amount
+	// takes effect, zero means there is no pending change.
+	PendingEffectiveAt int64 `protobuf:"varint,6,opt,name=pending_effective_at,json=pendingEffectiveAt,proto3" json:"pending_effective_at,omitempty"`
+}
+
+func (m *BettorLimit) Reset()         { *m = BettorLimit{} }
+func (m *BettorLimit) String() string { return proto.CompactTextString(m) }
+func (*BettorLimit) ProtoMessage()    {}
+func (*BettorLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0117a5d1cc40fe27, []int{0}
+}
+func (m *BettorLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BettorLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BettorLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BettorLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BettorLimit.Merge(m, src)
+}
+func (m *BettorLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BettorLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BettorLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BettorLimit proto.InternalMessageInfo
+
+func (m *BettorLimit) GetLimitType() LimitType {
+	if m != nil {
+		return m.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (m *BettorLimit) GetPeriod() LimitPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return LimitPeriod_LIMIT_PERIOD_UNSPECIFIED
+}
+
+func (m *BettorLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BettorLimit) GetPendingEffectiveAt() int64 {
+	if m != nil {
+		return m.PendingEffectiveAt
+	}
+	return 0
+}
+
+// BettorLimits is the responsible gambling settings of a bettor.
+type BettorLimits struct {
+	// address is the bettor address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// self_excluded_until is the unix timestamp until that the bettor
+	// is excluded from wagering.
+	SelfExcludedUntil int64 `protobuf:"varint,2,opt,name=self_excluded_until,json=selfExcludedUntil,proto3" json:"self_excluded_until,omitempty"`
+	// limits is the list of the stake and net loss limits of the bettor.
+	Limits []BettorLimit `protobuf:"bytes,3,rep,name=limits,proto3" json:"limits"`
+}
+
+func (m *BettorLimits) Reset()         { *m = BettorLimits{} }
+func (m *BettorLimits) String() string { return proto.CompactTextString(m) }
+func (*BettorLimits) ProtoMessage()    {}
+func (*BettorLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0117a5d1cc40fe27, []int{1}
+}
+func (m *BettorLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BettorLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BettorLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BettorLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BettorLimits.Merge(m, src)
+}
+func (m *BettorLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *BettorLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_BettorLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BettorLimits proto.InternalMessageInfo
+
+func (m *BettorLimits) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BettorLimits) GetSelfExcludedUntil() int64 {
+	if m != nil {
+		return m.SelfExcludedUntil
+	}
+	return 0
+}
+
+func (m *BettorLimits) GetLimits() []BettorLimit {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+// BettorDailyTotals is the total wagered amount and the net loss of
+// a bettor in a certain denom in a day, the rolling totals of the limit
+// periods are calculated by the daily totals.
+type BettorDailyTotals struct {
+	// address is the bettor address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denomination of the totals.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// day is the number of days since the unix epoch.
+	Day uint64 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	// stake is the total wagered amount of the day.
+	Stake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=stake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"stake"`
+	// net_loss is the total wagered amount minus the total returned amount
+	// of the day, it is negative if the bettor has won more than wagered.
+	NetLoss github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=net_loss,json=netLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"net_loss"`
+}
+
+func (m *BettorDailyTotals) Reset()         { *m = BettorDailyTotals{} }
+func (m *BettorDailyTotals) String() string { return proto.CompactTextString(m) }
+func (*BettorDailyTotals) ProtoMessage()    {}
+func (*BettorDailyTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0117a5d1cc40fe27, []int{2}
+}
+func (m *BettorDailyTotals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BettorDailyTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BettorDailyTotals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BettorDailyTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BettorDailyTotals.Merge(m, src)
+}
+func (m *BettorDailyTotals) XXX_Size() int {
+	return m.Size()
+}
+func (m *BettorDailyTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_BettorDailyTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BettorDailyTotals proto.InternalMessageInfo
+
+func (m *BettorDailyTotals) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BettorDailyTotals) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BettorDailyTotals) GetDay() uint64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("sgenetwork.sge.bet.LimitType", LimitType_name, LimitType_value)
+	proto.RegisterEnum("sgenetwork.sge.bet.LimitPeriod", LimitPeriod_name, LimitPeriod_value)
+	proto.RegisterType((*BettorLimit)(nil), "sgenetwork.sge.bet.BettorLimit")
+	proto.RegisterType((*BettorLimits)(nil), "sgenetwork.sge.bet.BettorLimits")
+	proto.RegisterType((*BettorDailyTotals)(nil), "sgenetwork.sge.bet.BettorDailyTotals")
+}
+
+func init() { proto.RegisterFile("sge/bet/limits.proto", fileDescriptor_0117a5d1cc40fe27) }
+
+var fileDescriptor_0117a5d1cc40fe27 = []byte{
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6e, 0xda, 0x40,
+	0x10, 0xc7, 0x31, 0x0e, 0xa4, 0x0c, 0x6d, 0xe4, 0x6c, 0xac, 0xd4, 0x8a, 0x5a, 0x83, 0x38, 0x54,
+	0x28, 0x52, 0xec, 0xaa, 0x3d, 0xf4, 0xd2, 0x4a, 0x85, 0xe2, 0xa8, 0x56, 0x1c, 0x40, 0xc6, 0xb4,
+	0xa2, 0x17, 0xcb, 0xe0, 0xc5, 0xb5, 0x30, 0x5e, 0xc4, 0x2e, 0x69, 0x78, 0x8b, 0xde, 0xfa, 0x4a,
+	0x39, 0xe6, 0x58, 0xf5, 0x10, 0x55, 0x20, 0xf5, 0xd2, 0x97, 0xa8, 0xfc, 0x41, 0xe4, 0xf4, 0xeb,
+	0xc0, 0x89, 0xd9, 0xf9, 0xcf, 0xfc, 0x58, 0xff, 0x67, 0xb4, 0x20, 0x52, 0x0f, 0xab, 0x43, 0xcc,
+	0xd4, 0xc0, 0x9f, 0xfa, 0x8c, 0x2a, 0xb3, 0x39, 0x61, 0x04, 0x21, 0xea, 0xe1, 0x10, 0xb3, 0x4f,
+	0x64, 0x3e, 0x51, 0xa8, 0x87, 0x95, 0x21, 0x66, 0x47, 0xa2, 0x47, 0x3c, 0x12, 0xcb, 0x6a, 0x14,
+	0x25, 0x95, 0xb5, 0x9f, 0x79, 0x28, 0x37, 0x31, 0x63, 0x64, 0x6e, 0x44, 0x00, 0xf4, 0x12, 0x20,
+	0x26, 0xd9, 0x6c, 0x39, 0xc3, 0x12, 0x57, 0xe5, 0xea, 0x7b, 0xcf, 0x1e, 0x2b, 0x7f, 0xe2, 0x94,
+	0xb8, 0xdc, 0x5a, 0xce, 0xb0, 0x59, 0x0a, 0x36, 0x21, 0x7a, 0x01, 0xc5, 0x19, 0x9e, 0xfb, 0xc4,
+	0x95, 0xf2, 0x71, 0x67, 0xe5, 0x9f, 0x9d, 0xdd, 0xb8, 0xcc, 0x4c, 0xcb, 0x91, 0x08, 0x05, 0x17,
+	0x87, 0x64, 0x2a, 0xf1, 0x55, 0xae, 0x5e, 0x32, 0x93, 0x03, 0x3a, 0x85, 0xa2, 0x33, 0x25, 0x8b,
+	0x90, 0x49, 0x3b, 0x51, 0xba, 0xa9, 0x5c, 0xdd, 0x54, 0x72, 0xdf, 0x6e, 0x2a, 0x4f, 0x3c, 0x9f,
+	0x7d, 0x5c, 0x0c, 0x95, 0x11, 0x99, 0xaa, 0x23, 0x42, 0xa7, 0x84, 0xa6, 0x3f, 0x27, 0xd4, 0x9d,
+	0xa8, 0xd1, 0xcd, 0xa9, 0xa2, 0x87, 0xcc, 0x4c, 0xbb, 0x51, 0x1f, 0xf6, 0x66, 0x38, 0x74, 0xfd,
+	0xd0, 0xb3, 0x53, 0x5e, 0x61, 0x2b, 0xde, 0x83, 0x94, 0xd2, 0x48, 0xb0, 0x4f, 0x41, 0xdc, 0x60,
+	0xf1, 0x78, 0x8c, 0x47, 0xcc, 0xbf, 0xc0, 0xb6, 0xc3, 0xa4, 0x62, 0x95, 0xab, 0xf3, 0x26, 0x4a,
+	0x35, 0x6d, 0x23, 0x35, 0x58, 0xed, 0x0b, 0x07, 0xf7, 0x33, 0x6e, 0x53, 0x24, 0xc1, 0xae, 0xe3,
+	0xba, 0x73, 0x4c, 0x69, 0xec, 0x75, 0xc9, 0xdc, 0x1c, 0x91, 0x02, 0x07, 0x14, 0x07, 0x63, 0x1b,
+	0x5f, 0x8e, 0x82, 0x85, 0x8b, 0x5d, 0x7b, 0x11, 0x32, 0x3f, 0x88, 0x7d, 0xe5, 0xcd, 0xfd, 0x48,
+	0xd2, 0x52, 0xa5, 0x1f, 0x09, 0xe8, 0x15, 0x14, 0x93, 0x15, 0x90, 0xf8, 0x2a, 0x5f, 0x2f, 0xff,
+	0xdd, 0xfa, 0xcc, 0x7f, 0x37, 0x77, 0xa2, 0x8f, 0x37, 0xd3, 0xa6, 0xda, 0x0f, 0x0e, 0xf6, 0x13,
+	0xb5, 0xe5, 0xf8, 0xc1, 0xd2, 0x22, 0xcc, 0x09, 0xfe, 0x77, 0xbd, 0xdb, 0x81, 0xe5, 0xb3, 0x03,
+	0x13, 0x80, 0x77, 0x9d, 0x65, 0x3c, 0xc4, 0x1d, 0x33, 0x0a, 0x51, 0x0b, 0x0a, 0x94, 0x39, 0x13,
+	0xbc, 0xe5, 0x04, 0x93, 0x66, 0xa4, 0xc3, 0xbd, 0x10, 0x33, 0x3b, 0x20, 0x94, 0x6e, 0x39, 0xba,
+	0xdd, 0x10, 0x33, 0x83, 0x50, 0x7a, 0xfc, 0x0e, 0x4a, 0xb7, 0xab, 0x8b, 0x8e, 0xe0, 0xd0, 0xd0,
+	0xcf, 0x75, 0xcb, 0xb6, 0x06, 0x5d, 0xcd, 0xee, 0xb7, 0x7b, 0x5d, 0xed, 0x8d, 0x7e, 0xaa, 0x6b,
+	0x2d, 0x21, 0x87, 0x44, 0x10, 0x32, 0x5a, 0xcf, 0x6a, 0x9c, 0x69, 0x02, 0x87, 0x1e, 0xc2, 0x41,
+	0x26, 0xdb, 0xd6, 0x2c, 0xdb, 0xe8, 0xf4, 0x7a, 0x42, 0xfe, 0xf8, 0x02, 0xca, 0x99, 0xc5, 0x46,
+	0x8f, 0x40, 0x4a, 0xea, 0xba, 0x9a, 0xa9, 0x77, 0x5a, 0xbf, 0xb1, 0x0f, 0x01, 0xdd, 0x51, 0x5b,
+	0x0d, 0xdd, 0x18, 0x64, 0xe9, 0x69, 0xfe, 0xbd, 0xa6, 0x9d, 0x19, 0x03, 0x21, 0x8f, 0x24, 0x10,
+	0xef, 0x08, 0xe7, 0x9d, 0xb6, 0xf5, 0xd6, 0x18, 0x08, 0x7c, 0xf3, 0xf5, 0xd5, 0x4a, 0xe6, 0xae,
+	0x57, 0x32, 0xf7, 0x7d, 0x25, 0x73, 0x9f, 0xd7, 0x72, 0xee, 0x7a, 0x2d, 0xe7, 0xbe, 0xae, 0xe5,
+	0xdc, 0x87, 0xac, 0x35, 0xd4, 0xc3, 0x27, 0xe9, 0x32, 0x44, 0xb1, 0x7a, 0x19, 0xbf, 0x19, 0xb1,
+	0x3d, 0xc3, 0x62, 0xfc, 0x12, 0x3c, 0xff, 0x35, 0x00, 0xa5, 0xbe, 0x8e, 0xec, 0x4b, 0x04, 0x00,
+	0x00,
+}
+
+func (m *BettorLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BettorLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BettorLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingEffectiveAt != 0 {
+		i = encodeVarintLimits(dAtA, i, uint64(m.PendingEffectiveAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.PendingAmount.Size()
+		i -= size
+		if _, err := m.PendingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLimits(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Period != 0 {
+		i = encodeVarintLimits(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LimitType != 0 {
+		i = encodeVarintLimits(dAtA, i, uint64(m.LimitType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BettorLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BettorLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BettorLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLimits(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SelfExcludedUntil != 0 {
+		i = encodeVarintLimits(dAtA, i, uint64(m.SelfExcludedUntil))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLimits(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BettorDailyTotals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BettorDailyTotals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BettorDailyTotals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetLoss.Size()
+		i -= size
+		if _, err := m.NetLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLimits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Day != 0 {
+		i = encodeVarintLimits(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLimits(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLimits(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLimits(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLimits(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BettorLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LimitType != 0 {
+		n += 1 + sovLimits(uint64(m.LimitType))
+	}
+	if m.Period != 0 {
+		n += 1 + sovLimits(uint64(m.Period))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLimits(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLimits(uint64(l))
+	l = m.PendingAmount.Size()
+	n += 1 + l + sovLimits(uint64(l))
+	if m.PendingEffectiveAt != 0 {
+		n += 1 + sovLimits(uint64(m.PendingEffectiveAt))
+	}
+	return n
+}
+
+func (m *BettorLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLimits(uint64(l))
+	}
+	if m.SelfExcludedUntil != 0 {
+		n += 1 + sovLimits(uint64(m.SelfExcludedUntil))
+	}
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovLimits(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BettorDailyTotals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLimits(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLimits(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovLimits(uint64(m.Day))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovLimits(uint64(l))
+	l = m.NetLoss.Size()
+	n += 1 + l + sovLimits(uint64(l))
+	return n
+}
+
+func sovLimits(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLimits(x uint64) (n int) {
+	return sovLimits(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BettorLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BettorLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BettorLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitType", wireType)
+			}
+			m.LimitType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitType |= LimitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= LimitPeriod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingEffectiveAt", wireType)
+			}
+			m.PendingEffectiveAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingEffectiveAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BettorLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BettorLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BettorLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfExcludedUntil", wireType)
+			}
+			m.SelfExcludedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfExcludedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limits = append(m.Limits, BettorLimit{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BettorDailyTotals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BettorDailyTotals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BettorDailyTotals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetLoss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLimits(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLimits
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLimits
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLimits
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLimits
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLimits        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLimits          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLimits = fmt.Errorf("proto: unexpected end of group")
+)
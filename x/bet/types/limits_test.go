@@ -0,0 +1,149 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func TestBettorLimitsSetLimit(t *testing.T) {
+	const (
+		now        = int64(1000)
+		coolingOff = int64(100)
+	)
+
+	limits := types.BettorLimits{}
+	setLimit := func(amount int64, ts int64) (types.BettorLimit, error) {
+		return limits.SetLimit(
+			types.LimitType_LIMIT_TYPE_STAKE, types.LimitPeriod_LIMIT_PERIOD_DAILY,
+			params.DefaultBondDenom, sdk.NewInt(amount), ts, coolingOff,
+		)
+	}
+
+	// removing a limit that is not set
+	_, err := setLimit(0, now)
+	require.ErrorIs(t, err, types.ErrBettorLimitNotFound)
+
+	// new limit takes effect immediately
+	limit, err := setLimit(1000, now)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000).String(), limit.EffectiveAmount(now).String())
+
+	// tightened limit takes effect immediately
+	limit, err = setLimit(500, now)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500).String(), limit.EffectiveAmount(now).String())
+	require.False(t, limit.HasPendingChange())
+
+	// loosened limit takes effect after the cooling-off period
+	limit, err = setLimit(2000, now)
+	require.NoError(t, err)
+	require.True(t, limit.HasPendingChange())
+	require.Equal(t, sdk.NewInt(500).String(), limit.EffectiveAmount(now+coolingOff-1).String())
+	require.Equal(t, sdk.NewInt(2000).String(), limit.EffectiveAmount(now+coolingOff).String())
+
+	// tightening cancels the pending loosening
+	limit, err = setLimit(400, now+1)
+	require.NoError(t, err)
+	require.False(t, limit.HasPendingChange())
+	require.Equal(t, sdk.NewInt(400).String(), limit.EffectiveAmount(now+coolingOff).String())
+
+	// removed limit is deleted after the cooling-off period
+	_, err = setLimit(0, now+2)
+	require.NoError(t, err)
+	require.Len(t, limits.Limits, 1)
+	require.True(t, limits.HasLimitOfDenom(params.DefaultBondDenom, now+2+coolingOff-1))
+	require.False(t, limits.HasLimitOfDenom(params.DefaultBondDenom, now+2+coolingOff))
+	require.False(t, limits.HasLimitOfDenom("uatom", now+2))
+	limits.ApplyPendingChanges(now + 2 + coolingOff)
+	require.Empty(t, limits.Limits)
+}
+
+func TestBettorLimitsCheckWager(t *testing.T) {
+	const now = int64(1000)
+
+	limits := types.BettorLimits{
+		Limits: []types.BettorLimit{
+			{
+				LimitType:     types.LimitType_LIMIT_TYPE_STAKE,
+				Period:        types.LimitPeriod_LIMIT_PERIOD_DAILY,
+				Denom:         params.DefaultBondDenom,
+				Amount:        sdk.NewInt(1000),
+				PendingAmount: sdk.ZeroInt(),
+			},
+			{
+				LimitType:     types.LimitType_LIMIT_TYPE_NET_LOSS,
+				Period:        types.LimitPeriod_LIMIT_PERIOD_WEEKLY,
+				Denom:         params.DefaultBondDenom,
+				Amount:        sdk.NewInt(600),
+				PendingAmount: sdk.ZeroInt(),
+			},
+		},
+	}
+
+	totals := func(stake, netLoss int64) func(types.LimitPeriod) (sdkmath.Int, sdkmath.Int) {
+		return func(types.LimitPeriod) (sdkmath.Int, sdkmath.Int) {
+			return sdk.NewInt(stake), sdk.NewInt(netLoss)
+		}
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		denom   string
+		amount  int64
+		stake   int64
+		netLoss int64
+		err     error
+	}{
+		{
+			desc:   "within limits",
+			denom:  params.DefaultBondDenom,
+			amount: 500,
+		},
+		{
+			desc:   "stake limit exceeded",
+			denom:  params.DefaultBondDenom,
+			amount: 500,
+			stake:  600,
+			err:    types.ErrBettorLimitExceeded,
+		},
+		{
+			desc:    "net loss limit exceeded",
+			denom:   params.DefaultBondDenom,
+			amount:  500,
+			netLoss: 200,
+			err:     types.ErrBettorLimitExceeded,
+		},
+		{
+			desc:    "net loss is reduced by the wins",
+			denom:   params.DefaultBondDenom,
+			amount:  500,
+			stake:   400,
+			netLoss: -200,
+		},
+		{
+			desc:   "other denom",
+			denom:  "uatom",
+			amount: 5000,
+			stake:  5000,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := limits.CheckWager(tc.denom, sdk.NewInt(tc.amount), now, totals(tc.stake, tc.netLoss))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	limits.SelfExcludedUntil = now + 1
+	err := limits.CheckWager(params.DefaultBondDenom, sdk.NewInt(1), now, totals(0, 0))
+	require.ErrorIs(t, err, types.ErrBettorSelfExcluded)
+}
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+)
+
+const (
+	// typeMsgSetAffiliate is type of message MsgSetAffiliate
+	typeMsgSetAffiliate = "bet_set_affiliate"
+	// typeMsgSetReferrer is type of message MsgSetReferrer
+	typeMsgSetReferrer = "bet_set_referrer"
+	// typeMsgBindReferrer is type of message MsgBindReferrer
+	typeMsgBindReferrer = "bet_bind_referrer"
+)
+
+var (
+	_ sdk.Msg = &MsgSetAffiliate{}
+	_ sdk.Msg = &MsgSetReferrer{}
+	_ sdk.Msg = &MsgBindReferrer{}
+)
+
+// NewMsgSetAffiliate returns a MsgSetAffiliate using given data
+func NewMsgSetAffiliate(
+	creator string,
+	ticket string,
+) *MsgSetAffiliate {
+	return &MsgSetAffiliate{
+		Creator: creator,
+		Ticket:  ticket,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgSetAffiliate) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgSetAffiliate) Type() string { return typeMsgSetAffiliate }
+
+// GetSigners returns the signers of its message
+func (msg *MsgSetAffiliate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgSetAffiliate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgSetAffiliate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if strings.TrimSpace(msg.Ticket) == "" || strings.Contains(msg.Ticket, " ") {
+		return ErrInvalidTicket
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgSetAffiliate) EmitEvent(ctx *sdk.Context, affiliate Affiliate) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgSetAffiliate, msg.Creator,
+		sdk.NewAttribute(attributeKeyAffiliateAddress, affiliate.Address),
+		sdk.NewAttribute(attributeKeyAffiliateFeeShare, affiliate.FeeShare.String()),
+	)
+	emitter.Emit()
+}
+
+// NewMsgSetReferrer returns a MsgSetReferrer using given data
+func NewMsgSetReferrer(
+	creator string,
+	referrer string,
+) *MsgSetReferrer {
+	return &MsgSetReferrer{
+		Creator:  creator,
+		Referrer: referrer,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgSetReferrer) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgSetReferrer) Type() string { return typeMsgSetReferrer }
+
+// GetSigners returns the signers of its message
+func (msg *MsgSetReferrer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgSetReferrer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgSetReferrer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	return ValidateReferral(msg.Creator, msg.Referrer)
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgSetReferrer) EmitEvent(ctx *sdk.Context) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgSetReferrer, msg.Creator,
+		sdk.NewAttribute(attributeKeyReferralBettor, msg.Creator),
+		sdk.NewAttribute(attributeKeyReferralReferrer, msg.Referrer),
+	)
+	emitter.Emit()
+}
+
+// NewMsgBindReferrer returns a MsgBindReferrer using given data
+func NewMsgBindReferrer(
+	creator string,
+	ticket string,
+) *MsgBindReferrer {
+	return &MsgBindReferrer{
+		Creator: creator,
+		Ticket:  ticket,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgBindReferrer) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgBindReferrer) Type() string { return typeMsgBindReferrer }
+
+// GetSigners returns the signers of its message
+func (msg *MsgBindReferrer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgBindReferrer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgBindReferrer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if strings.TrimSpace(msg.Ticket) == "" || strings.Contains(msg.Ticket, " ") {
+		return ErrInvalidTicket
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgBindReferrer) EmitEvent(ctx *sdk.Context, referral Referral) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgBindReferrer, msg.Creator,
+		sdk.NewAttribute(attributeKeyReferralBettor, referral.Bettor),
+		sdk.NewAttribute(attributeKeyReferralReferrer, referral.Referrer),
+	)
+	emitter.Emit()
+}
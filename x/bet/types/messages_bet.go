@@ -78,5 +78,39 @@ func NewBet(creator string, props *WagerProps, oddsType OddsType, odds *BetOdds)
 		Amount:            props.Amount,
 		OddsType:          oddsType,
 		MaxLossMultiplier: odds.MaxLossMultiplier,
+		FreeBetCreditUID:  props.FreeBetCreditUID,
 	}
 }
+
+// NewParlayBet creates and returns a new multi-leg (parlay) bet from given message,
+// the combined odds value of the bet is stored in decimal odds type.
+func NewParlayBet(creator string, props *WagerProps, oddsType OddsType, ticketLegs []*WagerTicketLeg) (*Bet, error) {
+	legs := make([]*BetLeg, 0, len(ticketLegs))
+	for _, tl := range ticketLegs {
+		legs = append(legs, &BetLeg{
+			MarketUID:         tl.SelectedOdds.MarketUID,
+			OddsUID:           tl.SelectedOdds.UID,
+			OddsType:          oddsType,
+			OddsValue:         tl.SelectedOdds.Value,
+			MaxLossMultiplier: tl.SelectedOdds.MaxLossMultiplier,
+			Result:            Bet_RESULT_PENDING,
+		})
+	}
+
+	combinedOdds, err := CalculateParlayOdds(legs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bet{
+		Creator:           creator,
+		UID:               props.UID,
+		MarketUID:         legs[0].MarketUID,
+		OddsUID:           legs[0].OddsUID,
+		OddsValue:         combinedOdds.String(),
+		Amount:            props.Amount,
+		OddsType:          OddsType_ODDS_TYPE_DECIMAL,
+		MaxLossMultiplier: legs[0].MaxLossMultiplier,
+		Legs:              legs,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// typeMsgWagerBatch is type of message MsgWagerBatch
+	typeMsgWagerBatch = "bet_wager_batch"
+)
+
+var _ sdk.Msg = &MsgWagerBatch{}
+
+// NewMsgWagerBatch returns a MsgWagerBatch using given data
+func NewMsgWagerBatch(
+	creator string,
+	props []*WagerProps,
+	mode WagerBatchMode,
+) *MsgWagerBatch {
+	return &MsgWagerBatch{
+		Creator: creator,
+		Props:   props,
+		Mode:    mode,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgWagerBatch) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgWagerBatch) Type() string { return typeMsgWagerBatch }
+
+// GetSigners returns the signers of its message
+func (msg *MsgWagerBatch) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgWagerBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message,
+// each of the bets is validated the same as the single wager.
+func (msg *MsgWagerBatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if msg.Mode != WagerBatchMode_WAGER_BATCH_MODE_ATOMIC &&
+		msg.Mode != WagerBatchMode_WAGER_BATCH_MODE_BEST_EFFORT {
+		return sdkerrors.Wrapf(ErrInvalidWagerBatch, "mode %s", msg.Mode)
+	}
+
+	if len(msg.Props) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidWagerBatch, "empty bet list")
+	}
+
+	uids := make(map[string]struct{}, len(msg.Props))
+	for i, props := range msg.Props {
+		if props == nil {
+			return sdkerrors.Wrapf(ErrInvalidWagerBatch, "empty bet %d", i)
+		}
+
+		if err := WagerValidation(props); err != nil {
+			return sdkerrors.Wrapf(err, "bet %d", i)
+		}
+
+		if _, ok := uids[props.UID]; ok {
+			return sdkerrors.Wrapf(ErrDuplicateUID, "%s", props.UID)
+		}
+		uids[props.UID] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsAtomic returns true if all of the bets should be placed or the whole batch fails.
+func (msg *MsgWagerBatch) IsAtomic() bool {
+	return msg.Mode == WagerBatchMode_WAGER_BATCH_MODE_ATOMIC
+}
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/google/uuid"
+	"github.com/sge-network/sge/testutil/sample"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgWagerBatchValidateBasic(t *testing.T) {
+	validProps := func() *types.WagerProps {
+		return &types.WagerProps{
+			UID:    uuid.NewString(),
+			Amount: sdk.NewInt(int64(10)),
+			Ticket: "Ticket",
+		}
+	}
+	duplicateProps := validProps()
+
+	tests := []struct {
+		name string
+		msg  types.MsgWagerBatch
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: types.MsgWagerBatch{
+				Creator: "invalid_address",
+				Props:   []*types.WagerProps{validProps()},
+				Mode:    types.WagerBatchMode_WAGER_BATCH_MODE_ATOMIC,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "unspecified mode",
+			msg: types.MsgWagerBatch{
+				Creator: sample.AccAddress(),
+				Props:   []*types.WagerProps{validProps()},
+			},
+			err: types.ErrInvalidWagerBatch,
+		},
+		{
+			name: "empty bets",
+			msg: types.MsgWagerBatch{
+				Creator: sample.AccAddress(),
+				Mode:    types.WagerBatchMode_WAGER_BATCH_MODE_ATOMIC,
+			},
+			err: types.ErrInvalidWagerBatch,
+		},
+		{
+			name: "invalid bet",
+			msg: types.MsgWagerBatch{
+				Creator: sample.AccAddress(),
+				Props: []*types.WagerProps{validProps(), {
+					UID:    uuid.NewString(),
+					Amount: sdk.NewInt(int64(-1)),
+					Ticket: "Ticket",
+				}},
+				Mode: types.WagerBatchMode_WAGER_BATCH_MODE_BEST_EFFORT,
+			},
+			err: types.ErrInvalidAmount,
+		},
+		{
+			name: "duplicate uid",
+			msg: types.MsgWagerBatch{
+				Creator: sample.AccAddress(),
+				Props:   []*types.WagerProps{duplicateProps, duplicateProps},
+				Mode:    types.WagerBatchMode_WAGER_BATCH_MODE_BEST_EFFORT,
+			},
+			err: types.ErrDuplicateUID,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgWagerBatch{
+				Creator: sample.AccAddress(),
+				Props:   []*types.WagerProps{validProps(), validProps()},
+				Mode:    types.WagerBatchMode_WAGER_BATCH_MODE_ATOMIC,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
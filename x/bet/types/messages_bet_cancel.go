@@ -0,0 +1,72 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+)
+
+const (
+	// typeMsgCancelBet is type of message MsgCancelBet
+	typeMsgCancelBet = "bet_cancel"
+)
+
+var _ sdk.Msg = &MsgCancelBet{}
+
+// NewMsgCancelBet returns a MsgCancelBet using given data
+func NewMsgCancelBet(
+	creator string,
+	ticket string,
+) *MsgCancelBet {
+	return &MsgCancelBet{
+		Creator: creator,
+		Ticket:  ticket,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgCancelBet) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgCancelBet) Type() string { return typeMsgCancelBet }
+
+// GetSigners returns the signers of its message
+func (msg *MsgCancelBet) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgCancelBet) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgCancelBet) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if strings.TrimSpace(msg.Ticket) == "" || strings.Contains(msg.Ticket, " ") {
+		return ErrInvalidTicket
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgCancelBet) EmitEvent(ctx *sdk.Context, betUID string) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgCancelBet, msg.Creator,
+		sdk.NewAttribute(attributeKeyBetCreator, msg.Creator),
+		sdk.NewAttribute(attributeKeyBetUID, betUID),
+	)
+	emitter.Emit()
+}
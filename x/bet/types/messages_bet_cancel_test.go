@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/testutil/sample"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCancelBetValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgCancelBet
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: types.MsgCancelBet{
+				Creator: "invalid_address",
+				Ticket:  "Ticket",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty ticket",
+			msg: types.MsgCancelBet{
+				Creator: sample.AccAddress(),
+			},
+			err: types.ErrInvalidTicket,
+		},
+		{
+			name: "valid cancel message",
+			msg: types.MsgCancelBet{
+				Creator: sample.AccAddress(),
+				Ticket:  "Ticket",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
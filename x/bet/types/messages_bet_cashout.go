@@ -0,0 +1,74 @@
+package types
+
+import (
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+)
+
+const (
+	// typeMsgCashOut is type of message MsgCashOut
+	typeMsgCashOut = "bet_cash_out"
+)
+
+var _ sdk.Msg = &MsgCashOut{}
+
+// NewMsgCashOut returns a MsgCashOut using given data
+func NewMsgCashOut(
+	creator string,
+	ticket string,
+) *MsgCashOut {
+	return &MsgCashOut{
+		Creator: creator,
+		Ticket:  ticket,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgCashOut) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgCashOut) Type() string { return typeMsgCashOut }
+
+// GetSigners returns the signers of its message
+func (msg *MsgCashOut) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgCashOut) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgCashOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if strings.TrimSpace(msg.Ticket) == "" || strings.Contains(msg.Ticket, " ") {
+		return ErrInvalidTicket
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgCashOut) EmitEvent(ctx *sdk.Context, betUID string, amount sdkmath.Int) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgCashOut, msg.Creator,
+		sdk.NewAttribute(attributeKeyBetCreator, msg.Creator),
+		sdk.NewAttribute(attributeKeyBetUID, betUID),
+		sdk.NewAttribute(attributeKeyCashOutAmount, amount.String()),
+	)
+	emitter.Emit()
+}
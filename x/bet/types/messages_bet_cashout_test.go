@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/testutil/sample"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCashOutValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgCashOut
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: types.MsgCashOut{
+				Creator: "invalid_address",
+				Ticket:  "Ticket",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty ticket",
+			msg: types.MsgCashOut{
+				Creator: sample.AccAddress(),
+			},
+			err: types.ErrInvalidTicket,
+		},
+		{
+			name: "valid cash-out message",
+			msg: types.MsgCashOut{
+				Creator: sample.AccAddress(),
+				Ticket:  "Ticket",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+)
+
+const (
+	// typeMsgSettleBets is type of message MsgSettleBets
+	typeMsgSettleBets = "bet_settle"
+)
+
+var _ sdk.Msg = &MsgSettleBets{}
+
+// NewMsgSettleBets returns a MsgSettleBets using given data
+func NewMsgSettleBets(
+	creator string,
+	bets []SettleBetItem,
+) *MsgSettleBets {
+	return &MsgSettleBets{
+		Creator: creator,
+		Bets:    bets,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgSettleBets) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgSettleBets) Type() string { return typeMsgSettleBets }
+
+// GetSigners returns the signers of its message
+func (msg *MsgSettleBets) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgSettleBets) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgSettleBets) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if len(msg.Bets) == 0 {
+		return ErrNoBetsToSettle
+	}
+
+	uids := make(map[string]struct{}, len(msg.Bets))
+	for _, bet := range msg.Bets {
+		if !utils.IsValidUID(bet.UID) {
+			return sdkerrors.Wrapf(ErrInvalidBetUID, "%s", bet.UID)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(bet.Creator); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+		}
+
+		if _, ok := uids[bet.UID]; ok {
+			return sdkerrors.Wrapf(ErrDuplicateUID, "%s", bet.UID)
+		}
+		uids[bet.UID] = struct{}{}
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgSettleBets) EmitEvent(ctx *sdk.Context) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgSettleBets, msg.Creator)
+	for _, bet := range msg.Bets {
+		emitter.AddEvent(typeMsgSettleBets,
+			sdk.NewAttribute(attributeKeyBetCreator, bet.Creator),
+			sdk.NewAttribute(attributeKeyBetUID, bet.UID),
+		)
+	}
+	emitter.Emit()
+}
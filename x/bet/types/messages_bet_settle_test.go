@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/google/uuid"
+	"github.com/sge-network/sge/testutil/sample"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSettleBetsValidateBasic(t *testing.T) {
+	betUID := uuid.NewString()
+	bettor := sample.AccAddress()
+
+	tests := []struct {
+		name string
+		msg  types.MsgSettleBets
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: types.MsgSettleBets{
+				Creator: "invalid_address",
+				Bets:    []types.SettleBetItem{{UID: betUID, Creator: bettor}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty bets",
+			msg: types.MsgSettleBets{
+				Creator: sample.AccAddress(),
+			},
+			err: types.ErrNoBetsToSettle,
+		},
+		{
+			name: "invalid bet uid",
+			msg: types.MsgSettleBets{
+				Creator: sample.AccAddress(),
+				Bets:    []types.SettleBetItem{{UID: "invalid_uid", Creator: bettor}},
+			},
+			err: types.ErrInvalidBetUID,
+		},
+		{
+			name: "invalid bettor",
+			msg: types.MsgSettleBets{
+				Creator: sample.AccAddress(),
+				Bets:    []types.SettleBetItem{{UID: betUID, Creator: "invalid_address"}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "duplicate bet",
+			msg: types.MsgSettleBets{
+				Creator: sample.AccAddress(),
+				Bets: []types.SettleBetItem{
+					{UID: betUID, Creator: bettor},
+					{UID: betUID, Creator: bettor},
+				},
+			},
+			err: types.ErrDuplicateUID,
+		},
+		{
+			name: "valid settle message",
+			msg: types.MsgSettleBets{
+				Creator: sample.AccAddress(),
+				Bets: []types.SettleBetItem{
+					{UID: betUID, Creator: bettor},
+					{UID: uuid.NewString(), Creator: sample.AccAddress()},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
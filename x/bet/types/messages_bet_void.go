@@ -0,0 +1,77 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+)
+
+const (
+	// typeMsgVoidBets is type of message MsgVoidBets
+	typeMsgVoidBets = "bet_void"
+)
+
+var _ sdk.Msg = &MsgVoidBets{}
+
+// NewMsgVoidBets returns a MsgVoidBets using given data
+func NewMsgVoidBets(
+	creator string,
+	ticket string,
+) *MsgVoidBets {
+	return &MsgVoidBets{
+		Creator: creator,
+		Ticket:  ticket,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgVoidBets) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgVoidBets) Type() string { return typeMsgVoidBets }
+
+// GetSigners returns the signers of its message
+func (msg *MsgVoidBets) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgVoidBets) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgVoidBets) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if strings.TrimSpace(msg.Ticket) == "" || strings.Contains(msg.Ticket, " ") {
+		return ErrInvalidTicket
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success, the void reason
+// is recorded for each of the voided bets.
+func (msg *MsgVoidBets) EmitEvent(ctx *sdk.Context, bets []VoidBetItem, reason string) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgVoidBets, msg.Creator)
+	for _, bet := range bets {
+		emitter.AddEvent(typeMsgVoidBets,
+			sdk.NewAttribute(attributeKeyBetCreator, bet.Creator),
+			sdk.NewAttribute(attributeKeyBetUID, bet.UID),
+			sdk.NewAttribute(attributeKeyVoidReason, reason),
+		)
+	}
+	emitter.Emit()
+}
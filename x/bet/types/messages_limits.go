@@ -0,0 +1,146 @@
+package types
+
+import (
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+	"github.com/spf13/cast"
+)
+
+const (
+	// typeMsgSetSelfExclusion is type of message MsgSetSelfExclusion
+	typeMsgSetSelfExclusion = "bet_set_self_exclusion"
+
+	// typeMsgSetBettorLimit is type of message MsgSetBettorLimit
+	typeMsgSetBettorLimit = "bet_set_bettor_limit"
+)
+
+var (
+	_ sdk.Msg = &MsgSetSelfExclusion{}
+	_ sdk.Msg = &MsgSetBettorLimit{}
+)
+
+// NewMsgSetSelfExclusion returns a MsgSetSelfExclusion using given data
+func NewMsgSetSelfExclusion(creator string, until int64) *MsgSetSelfExclusion {
+	return &MsgSetSelfExclusion{
+		Creator: creator,
+		Until:   until,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgSetSelfExclusion) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgSetSelfExclusion) Type() string { return typeMsgSetSelfExclusion }
+
+// GetSigners returns the signers of its message
+func (msg *MsgSetSelfExclusion) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgSetSelfExclusion) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgSetSelfExclusion) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if msg.Until <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidSelfExclusion, "%d", msg.Until)
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgSetSelfExclusion) EmitEvent(ctx *sdk.Context) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgSetSelfExclusion, msg.Creator,
+		sdk.NewAttribute(attributeKeyBetCreator, msg.Creator),
+		sdk.NewAttribute(attributeKeySelfExcludedUntil, cast.ToString(msg.Until)),
+	)
+	emitter.Emit()
+}
+
+// NewMsgSetBettorLimit returns a MsgSetBettorLimit using given data
+func NewMsgSetBettorLimit(
+	creator string,
+	limitType LimitType,
+	period LimitPeriod,
+	denom string,
+	amount sdkmath.Int,
+) *MsgSetBettorLimit {
+	return &MsgSetBettorLimit{
+		Creator:   creator,
+		LimitType: limitType,
+		Period:    period,
+		Denom:     denom,
+		Amount:    amount,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgSetBettorLimit) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgSetBettorLimit) Type() string { return typeMsgSetBettorLimit }
+
+// GetSigners returns the signers of its message
+func (msg *MsgSetBettorLimit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgSetBettorLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgSetBettorLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if err := ValidateLimitProps(msg.LimitType, msg.Period, msg.Denom); err != nil {
+		return err
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidBettorLimit, "amount %s", msg.Amount)
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgSetBettorLimit) EmitEvent(ctx *sdk.Context) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgSetBettorLimit, msg.Creator,
+		sdk.NewAttribute(attributeKeyBetCreator, msg.Creator),
+		sdk.NewAttribute(attributeKeyLimitType, msg.LimitType.String()),
+		sdk.NewAttribute(attributeKeyLimitPeriod, msg.Period.String()),
+		sdk.NewAttribute(attributeKeyLimitDenom, msg.Denom),
+		sdk.NewAttribute(attributeKeyLimitAmount, msg.Amount.String()),
+	)
+	emitter.Emit()
+}
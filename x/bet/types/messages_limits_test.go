@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/app/params"
+	"github.com/sge-network/sge/testutil/sample"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetSelfExclusionValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgSetSelfExclusion
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: types.MsgSetSelfExclusion{
+				Creator: "invalid_address",
+				Until:   1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid until",
+			msg: types.MsgSetSelfExclusion{
+				Creator: sample.AccAddress(),
+			},
+			err: types.ErrInvalidSelfExclusion,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgSetSelfExclusion{
+				Creator: sample.AccAddress(),
+				Until:   1700000000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetBettorLimitValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgSetBettorLimit
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: types.NewMsgSetBettorLimit(
+				"invalid_address", types.LimitType_LIMIT_TYPE_STAKE, types.LimitPeriod_LIMIT_PERIOD_DAILY,
+				params.DefaultBondDenom, sdk.NewInt(1000),
+			),
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid limit type",
+			msg: types.NewMsgSetBettorLimit(
+				sample.AccAddress(), types.LimitType_LIMIT_TYPE_UNSPECIFIED, types.LimitPeriod_LIMIT_PERIOD_DAILY,
+				params.DefaultBondDenom, sdk.NewInt(1000),
+			),
+			err: types.ErrInvalidBettorLimit,
+		},
+		{
+			name: "invalid period",
+			msg: types.NewMsgSetBettorLimit(
+				sample.AccAddress(), types.LimitType_LIMIT_TYPE_STAKE, types.LimitPeriod_LIMIT_PERIOD_UNSPECIFIED,
+				params.DefaultBondDenom, sdk.NewInt(1000),
+			),
+			err: types.ErrInvalidBettorLimit,
+		},
+		{
+			name: "invalid denom",
+			msg: types.NewMsgSetBettorLimit(
+				sample.AccAddress(), types.LimitType_LIMIT_TYPE_STAKE, types.LimitPeriod_LIMIT_PERIOD_DAILY,
+				"1usge", sdk.NewInt(1000),
+			),
+			err: types.ErrInvalidBettorLimit,
+		},
+		{
+			name: "negative amount",
+			msg: types.NewMsgSetBettorLimit(
+				sample.AccAddress(), types.LimitType_LIMIT_TYPE_NET_LOSS, types.LimitPeriod_LIMIT_PERIOD_WEEKLY,
+				params.DefaultBondDenom, sdk.NewInt(-1),
+			),
+			err: types.ErrInvalidBettorLimit,
+		},
+		{
+			name: "valid removal message",
+			msg: types.NewMsgSetBettorLimit(
+				sample.AccAddress(), types.LimitType_LIMIT_TYPE_NET_LOSS, types.LimitPeriod_LIMIT_PERIOD_MONTHLY,
+				params.DefaultBondDenom, sdk.ZeroInt(),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+)
+
+const (
+	// typeMsgFundPromoPool is type of message MsgFundPromoPool
+	typeMsgFundPromoPool = "bet_fund_promo_pool"
+	// typeMsgIssueFreeBet is type of message MsgIssueFreeBet
+	typeMsgIssueFreeBet = "bet_issue_free_bet"
+)
+
+var (
+	_ sdk.Msg = &MsgFundPromoPool{}
+	_ sdk.Msg = &MsgIssueFreeBet{}
+)
+
+// NewMsgFundPromoPool returns a MsgFundPromoPool using given data
+func NewMsgFundPromoPool(
+	creator string,
+	ticket string,
+) *MsgFundPromoPool {
+	return &MsgFundPromoPool{
+		Creator: creator,
+		Ticket:  ticket,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgFundPromoPool) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgFundPromoPool) Type() string { return typeMsgFundPromoPool }
+
+// GetSigners returns the signers of its message
+func (msg *MsgFundPromoPool) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgFundPromoPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgFundPromoPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if strings.TrimSpace(msg.Ticket) == "" || strings.Contains(msg.Ticket, " ") {
+		return ErrInvalidTicket
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgFundPromoPool) EmitEvent(ctx *sdk.Context, denom string, amount sdkmath.Int) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgFundPromoPool, msg.Creator,
+		sdk.NewAttribute(attributeKeyPromoPoolDenom, denom),
+		sdk.NewAttribute(attributeKeyPromoPoolAmount, amount.String()),
+	)
+	emitter.Emit()
+}
+
+// NewMsgIssueFreeBet returns a MsgIssueFreeBet using given data
+func NewMsgIssueFreeBet(
+	creator string,
+	ticket string,
+) *MsgIssueFreeBet {
+	return &MsgIssueFreeBet{
+		Creator: creator,
+		Ticket:  ticket,
+	}
+}
+
+// Route returns the module's message router key.
+func (*MsgIssueFreeBet) Route() string { return RouterKey }
+
+// Type returns type of its message
+func (*MsgIssueFreeBet) Type() string { return typeMsgIssueFreeBet }
+
+// GetSigners returns the signers of its message
+func (msg *MsgIssueFreeBet) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes returns sortJson form of its message
+func (msg *MsgIssueFreeBet) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic does some validate checks on its message
+func (msg *MsgIssueFreeBet) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil || msg.Creator == "" || strings.Contains(msg.Creator, " ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	if strings.TrimSpace(msg.Ticket) == "" || strings.Contains(msg.Ticket, " ") {
+		return ErrInvalidTicket
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgIssueFreeBet) EmitEvent(ctx *sdk.Context, credit FreeBetCredit) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgIssueFreeBet, msg.Creator,
+		sdk.NewAttribute(attributeKeyFreeBetUID, credit.UID),
+		sdk.NewAttribute(attributeKeyFreeBetAddress, credit.Address),
+		sdk.NewAttribute(attributeKeyFreeBetAmount, credit.Amount.String()),
+	)
+	emitter.Emit()
+}
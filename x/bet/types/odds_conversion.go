@@ -0,0 +1,142 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SupportedOddsTypes is the list of the odds types supported by the blockchain.
+var SupportedOddsTypes = []OddsType{
+	OddsType_ODDS_TYPE_DECIMAL,
+	OddsType_ODDS_TYPE_FRACTIONAL,
+	OddsType_ODDS_TYPE_MONEYLINE,
+	OddsType_ODDS_TYPE_HONG_KONG,
+	OddsType_ODDS_TYPE_INDONESIAN,
+	OddsType_ODDS_TYPE_MALAY,
+}
+
+// moneylineBase is the stake or the profit that the moneyline odds value is relative to.
+var moneylineBase = sdk.NewDec(100)
+
+// ConvertOddsValue converts the odds value of an odds type to the odds value of another odds type,
+// the moneyline odds value is rounded to the nearest integer because it does not accept decimal places.
+func ConvertOddsValue(fromType OddsType, oddsVal string, toType OddsType) (string, error) {
+	decimalOdds, err := CalculateDecimalOdds(fromType, oddsVal)
+	if err != nil {
+		return "", err
+	}
+
+	// the value is returned as is to prevent any loss of precision
+	if fromType == toType {
+		return oddsVal, nil
+	}
+
+	// profit of a single unit of the bet amount
+	profitRatio := decimalOdds.Sub(sdk.OneDec())
+	if !profitRatio.IsPositive() {
+		return "", sdkerrors.Wrapf(ErrOddsValueNotConvertible, "%s", oddsVal)
+	}
+
+	// the inverse of the profit ratio is calculated by the fraction to prevent
+	// accumulation of the rounding errors such as 1/3 being converted to 3.000000000000000003
+	numerator, denominator := decToFraction(profitRatio)
+	inverseRatio := sdk.NewDecFromInt(denominator).Quo(sdk.NewDecFromInt(numerator))
+
+	switch toType {
+	case OddsType_ODDS_TYPE_DECIMAL:
+		return formatOddsDec(decimalOdds), nil
+
+	case OddsType_ODDS_TYPE_FRACTIONAL:
+		return numerator.String() + "/" + denominator.String(), nil
+
+	case OddsType_ODDS_TYPE_MONEYLINE:
+		if profitRatio.GTE(sdk.OneDec()) {
+			return "+" + profitRatio.Mul(moneylineBase).RoundInt().String(), nil
+		}
+		return inverseRatio.Mul(moneylineBase).RoundInt().Neg().String(), nil
+
+	case OddsType_ODDS_TYPE_HONG_KONG:
+		return formatOddsDec(profitRatio), nil
+
+	case OddsType_ODDS_TYPE_INDONESIAN:
+		if profitRatio.GTE(sdk.OneDec()) {
+			return formatOddsDec(profitRatio), nil
+		}
+		return formatOddsDec(inverseRatio.Neg()), nil
+
+	case OddsType_ODDS_TYPE_MALAY:
+		if profitRatio.LTE(sdk.OneDec()) {
+			return formatOddsDec(profitRatio), nil
+		}
+		return formatOddsDec(inverseRatio.Neg()), nil
+
+	default:
+		return "", ErrInvalidOddsType
+	}
+}
+
+// CalculateOddsConversions converts the odds value to all of the supported odds types
+// and calculates the payout of the bet amount by each of the converted values.
+func CalculateOddsConversions(oddsType OddsType, oddsVal string, amount sdkmath.Int) ([]OddsConversion, error) {
+	conversions := make([]OddsConversion, 0, len(SupportedOddsTypes))
+	for _, toType := range SupportedOddsTypes {
+		convertedVal, err := ConvertOddsValue(oddsType, oddsVal, toType)
+		if err != nil {
+			return nil, err
+		}
+
+		payoutProfit, err := CalculatePayoutProfit(toType, convertedVal, amount)
+		if err != nil {
+			return nil, err
+		}
+
+		conversions = append(conversions, OddsConversion{
+			OddsType:     toType,
+			OddsValue:    convertedVal,
+			Payout:       sdk.NewDecFromInt(amount).Add(payoutProfit),
+			PayoutProfit: payoutProfit,
+		})
+	}
+
+	return conversions, nil
+}
+
+// formatOddsDec returns the string of the decimal value without the trailing zeros.
+func formatOddsDec(value sdk.Dec) string {
+	str := value.String()
+	if strings.Contains(str, ".") {
+		str = strings.TrimRight(strings.TrimRight(str, "0"), ".")
+	}
+	return str
+}
+
+// decToFraction returns the fraction with the smallest denominator that its decimal value
+// is equal to the given value by using the convergents of the continued fraction,
+// the last convergent is the exact fraction of the decimal value so a result is always found.
+func decToFraction(value sdk.Dec) (sdkmath.Int, sdkmath.Int) {
+	num := new(big.Int).Set(value.BigInt())
+	den := new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
+
+	// h and k are the numerators and denominators of the last two convergents
+	h0, h1 := big.NewInt(0), big.NewInt(1)
+	k0, k1 := big.NewInt(1), big.NewInt(0)
+	for den.Sign() != 0 {
+		quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+
+		h0, h1 = h1, new(big.Int).Add(new(big.Int).Mul(quo, h1), h0)
+		k0, k1 = k1, new(big.Int).Add(new(big.Int).Mul(quo, k1), k0)
+
+		numerator, denominator := sdkmath.NewIntFromBigInt(h1), sdkmath.NewIntFromBigInt(k1)
+		if sdk.NewDecFromInt(numerator).Quo(sdk.NewDecFromInt(denominator)).Equal(value) {
+			return numerator, denominator
+		}
+
+		num, den = den, rem
+	}
+
+	return sdkmath.NewIntFromBigInt(h1), sdkmath.NewIntFromBigInt(k1)
+}
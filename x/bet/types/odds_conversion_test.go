@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/x/bet/types"
+)
+
+func TestConvertOddsValue(t *testing.T) {
+	tcs := []struct {
+		desc      string
+		fromType  types.OddsType
+		oddsValue string
+		expVals   map[types.OddsType]string
+		err       error
+	}{
+		{
+			desc:      "fractional upper than even",
+			fromType:  types.OddsType_ODDS_TYPE_FRACTIONAL,
+			oddsValue: "5/2",
+			expVals: map[types.OddsType]string{
+				types.OddsType_ODDS_TYPE_DECIMAL:    "3.5",
+				types.OddsType_ODDS_TYPE_FRACTIONAL: "5/2",
+				types.OddsType_ODDS_TYPE_MONEYLINE:  "+250",
+				types.OddsType_ODDS_TYPE_HONG_KONG:  "2.5",
+				types.OddsType_ODDS_TYPE_INDONESIAN: "2.5",
+				types.OddsType_ODDS_TYPE_MALAY:      "-0.4",
+			},
+		},
+		{
+			desc:      "moneyline with repeating decimal",
+			fromType:  types.OddsType_ODDS_TYPE_MONEYLINE,
+			oddsValue: "-300",
+			expVals: map[types.OddsType]string{
+				types.OddsType_ODDS_TYPE_DECIMAL:    "1.333333333333333333",
+				types.OddsType_ODDS_TYPE_FRACTIONAL: "1/3",
+				types.OddsType_ODDS_TYPE_MONEYLINE:  "-300",
+				types.OddsType_ODDS_TYPE_HONG_KONG:  "0.333333333333333333",
+				types.OddsType_ODDS_TYPE_INDONESIAN: "-3",
+				types.OddsType_ODDS_TYPE_MALAY:      "0.333333333333333333",
+			},
+		},
+		{
+			desc:      "decimal lower than even",
+			fromType:  types.OddsType_ODDS_TYPE_DECIMAL,
+			oddsValue: "1.80",
+			expVals: map[types.OddsType]string{
+				types.OddsType_ODDS_TYPE_DECIMAL:    "1.80",
+				types.OddsType_ODDS_TYPE_FRACTIONAL: "4/5",
+				types.OddsType_ODDS_TYPE_MONEYLINE:  "-125",
+				types.OddsType_ODDS_TYPE_HONG_KONG:  "0.8",
+				types.OddsType_ODDS_TYPE_INDONESIAN: "-1.25",
+				types.OddsType_ODDS_TYPE_MALAY:      "0.8",
+			},
+		},
+		{
+			desc:      "decimal with rounded moneyline",
+			fromType:  types.OddsType_ODDS_TYPE_DECIMAL,
+			oddsValue: "2.123",
+			expVals: map[types.OddsType]string{
+				types.OddsType_ODDS_TYPE_FRACTIONAL: "1123/1000",
+				types.OddsType_ODDS_TYPE_MONEYLINE:  "+112",
+			},
+		},
+		{
+			desc:      "malay negative",
+			fromType:  types.OddsType_ODDS_TYPE_MALAY,
+			oddsValue: "-0.5",
+			expVals: map[types.OddsType]string{
+				types.OddsType_ODDS_TYPE_DECIMAL:    "3",
+				types.OddsType_ODDS_TYPE_FRACTIONAL: "2/1",
+				types.OddsType_ODDS_TYPE_MONEYLINE:  "+200",
+				types.OddsType_ODDS_TYPE_INDONESIAN: "2",
+			},
+		},
+		{
+			desc:      "indonesian negative",
+			fromType:  types.OddsType_ODDS_TYPE_INDONESIAN,
+			oddsValue: "-1.25",
+			expVals: map[types.OddsType]string{
+				types.OddsType_ODDS_TYPE_DECIMAL:   "1.8",
+				types.OddsType_ODDS_TYPE_HONG_KONG: "0.8",
+				types.OddsType_ODDS_TYPE_MALAY:     "0.8",
+			},
+		},
+		{
+			desc:      "invalid odds value",
+			fromType:  types.OddsType_ODDS_TYPE_DECIMAL,
+			oddsValue: "0.5",
+			expVals: map[types.OddsType]string{
+				types.OddsType_ODDS_TYPE_FRACTIONAL: "",
+			},
+			err: types.ErrDecimalOddsCanNotBeLessThanOne,
+		},
+		{
+			desc:      "too small profit",
+			fromType:  types.OddsType_ODDS_TYPE_INDONESIAN,
+			oddsValue: "-1000000000000000000000",
+			expVals: map[types.OddsType]string{
+				types.OddsType_ODDS_TYPE_DECIMAL: "",
+			},
+			err: types.ErrOddsValueNotConvertible,
+		},
+		{
+			desc:      "invalid odds type",
+			fromType:  types.OddsType_ODDS_TYPE_DECIMAL,
+			oddsValue: "1.5",
+			expVals: map[types.OddsType]string{
+				types.OddsType_ODDS_TYPE_UNSPECIFIED: "",
+			},
+			err: types.ErrInvalidOddsType,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.desc, func(t *testing.T) {
+			for toType, expVal := range tc.expVals {
+				oddsValue, err := types.ConvertOddsValue(tc.fromType, tc.oddsValue, toType)
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+					continue
+				}
+				require.NoError(t, err)
+				require.Equal(t, expVal, oddsValue, toType.String())
+			}
+		})
+	}
+}
+
+func TestCalculateOddsConversions(t *testing.T) {
+	amount := sdk.NewInt(1000000)
+
+	conversions, err := types.CalculateOddsConversions(types.OddsType_ODDS_TYPE_FRACTIONAL, "5/2", amount)
+	require.NoError(t, err)
+	require.Len(t, conversions, len(types.SupportedOddsTypes))
+
+	for i, conversion := range conversions {
+		require.Equal(t, types.SupportedOddsTypes[i], conversion.OddsType)
+		require.Equal(t, sdk.NewDec(2500000), conversion.PayoutProfit, conversion.OddsType.String())
+		require.Equal(t, sdk.NewDec(3500000), conversion.Payout, conversion.OddsType.String())
+	}
+
+	_, err = types.CalculateOddsConversions(types.OddsType_ODDS_TYPE_MONEYLINE, "0", amount)
+	require.ErrorIs(t, err, types.ErrMoneylineOddsCanNotBeZero)
+}
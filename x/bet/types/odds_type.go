@@ -235,3 +235,151 @@ func (*moneylineOdds) CalculateBetAmount(oddsVal string, payoutProfit sdk.Dec) (
 	// get the integer part of the bet amount
 	return betAmount, nil
 }
+
+// hongKongOdds is the type to define OddsTypeI interface
+// for the hong kong odds type
+type hongKongOdds struct{}
+
+// parseHongKongOdds parses and validates the hong kong odds value,
+// the value is the profit of a single unit of the bet amount so it should be positive.
+func parseHongKongOdds(oddsVal string) (sdk.Dec, error) {
+	oddsDecVal, err := sdk.NewDecFromStr(oddsVal)
+	if err != nil {
+		return sdk.ZeroDec(),
+			sdkerrors.Wrapf(ErrHongKongOddsIncorrectFormat, "%s", err)
+	}
+
+	if !oddsDecVal.IsPositive() {
+		return sdk.ZeroDec(),
+			sdkerrors.Wrapf(ErrHongKongOddsShouldBePositive, "%s", oddsVal)
+	}
+
+	return oddsDecVal, nil
+}
+
+// CalculatePayout calculates total payout of a certain bet amount by hong kong odds calculations
+func (*hongKongOdds) CalculatePayout(oddsVal string, amount sdkmath.Int) (sdk.Dec, error) {
+	oddsDecVal, err := parseHongKongOdds(oddsVal)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// the profit is the bet amount multiplied by the odds value
+	profit := oddsDecVal.MulInt(amount)
+
+	return sdk.NewDecFromInt(amount).Add(profit), nil
+}
+
+// CalculateBetAmount calculates bet amount
+func (*hongKongOdds) CalculateBetAmount(oddsVal string, payoutProfit sdk.Dec) (sdk.Dec, error) {
+	oddsDecVal, err := parseHongKongOdds(oddsVal)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return payoutProfit.Quo(oddsDecVal), nil
+}
+
+// indonesianOdds is the type to define OddsTypeI interface
+// for the indonesian odds type
+type indonesianOdds struct{}
+
+// parseIndonesianOdds parses and validates the indonesian odds value, the positive value
+// is the profit of a single unit of the bet amount and the negative value is the bet amount
+// needed for a single unit of profit, so the absolute value can not be less than 1.
+func parseIndonesianOdds(oddsVal string) (sdk.Dec, error) {
+	oddsDecVal, err := sdk.NewDecFromStr(oddsVal)
+	if err != nil {
+		return sdk.ZeroDec(),
+			sdkerrors.Wrapf(ErrIndonesianOddsIncorrectFormat, "%s", err)
+	}
+
+	if oddsDecVal.Abs().LT(sdk.OneDec()) {
+		return sdk.ZeroDec(),
+			sdkerrors.Wrapf(ErrIndonesianOddsOutOfRange, "%s", oddsVal)
+	}
+
+	return oddsDecVal, nil
+}
+
+// CalculatePayout calculates total payout of a certain bet amount by indonesian odds calculations
+func (*indonesianOdds) CalculatePayout(oddsVal string, amount sdkmath.Int) (sdk.Dec, error) {
+	oddsDecVal, err := parseIndonesianOdds(oddsVal)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	var profit sdk.Dec
+	if oddsDecVal.IsPositive() {
+		profit = sdk.NewDecFromInt(amount).Mul(oddsDecVal)
+	} else {
+		profit = sdk.NewDecFromInt(amount).Quo(oddsDecVal.Abs())
+	}
+
+	return sdk.NewDecFromInt(amount).Add(profit), nil
+}
+
+// CalculateBetAmount calculates bet amount
+func (*indonesianOdds) CalculateBetAmount(oddsVal string, payoutProfit sdk.Dec) (sdk.Dec, error) {
+	oddsDecVal, err := parseIndonesianOdds(oddsVal)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if oddsDecVal.IsPositive() {
+		return payoutProfit.Quo(oddsDecVal), nil
+	}
+	return payoutProfit.Mul(oddsDecVal.Abs()), nil
+}
+
+// malayOdds is the type to define OddsTypeI interface
+// for the malay odds type
+type malayOdds struct{}
+
+// parseMalayOdds parses and validates the malay odds value, the positive value is the
+// profit of a single unit of the bet amount and the negative value is the bet amount
+// needed for a single unit of profit, so the value should be non-zero between -1 and 1.
+func parseMalayOdds(oddsVal string) (sdk.Dec, error) {
+	oddsDecVal, err := sdk.NewDecFromStr(oddsVal)
+	if err != nil {
+		return sdk.ZeroDec(),
+			sdkerrors.Wrapf(ErrMalayOddsIncorrectFormat, "%s", err)
+	}
+
+	if oddsDecVal.IsZero() || oddsDecVal.Abs().GT(sdk.OneDec()) {
+		return sdk.ZeroDec(),
+			sdkerrors.Wrapf(ErrMalayOddsOutOfRange, "%s", oddsVal)
+	}
+
+	return oddsDecVal, nil
+}
+
+// CalculatePayout calculates total payout of a certain bet amount by malay odds calculations
+func (*malayOdds) CalculatePayout(oddsVal string, amount sdkmath.Int) (sdk.Dec, error) {
+	oddsDecVal, err := parseMalayOdds(oddsVal)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	var profit sdk.Dec
+	if oddsDecVal.IsPositive() {
+		profit = sdk.NewDecFromInt(amount).Mul(oddsDecVal)
+	} else {
+		profit = sdk.NewDecFromInt(amount).Quo(oddsDecVal.Abs())
+	}
+
+	return sdk.NewDecFromInt(amount).Add(profit), nil
+}
+
+// CalculateBetAmount calculates bet amount
+func (*malayOdds) CalculateBetAmount(oddsVal string, payoutProfit sdk.Dec) (sdk.Dec, error) {
+	oddsDecVal, err := parseMalayOdds(oddsVal)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if oddsDecVal.IsPositive() {
+		return payoutProfit.Quo(oddsDecVal), nil
+	}
+	return payoutProfit.Mul(oddsDecVal.Abs()), nil
+}
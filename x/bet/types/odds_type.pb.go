@@ -32,6 +32,14 @@ const (
 	OddsType_ODDS_TYPE_FRACTIONAL OddsType = 2
 	// moneyline odds type (american)
 	OddsType_ODDS_TYPE_MONEYLINE OddsType = 3
+	// hong kong odds type, the profit of a single unit of the bet amount
+	OddsType_ODDS_TYPE_HONG_KONG OddsType = 4
+	// indonesian odds type, positive or negative with absolute value not
+	// less than 1
+	OddsType_ODDS_TYPE_INDONESIAN OddsType = 5
+	// malay odds type, positive or negative with absolute value not more
+	// than 1
+	OddsType_ODDS_TYPE_MALAY OddsType = 6
 )
 
 var OddsType_name = map[int32]string{
@@ -39,6 +47,9 @@ var OddsType_name = map[int32]string{
 	1: "ODDS_TYPE_DECIMAL",
 	2: "ODDS_TYPE_FRACTIONAL",
 	3: "ODDS_TYPE_MONEYLINE",
+	4: "ODDS_TYPE_HONG_KONG",
+	5: "ODDS_TYPE_INDONESIAN",
+	6: "ODDS_TYPE_MALAY",
 }
 
 var OddsType_value = map[string]int32{
@@ -46,6 +57,9 @@ var OddsType_value = map[string]int32{
 	"ODDS_TYPE_DECIMAL":     1,
 	"ODDS_TYPE_FRACTIONAL":  2,
 	"ODDS_TYPE_MONEYLINE":   3,
+	"ODDS_TYPE_HONG_KONG":   4,
+	"ODDS_TYPE_INDONESIAN":  5,
+	"ODDS_TYPE_MALAY":       6,
 }
 
 func (x OddsType) String() string {
@@ -63,19 +77,22 @@ func init() {
 func init() { proto.RegisterFile("sge/bet/odds_type.proto", fileDescriptor_9054da535c81741c) }
 
 var fileDescriptor_9054da535c81741c = []byte{
-	// 219 bytes of a gzipped FileDescriptorProto
+	// 257 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2f, 0x4e, 0x4f, 0xd5,
 	0x4f, 0x4a, 0x2d, 0xd1, 0xcf, 0x4f, 0x49, 0x29, 0x8e, 0x2f, 0xa9, 0x2c, 0x48, 0xd5, 0x2b, 0x28,
 	0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2a, 0x4e, 0x4f, 0xcd, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6,
-	0x2b, 0x4e, 0x4f, 0xd5, 0x4b, 0x4a, 0x2d, 0xd1, 0xca, 0xe7, 0xe2, 0xf0, 0x4f, 0x49, 0x29, 0x0e,
-	0xa9, 0x2c, 0x48, 0x15, 0x92, 0xe4, 0x12, 0xf5, 0x77, 0x71, 0x09, 0x8e, 0x0f, 0x89, 0x0c, 0x70,
-	0x8d, 0x0f, 0xf5, 0x0b, 0x0e, 0x70, 0x75, 0xf6, 0x74, 0xf3, 0x74, 0x75, 0x11, 0x60, 0x10, 0x12,
-	0xe5, 0x12, 0x44, 0x48, 0xb9, 0xb8, 0x3a, 0x7b, 0xfa, 0x3a, 0xfa, 0x08, 0x30, 0x0a, 0x49, 0x70,
-	0x89, 0x20, 0x84, 0xdd, 0x82, 0x1c, 0x9d, 0x43, 0x3c, 0xfd, 0xfd, 0x1c, 0x7d, 0x04, 0x98, 0x84,
-	0xc4, 0xb9, 0x84, 0x11, 0x32, 0xbe, 0xfe, 0x7e, 0xae, 0x91, 0x3e, 0x9e, 0x7e, 0xae, 0x02, 0xcc,
-	0x4e, 0x0e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
-	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x96, 0x9e, 0x59,
-	0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x5f, 0x9c, 0x9e, 0xaa, 0x0b, 0x75, 0x2a, 0x88,
-	0xad, 0x5f, 0x01, 0xf6, 0x10, 0xc8, 0x2f, 0xc5, 0x49, 0x6c, 0x60, 0xdf, 0x18, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x93, 0x6e, 0x4e, 0x56, 0xe8, 0x00, 0x00, 0x00,
+	0x2b, 0x4e, 0x4f, 0xd5, 0x4b, 0x4a, 0x2d, 0xd1, 0xda, 0xce, 0xc8, 0xc5, 0xe1, 0x9f, 0x92, 0x52,
+	0x1c, 0x52, 0x59, 0x90, 0x2a, 0x24, 0xc9, 0x25, 0xea, 0xef, 0xe2, 0x12, 0x1c, 0x1f, 0x12, 0x19,
+	0xe0, 0x1a, 0x1f, 0xea, 0x17, 0x1c, 0xe0, 0xea, 0xec, 0xe9, 0xe6, 0xe9, 0xea, 0x22, 0xc0, 0x20,
+	0x24, 0xca, 0x25, 0x88, 0x90, 0x72, 0x71, 0x75, 0xf6, 0xf4, 0x75, 0xf4, 0x11, 0x60, 0x14, 0x92,
+	0xe0, 0x12, 0x41, 0x08, 0xbb, 0x05, 0x39, 0x3a, 0x87, 0x78, 0xfa, 0xfb, 0x39, 0xfa, 0x08, 0x30,
+	0x09, 0x89, 0x73, 0x09, 0x23, 0x64, 0x7c, 0xfd, 0xfd, 0x5c, 0x23, 0x7d, 0x3c, 0xfd, 0x5c, 0x05,
+	0x98, 0x51, 0x25, 0x3c, 0xfc, 0xfd, 0xdc, 0xe3, 0xbd, 0xfd, 0xfd, 0xdc, 0x05, 0x58, 0x50, 0xcd,
+	0xf2, 0xf4, 0x73, 0xf1, 0xf7, 0x73, 0x0d, 0xf6, 0x74, 0xf4, 0x13, 0x60, 0x15, 0x12, 0xe6, 0xe2,
+	0x47, 0x32, 0xcb, 0xd1, 0xc7, 0x31, 0x52, 0x80, 0xcd, 0xc9, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
+	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
+	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xd4, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73,
+	0xf5, 0x8b, 0xd3, 0x53, 0x75, 0xa1, 0x7e, 0x06, 0xb1, 0xf5, 0x2b, 0xc0, 0x21, 0x03, 0x0a, 0x94,
+	0xe2, 0x24, 0x36, 0x70, 0xb0, 0x18, 0x03, 0x06, 0x00, 0x48, 0x6f, 0x44, 0x48, 0x31, 0x01, 0x00,
+	0x00,
 }
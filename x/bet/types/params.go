@@ -2,15 +2,24 @@ package types
 
 import (
 	fmt "fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	"github.com/sge-network/sge/app/params"
 )
 
 const (
 	batchSettlementCount  = 1000
 	maxBetByUIDQueryCount = 10
+	maxWagerBatchCount    = 100
+	delayedBetBatchCount  = 1000
+
+	// limitCoolingOffPeriod is the default cooling-off period
+	// of the loosened bettor limits, seven days.
+	limitCoolingOffPeriod = 7 * 24 * 60 * 60
 )
 
 var (
@@ -31,6 +40,30 @@ var (
 	// keyWagerConstraints is the default bet placement
 	// constraints.
 	keyWagerConstraints = []byte("WagerConstraints")
+
+	// keyLimitCoolingOffPeriod is the cooling-off period
+	// of the loosened bettor limits.
+	keyLimitCoolingOffPeriod = []byte("LimitCoolingOffPeriod")
+
+	// keyMaxWagerBatchCount is the max count of
+	// the bets of a batch wager.
+	keyMaxWagerBatchCount = []byte("MaxWagerBatchCount")
+
+	// keyInPlayDelayBlocks is the count of the blocks that
+	// the bets on the started markets are delayed.
+	keyInPlayDelayBlocks = []byte("InPlayDelayBlocks")
+
+	// keyInPlayDelaySeconds is the duration in seconds that
+	// the bets on the started markets are delayed.
+	keyInPlayDelaySeconds = []byte("InPlayDelaySeconds")
+
+	// keyDelayedBetBatchCount is the max count of
+	// the due delayed bets processed in a block.
+	keyDelayedBetBatchCount = []byte("DelayedBetBatchCount")
+
+	// keyDenomConstraints is the bet placement constraints
+	// of the denoms other than the bond denom.
+	keyDenomConstraints = []byte("DenomConstraints")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -48,7 +81,13 @@ func NewParams() Params {
 		Constraints: Constraints{
 			MinAmount: defaultMinAmount,
 			Fee:       defaultFee,
+			FeeRate:   sdk.ZeroDec(),
+			MinFee:    sdk.ZeroInt(),
+			MaxFee:    sdk.ZeroInt(),
 		},
+		LimitCoolingOffPeriod: limitCoolingOffPeriod,
+		MaxWagerBatchCount:    maxWagerBatchCount,
+		DelayedBetBatchCount:  delayedBetBatchCount,
 	}
 }
 
@@ -75,6 +114,36 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.Constraints,
 			validateConstraints,
 		),
+		paramtypes.NewParamSetPair(
+			keyLimitCoolingOffPeriod,
+			&p.LimitCoolingOffPeriod,
+			validateLimitCoolingOffPeriod,
+		),
+		paramtypes.NewParamSetPair(
+			keyMaxWagerBatchCount,
+			&p.MaxWagerBatchCount,
+			validateMaxWagerBatchCount,
+		),
+		paramtypes.NewParamSetPair(
+			keyInPlayDelayBlocks,
+			&p.InPlayDelayBlocks,
+			validateInPlayDelay,
+		),
+		paramtypes.NewParamSetPair(
+			keyInPlayDelaySeconds,
+			&p.InPlayDelaySeconds,
+			validateInPlayDelay,
+		),
+		paramtypes.NewParamSetPair(
+			keyDelayedBetBatchCount,
+			&p.DelayedBetBatchCount,
+			validateDelayedBetBatchCount,
+		),
+		paramtypes.NewParamSetPair(
+			keyDenomConstraints,
+			&p.DenomConstraints,
+			validateDenomConstraints,
+		),
 	}
 }
 
@@ -88,7 +157,52 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateConstraints(p.Constraints)
+	if err := validateConstraints(p.Constraints); err != nil {
+		return err
+	}
+
+	if err := validateLimitCoolingOffPeriod(p.LimitCoolingOffPeriod); err != nil {
+		return err
+	}
+
+	if err := validateMaxWagerBatchCount(p.MaxWagerBatchCount); err != nil {
+		return err
+	}
+
+	if err := validateInPlayDelay(p.InPlayDelayBlocks); err != nil {
+		return err
+	}
+
+	if err := validateInPlayDelay(p.InPlayDelaySeconds); err != nil {
+		return err
+	}
+
+	if err := validateDelayedBetBatchCount(p.DelayedBetBatchCount); err != nil {
+		return err
+	}
+
+	return validateDenomConstraints(p.DenomConstraints)
+}
+
+// ConstraintsOfDenom returns the bet constraints of the denom, the constraints
+// of the params are applied to the bond denom.
+func (p Params) ConstraintsOfDenom(denom string) (Constraints, bool) {
+	if denom == params.DefaultBondDenom {
+		return p.Constraints, true
+	}
+
+	for _, dc := range p.DenomConstraints {
+		if dc.Denom == denom {
+			return dc.Constraints, true
+		}
+	}
+
+	return Constraints{}, false
+}
+
+// HasInPlayDelay returns true if the bets on the started markets are delayed.
+func (p Params) HasInPlayDelay() bool {
+	return p.InPlayDelayBlocks > 0 || p.InPlayDelaySeconds > 0
 }
 
 // String implements the Stringer interface.
@@ -140,5 +254,124 @@ func validateConstraints(i interface{}) error {
 		return fmt.Errorf("minimum bet fee must be positive: %d", v.Fee.Int64())
 	}
 
+	if err := validateFeeRate(v.FeeRate); err != nil {
+		return err
+	}
+
+	if v.MinFee.IsNil() || v.MinFee.IsNegative() {
+		return fmt.Errorf("minimum bet fee cap must not be negative: %s", v.MinFee)
+	}
+
+	if v.MaxFee.IsNil() || v.MaxFee.IsNegative() {
+		return fmt.Errorf("maximum bet fee cap must not be negative: %s", v.MaxFee)
+	}
+
+	if v.MaxFee.IsPositive() && v.MaxFee.LT(v.MinFee) {
+		return fmt.Errorf("maximum bet fee cap must not be less than the minimum: %s < %s", v.MaxFee, v.MinFee)
+	}
+
+	for i, tier := range v.FeeTiers {
+		if tier.MinVolume.IsNil() || !tier.MinVolume.IsPositive() {
+			return fmt.Errorf("minimum volume of the fee tier must be positive: %d", i)
+		}
+
+		if i > 0 && tier.MinVolume.LTE(v.FeeTiers[i-1].MinVolume) {
+			return fmt.Errorf("fee tiers must be sorted by minimum volume in ascending order: %d", i)
+		}
+
+		if err := validateFeeRate(tier.FeeRate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateFeeRate(feeRate sdk.Dec) error {
+	if feeRate.IsNil() || feeRate.IsNegative() || feeRate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("bet fee rate must be between zero and one: %s", feeRate)
+	}
+
+	return nil
+}
+
+func validateLimitCoolingOffPeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("%s: %T", ErrTextInvalidParamType, i)
+	}
+
+	if v > math.MaxInt64 {
+		return fmt.Errorf("limit cooling-off period is too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxWagerBatchCount(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("%s: %T", ErrTextInvalidParamType, i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("%s: %d", ErrTextMaxWagerBatchCountMustBePositive, v)
+	}
+
+	return nil
+}
+
+func validateInPlayDelay(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("%s: %T", ErrTextInvalidParamType, i)
+	}
+
+	if v > math.MaxInt64 {
+		return fmt.Errorf("in-play bet delay is too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateDelayedBetBatchCount(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("%s: %T", ErrTextInvalidParamType, i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("%s: %d", ErrTextDelayedBetBatchCountMustBePositive, v)
+	}
+
+	return nil
+}
+
+func validateDenomConstraints(i interface{}) error {
+	v, ok := i.([]DenomConstraints)
+	if !ok {
+		return fmt.Errorf("%s: %T", ErrTextInvalidParamType, i)
+	}
+
+	denoms := make(map[string]struct{}, len(v))
+	for _, dc := range v {
+		if err := sdk.ValidateDenom(dc.Denom); err != nil {
+			return err
+		}
+
+		if dc.Denom == params.DefaultBondDenom {
+			return fmt.Errorf("constraints of the bond denom are set by the wager constraints: %s", dc.Denom)
+		}
+
+		if _, ok := denoms[dc.Denom]; ok {
+			return fmt.Errorf("duplicate denom constraints: %s", dc.Denom)
+		}
+		denoms[dc.Denom] = struct{}{}
+
+		if err := validateConstraints(dc.Constraints); err != nil {
+			return fmt.Errorf("%s: %w", dc.Denom, err)
+		}
+	}
+
 	return nil
 }
@@ -31,6 +31,25 @@ type Params struct {
 	MaxBetByUidQueryCount uint32 `protobuf:"varint,2,opt,name=max_bet_by_uid_query_count,json=maxBetByUidQueryCount,proto3" json:"max_bet_by_uid_query_count,omitempty"`
 	// constraints is the bet constraints.
 	Constraints Constraints `protobuf:"bytes,3,opt,name=constraints,proto3" json:"constraints" yaml:"constraints"`
+	// limit_cooling_off_period is the duration in seconds that the loosened
+	// responsible gambling limits wait before taking effect.
+	LimitCoolingOffPeriod uint64 `protobuf:"varint,4,opt,name=limit_cooling_off_period,json=limitCoolingOffPeriod,proto3" json:"limit_cooling_off_period,omitempty"`
+	// max_wager_batch_count is the maximum count of the bets of a batch wager.
+	MaxWagerBatchCount uint32 `protobuf:"varint,5,opt,name=max_wager_batch_count,json=maxWagerBatchCount,proto3" json:"max_wager_batch_count,omitempty"`
+	// in_play_delay_blocks is the count of the blocks that the bets on the
+	// started markets wait before being fulfilled, zero means no delay.
+	InPlayDelayBlocks uint64 `protobuf:"varint,6,opt,name=in_play_delay_blocks,json=inPlayDelayBlocks,proto3" json:"in_play_delay_blocks,omitempty"`
+	// in_play_delay_seconds is the duration in seconds that the bets on the
+	// started markets wait before being fulfilled, zero means no delay.
+	InPlayDelaySeconds uint64 `protobuf:"varint,7,opt,name=in_play_delay_seconds,json=inPlayDelaySeconds,proto3" json:"in_play_delay_seconds,omitempty"`
+	// delayed_bet_batch_count is the maximum count of the due delayed bets
+	// that are processed in a block.
+	DelayedBetBatchCount uint32 `protobuf:"varint,8,opt,name=delayed_bet_batch_count,json=delayedBetBatchCount,proto3" json:"delayed_bet_batch_count,omitempty"`
+	// denom_constraints is the list of the bet constraints of the denoms other
+	// than the bond denom, the constraints of the params are applied to the
+	// bets in the bond denom and the bets in a denom without constraints are
+	// rejected.
+	DenomConstraints []DenomConstraints `protobuf:"bytes,9,rep,name=denom_constraints,json=denomConstraints,proto3" json:"denom_constraints" yaml:"denom_constraints"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -86,6 +105,48 @@ func (m *Params) GetConstraints() Constraints {
 	return Constraints{}
 }
 
+func (m *Params) GetLimitCoolingOffPeriod() uint64 {
+	if m != nil {
+		return m.LimitCoolingOffPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxWagerBatchCount() uint32 {
+	if m != nil {
+		return m.MaxWagerBatchCount
+	}
+	return 0
+}
+
+func (m *Params) GetInPlayDelayBlocks() uint64 {
+	if m != nil {
+		return m.InPlayDelayBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetInPlayDelaySeconds() uint64 {
+	if m != nil {
+		return m.InPlayDelaySeconds
+	}
+	return 0
+}
+
+func (m *Params) GetDelayedBetBatchCount() uint32 {
+	if m != nil {
+		return m.DelayedBetBatchCount
+	}
+	return 0
+}
+
+func (m *Params) GetDenomConstraints() []DenomConstraints {
+	if m != nil {
+		return m.DenomConstraints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "sgenetwork.sge.bet.Params")
 }
@@ -93,26 +154,37 @@ func init() {
 func init() { proto.RegisterFile("sge/bet/params.proto", fileDescriptor_4216d2638a14c9d3) }
 
 var fileDescriptor_4216d2638a14c9d3 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x40, 0x1d, 0x52, 0xb1, 0x44, 0x05, 0x95, 0x0c, 0x4e, 0xd5, 0x01, 0x75, 0xc1,
-	0x91, 0x80, 0x85, 0x4e, 0x28, 0xbd, 0x00, 0x14, 0xb1, 0x20, 0x21, 0xcb, 0x4e, 0x9f, 0xdc, 0x88,
-	0x3a, 0x2e, 0xf1, 0x8b, 0x68, 0x6e, 0xc1, 0xc8, 0xc8, 0x71, 0x3a, 0x76, 0x64, 0xaa, 0x50, 0x7b,
-	0x03, 0x4e, 0x80, 0xe2, 0x16, 0xa8, 0xc4, 0xf6, 0xa4, 0xef, 0xfd, 0x9f, 0xfd, 0x7e, 0xbf, 0x65,
-	0x15, 0xc4, 0x12, 0x30, 0x9e, 0x8a, 0x42, 0x68, 0xcb, 0xa6, 0x85, 0x41, 0x13, 0x04, 0x56, 0x41,
-	0x0e, 0xf8, 0x62, 0x8a, 0x27, 0x66, 0x15, 0x30, 0x09, 0x18, 0xb6, 0x94, 0x51, 0xc6, 0xe1, 0xb8,
-	0x9e, 0x36, 0x9b, 0xe1, 0xc9, 0x4f, 0x3e, 0x35, 0xb9, 0xc5, 0x42, 0x64, 0x39, 0x6e, 0x25, 0xdd,
-	0x25, 0xf1, 0x1b, 0x37, 0xce, 0x1a, 0x5c, 0xfa, 0xc7, 0x52, 0x60, 0x3a, 0xe6, 0x16, 0x10, 0x27,
-	0xa0, 0x21, 0x47, 0x9e, 0x9a, 0x32, 0xc7, 0x36, 0xe9, 0x90, 0xde, 0xe1, 0xb0, 0xe5, 0xe8, 0xdd,
-	0x2f, 0x1c, 0xd4, 0x2c, 0xb8, 0xf2, 0x43, 0x2d, 0x66, 0x5c, 0x02, 0x72, 0x59, 0xf1, 0x32, 0x1b,
-	0xf1, 0xe7, 0x12, 0x8a, 0x6a, 0x9b, 0xdc, 0x73, 0xc9, 0x23, 0x2d, 0x66, 0x09, 0x60, 0x52, 0xdd,
-	0x67, 0xa3, 0xdb, 0x9a, 0x6e, 0xa2, 0x8f, 0x7e, 0x73, 0xe7, 0x43, 0xed, 0xfd, 0x0e, 0xe9, 0x35,
-	0xcf, 0x23, 0xf6, 0xff, 0x2c, 0x36, 0xf8, 0x5b, 0x4b, 0xc2, 0xf9, 0x32, 0xf2, 0xbe, 0x96, 0x51,
-	0x50, 0x09, 0x3d, 0xe9, 0x77, 0x77, 0x0c, 0xdd, 0xe1, 0xae, 0xaf, 0x7f, 0xf0, 0xf6, 0x1e, 0x79,
-	0xc9, 0xf5, 0x7c, 0x45, 0xc9, 0x62, 0x45, 0xc9, 0xe7, 0x8a, 0x92, 0xd7, 0x35, 0xf5, 0x16, 0x6b,
-	0xea, 0x7d, 0xac, 0xa9, 0xf7, 0x70, 0xaa, 0x32, 0x1c, 0x97, 0x92, 0xa5, 0x46, 0xc7, 0x56, 0xc1,
-	0xd9, 0xf6, 0xd1, 0x7a, 0x8e, 0x67, 0xae, 0x2e, 0xac, 0xa6, 0x60, 0x65, 0xc3, 0x35, 0x75, 0xf1,
-	0x1d, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x66, 0x52, 0x67, 0x86, 0x01, 0x00, 0x00,
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x1c, 0xc6, 0x13, 0xb7, 0x56, 0x9d, 0x22, 0xb8, 0x43, 0x57, 0x63, 0x0f, 0x69, 0x28, 0x22, 0xbd,
+	0x98, 0xe0, 0xaa, 0x88, 0x7b, 0x92, 0x74, 0xef, 0xd6, 0x2e, 0x22, 0x08, 0x32, 0x4c, 0x92, 0x7f,
+	0xb3, 0xc3, 0x26, 0x33, 0x31, 0x33, 0x65, 0x9b, 0xb7, 0xf0, 0xe8, 0xd1, 0xc7, 0xd9, 0xe3, 0x1e,
+	0xc5, 0xc3, 0x22, 0xed, 0x1b, 0xf8, 0x04, 0x32, 0x33, 0xd1, 0x8d, 0xd6, 0x4b, 0x18, 0xf8, 0xfd,
+	0xbf, 0xff, 0x7c, 0xdf, 0x97, 0x41, 0x43, 0x99, 0x43, 0x94, 0x80, 0x8a, 0x2a, 0x5a, 0xd3, 0x52,
+	0x86, 0x55, 0x2d, 0x94, 0xc0, 0x58, 0xe6, 0xc0, 0x41, 0x9d, 0x8b, 0xfa, 0x2c, 0x94, 0x39, 0x84,
+	0x09, 0xa8, 0xd1, 0x30, 0x17, 0xb9, 0x30, 0x38, 0xd2, 0x27, 0x3b, 0x39, 0x7a, 0xf8, 0x5b, 0x9f,
+	0x0a, 0x2e, 0x55, 0x4d, 0x19, 0x57, 0xed, 0x92, 0xc9, 0xf7, 0x1e, 0xea, 0xcf, 0xcd, 0x56, 0xfc,
+	0x1c, 0xdd, 0x4f, 0xa8, 0x4a, 0x4f, 0x89, 0x04, 0xa5, 0x0a, 0x28, 0x81, 0x2b, 0x92, 0x8a, 0x15,
+	0x57, 0x9e, 0x1b, 0xb8, 0xd3, 0xbb, 0x8b, 0xa1, 0xa1, 0x27, 0x7f, 0xe0, 0x4c, 0x33, 0xfc, 0x0a,
+	0x8d, 0x4a, 0xba, 0x26, 0x09, 0x28, 0x92, 0x34, 0x64, 0xc5, 0x32, 0xf2, 0x69, 0x05, 0x75, 0xd3,
+	0x2a, 0x6f, 0x18, 0xe5, 0x41, 0x49, 0xd7, 0x31, 0xa8, 0xb8, 0x79, 0xc7, 0xb2, 0xb7, 0x9a, 0x5a,
+	0xe9, 0x47, 0x34, 0xe8, 0x18, 0xf2, 0xf6, 0x02, 0x77, 0x3a, 0x38, 0x1c, 0x87, 0xbb, 0xb1, 0xc2,
+	0xd9, 0xf5, 0x58, 0x3c, 0xba, 0xb8, 0x1a, 0x3b, 0x3f, 0xaf, 0xc6, 0xb8, 0xa1, 0x65, 0x71, 0x34,
+	0xe9, 0x6c, 0x98, 0x2c, 0xba, 0xfb, 0xf0, 0x4b, 0xe4, 0x15, 0xac, 0x64, 0x3a, 0x84, 0x28, 0x18,
+	0xcf, 0x89, 0x58, 0x2e, 0x49, 0x05, 0x35, 0x13, 0x99, 0xd7, 0x0b, 0xdc, 0x69, 0x6f, 0x71, 0x60,
+	0xf8, 0xcc, 0xe2, 0x37, 0xcb, 0xe5, 0xdc, 0x40, 0xfc, 0x14, 0x69, 0xc3, 0xe4, 0x9c, 0xe6, 0x50,
+	0x13, 0x5b, 0x89, 0x4d, 0x73, 0xd3, 0xa4, 0xc1, 0x25, 0x5d, 0xbf, 0xd7, 0x2c, 0xd6, 0xc8, 0x46,
+	0x89, 0xd0, 0x90, 0x71, 0x52, 0x15, 0xb4, 0x21, 0x19, 0xe8, 0x6f, 0x52, 0x88, 0xf4, 0x4c, 0x7a,
+	0x7d, 0x73, 0xcf, 0x3e, 0xe3, 0xf3, 0x82, 0x36, 0xc7, 0x9a, 0xc4, 0x06, 0xe8, 0x3b, 0xfe, 0x16,
+	0x48, 0x48, 0x05, 0xcf, 0xa4, 0x77, 0xcb, 0x28, 0x70, 0x47, 0x71, 0x62, 0x09, 0x7e, 0x81, 0x1e,
+	0x98, 0x51, 0xc8, 0x6c, 0xdb, 0x1d, 0x63, 0xb7, 0xed, 0x0f, 0x6a, 0xb1, 0xae, 0xfa, 0xda, 0x9a,
+	0x44, 0xfb, 0x19, 0x70, 0x51, 0x92, 0x6e, 0xd7, 0x77, 0x82, 0xbd, 0xe9, 0xe0, 0xf0, 0xd1, 0xff,
+	0xba, 0x3e, 0xd6, 0xc3, 0xdd, 0xc2, 0x83, 0xb6, 0x70, 0xcf, 0x16, 0xbe, 0xb3, 0x6c, 0xb2, 0xb8,
+	0x97, 0xfd, 0xa3, 0x39, 0xea, 0x7d, 0xf9, 0x3a, 0x76, 0xe2, 0xd7, 0x17, 0x1b, 0xdf, 0xbd, 0xdc,
+	0xf8, 0xee, 0x8f, 0x8d, 0xef, 0x7e, 0xde, 0xfa, 0xce, 0xe5, 0xd6, 0x77, 0xbe, 0x6d, 0x7d, 0xe7,
+	0xc3, 0xe3, 0x9c, 0xa9, 0xd3, 0x55, 0x12, 0xa6, 0xa2, 0x8c, 0x64, 0x0e, 0x4f, 0x5a, 0x13, 0xfa,
+	0x1c, 0xad, 0xcd, 0x53, 0x55, 0x4d, 0x05, 0x32, 0xe9, 0x9b, 0x57, 0xfa, 0xec, 0xd7, 0x00, 0x8f,
+	0xf4, 0x4f, 0xc8, 0x02, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -135,6 +207,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomConstraints) > 0 {
+		for iNdEx := len(m.DenomConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.DelayedBetBatchCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DelayedBetBatchCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InPlayDelaySeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InPlayDelaySeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InPlayDelayBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InPlayDelayBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxWagerBatchCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxWagerBatchCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LimitCoolingOffPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LimitCoolingOffPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -183,6 +294,27 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.Constraints.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.LimitCoolingOffPeriod != 0 {
+		n += 1 + sovParams(uint64(m.LimitCoolingOffPeriod))
+	}
+	if m.MaxWagerBatchCount != 0 {
+		n += 1 + sovParams(uint64(m.MaxWagerBatchCount))
+	}
+	if m.InPlayDelayBlocks != 0 {
+		n += 1 + sovParams(uint64(m.InPlayDelayBlocks))
+	}
+	if m.InPlayDelaySeconds != 0 {
+		n += 1 + sovParams(uint64(m.InPlayDelaySeconds))
+	}
+	if m.DelayedBetBatchCount != 0 {
+		n += 1 + sovParams(uint64(m.DelayedBetBatchCount))
+	}
+	if len(m.DenomConstraints) > 0 {
+		for _, e := range m.DenomConstraints {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -292,6 +424,135 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitCoolingOffPeriod", wireType)
+			}
+			m.LimitCoolingOffPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitCoolingOffPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWagerBatchCount", wireType)
+			}
+			m.MaxWagerBatchCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWagerBatchCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InPlayDelayBlocks", wireType)
+			}
+			m.InPlayDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InPlayDelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InPlayDelaySeconds", wireType)
+			}
+			m.InPlayDelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InPlayDelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedBetBatchCount", wireType)
+			}
+			m.DelayedBetBatchCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayedBetBatchCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomConstraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomConstraints = append(m.DenomConstraints, DenomConstraints{})
+			if err := m.DenomConstraints[len(m.DenomConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
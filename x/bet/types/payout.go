@@ -18,6 +18,27 @@ func CalculatePayoutProfit(oddsType OddsType, oddsVal string, amount sdkmath.Int
 	return profit, nil
 }
 
+// CalculateDecimalOdds converts the odds value of the odds type to decimal odds.
+func CalculateDecimalOdds(oddsType OddsType, oddsVal string) (sdk.Dec, error) {
+	// payout of a single unit of token is equal to the decimal odds value
+	return calculatePayout(oddsType, oddsVal, sdk.OneInt())
+}
+
+// CalculateParlayOdds calculates the combined decimal odds of the parlay legs
+// by multiplying the decimal odds of all of the legs.
+func CalculateParlayOdds(legs []*BetLeg) (sdk.Dec, error) {
+	combinedOdds := sdk.OneDec()
+	for _, leg := range legs {
+		decimalOdds, err := CalculateDecimalOdds(leg.OddsType, leg.OddsValue)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		combinedOdds = combinedOdds.Mul(decimalOdds)
+	}
+
+	return combinedOdds, nil
+}
+
 // calculatePayout calculates the amount of payout according to bet odds value and amount
 func calculatePayout(oddsType OddsType, oddsVal string, amount sdkmath.Int) (sdk.Dec, error) {
 	var oType OddsTypeI
@@ -33,6 +54,15 @@ func calculatePayout(oddsType OddsType, oddsVal string, amount sdkmath.Int) (sdk
 	case OddsType_ODDS_TYPE_MONEYLINE:
 		oType = new(moneylineOdds)
 
+	case OddsType_ODDS_TYPE_HONG_KONG:
+		oType = new(hongKongOdds)
+
+	case OddsType_ODDS_TYPE_INDONESIAN:
+		oType = new(indonesianOdds)
+
+	case OddsType_ODDS_TYPE_MALAY:
+		oType = new(malayOdds)
+
 	default:
 		return sdk.ZeroDec(), ErrInvalidOddsType
 	}
@@ -96,6 +126,15 @@ func calculateBetAmount(oddsType OddsType, oddsVal string, payoutProfit sdk.Dec)
 	case OddsType_ODDS_TYPE_MONEYLINE:
 		oType = new(moneylineOdds)
 
+	case OddsType_ODDS_TYPE_HONG_KONG:
+		oType = new(hongKongOdds)
+
+	case OddsType_ODDS_TYPE_INDONESIAN:
+		oType = new(indonesianOdds)
+
+	case OddsType_ODDS_TYPE_MALAY:
+		oType = new(malayOdds)
+
 	default:
 		return sdk.ZeroDec(), ErrInvalidOddsType
 	}
@@ -108,3 +147,36 @@ func calculateBetAmount(oddsType OddsType, oddsVal string, payoutProfit sdk.Dec)
 
 	return betAmount, nil
 }
+
+// CalculateWonAmount returns the bet amount and the payout profit that is paid to the bettor
+// by the won bet fulfillments, the same as the settlement of the order book.
+func CalculateWonAmount(betFulfillments []*BetFulfillment, winRatio, deadHeatFactor sdk.Dec) sdkmath.Int {
+	amount := sdkmath.ZeroInt()
+	for _, bf := range betFulfillments {
+		payoutProfit := sdk.NewDecFromInt(bf.PayoutProfit).Mul(winRatio).Mul(deadHeatFactor).TruncateInt()
+		wonBetAmount := sdk.NewDecFromInt(bf.BetAmount).Mul(deadHeatFactor).TruncateInt()
+		amount = amount.Add(payoutProfit).Add(wonBetAmount)
+	}
+	return amount
+}
+
+// CalculateWonBetAmount returns the portion of the bet amount that is returned to the bettor
+// by the won bet fulfillments excluding the payout profit, the same as the settlement of the order book.
+func CalculateWonBetAmount(betFulfillments []*BetFulfillment, deadHeatFactor sdk.Dec) sdkmath.Int {
+	amount := sdkmath.ZeroInt()
+	for _, bf := range betFulfillments {
+		amount = amount.Add(sdk.NewDecFromInt(bf.BetAmount).Mul(deadHeatFactor).TruncateInt())
+	}
+	return amount
+}
+
+// CalculateLostReturnedAmount returns the portion of the bet amount that is returned to the bettor
+// by the lost bet fulfillments, the same as the settlement of the order book.
+func CalculateLostReturnedAmount(betFulfillments []*BetFulfillment, lossRatio sdk.Dec) sdkmath.Int {
+	amount := sdkmath.ZeroInt()
+	for _, bf := range betFulfillments {
+		lostAmount := sdk.NewDecFromInt(bf.BetAmount).Mul(lossRatio).TruncateInt()
+		amount = amount.Add(bf.BetAmount.Sub(lostAmount))
+	}
+	return amount
+}
@@ -369,3 +369,254 @@ func TestCalculateMoneylineBetAmount(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateAsianOddsPayout(t *testing.T) {
+	tcs := []struct {
+		desc      string
+		oddsType  types.OddsType
+		oddsValue string
+		betAmount int64
+
+		expVal int64
+		err    error
+	}{
+		{
+			desc:      "hong kong lower than even",
+			oddsType:  types.OddsType_ODDS_TYPE_HONG_KONG,
+			oddsValue: "0.5",
+			betAmount: defaultBetAmount,
+
+			expVal: 17812894,
+		},
+		{
+			desc:      "hong kong upper than even",
+			oddsType:  types.OddsType_ODDS_TYPE_HONG_KONG,
+			oddsValue: "2.25",
+			betAmount: defaultBetAmount,
+
+			expVal: 80158025,
+		},
+		{
+			desc:      "hong kong zero",
+			oddsType:  types.OddsType_ODDS_TYPE_HONG_KONG,
+			oddsValue: "0",
+			betAmount: defaultBetAmount,
+
+			err: types.ErrHongKongOddsShouldBePositive,
+		},
+		{
+			desc:      "hong kong negative",
+			oddsType:  types.OddsType_ODDS_TYPE_HONG_KONG,
+			oddsValue: "-0.5",
+			betAmount: defaultBetAmount,
+
+			err: types.ErrHongKongOddsShouldBePositive,
+		},
+		{
+			desc:      "hong kong incorrect format",
+			oddsType:  types.OddsType_ODDS_TYPE_HONG_KONG,
+			oddsValue: "1/2",
+			betAmount: defaultBetAmount,
+
+			err: types.ErrHongKongOddsIncorrectFormat,
+		},
+		{
+			desc:      "indonesian positive",
+			oddsType:  types.OddsType_ODDS_TYPE_INDONESIAN,
+			oddsValue: "1.5",
+			betAmount: defaultBetAmount,
+
+			expVal: 53438683,
+		},
+		{
+			desc:      "indonesian negative",
+			oddsType:  types.OddsType_ODDS_TYPE_INDONESIAN,
+			oddsValue: "-1.5",
+			betAmount: defaultBetAmount,
+
+			expVal: 23750526,
+		},
+		{
+			desc:      "indonesian negative same as moneyline",
+			oddsType:  types.OddsType_ODDS_TYPE_INDONESIAN,
+			oddsValue: "-4.26",
+			betAmount: defaultBetAmount,
+
+			expVal: 8362861,
+		},
+		{
+			desc:      "indonesian even",
+			oddsType:  types.OddsType_ODDS_TYPE_INDONESIAN,
+			oddsValue: "-1",
+			betAmount: defaultBetAmount,
+
+			expVal: defaultBetAmount,
+		},
+		{
+			desc:      "indonesian out of range",
+			oddsType:  types.OddsType_ODDS_TYPE_INDONESIAN,
+			oddsValue: "-0.99",
+			betAmount: defaultBetAmount,
+
+			err: types.ErrIndonesianOddsOutOfRange,
+		},
+		{
+			desc:      "indonesian zero",
+			oddsType:  types.OddsType_ODDS_TYPE_INDONESIAN,
+			oddsValue: "0",
+			betAmount: defaultBetAmount,
+
+			err: types.ErrIndonesianOddsOutOfRange,
+		},
+		{
+			desc:      "indonesian incorrect format",
+			oddsType:  types.OddsType_ODDS_TYPE_INDONESIAN,
+			oddsValue: "+1.5a",
+			betAmount: defaultBetAmount,
+
+			err: types.ErrIndonesianOddsIncorrectFormat,
+		},
+		{
+			desc:      "malay positive",
+			oddsType:  types.OddsType_ODDS_TYPE_MALAY,
+			oddsValue: "0.5",
+			betAmount: defaultBetAmount,
+
+			expVal: 17812894,
+		},
+		{
+			desc:      "malay negative",
+			oddsType:  types.OddsType_ODDS_TYPE_MALAY,
+			oddsValue: "-0.5",
+			betAmount: defaultBetAmount,
+
+			expVal: 71251578,
+		},
+		{
+			desc:      "malay negative with truncation",
+			oddsType:  types.OddsType_ODDS_TYPE_MALAY,
+			oddsValue: "-0.8",
+			betAmount: defaultBetAmount,
+
+			expVal: 44532236,
+		},
+		{
+			desc:      "malay even",
+			oddsType:  types.OddsType_ODDS_TYPE_MALAY,
+			oddsValue: "1",
+			betAmount: defaultBetAmount,
+
+			expVal: defaultBetAmount,
+		},
+		{
+			desc:      "malay out of range",
+			oddsType:  types.OddsType_ODDS_TYPE_MALAY,
+			oddsValue: "-1.2",
+			betAmount: defaultBetAmount,
+
+			err: types.ErrMalayOddsOutOfRange,
+		},
+		{
+			desc:      "malay zero",
+			oddsType:  types.OddsType_ODDS_TYPE_MALAY,
+			oddsValue: "0",
+			betAmount: defaultBetAmount,
+
+			err: types.ErrMalayOddsOutOfRange,
+		},
+		{
+			desc:      "malay incorrect format",
+			oddsType:  types.OddsType_ODDS_TYPE_MALAY,
+			oddsValue: "",
+			betAmount: defaultBetAmount,
+
+			err: types.ErrMalayOddsIncorrectFormat,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.desc, func(t *testing.T) {
+			payoutProfit, err := types.CalculatePayoutProfit(
+				tc.oddsType,
+				tc.oddsValue,
+				sdk.NewInt(tc.betAmount),
+			)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.True(t, sdk.NewInt(tc.expVal).Equal(payoutProfit.TruncateInt()), "expected: %d, actual: %d", tc.expVal, payoutProfit)
+			}
+
+			calcBetAmount, err := types.CalculateBetAmount(
+				tc.oddsType,
+				tc.oddsValue,
+				payoutProfit,
+			)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.True(t, sdk.NewInt(tc.betAmount).Sub(calcBetAmount.Ceil().TruncateInt()).Abs().LTE(ecceptedTruncatedValue), "expected: %d, actual: %d", tc.betAmount, calcBetAmount)
+			}
+		})
+	}
+}
+
+func TestCalculateAsianOddsBetAmountInt(t *testing.T) {
+	tcs := []struct {
+		desc         string
+		oddsType     types.OddsType
+		oddsValue    string
+		payoutProfit sdk.Dec
+		truncated    sdk.Dec
+
+		expVal       sdk.Int
+		expTruncated sdk.Dec
+	}{
+		{
+			desc:         "hong kong",
+			oddsType:     types.OddsType_ODDS_TYPE_HONG_KONG,
+			oddsValue:    "0.8",
+			payoutProfit: sdk.MustNewDecFromStr("5003"),
+			truncated:    sdk.ZeroDec(),
+
+			expVal:       sdk.NewInt(6254),
+			expTruncated: sdk.MustNewDecFromStr("-0.25"),
+		},
+		{
+			desc:         "indonesian with previous truncated value",
+			oddsType:     types.OddsType_ODDS_TYPE_INDONESIAN,
+			oddsValue:    "-1.25",
+			payoutProfit: sdk.MustNewDecFromStr("5003"),
+			truncated:    sdk.MustNewDecFromStr("0.3"),
+
+			expVal:       sdk.NewInt(6254),
+			expTruncated: sdk.MustNewDecFromStr("0.35"),
+		},
+		{
+			desc:         "malay",
+			oddsType:     types.OddsType_ODDS_TYPE_MALAY,
+			oddsValue:    "-0.8",
+			payoutProfit: sdk.MustNewDecFromStr("5003"),
+			truncated:    sdk.ZeroDec(),
+
+			expVal:       sdk.NewInt(4002),
+			expTruncated: sdk.MustNewDecFromStr("0.4"),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.desc, func(t *testing.T) {
+			betAmount, truncated, err := types.CalculateBetAmountInt(
+				tc.oddsType,
+				tc.oddsValue,
+				tc.payoutProfit,
+				tc.truncated,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tc.expVal.String(), betAmount.String())
+			require.Equal(t, tc.expTruncated.String(), truncated.String())
+		})
+	}
+}
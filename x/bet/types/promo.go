@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+)
+
+// NewFreeBetCredit creates a new free bet credit from the issuance ticket payload.
+func NewFreeBetCredit(payload *IssueFreeBetTicketPayload, issuedAt int64) FreeBetCredit {
+	return FreeBetCredit{
+		UID:       payload.UID,
+		Address:   payload.Address,
+		Denom:     payload.Denom,
+		Amount:    payload.Amount,
+		ExpiresAt: payload.ExpiresAt,
+		IssuedAt:  issuedAt,
+	}
+}
+
+// Validate validates the fields of the free bet credit.
+func (credit *FreeBetCredit) Validate() error {
+	if !utils.IsValidUID(credit.UID) {
+		return sdkerrors.Wrapf(ErrInvalidFreeBetCredit, "invalid uid %s", credit.UID)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(credit.Address); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidFreeBetCredit, "%s", err)
+	}
+
+	if err := sdk.ValidateDenom(credit.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidFreeBetCredit, "%s", err)
+	}
+
+	if credit.Amount.IsNil() || !credit.Amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	if credit.BetUID != "" && !utils.IsValidUID(credit.BetUID) {
+		return sdkerrors.Wrapf(ErrInvalidFreeBetCredit, "invalid bet uid %s", credit.BetUID)
+	}
+
+	return nil
+}
+
+// IsUsed returns true if the credit is used for a bet.
+func (credit *FreeBetCredit) IsUsed() bool {
+	return credit.BetUID != ""
+}
+
+// IsExpired returns true if the credit can not be used at the given time.
+func (credit *FreeBetCredit) IsExpired(blockTime int64) bool {
+	return credit.ExpiresAt != 0 && credit.ExpiresAt <= blockTime
+}
+
+// IsFreeBet returns true if the bet is placed with a free bet credit.
+func (bet *Bet) IsFreeBet() bool {
+	return bet.FreeBetCreditUID != ""
+}
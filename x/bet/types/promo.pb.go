@@ -0,0 +1,830 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sge/bet/promo.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FreeBetCredit is a promotional credit that lets the bettor wager the
+// credited amount from the promo pool instead of their own balance.
+type FreeBetCredit struct {
+	// uid is the universal unique identifier of the credit.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// address is the bettor address that the credit is issued to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denomination of the credited amount.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the credited amount including the bet fee.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// expires_at is the timestamp that the credit can not be used after,
+	// zero means the credit does not expire.
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// issued_at is the timestamp of the issuance of the credit.
+	IssuedAt int64 `protobuf:"varint,6,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// bet_uid is the universal unique identifier of the bet that the credit is
+	// used for, it is empty if the credit is not used.
+	BetUID string `protobuf:"bytes,7,opt,name=bet_uid,proto3" json:"bet_uid"`
+}
+
+func (m *FreeBetCredit) Reset()         { *m = FreeBetCredit{} }
+func (m *FreeBetCredit) String() string { return proto.CompactTextString(m) }
+func (*FreeBetCredit) ProtoMessage()    {}
+func (*FreeBetCredit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae6bfd078c9dbc0c, []int{0}
+}
+func (m *FreeBetCredit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreeBetCredit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FreeBetCredit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FreeBetCredit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreeBetCredit.Merge(m, src)
+}
+func (m *FreeBetCredit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreeBetCredit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreeBetCredit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreeBetCredit proto.InternalMessageInfo
+
+func (m *FreeBetCredit) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *FreeBetCredit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FreeBetCredit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FreeBetCredit) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *FreeBetCredit) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *FreeBetCredit) GetBetUID() string {
+	if m != nil {
+		return m.BetUID
+	}
+	return ""
+}
+
+// FreeBetCreditReserve is the total amount of the unused free bet credits of
+// a denom that the balance of the promo pool is reserved for.
+type FreeBetCreditReserve struct {
+	// denom is the denomination of the reserved amount.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total amount of the unused free bet credits.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *FreeBetCreditReserve) Reset()         { *m = FreeBetCreditReserve{} }
+func (m *FreeBetCreditReserve) String() string { return proto.CompactTextString(m) }
+func (*FreeBetCreditReserve) ProtoMessage()    {}
+func (*FreeBetCreditReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae6bfd078c9dbc0c, []int{1}
+}
+func (m *FreeBetCreditReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreeBetCreditReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FreeBetCreditReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FreeBetCreditReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreeBetCreditReserve.Merge(m, src)
+}
+func (m *FreeBetCreditReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreeBetCreditReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreeBetCreditReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreeBetCreditReserve proto.InternalMessageInfo
+
+func (m *FreeBetCreditReserve) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*FreeBetCredit)(nil), "sgenetwork.sge.bet.FreeBetCredit")
+	proto.RegisterType((*FreeBetCreditReserve)(nil), "sgenetwork.sge.bet.FreeBetCreditReserve")
+}
+
+func init() { proto.RegisterFile("sge/bet/promo.proto", fileDescriptor_ae6bfd078c9dbc0c) }
+
+var fileDescriptor_ae6bfd078c9dbc0c = []byte{
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x4d, 0x9a, 0xd7, 0xf4, 0x75, 0xe0, 0xbd, 0xc5, 0x58, 0x21, 0x28, 0x26, 0xa5, 0x0b, 0xe9,
+	0xa6, 0xc9, 0xc2, 0x1f, 0xb0, 0x51, 0x0a, 0xdd, 0x06, 0xdc, 0xb8, 0x29, 0x4d, 0x73, 0x89, 0xa1,
+	0x24, 0x13, 0xe6, 0xde, 0x68, 0xfd, 0x0b, 0x57, 0x7e, 0x53, 0x97, 0x5d, 0x8a, 0x8b, 0x20, 0xe9,
+	0xce, 0xaf, 0x90, 0x4c, 0x52, 0x54, 0x70, 0xe7, 0x66, 0xe6, 0xdc, 0x73, 0xee, 0x1c, 0xe6, 0xcc,
+	0x1d, 0x76, 0x84, 0x31, 0x78, 0x21, 0x90, 0x97, 0x4b, 0x91, 0x0a, 0x37, 0x97, 0x82, 0x04, 0xe7,
+	0x18, 0x43, 0x06, 0xf4, 0x20, 0xe4, 0xda, 0xc5, 0x18, 0xdc, 0x10, 0xe8, 0x64, 0x10, 0x8b, 0x58,
+	0x28, 0xd9, 0xab, 0x51, 0xd3, 0x39, 0x7a, 0xee, 0xb0, 0x7f, 0x33, 0x09, 0xe0, 0x03, 0x5d, 0x49,
+	0x88, 0x12, 0xe2, 0x43, 0x66, 0x14, 0x49, 0x64, 0xe9, 0x43, 0x7d, 0xdc, 0xf7, 0xff, 0x57, 0xa5,
+	0x63, 0xdc, 0xcc, 0xaf, 0xdf, 0x4b, 0xa7, 0x66, 0x83, 0x7a, 0xe1, 0x16, 0xeb, 0x2d, 0xa3, 0x48,
+	0x02, 0xa2, 0xd5, 0xa9, 0xbb, 0x82, 0x43, 0xc9, 0x07, 0xac, 0x1b, 0x41, 0x26, 0x52, 0xcb, 0x50,
+	0x7c, 0x53, 0xf0, 0x19, 0x33, 0x97, 0xa9, 0x28, 0x32, 0xb2, 0xfe, 0x28, 0x53, 0x77, 0x5b, 0x3a,
+	0xda, 0x6b, 0xe9, 0x9c, 0xc7, 0x09, 0xdd, 0x15, 0xa1, 0xbb, 0x12, 0xa9, 0xb7, 0x12, 0x98, 0x0a,
+	0x6c, 0xb7, 0x09, 0x46, 0x6b, 0x8f, 0x1e, 0x73, 0x40, 0x77, 0x9e, 0x51, 0xd0, 0x9e, 0xe6, 0x67,
+	0x8c, 0xc1, 0x26, 0x4f, 0x24, 0xe0, 0x62, 0x49, 0x56, 0x77, 0xa8, 0x8f, 0x8d, 0xa0, 0xdf, 0x32,
+	0x53, 0xe2, 0xa7, 0xac, 0x9f, 0x20, 0x16, 0x10, 0xd5, 0xaa, 0xa9, 0xd4, 0xbf, 0x0d, 0x31, 0x25,
+	0xee, 0xb1, 0x5e, 0x08, 0xb4, 0xa8, 0x93, 0xf5, 0xd4, 0x25, 0x8e, 0xab, 0xd2, 0x31, 0x7d, 0xa0,
+	0x26, 0xdc, 0x41, 0x0c, 0x0e, 0x60, 0x44, 0x6c, 0xf0, 0xed, 0x5d, 0x02, 0x40, 0x90, 0xf7, 0xf0,
+	0x19, 0x51, 0xff, 0x39, 0x62, 0xe7, 0x37, 0x11, 0xfd, 0xcb, 0x6d, 0x65, 0xeb, 0xbb, 0xca, 0xd6,
+	0xdf, 0x2a, 0x5b, 0x7f, 0xda, 0xdb, 0xda, 0x6e, 0x6f, 0x6b, 0x2f, 0x7b, 0x5b, 0xbb, 0xfd, 0xea,
+	0x84, 0x31, 0x4c, 0xda, 0xf1, 0xd6, 0xd8, 0xdb, 0xa8, 0x0f, 0xa0, 0xdc, 0x42, 0x53, 0xcd, 0xf5,
+	0xe2, 0x63, 0x00, 0x76, 0xb7, 0x97, 0x83, 0x18, 0x02, 0x00, 0x00,
+}
+
+func (m *FreeBetCredit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreeBetCredit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreeBetCredit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BetUID) > 0 {
+		i -= len(m.BetUID)
+		copy(dAtA[i:], m.BetUID)
+		i = encodeVarintPromo(dAtA, i, uint64(len(m.BetUID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintPromo(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintPromo(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPromo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPromo(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPromo(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintPromo(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FreeBetCreditReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreeBetCreditReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreeBetCreditReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPromo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPromo(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPromo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPromo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FreeBetCredit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovPromo(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPromo(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPromo(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPromo(uint64(l))
+	if m.ExpiresAt != 0 {
+		n += 1 + sovPromo(uint64(m.ExpiresAt))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovPromo(uint64(m.IssuedAt))
+	}
+	l = len(m.BetUID)
+	if l > 0 {
+		n += 1 + l + sovPromo(uint64(l))
+	}
+	return n
+}
+
+func (m *FreeBetCreditReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPromo(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPromo(uint64(l))
+	return n
+}
+
+func sovPromo(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPromo(x uint64) (n int) {
+	return sovPromo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FreeBetCredit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreeBetCredit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreeBetCredit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BetUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPromo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreeBetCreditReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPromo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreeBetCreditReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreeBetCreditReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPromo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPromo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPromo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPromo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPromo(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPromo
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPromo
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPromo
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPromo
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPromo
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPromo        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPromo          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPromo = fmt.Errorf("proto: unexpected end of group")
+)
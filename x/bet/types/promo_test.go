@@ -0,0 +1,186 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/google/uuid"
+	"github.com/sge-network/sge/app/params"
+	"github.com/sge-network/sge/testutil/sample"
+	"github.com/sge-network/sge/x/bet/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFundPromoPoolValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgFundPromoPool
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: types.MsgFundPromoPool{
+				Creator: "invalid_address",
+				Ticket:  "Ticket",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid ticket",
+			msg: types.MsgFundPromoPool{
+				Creator: sample.AccAddress(),
+			},
+			err: types.ErrInvalidTicket,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgFundPromoPool{
+				Creator: sample.AccAddress(),
+				Ticket:  "Ticket",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgIssueFreeBetValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgIssueFreeBet
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: types.MsgIssueFreeBet{
+				Creator: "invalid_address",
+				Ticket:  "Ticket",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid ticket",
+			msg: types.MsgIssueFreeBet{
+				Creator: sample.AccAddress(),
+				Ticket:  "invalid ticket",
+			},
+			err: types.ErrInvalidTicket,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgIssueFreeBet{
+				Creator: sample.AccAddress(),
+				Ticket:  "Ticket",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestIssueFreeBetTicketPayloadValidation(t *testing.T) {
+	blockTime := int64(1700000000)
+
+	tests := []struct {
+		name    string
+		payload types.IssueFreeBetTicketPayload
+		err     error
+	}{
+		{
+			name: "invalid uid",
+			payload: types.IssueFreeBetTicketPayload{
+				UID:     "invalid",
+				Address: sample.AccAddress(),
+				Denom:   params.DefaultBondDenom,
+				Amount:  sdk.NewInt(1000),
+			},
+			err: types.ErrInvalidFreeBetCredit,
+		},
+		{
+			name: "invalid address",
+			payload: types.IssueFreeBetTicketPayload{
+				UID:     uuid.NewString(),
+				Address: "invalid_address",
+				Denom:   params.DefaultBondDenom,
+				Amount:  sdk.NewInt(1000),
+			},
+			err: types.ErrInvalidFreeBetCredit,
+		},
+		{
+			name: "zero amount",
+			payload: types.IssueFreeBetTicketPayload{
+				UID:     uuid.NewString(),
+				Address: sample.AccAddress(),
+				Denom:   params.DefaultBondDenom,
+				Amount:  sdk.ZeroInt(),
+			},
+			err: types.ErrInvalidAmount,
+		},
+		{
+			name: "expired",
+			payload: types.IssueFreeBetTicketPayload{
+				UID:       uuid.NewString(),
+				Address:   sample.AccAddress(),
+				Denom:     params.DefaultBondDenom,
+				Amount:    sdk.NewInt(1000),
+				ExpiresAt: blockTime,
+			},
+			err: types.ErrInvalidFreeBetCredit,
+		},
+		{
+			name: "valid",
+			payload: types.IssueFreeBetTicketPayload{
+				UID:       uuid.NewString(),
+				Address:   sample.AccAddress(),
+				Denom:     params.DefaultBondDenom,
+				Amount:    sdk.NewInt(1000),
+				ExpiresAt: blockTime + 1,
+			},
+		},
+		{
+			name: "valid without expiration",
+			payload: types.IssueFreeBetTicketPayload{
+				UID:     uuid.NewString(),
+				Address: sample.AccAddress(),
+				Denom:   params.DefaultBondDenom,
+				Amount:  sdk.NewInt(1000),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate(blockTime)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFreeBetCreditIsExpired(t *testing.T) {
+	credit := types.FreeBetCredit{}
+	require.False(t, credit.IsExpired(1700000000))
+
+	credit.ExpiresAt = 1700000000
+	require.False(t, credit.IsExpired(1699999999))
+	require.True(t, credit.IsExpired(1700000000))
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -750,1207 +751,5560 @@ func (m *QuerySettledBetsOfHeightResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "sgenetwork.sge.bet.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "sgenetwork.sge.bet.QueryParamsResponse")
-	proto.RegisterType((*QueryBetRequest)(nil), "sgenetwork.sge.bet.QueryBetRequest")
-	proto.RegisterType((*QueryBetResponse)(nil), "sgenetwork.sge.bet.QueryBetResponse")
-	proto.RegisterType((*QueryBetsRequest)(nil), "sgenetwork.sge.bet.QueryBetsRequest")
-	proto.RegisterType((*QueryBetsResponse)(nil), "sgenetwork.sge.bet.QueryBetsResponse")
-	proto.RegisterType((*QueryBetsByCreatorRequest)(nil), "sgenetwork.sge.bet.QueryBetsByCreatorRequest")
-	proto.RegisterType((*QueryBetsByCreatorResponse)(nil), "sgenetwork.sge.bet.QueryBetsByCreatorResponse")
-	proto.RegisterType((*QueryBetsByUIDsRequest)(nil), "sgenetwork.sge.bet.QueryBetsByUIDsRequest")
-	proto.RegisterType((*QueryBetsByUIDsResponse)(nil), "sgenetwork.sge.bet.QueryBetsByUIDsResponse")
-	proto.RegisterType((*QueryPendingBetsRequest)(nil), "sgenetwork.sge.bet.QueryPendingBetsRequest")
-	proto.RegisterType((*QueryPendingBetsResponse)(nil), "sgenetwork.sge.bet.QueryPendingBetsResponse")
-	proto.RegisterType((*QuerySettledBetsOfHeightRequest)(nil), "sgenetwork.sge.bet.QuerySettledBetsOfHeightRequest")
-	proto.RegisterType((*QuerySettledBetsOfHeightResponse)(nil), "sgenetwork.sge.bet.QuerySettledBetsOfHeightResponse")
+// QueryBettorFeeTierRequest is the request type for the bettor fee tier query
+// Query/BettorFeeTier RPC method.
+type QueryBettorFeeTierRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() { proto.RegisterFile("sge/bet/query.proto", fileDescriptor_9b93ca36013f0806) }
-
-var fileDescriptor_9b93ca36013f0806 = []byte{
-	// 887 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0xa4, 0x9b, 0x2a, 0x2f, 0xac, 0x42, 0xa7, 0x85, 0x64, 0x2d, 0x9a, 0x74, 0x4d,
-	0x37, 0x5d, 0xb5, 0x1b, 0x8f, 0xda, 0xe5, 0x00, 0xe2, 0x82, 0x0c, 0x2a, 0x70, 0x40, 0x5b, 0x8c,
-	0x7a, 0xd9, 0x4b, 0x64, 0x27, 0x53, 0xd7, 0xb4, 0xf1, 0xa4, 0x9e, 0x09, 0x10, 0x45, 0x91, 0x10,
-	0xe7, 0x22, 0x21, 0x51, 0xc1, 0x85, 0xcf, 0xc0, 0xe7, 0xe8, 0x81, 0x43, 0x25, 0x2e, 0x9c, 0x2a,
-	0xd4, 0x72, 0xea, 0xa7, 0x40, 0x9e, 0x99, 0x24, 0x76, 0x93, 0x34, 0x41, 0x2a, 0xea, 0xa5, 0x6e,
-	0x9e, 0xdf, 0xbc, 0xff, 0xef, 0xbd, 0x99, 0xf7, 0xc6, 0xb0, 0xcc, 0x3c, 0x82, 0x5d, 0xc2, 0xf1,
-	0x49, 0x87, 0x84, 0x5d, 0xb3, 0x1d, 0x52, 0x4e, 0x11, 0x62, 0x1e, 0x09, 0x08, 0xff, 0x96, 0x86,
-	0x47, 0x26, 0xf3, 0x88, 0xe9, 0x12, 0xae, 0xaf, 0x78, 0xd4, 0xa3, 0xe2, 0x35, 0x8e, 0xfe, 0x93,
-	0x9e, 0xfa, 0x3b, 0x1e, 0xa5, 0xde, 0x31, 0xc1, 0x4e, 0xdb, 0xc7, 0x4e, 0x10, 0x50, 0xee, 0x70,
-	0x9f, 0x06, 0x4c, 0xbd, 0xdd, 0x6c, 0x50, 0xd6, 0xa2, 0x0c, 0xbb, 0x0e, 0x23, 0x52, 0x00, 0x7f,
-	0xb3, 0xed, 0x12, 0xee, 0x6c, 0xe3, 0xb6, 0xe3, 0xf9, 0x81, 0x70, 0x56, 0xbe, 0x2b, 0x03, 0x90,
-	0xb6, 0x13, 0x3a, 0xad, 0x41, 0x84, 0xa5, 0x81, 0xd5, 0x25, 0x5c, 0x99, 0x8a, 0x91, 0xa9, 0xe5,
-	0x84, 0x47, 0x84, 0xab, 0x87, 0x7c, 0x61, 0xac, 0x00, 0xfa, 0x32, 0xd2, 0xd8, 0x13, 0x01, 0x6c,
-	0x72, 0xd2, 0x21, 0x8c, 0x1b, 0xaf, 0x60, 0x39, 0x61, 0x65, 0x6d, 0x1a, 0x30, 0x82, 0xde, 0x87,
-	0xac, 0x14, 0x2a, 0x69, 0x6b, 0xda, 0xf3, 0xfc, 0x8e, 0x6e, 0x8e, 0xe7, 0x6c, 0xca, 0x35, 0xd6,
-	0xc2, 0xf9, 0x65, 0x25, 0x65, 0x2b, 0x7f, 0x63, 0x17, 0x0a, 0x22, 0xa0, 0x45, 0xb8, 0xd2, 0x40,
-	0x25, 0x58, 0x6c, 0x84, 0xc4, 0xe1, 0x34, 0x14, 0xd1, 0x72, 0xf6, 0xe0, 0x27, 0x7a, 0x02, 0x99,
-	0x8e, 0xdf, 0x2c, 0xa5, 0x23, 0xab, 0xb5, 0x78, 0x73, 0x59, 0x89, 0x7e, 0xda, 0xd1, 0x1f, 0xe3,
-	0x7b, 0x0d, 0xde, 0x1c, 0x05, 0x52, 0x58, 0x18, 0x32, 0x2e, 0xe1, 0x8a, 0xa9, 0x38, 0x89, 0xc9,
-	0x22, 0x5c, 0x01, 0x45, 0x9e, 0xe8, 0x43, 0xc8, 0xca, 0x22, 0x08, 0x8d, 0xfc, 0xce, 0xea, 0xed,
-	0x35, 0xaa, 0x44, 0x5f, 0x88, 0xc7, 0x20, 0x15, 0x69, 0x34, 0x5e, 0x8f, 0x08, 0x06, 0xf5, 0x42,
-	0xbb, 0x00, 0xa3, 0xbd, 0x51, 0x20, 0x55, 0x53, 0x6e, 0xa4, 0x19, 0x6d, 0xa4, 0x29, 0x4f, 0x8a,
-	0xda, 0x48, 0x73, 0xcf, 0xf1, 0x88, 0x5a, 0x6b, 0xc7, 0x56, 0x1a, 0x3f, 0x6a, 0xb0, 0x14, 0x0b,
-	0x7e, 0x3b, 0xbf, 0xcc, 0x9c, 0xf9, 0x7d, 0x9a, 0xc0, 0x91, 0x39, 0x6e, 0xcc, 0xc4, 0x91, 0x6a,
-	0x09, 0x9e, 0x3e, 0x3c, 0x19, 0xe2, 0x58, 0xdd, 0x8f, 0xe5, 0xfe, 0xdc, 0x73, 0xd2, 0xf1, 0x83,
-	0x90, 0x4e, 0x1c, 0x04, 0xe3, 0x17, 0x0d, 0xf4, 0x49, 0xfa, 0x0f, 0x5e, 0x97, 0x0f, 0xe0, 0xed,
-	0x18, 0xd7, 0xfe, 0xe7, 0x9f, 0x0c, 0x4f, 0x42, 0x05, 0x1e, 0xf9, 0x9c, 0x88, 0x0e, 0xc9, 0x3c,
-	0xcf, 0x59, 0xb9, 0x9b, 0xcb, 0x8a, 0x34, 0xd8, 0xf2, 0x61, 0x74, 0xa1, 0x38, 0xb6, 0x54, 0xe5,
-	0xb3, 0x0d, 0x0b, 0x2e, 0xe1, 0x6c, 0xbe, 0x84, 0x84, 0x2b, 0xda, 0x02, 0x14, 0x50, 0x5e, 0x3f,
-	0xa0, 0x9d, 0xa0, 0x59, 0x77, 0x09, 0xaf, 0x77, 0xfc, 0x26, 0x2b, 0xa5, 0x23, 0x6d, 0xbb, 0x10,
-	0x50, 0xbe, 0x1b, 0xbd, 0xb0, 0x08, 0xdf, 0xf7, 0x9b, 0x2c, 0x6a, 0x1e, 0xa9, 0xbd, 0x47, 0x82,
-	0xa6, 0x1f, 0x78, 0xff, 0xc3, 0x09, 0x46, 0xab, 0x00, 0xb2, 0x4f, 0xea, 0xc3, 0x16, 0xb6, 0x73,
-	0xd2, 0xb2, 0xef, 0x37, 0x8d, 0x33, 0x0d, 0x4a, 0xe3, 0x08, 0x0f, 0xbe, 0x9f, 0xa7, 0x1a, 0x54,
-	0x04, 0xd6, 0x57, 0x84, 0xf3, 0x63, 0x12, 0x55, 0x8c, 0xbd, 0x3a, 0xf8, 0x8c, 0xf8, 0xde, 0x21,
-	0xbf, 0xef, 0x0a, 0x3d, 0x85, 0x37, 0xdc, 0x63, 0xda, 0x38, 0xaa, 0x1f, 0x8a, 0xf0, 0x02, 0x3b,
-	0x63, 0xe7, 0x85, 0x4d, 0x2a, 0x1a, 0xbf, 0x69, 0xb0, 0x36, 0x1d, 0xe7, 0xa1, 0xab, 0xb5, 0xf3,
-	0xc7, 0x22, 0x3c, 0x12, 0x78, 0x28, 0x84, 0xac, 0x1c, 0xf7, 0xa8, 0x3a, 0x09, 0x60, 0xfc, 0x66,
-	0xd1, 0x37, 0x66, 0xfa, 0x49, 0x41, 0xa3, 0xf8, 0xc3, 0x9f, 0xff, 0xfc, 0x9c, 0x5e, 0x42, 0x05,
-	0x9c, 0xbc, 0xe3, 0x50, 0x08, 0x19, 0x8b, 0x70, 0xf4, 0xee, 0xd4, 0x40, 0xa3, 0x3b, 0x46, 0x5f,
-	0xbf, 0xdb, 0x49, 0x49, 0xad, 0x09, 0x29, 0x1d, 0x95, 0x86, 0x52, 0x3d, 0x35, 0x81, 0xfa, 0xb8,
-	0xd7, 0xf1, 0x9b, 0x7d, 0xf4, 0xab, 0x06, 0x8f, 0x13, 0x33, 0x08, 0xd5, 0xee, 0x8a, 0x3c, 0x36,
-	0x2b, 0x75, 0x73, 0x5e, 0x77, 0x85, 0xb4, 0x21, 0x90, 0x9e, 0xa2, 0xca, 0x10, 0x49, 0x11, 0xc5,
-	0xd0, 0xc4, 0x00, 0xf8, 0x1a, 0x16, 0xa2, 0x08, 0xe8, 0xce, 0x4c, 0x87, 0xd5, 0x7f, 0x36, 0xc3,
-	0x4b, 0xa9, 0xbf, 0x25, 0xd4, 0x0b, 0xe8, 0x31, 0x8e, 0x7d, 0x49, 0x30, 0x74, 0xa6, 0x41, 0x3e,
-	0xd6, 0xb7, 0x68, 0x6b, 0xfa, 0x5e, 0x8e, 0x0d, 0x18, 0xfd, 0xc5, 0x7c, 0xce, 0x8a, 0x60, 0x53,
-	0x10, 0xac, 0x23, 0x23, 0x41, 0x80, 0xdb, 0xd2, 0x15, 0xf7, 0x46, 0x33, 0xa6, 0x8f, 0x7e, 0xd7,
-	0x60, 0x79, 0x42, 0xa3, 0xa0, 0x97, 0x53, 0x15, 0xa7, 0x77, 0xb9, 0xfe, 0xde, 0x7f, 0x5b, 0xa4,
-	0x70, 0x5f, 0x08, 0xdc, 0x2a, 0x5a, 0x4f, 0xe2, 0x32, 0xb9, 0x04, 0xf7, 0xe2, 0x0d, 0xdf, 0x47,
-	0xa7, 0x1a, 0xc0, 0x68, 0xfc, 0xa3, 0xcd, 0x19, 0x67, 0x23, 0x76, 0xbd, 0xe8, 0x5b, 0x73, 0xf9,
-	0x2a, 0xaa, 0x67, 0x82, 0xaa, 0x82, 0x56, 0x13, 0x54, 0x35, 0xb7, 0x5b, 0x8b, 0x6e, 0x09, 0xdc,
-	0x13, 0x17, 0x52, 0xdf, 0xfa, 0xe8, 0xfc, 0xaa, 0xac, 0x5d, 0x5c, 0x95, 0xb5, 0xbf, 0xaf, 0xca,
-	0xda, 0x4f, 0xd7, 0xe5, 0xd4, 0xc5, 0x75, 0x39, 0xf5, 0xd7, 0x75, 0x39, 0xf5, 0xba, 0xea, 0xf9,
-	0xfc, 0xb0, 0xe3, 0x9a, 0x0d, 0xda, 0x8a, 0x42, 0xd4, 0x94, 0xb0, 0x08, 0xf7, 0x9d, 0x08, 0xc8,
-	0xbb, 0x6d, 0xc2, 0xdc, 0xac, 0xf8, 0x96, 0x7c, 0xf9, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x28,
-	0x56, 0x0d, 0x0a, 0x18, 0x0b, 0x00, 0x00,
+func (m *QueryBettorFeeTierRequest) Reset()         { *m = QueryBettorFeeTierRequest{} }
+func (m *QueryBettorFeeTierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBettorFeeTierRequest) ProtoMessage()    {}
+func (*QueryBettorFeeTierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{14}
+}
+func (m *QueryBettorFeeTierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBettorFeeTierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBettorFeeTierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBettorFeeTierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBettorFeeTierRequest.Merge(m, src)
+}
+func (m *QueryBettorFeeTierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBettorFeeTierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBettorFeeTierRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryBettorFeeTierRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Bet by uid.
-	Bet(ctx context.Context, in *QueryBetRequest, opts ...grpc.CallOption) (*QueryBetResponse, error)
-	// Queries list of Bet items of a certain creator sorted by timestamp.
-	BetsByCreator(ctx context.Context, in *QueryBetsByCreatorRequest, opts ...grpc.CallOption) (*QueryBetsByCreatorResponse, error)
-	// Queries list of Bet items.
-	Bets(ctx context.Context, in *QueryBetsRequest, opts ...grpc.CallOption) (*QueryBetsResponse, error)
-	// Queries list of pending Bet items.
-	PendingBets(ctx context.Context, in *QueryPendingBetsRequest, opts ...grpc.CallOption) (*QueryPendingBetsResponse, error)
-	// Queries list of settled Bet items of a certain height.
-	SettledBetsOfHeight(ctx context.Context, in *QuerySettledBetsOfHeightRequest, opts ...grpc.CallOption) (*QuerySettledBetsOfHeightResponse, error)
-	// Queries a list of Bet items filtered by uid list.
-	BetsByUIDs(ctx context.Context, in *QueryBetsByUIDsRequest, opts ...grpc.CallOption) (*QueryBetsByUIDsResponse, error)
+func (m *QueryBettorFeeTierRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryBettorFeeTierRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryBettorFeeTierResponse is the response type for the bettor fee tier
+// query Query/BettorFeeTier RPC method.
+type QueryBettorFeeTierResponse struct {
+	// volume is the wagered volume of the bettor in the denom.
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+	// fee_tier is the fee tier in effect for the bettor.
+	FeeTier FeeTier `protobuf:"bytes,2,opt,name=fee_tier,json=feeTier,proto3" json:"fee_tier"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBettorFeeTierResponse) Reset()         { *m = QueryBettorFeeTierResponse{} }
+func (m *QueryBettorFeeTierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBettorFeeTierResponse) ProtoMessage()    {}
+func (*QueryBettorFeeTierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{15}
+}
+func (m *QueryBettorFeeTierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBettorFeeTierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBettorFeeTierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBettorFeeTierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBettorFeeTierResponse.Merge(m, src)
+}
+func (m *QueryBettorFeeTierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBettorFeeTierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBettorFeeTierResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Bet(ctx context.Context, in *QueryBetRequest, opts ...grpc.CallOption) (*QueryBetResponse, error) {
-	out := new(QueryBetResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/Bet", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBettorFeeTierResponse proto.InternalMessageInfo
+
+func (m *QueryBettorFeeTierResponse) GetFeeTier() FeeTier {
+	if m != nil {
+		return m.FeeTier
 	}
-	return out, nil
+	return FeeTier{}
 }
 
-func (c *queryClient) BetsByCreator(ctx context.Context, in *QueryBetsByCreatorRequest, opts ...grpc.CallOption) (*QueryBetsByCreatorResponse, error) {
-	out := new(QueryBetsByCreatorResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/BetsByCreator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBettorLimitsRequest is the request type for the
+// Query/BettorLimits RPC method.
+type QueryBettorLimitsRequest struct {
+	// address is the bettor address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (c *queryClient) Bets(ctx context.Context, in *QueryBetsRequest, opts ...grpc.CallOption) (*QueryBetsResponse, error) {
-	out := new(QueryBetsResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/Bets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBettorLimitsRequest) Reset()         { *m = QueryBettorLimitsRequest{} }
+func (m *QueryBettorLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBettorLimitsRequest) ProtoMessage()    {}
+func (*QueryBettorLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{16}
+}
+func (m *QueryBettorLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBettorLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBettorLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBettorLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBettorLimitsRequest.Merge(m, src)
+}
+func (m *QueryBettorLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBettorLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBettorLimitsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PendingBets(ctx context.Context, in *QueryPendingBetsRequest, opts ...grpc.CallOption) (*QueryPendingBetsResponse, error) {
-	out := new(QueryPendingBetsResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/PendingBets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBettorLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryBettorLimitsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) SettledBetsOfHeight(ctx context.Context, in *QuerySettledBetsOfHeightRequest, opts ...grpc.CallOption) (*QuerySettledBetsOfHeightResponse, error) {
-	out := new(QuerySettledBetsOfHeightResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/SettledBetsOfHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBettorLimitsResponse is the response type for the
+// Query/BettorLimits RPC method.
+type QueryBettorLimitsResponse struct {
+	// limits is the responsible gambling settings of the bettor.
+	Limits BettorLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits"`
 }
 
-func (c *queryClient) BetsByUIDs(ctx context.Context, in *QueryBetsByUIDsRequest, opts ...grpc.CallOption) (*QueryBetsByUIDsResponse, error) {
-	out := new(QueryBetsByUIDsResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/BetsByUIDs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBettorLimitsResponse) Reset()         { *m = QueryBettorLimitsResponse{} }
+func (m *QueryBettorLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBettorLimitsResponse) ProtoMessage()    {}
+func (*QueryBettorLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{17}
+}
+func (m *QueryBettorLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBettorLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBettorLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Bet by uid.
-	Bet(context.Context, *QueryBetRequest) (*QueryBetResponse, error)
-	// Queries list of Bet items of a certain creator sorted by timestamp.
-	BetsByCreator(context.Context, *QueryBetsByCreatorRequest) (*QueryBetsByCreatorResponse, error)
-	// Queries list of Bet items.
-	Bets(context.Context, *QueryBetsRequest) (*QueryBetsResponse, error)
-	// Queries list of pending Bet items.
-	PendingBets(context.Context, *QueryPendingBetsRequest) (*QueryPendingBetsResponse, error)
-	// Queries list of settled Bet items of a certain height.
-	SettledBetsOfHeight(context.Context, *QuerySettledBetsOfHeightRequest) (*QuerySettledBetsOfHeightResponse, error)
-	// Queries a list of Bet items filtered by uid list.
-	BetsByUIDs(context.Context, *QueryBetsByUIDsRequest) (*QueryBetsByUIDsResponse, error)
+func (m *QueryBettorLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBettorLimitsResponse.Merge(m, src)
+}
+func (m *QueryBettorLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBettorLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBettorLimitsResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryBettorLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryBettorLimitsResponse) GetLimits() BettorLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return BettorLimits{}
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// QueryCalcPayoutRequest is the request type for the
+// Query/CalcPayout RPC method.
+type QueryCalcPayoutRequest struct {
+	// odds_type is the type of the odds value.
+	OddsType OddsType `protobuf:"varint,1,opt,name=odds_type,json=oddsType,proto3,enum=sgenetwork.sge.bet.OddsType" json:"odds_type,omitempty"`
+	// odds_value is the odds value of the odds type.
+	OddsValue string `protobuf:"bytes,2,opt,name=odds_value,json=oddsValue,proto3" json:"odds_value,omitempty"`
+	// amount is the bet amount.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
-func (*UnimplementedQueryServer) Bet(ctx context.Context, req *QueryBetRequest) (*QueryBetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bet not implemented")
+
+func (m *QueryCalcPayoutRequest) Reset()         { *m = QueryCalcPayoutRequest{} }
+func (m *QueryCalcPayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcPayoutRequest) ProtoMessage()    {}
+func (*QueryCalcPayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{18}
 }
-func (*UnimplementedQueryServer) BetsByCreator(ctx context.Context, req *QueryBetsByCreatorRequest) (*QueryBetsByCreatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BetsByCreator not implemented")
+func (m *QueryCalcPayoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Bets(ctx context.Context, req *QueryBetsRequest) (*QueryBetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bets not implemented")
+func (m *QueryCalcPayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalcPayoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PendingBets(ctx context.Context, req *QueryPendingBetsRequest) (*QueryPendingBetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingBets not implemented")
+func (m *QueryCalcPayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcPayoutRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SettledBetsOfHeight(ctx context.Context, req *QuerySettledBetsOfHeightRequest) (*QuerySettledBetsOfHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SettledBetsOfHeight not implemented")
+func (m *QueryCalcPayoutRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) BetsByUIDs(ctx context.Context, req *QueryBetsByUIDsRequest) (*QueryBetsByUIDsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BetsByUIDs not implemented")
+func (m *QueryCalcPayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcPayoutRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryCalcPayoutRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.bet.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryCalcPayoutRequest) GetOddsType() OddsType {
+	if m != nil {
+		return m.OddsType
 	}
-	return interceptor(ctx, in, info, handler)
+	return OddsType_ODDS_TYPE_UNSPECIFIED
 }
 
-func _Query_Bet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Bet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.bet.Query/Bet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Bet(ctx, req.(*QueryBetRequest))
+func (m *QueryCalcPayoutRequest) GetOddsValue() string {
+	if m != nil {
+		return m.OddsValue
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BetsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBetsByCreatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BetsByCreator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.bet.Query/BetsByCreator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BetsByCreator(ctx, req.(*QueryBetsByCreatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryCalcPayoutResponse is the response type for the
+// Query/CalcPayout RPC method.
+type QueryCalcPayoutResponse struct {
+	// payout is the total payout of the bet amount including the bet amount.
+	Payout github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=payout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"payout"`
+	// payout_profit is the profit of the bet amount.
+	PayoutProfit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=payout_profit,json=payoutProfit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"payout_profit"`
+	// conversions is the list of the odds value converted to all of the
+	// supported odds types.
+	Conversions []OddsConversion `protobuf:"bytes,3,rep,name=conversions,proto3" json:"conversions"`
 }
 
-func _Query_Bets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Bets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.bet.Query/Bets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Bets(ctx, req.(*QueryBetsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryCalcPayoutResponse) Reset()         { *m = QueryCalcPayoutResponse{} }
+func (m *QueryCalcPayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCalcPayoutResponse) ProtoMessage()    {}
+func (*QueryCalcPayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{19}
 }
-
-func _Query_PendingBets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingBetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingBets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.bet.Query/PendingBets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingBets(ctx, req.(*QueryPendingBetsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryCalcPayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_SettledBetsOfHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySettledBetsOfHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SettledBetsOfHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.bet.Query/SettledBetsOfHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SettledBetsOfHeight(ctx, req.(*QuerySettledBetsOfHeightRequest))
+func (m *QueryCalcPayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCalcPayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCalcPayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCalcPayoutResponse.Merge(m, src)
+}
+func (m *QueryCalcPayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCalcPayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCalcPayoutResponse.DiscardUnknown(m)
 }
 
-func _Query_BetsByUIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBetsByUIDsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BetsByUIDs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.bet.Query/BetsByUIDs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BetsByUIDs(ctx, req.(*QueryBetsByUIDsRequest))
+var xxx_messageInfo_QueryCalcPayoutResponse proto.InternalMessageInfo
+
+func (m *QueryCalcPayoutResponse) GetConversions() []OddsConversion {
+	if m != nil {
+		return m.Conversions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sgenetwork.sge.bet.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Bet",
-			Handler:    _Query_Bet_Handler,
-		},
-		{
-			MethodName: "BetsByCreator",
-			Handler:    _Query_BetsByCreator_Handler,
-		},
-		{
-			MethodName: "Bets",
-			Handler:    _Query_Bets_Handler,
-		},
-		{
-			MethodName: "PendingBets",
-			Handler:    _Query_PendingBets_Handler,
-		},
-		{
-			MethodName: "SettledBetsOfHeight",
-			Handler:    _Query_SettledBetsOfHeight_Handler,
-		},
-		{
-			MethodName: "BetsByUIDs",
-			Handler:    _Query_BetsByUIDs_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sge/bet/query.proto",
+// OddsConversion is the odds value converted to a certain odds type and the
+// payout of the bet amount calculated by the converted value.
+type OddsConversion struct {
+	// odds_type is the type of the converted odds value.
+	OddsType OddsType `protobuf:"varint,1,opt,name=odds_type,json=oddsType,proto3,enum=sgenetwork.sge.bet.OddsType" json:"odds_type,omitempty"`
+	// odds_value is the converted odds value.
+	OddsValue string `protobuf:"bytes,2,opt,name=odds_value,json=oddsValue,proto3" json:"odds_value,omitempty"`
+	// payout is the total payout of the bet amount by the converted odds value.
+	Payout github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=payout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"payout"`
+	// payout_profit is the profit of the bet amount by the converted odds
+	// value.
+	PayoutProfit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=payout_profit,json=payoutProfit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"payout_profit"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OddsConversion) Reset()         { *m = OddsConversion{} }
+func (m *OddsConversion) String() string { return proto.CompactTextString(m) }
+func (*OddsConversion) ProtoMessage()    {}
+func (*OddsConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{20}
+}
+func (m *OddsConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OddsConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OddsConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OddsConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OddsConversion.Merge(m, src)
+}
+func (m *OddsConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *OddsConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_OddsConversion.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_OddsConversion proto.InternalMessageInfo
+
+func (m *OddsConversion) GetOddsType() OddsType {
+	if m != nil {
+		return m.OddsType
+	}
+	return OddsType_ODDS_TYPE_UNSPECIFIED
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OddsConversion) GetOddsValue() string {
+	if m != nil {
+		return m.OddsValue
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFreeBetCreditsRequest is the request type for the
+// Query/FreeBetCredits RPC method.
+type QueryFreeBetCreditsRequest struct {
+	// address is the bettor address.
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFreeBetCreditsRequest) Reset()         { *m = QueryFreeBetCreditsRequest{} }
+func (m *QueryFreeBetCreditsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFreeBetCreditsRequest) ProtoMessage()    {}
+func (*QueryFreeBetCreditsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{21}
+}
+func (m *QueryFreeBetCreditsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFreeBetCreditsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFreeBetCreditsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFreeBetCreditsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreeBetCreditsRequest.Merge(m, src)
 }
-
-func (m *QueryBetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFreeBetCreditsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFreeBetCreditsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreeBetCreditsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uid) > 0 {
-		i -= len(m.Uid)
-		copy(dAtA[i:], m.Uid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFreeBetCreditsRequest proto.InternalMessageInfo
+
+func (m *QueryFreeBetCreditsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryBetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFreeBetCreditsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFreeBetCreditsResponse is the response type for the
+// Query/FreeBetCredits RPC method.
+type QueryFreeBetCreditsResponse struct {
+	Credits    []FreeBetCredit     `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFreeBetCreditsResponse) Reset()         { *m = QueryFreeBetCreditsResponse{} }
+func (m *QueryFreeBetCreditsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFreeBetCreditsResponse) ProtoMessage()    {}
+func (*QueryFreeBetCreditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{22}
+}
+func (m *QueryFreeBetCreditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFreeBetCreditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFreeBetCreditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Bet.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryFreeBetCreditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreeBetCreditsResponse.Merge(m, src)
+}
+func (m *QueryFreeBetCreditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFreeBetCreditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreeBetCreditsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFreeBetCreditsResponse proto.InternalMessageInfo
+
+func (m *QueryFreeBetCreditsResponse) GetCredits() []FreeBetCredit {
+	if m != nil {
+		return m.Credits
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFreeBetCreditsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFreeBetCreditRequest is the request type for the
+// Query/FreeBetCredit RPC method.
+type QueryFreeBetCreditRequest struct {
+	// address is the bettor address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// uid is the universal unique identifier of the credit.
+	Uid string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
 }
 
-func (m *QueryBetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFreeBetCreditRequest) Reset()         { *m = QueryFreeBetCreditRequest{} }
+func (m *QueryFreeBetCreditRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFreeBetCreditRequest) ProtoMessage()    {}
+func (*QueryFreeBetCreditRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{23}
+}
+func (m *QueryFreeBetCreditRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFreeBetCreditRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFreeBetCreditRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFreeBetCreditRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreeBetCreditRequest.Merge(m, src)
+}
+func (m *QueryFreeBetCreditRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFreeBetCreditRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreeBetCreditRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFreeBetCreditRequest proto.InternalMessageInfo
+
+func (m *QueryFreeBetCreditRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFreeBetCreditRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
 }
 
-func (m *QueryBetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Bet) > 0 {
-		for iNdEx := len(m.Bet) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// QueryFreeBetCreditResponse is the response type for the
+// Query/FreeBetCredit RPC method.
+type QueryFreeBetCreditResponse struct {
+	Credit FreeBetCredit `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit"`
+}
+
+func (m *QueryFreeBetCreditResponse) Reset()         { *m = QueryFreeBetCreditResponse{} }
+func (m *QueryFreeBetCreditResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFreeBetCreditResponse) ProtoMessage()    {}
+func (*QueryFreeBetCreditResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{24}
+}
+func (m *QueryFreeBetCreditResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFreeBetCreditResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFreeBetCreditResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFreeBetCreditResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreeBetCreditResponse.Merge(m, src)
+}
+func (m *QueryFreeBetCreditResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFreeBetCreditResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreeBetCreditResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBetsByCreatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFreeBetCreditResponse proto.InternalMessageInfo
+
+func (m *QueryFreeBetCreditResponse) GetCredit() FreeBetCredit {
+	if m != nil {
+		return m.Credit
 	}
-	return dAtA[:n], nil
+	return FreeBetCredit{}
 }
 
-func (m *QueryBetsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAffiliatesRequest is the request type for the
+// Query/Affiliates RPC method.
+type QueryAffiliatesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBetsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAffiliatesRequest) Reset()         { *m = QueryAffiliatesRequest{} }
+func (m *QueryAffiliatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAffiliatesRequest) ProtoMessage()    {}
+func (*QueryAffiliatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{25}
+}
+func (m *QueryAffiliatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAffiliatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAffiliatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAffiliatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAffiliatesRequest.Merge(m, src)
+}
+func (m *QueryAffiliatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAffiliatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAffiliatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBetsByCreatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAffiliatesRequest proto.InternalMessageInfo
+
+func (m *QueryAffiliatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBetsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAffiliatesResponse is the response type for the
+// Query/Affiliates RPC method.
+type QueryAffiliatesResponse struct {
+	Affiliates []Affiliate         `protobuf:"bytes,1,rep,name=affiliates,proto3" json:"affiliates"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBetsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAffiliatesResponse) Reset()         { *m = QueryAffiliatesResponse{} }
+func (m *QueryAffiliatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAffiliatesResponse) ProtoMessage()    {}
+func (*QueryAffiliatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{26}
+}
+func (m *QueryAffiliatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAffiliatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAffiliatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Bet) > 0 {
-		for iNdEx := len(m.Bet) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryAffiliatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAffiliatesResponse.Merge(m, src)
+}
+func (m *QueryAffiliatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAffiliatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAffiliatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAffiliatesResponse proto.InternalMessageInfo
+
+func (m *QueryAffiliatesResponse) GetAffiliates() []Affiliate {
+	if m != nil {
+		return m.Affiliates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBetsByUIDsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAffiliatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBetsByUIDsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAffiliateRequest is the request type for the
+// Query/Affiliate RPC method.
+type QueryAffiliateRequest struct {
+	// address is the affiliate address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryBetsByUIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Items[iNdEx])
-			copy(dAtA[i:], m.Items[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Items[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAffiliateRequest) Reset()         { *m = QueryAffiliateRequest{} }
+func (m *QueryAffiliateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAffiliateRequest) ProtoMessage()    {}
+func (*QueryAffiliateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{27}
+}
+func (m *QueryAffiliateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAffiliateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAffiliateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAffiliateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAffiliateRequest.Merge(m, src)
+}
+func (m *QueryAffiliateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAffiliateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAffiliateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBetsByUIDsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAffiliateRequest proto.InternalMessageInfo
+
+func (m *QueryAffiliateRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBetsByUIDsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAffiliateResponse is the response type for the
+// Query/Affiliate RPC method.
+type QueryAffiliateResponse struct {
+	Affiliate Affiliate `protobuf:"bytes,1,opt,name=affiliate,proto3" json:"affiliate"`
+	// earnings is the accrued bet fee share of the affiliate in each denom.
+	Earnings []AffiliateEarnings `protobuf:"bytes,2,rep,name=earnings,proto3" json:"earnings"`
 }
 
-func (m *QueryBetsByUIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NotFoundBetUids) > 0 {
-		for iNdEx := len(m.NotFoundBetUids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NotFoundBetUids[iNdEx])
-			copy(dAtA[i:], m.NotFoundBetUids[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.NotFoundBetUids[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *QueryAffiliateResponse) Reset()         { *m = QueryAffiliateResponse{} }
+func (m *QueryAffiliateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAffiliateResponse) ProtoMessage()    {}
+func (*QueryAffiliateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{28}
+}
+func (m *QueryAffiliateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAffiliateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAffiliateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Bets) > 0 {
-		for iNdEx := len(m.Bets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryAffiliateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAffiliateResponse.Merge(m, src)
+}
+func (m *QueryAffiliateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAffiliateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAffiliateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAffiliateResponse proto.InternalMessageInfo
+
+func (m *QueryAffiliateResponse) GetAffiliate() Affiliate {
+	if m != nil {
+		return m.Affiliate
 	}
-	return len(dAtA) - i, nil
+	return Affiliate{}
 }
 
-func (m *QueryPendingBetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAffiliateResponse) GetEarnings() []AffiliateEarnings {
+	if m != nil {
+		return m.Earnings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPendingBetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReferralRequest is the request type for the
+// Query/Referral RPC method.
+type QueryReferralRequest struct {
+	// bettor is the referred bettor address.
+	Bettor string `protobuf:"bytes,1,opt,name=bettor,proto3" json:"bettor,omitempty"`
 }
 
-func (m *QueryPendingBetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MarketUid) > 0 {
-		i -= len(m.MarketUid)
-		copy(dAtA[i:], m.MarketUid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketUid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryReferralRequest) Reset()         { *m = QueryReferralRequest{} }
+func (m *QueryReferralRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralRequest) ProtoMessage()    {}
+func (*QueryReferralRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{29}
+}
+func (m *QueryReferralRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryReferralRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralRequest.Merge(m, src)
+}
+func (m *QueryReferralRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPendingBetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReferralRequest proto.InternalMessageInfo
+
+func (m *QueryReferralRequest) GetBettor() string {
+	if m != nil {
+		return m.Bettor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPendingBetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReferralResponse is the response type for the
+// Query/Referral RPC method.
+type QueryReferralResponse struct {
+	Referral Referral `protobuf:"bytes,1,opt,name=referral,proto3" json:"referral"`
 }
 
-func (m *QueryPendingBetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Bet) > 0 {
-		for iNdEx := len(m.Bet) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryReferralResponse) Reset()         { *m = QueryReferralResponse{} }
+func (m *QueryReferralResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReferralResponse) ProtoMessage()    {}
+func (*QueryReferralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{30}
+}
+func (m *QueryReferralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReferralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReferralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryReferralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReferralResponse.Merge(m, src)
+}
+func (m *QueryReferralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReferralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReferralResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySettledBetsOfHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReferralResponse proto.InternalMessageInfo
+
+func (m *QueryReferralResponse) GetReferral() Referral {
+	if m != nil {
+		return m.Referral
 	}
-	return dAtA[:n], nil
+	return Referral{}
 }
 
-func (m *QuerySettledBetsOfHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBettorCapsRequest is the request type for the
+// Query/BettorCaps RPC method.
+type QueryBettorCapsRequest struct {
+	// address is the bettor address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// market_uid is the universal unique identifier of the market.
+	MarketUid string `protobuf:"bytes,2,opt,name=market_uid,json=marketUid,proto3" json:"market_uid,omitempty"`
+	// odds_uid is the universal unique identifier of the odds, the odds caps
+	// are not returned if it is empty.
+	OddsUid string `protobuf:"bytes,3,opt,name=odds_uid,json=oddsUid,proto3" json:"odds_uid,omitempty"`
 }
 
-func (m *QuerySettledBetsOfHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBettorCapsRequest) Reset()         { *m = QueryBettorCapsRequest{} }
+func (m *QueryBettorCapsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBettorCapsRequest) ProtoMessage()    {}
+func (*QueryBettorCapsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{31}
+}
+func (m *QueryBettorCapsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBettorCapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBettorCapsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBettorCapsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBettorCapsRequest.Merge(m, src)
+}
+func (m *QueryBettorCapsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBettorCapsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBettorCapsRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySettledBetsOfHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBettorCapsRequest proto.InternalMessageInfo
+
+func (m *QueryBettorCapsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySettledBetsOfHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBettorCapsRequest) GetMarketUid() string {
+	if m != nil {
+		return m.MarketUid
+	}
+	return ""
 }
 
-func (m *QuerySettledBetsOfHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryBettorCapsRequest) GetOddsUid() string {
+	if m != nil {
+		return m.OddsUid
 	}
-	if len(m.Bet) > 0 {
-		for iNdEx := len(m.Bet) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return ""
+}
+
+// QueryBettorCapsResponse is the response type for the
+// Query/BettorCaps RPC method.
+type QueryBettorCapsResponse struct {
+	// market contains the totals and the remaining amounts of the market caps.
+	Market RemainingBettorCaps `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
+	// odds contains the totals and the remaining amounts of the odds caps.
+	Odds *RemainingBettorCaps `protobuf:"bytes,2,opt,name=odds,proto3" json:"odds,omitempty"`
+}
+
+func (m *QueryBettorCapsResponse) Reset()         { *m = QueryBettorCapsResponse{} }
+func (m *QueryBettorCapsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBettorCapsResponse) ProtoMessage()    {}
+func (*QueryBettorCapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b93ca36013f0806, []int{32}
+}
+func (m *QueryBettorCapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBettorCapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBettorCapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBettorCapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBettorCapsResponse.Merge(m, src)
+}
+func (m *QueryBettorCapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBettorCapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBettorCapsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryBettorCapsResponse proto.InternalMessageInfo
+
+func (m *QueryBettorCapsResponse) GetMarket() RemainingBettorCaps {
+	if m != nil {
+		return m.Market
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return RemainingBettorCaps{}
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryBettorCapsResponse) GetOdds() *RemainingBettorCaps {
+	if m != nil {
+		return m.Odds
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "sgenetwork.sge.bet.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "sgenetwork.sge.bet.QueryParamsResponse")
+	proto.RegisterType((*QueryBetRequest)(nil), "sgenetwork.sge.bet.QueryBetRequest")
+	proto.RegisterType((*QueryBetResponse)(nil), "sgenetwork.sge.bet.QueryBetResponse")
+	proto.RegisterType((*QueryBetsRequest)(nil), "sgenetwork.sge.bet.QueryBetsRequest")
+	proto.RegisterType((*QueryBetsResponse)(nil), "sgenetwork.sge.bet.QueryBetsResponse")
+	proto.RegisterType((*QueryBetsByCreatorRequest)(nil), "sgenetwork.sge.bet.QueryBetsByCreatorRequest")
+	proto.RegisterType((*QueryBetsByCreatorResponse)(nil), "sgenetwork.sge.bet.QueryBetsByCreatorResponse")
+	proto.RegisterType((*QueryBetsByUIDsRequest)(nil), "sgenetwork.sge.bet.QueryBetsByUIDsRequest")
+	proto.RegisterType((*QueryBetsByUIDsResponse)(nil), "sgenetwork.sge.bet.QueryBetsByUIDsResponse")
+	proto.RegisterType((*QueryPendingBetsRequest)(nil), "sgenetwork.sge.bet.QueryPendingBetsRequest")
+	proto.RegisterType((*QueryPendingBetsResponse)(nil), "sgenetwork.sge.bet.QueryPendingBetsResponse")
+	proto.RegisterType((*QuerySettledBetsOfHeightRequest)(nil), "sgenetwork.sge.bet.QuerySettledBetsOfHeightRequest")
+	proto.RegisterType((*QuerySettledBetsOfHeightResponse)(nil), "sgenetwork.sge.bet.QuerySettledBetsOfHeightResponse")
+	proto.RegisterType((*QueryBettorFeeTierRequest)(nil), "sgenetwork.sge.bet.QueryBettorFeeTierRequest")
+	proto.RegisterType((*QueryBettorFeeTierResponse)(nil), "sgenetwork.sge.bet.QueryBettorFeeTierResponse")
+	proto.RegisterType((*QueryBettorLimitsRequest)(nil), "sgenetwork.sge.bet.QueryBettorLimitsRequest")
+	proto.RegisterType((*QueryBettorLimitsResponse)(nil), "sgenetwork.sge.bet.QueryBettorLimitsResponse")
+	proto.RegisterType((*QueryCalcPayoutRequest)(nil), "sgenetwork.sge.bet.QueryCalcPayoutRequest")
+	proto.RegisterType((*QueryCalcPayoutResponse)(nil), "sgenetwork.sge.bet.QueryCalcPayoutResponse")
+	proto.RegisterType((*OddsConversion)(nil), "sgenetwork.sge.bet.OddsConversion")
+	proto.RegisterType((*QueryFreeBetCreditsRequest)(nil), "sgenetwork.sge.bet.QueryFreeBetCreditsRequest")
+	proto.RegisterType((*QueryFreeBetCreditsResponse)(nil), "sgenetwork.sge.bet.QueryFreeBetCreditsResponse")
+	proto.RegisterType((*QueryFreeBetCreditRequest)(nil), "sgenetwork.sge.bet.QueryFreeBetCreditRequest")
+	proto.RegisterType((*QueryFreeBetCreditResponse)(nil), "sgenetwork.sge.bet.QueryFreeBetCreditResponse")
+	proto.RegisterType((*QueryAffiliatesRequest)(nil), "sgenetwork.sge.bet.QueryAffiliatesRequest")
+	proto.RegisterType((*QueryAffiliatesResponse)(nil), "sgenetwork.sge.bet.QueryAffiliatesResponse")
+	proto.RegisterType((*QueryAffiliateRequest)(nil), "sgenetwork.sge.bet.QueryAffiliateRequest")
+	proto.RegisterType((*QueryAffiliateResponse)(nil), "sgenetwork.sge.bet.QueryAffiliateResponse")
+	proto.RegisterType((*QueryReferralRequest)(nil), "sgenetwork.sge.bet.QueryReferralRequest")
+	proto.RegisterType((*QueryReferralResponse)(nil), "sgenetwork.sge.bet.QueryReferralResponse")
+	proto.RegisterType((*QueryBettorCapsRequest)(nil), "sgenetwork.sge.bet.QueryBettorCapsRequest")
+	proto.RegisterType((*QueryBettorCapsResponse)(nil), "sgenetwork.sge.bet.QueryBettorCapsResponse")
 }
 
-func (m *QueryBetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() { proto.RegisterFile("sge/bet/query.proto", fileDescriptor_9b93ca36013f0806) }
+
+var fileDescriptor_9b93ca36013f0806 = []byte{
+	// 1797 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdb, 0x6f, 0x1b, 0x59,
+	0x19, 0xef, 0xd8, 0xa9, 0x9b, 0x7c, 0xe9, 0x65, 0x73, 0x72, 0x73, 0x26, 0x4d, 0x9c, 0x4c, 0x9b,
+	0xb4, 0x8d, 0xeb, 0x19, 0x25, 0xdd, 0x07, 0x56, 0x8b, 0x80, 0x75, 0x76, 0x53, 0x96, 0x8b, 0x1a,
+	0xbc, 0x5b, 0x90, 0x16, 0x21, 0x33, 0xf6, 0x1c, 0xbb, 0x43, 0xec, 0x39, 0xde, 0x99, 0xe3, 0xb0,
+	0x51, 0x64, 0x84, 0x0a, 0x12, 0x42, 0x5a, 0x10, 0xd2, 0xae, 0x40, 0x42, 0x80, 0x04, 0x42, 0x42,
+	0xbc, 0xf0, 0x07, 0xf0, 0x17, 0xec, 0xe3, 0x4a, 0xbc, 0x20, 0x1e, 0x22, 0xd4, 0xf2, 0xb4, 0xaf,
+	0xfc, 0x03, 0x68, 0xce, 0xf9, 0xe6, 0xe6, 0xeb, 0x6c, 0x48, 0xd5, 0x97, 0x8c, 0xe7, 0x9c, 0xef,
+	0xf2, 0xfb, 0x2e, 0xe7, 0x3b, 0xdf, 0x37, 0x81, 0x79, 0xaf, 0x49, 0x8d, 0x1a, 0xe5, 0xc6, 0xfb,
+	0x5d, 0xea, 0x9e, 0xe8, 0x1d, 0x97, 0x71, 0x46, 0x88, 0xd7, 0xa4, 0x0e, 0xe5, 0x3f, 0x64, 0xee,
+	0x91, 0xee, 0x35, 0xa9, 0x5e, 0xa3, 0x5c, 0x5d, 0x68, 0xb2, 0x26, 0x13, 0xdb, 0x86, 0xff, 0x4b,
+	0x52, 0xaa, 0x37, 0x9b, 0x8c, 0x35, 0x5b, 0xd4, 0x30, 0x3b, 0xb6, 0x61, 0x3a, 0x0e, 0xe3, 0x26,
+	0xb7, 0x99, 0xe3, 0xe1, 0xee, 0x4e, 0x9d, 0x79, 0x6d, 0xe6, 0x19, 0x35, 0xd3, 0xa3, 0x52, 0x81,
+	0x71, 0xbc, 0x5b, 0xa3, 0xdc, 0xdc, 0x35, 0x3a, 0x66, 0xd3, 0x76, 0x04, 0x31, 0xd2, 0x2e, 0x04,
+	0x40, 0x3a, 0xa6, 0x6b, 0xb6, 0x03, 0x09, 0x73, 0xc1, 0x6a, 0x8d, 0x72, 0x5c, 0x5a, 0x09, 0x96,
+	0xea, 0xcc, 0xf1, 0xb8, 0x6b, 0xda, 0x0e, 0xf7, 0xfa, 0x65, 0xb4, 0xec, 0xb6, 0x1d, 0xae, 0x2e,
+	0x07, 0xab, 0xcc, 0xb2, 0xbc, 0x2a, 0x3f, 0xe9, 0x50, 0xdc, 0x08, 0x6d, 0xef, 0xb8, 0xac, 0xcd,
+	0xfa, 0xa9, 0xcd, 0x46, 0xc3, 0x6e, 0xd9, 0x26, 0x0f, 0xa8, 0x97, 0x82, 0x0d, 0xfa, 0x41, 0x87,
+	0x79, 0x5d, 0x97, 0xc6, 0x19, 0xda, 0xa6, 0x7b, 0x44, 0x39, 0x3e, 0xe4, 0x86, 0xb6, 0x00, 0xe4,
+	0x5b, 0xbe, 0xcd, 0x87, 0xc2, 0xa0, 0x0a, 0x7d, 0xbf, 0x4b, 0x3d, 0xae, 0x3d, 0x82, 0xf9, 0xc4,
+	0xaa, 0xd7, 0x61, 0x8e, 0x47, 0xc9, 0x17, 0x20, 0x27, 0x0d, 0xcf, 0x2b, 0x1b, 0xca, 0xdd, 0xd9,
+	0x3d, 0x55, 0x1f, 0x8c, 0x81, 0x2e, 0x79, 0xca, 0x53, 0x9f, 0x9c, 0x15, 0x2e, 0x55, 0x90, 0x5e,
+	0x3b, 0x80, 0x1b, 0x42, 0x60, 0x99, 0x72, 0xd4, 0x41, 0xf2, 0x70, 0xa5, 0xee, 0x52, 0x93, 0x33,
+	0x57, 0x48, 0x9b, 0xa9, 0x04, 0xaf, 0x64, 0x05, 0xb2, 0x5d, 0xdb, 0xca, 0x67, 0xfc, 0xd5, 0xf2,
+	0x95, 0xcf, 0xce, 0x0a, 0xfe, 0x6b, 0xc5, 0xff, 0xa3, 0xfd, 0x58, 0x81, 0x57, 0x22, 0x41, 0x08,
+	0xcb, 0x80, 0x6c, 0x8d, 0x72, 0xc4, 0xb4, 0x3c, 0x0c, 0x53, 0x99, 0x72, 0x04, 0xe4, 0x53, 0x92,
+	0xd7, 0x21, 0x27, 0x9d, 0x20, 0x74, 0xcc, 0xee, 0xad, 0xf5, 0xf3, 0xc8, 0x5d, 0xfd, 0x9b, 0xe2,
+	0x11, 0x98, 0x22, 0x17, 0xb5, 0xf7, 0x22, 0x04, 0x81, 0xbf, 0xc8, 0x01, 0x40, 0x94, 0x2b, 0x08,
+	0x64, 0x5b, 0x97, 0x89, 0xa5, 0xfb, 0x89, 0xa5, 0xcb, 0xcc, 0xc5, 0xc4, 0xd2, 0x0f, 0xcd, 0x26,
+	0x45, 0xde, 0x4a, 0x8c, 0x53, 0xfb, 0x85, 0x02, 0x73, 0x31, 0xe1, 0xfd, 0xf6, 0x65, 0x53, 0xda,
+	0xf7, 0x30, 0x01, 0x47, 0xda, 0x78, 0x67, 0x22, 0x1c, 0xa9, 0x2d, 0x81, 0xa7, 0x07, 0x2b, 0x21,
+	0x9c, 0xf2, 0xc9, 0xbe, 0x8c, 0xcf, 0x05, 0x1b, 0x1d, 0x4f, 0x84, 0x4c, 0x22, 0x11, 0xb4, 0x5f,
+	0x2b, 0xa0, 0x0e, 0xd3, 0xff, 0xd2, 0xfd, 0xf2, 0x1a, 0x2c, 0xc5, 0x70, 0x3d, 0x7e, 0xfb, 0xcd,
+	0x30, 0x13, 0x0a, 0x70, 0xd9, 0xe6, 0x54, 0x9c, 0x90, 0xec, 0xdd, 0x99, 0xf2, 0xcc, 0x67, 0x67,
+	0x05, 0xb9, 0x50, 0x91, 0x0f, 0xed, 0x04, 0x96, 0x07, 0x58, 0xd1, 0x9e, 0x5d, 0x98, 0xaa, 0x51,
+	0xee, 0xa5, 0x33, 0x48, 0x90, 0x92, 0x22, 0x10, 0x87, 0xf1, 0x6a, 0x83, 0x75, 0x1d, 0xab, 0x5a,
+	0xa3, 0xbc, 0xda, 0xb5, 0x2d, 0x2f, 0x9f, 0xf1, 0x75, 0x57, 0x6e, 0x38, 0x8c, 0x1f, 0xf8, 0x1b,
+	0x65, 0xca, 0x1f, 0xdb, 0x96, 0xe7, 0x1f, 0x1e, 0xa9, 0xfb, 0x90, 0x3a, 0x96, 0xed, 0x34, 0x5f,
+	0x40, 0x06, 0x93, 0x35, 0x00, 0x79, 0x4e, 0xaa, 0xe1, 0x11, 0xae, 0xcc, 0xc8, 0x95, 0xc7, 0xb6,
+	0xa5, 0x7d, 0xac, 0x40, 0x7e, 0x10, 0xc2, 0x4b, 0x8f, 0xe7, 0x87, 0x0a, 0x14, 0x04, 0xac, 0x77,
+	0x28, 0xe7, 0x2d, 0xea, 0x7b, 0xcc, 0x7b, 0xd4, 0xf8, 0x2a, 0xb5, 0x9b, 0x4f, 0xf8, 0x45, 0x7b,
+	0x68, 0x13, 0xae, 0xd6, 0x5a, 0xac, 0x7e, 0x54, 0x7d, 0x22, 0xc4, 0x0b, 0xd8, 0xd9, 0xca, 0xac,
+	0x58, 0x93, 0x1a, 0xb5, 0xdf, 0x29, 0xb0, 0x31, 0x1a, 0xce, 0x4b, 0xf7, 0xd6, 0xd7, 0xa3, 0xaa,
+	0xc0, 0x99, 0x7b, 0x40, 0xe9, 0xbb, 0x36, 0x75, 0x63, 0x65, 0xdd, 0xb4, 0x2c, 0x97, 0x7a, 0x5e,
+	0x50, 0xd6, 0xf1, 0x95, 0x2c, 0xc0, 0x65, 0x8b, 0x3a, 0xac, 0x8d, 0x59, 0x21, 0x5f, 0xb4, 0x3f,
+	0xc5, 0xce, 0x78, 0x5c, 0x1a, 0x5a, 0x79, 0x00, 0xb9, 0x63, 0xd6, 0xea, 0xb6, 0xa9, 0x94, 0x56,
+	0xd6, 0x7d, 0x7b, 0xfe, 0x75, 0x56, 0xd8, 0x6e, 0xda, 0xfc, 0x49, 0xb7, 0xa6, 0xd7, 0x59, 0xdb,
+	0xc0, 0x0b, 0x5c, 0x3e, 0x4a, 0x9e, 0x75, 0x64, 0xf8, 0xf7, 0xa7, 0xa7, 0xbf, 0xed, 0xf0, 0x0a,
+	0x72, 0x93, 0x2f, 0xc2, 0x74, 0x83, 0xd2, 0x2a, 0xb7, 0xa9, 0x8b, 0xa6, 0xaf, 0x0e, 0x73, 0x19,
+	0xaa, 0x47, 0xb7, 0x5d, 0x69, 0xc8, 0x57, 0xed, 0x55, 0xc8, 0xc7, 0x30, 0x7e, 0x43, 0x5c, 0xdc,
+	0x13, 0x0d, 0xd6, 0xbe, 0x0b, 0x2b, 0x43, 0xb8, 0xd0, 0xb0, 0x2f, 0x41, 0x4e, 0x36, 0x00, 0x98,
+	0x4a, 0x1b, 0x23, 0x22, 0x18, 0x72, 0x06, 0xd7, 0x90, 0xe4, 0xd2, 0xfe, 0xae, 0x60, 0x0d, 0xda,
+	0x37, 0x5b, 0xf5, 0x43, 0xf3, 0x84, 0x75, 0xc3, 0x4c, 0x7d, 0x0d, 0x66, 0xc2, 0x2e, 0x42, 0x48,
+	0xbf, 0xbe, 0x77, 0x73, 0x98, 0xf4, 0x47, 0x96, 0xe5, 0xbd, 0x7b, 0xd2, 0xa1, 0x95, 0x69, 0x86,
+	0xbf, 0xfc, 0xe3, 0x2b, 0x58, 0x8f, 0xcd, 0x56, 0x97, 0x06, 0xc7, 0xd7, 0x5f, 0xf9, 0xb6, 0xbf,
+	0xe0, 0x47, 0xc3, 0x6c, 0xb3, 0xae, 0xc3, 0xf3, 0xd9, 0xf3, 0x45, 0x43, 0x72, 0x6b, 0x4f, 0x33,
+	0xb0, 0x3c, 0x00, 0x3e, 0x8a, 0x78, 0x47, 0xac, 0x9c, 0x23, 0xe2, 0x6f, 0xd2, 0x7a, 0x05, 0xb9,
+	0xc9, 0x3b, 0x70, 0x4d, 0xfe, 0xaa, 0x76, 0x5c, 0xd6, 0xb0, 0x79, 0x3e, 0x73, 0x2e, 0x71, 0x57,
+	0xa5, 0x90, 0x43, 0x21, 0x83, 0x7c, 0x0d, 0x66, 0xeb, 0xcc, 0x39, 0xa6, 0xae, 0xe7, 0x77, 0x90,
+	0xf9, 0xac, 0x38, 0x7c, 0xda, 0x28, 0xe7, 0xee, 0x87, 0xa4, 0x18, 0xbc, 0x38, 0xb3, 0xf6, 0xb3,
+	0x0c, 0x5c, 0x4f, 0x52, 0xbd, 0xd8, 0xc8, 0xa1, 0x57, 0xb3, 0x17, 0xeb, 0xd5, 0xa9, 0xff, 0xdf,
+	0xab, 0xda, 0x8f, 0xb0, 0x04, 0x1c, 0xb8, 0x94, 0x96, 0x29, 0xdf, 0x77, 0xa9, 0x95, 0xe6, 0x80,
+	0xf5, 0x95, 0xe4, 0xcc, 0xb9, 0xdb, 0xae, 0xbf, 0x2a, 0xb0, 0x3a, 0x14, 0x00, 0xa6, 0xe4, 0x1b,
+	0xa2, 0x43, 0xb1, 0xec, 0xf0, 0x6e, 0xde, 0x1c, 0x5a, 0x3b, 0xe2, 0xcc, 0x41, 0x05, 0x41, 0xbe,
+	0x8b, 0x2b, 0xbe, 0x0f, 0xb1, 0xa8, 0x24, 0xb4, 0x4d, 0x76, 0xd5, 0x2b, 0xb1, 0x9e, 0x5a, 0xb6,
+	0xd2, 0xdf, 0x1b, 0xe6, 0xf4, 0xd0, 0xe4, 0x2f, 0x43, 0x4e, 0x42, 0xc7, 0xf2, 0x94, 0xda, 0x62,
+	0x64, 0xd3, 0xbe, 0x8f, 0xe5, 0xe9, 0x8d, 0x60, 0x42, 0xb9, 0xf0, 0x66, 0xf9, 0x2f, 0x41, 0x3b,
+	0x13, 0x57, 0x81, 0xf0, 0xf7, 0x01, 0xc2, 0xd1, 0x28, 0x08, 0xda, 0xda, 0x30, 0x13, 0x42, 0x5e,
+	0x84, 0x1f, 0x63, 0xbb, 0xb8, 0x98, 0xed, 0xc2, 0x62, 0x12, 0xe8, 0xe4, 0xbb, 0xe3, 0xcf, 0x4a,
+	0xbf, 0xff, 0x62, 0xd9, 0x38, 0x13, 0x82, 0x44, 0xf7, 0xa5, 0x32, 0x2d, 0xe2, 0x22, 0x0f, 0x61,
+	0x9a, 0x9a, 0xae, 0x63, 0x3b, 0x4d, 0xd9, 0x2c, 0xce, 0xee, 0x6d, 0x8d, 0x95, 0xf0, 0x16, 0x12,
+	0xa3, 0xa4, 0x90, 0x59, 0xd3, 0x61, 0x41, 0xa0, 0xac, 0xd0, 0x06, 0x75, 0x5d, 0xb3, 0x15, 0x18,
+	0xb6, 0x04, 0xb9, 0x9a, 0xb8, 0xbb, 0xd0, 0x2e, 0x7c, 0xd3, 0xbe, 0x03, 0x8b, 0x7d, 0xf4, 0xe1,
+	0x75, 0x38, 0xed, 0xe2, 0x1a, 0xda, 0x34, 0xb4, 0xf0, 0x05, 0x7c, 0x01, 0x90, 0x80, 0x47, 0x6b,
+	0x45, 0x1d, 0x39, 0x67, 0xee, 0xbe, 0xd9, 0x49, 0x51, 0x3e, 0xc6, 0xf7, 0xaa, 0x64, 0x05, 0x44,
+	0x75, 0x15, 0x9b, 0x59, 0xc9, 0xe9, 0xbf, 0xfb, 0x6d, 0xec, 0xef, 0x15, 0x58, 0x1e, 0x50, 0x87,
+	0x96, 0xbc, 0x15, 0x0e, 0x97, 0x0a, 0x66, 0xcc, 0x50, 0x3b, 0xda, 0xa6, 0xed, 0xc8, 0x06, 0x18,
+	0x05, 0x24, 0xc7, 0x4c, 0xf2, 0x3a, 0x4c, 0xf9, 0xda, 0xf2, 0x99, 0xcf, 0x25, 0xa4, 0x22, 0x98,
+	0xf6, 0xfe, 0x3b, 0x07, 0x97, 0x05, 0x3e, 0xe2, 0x42, 0x4e, 0x0e, 0xe4, 0x64, 0x7b, 0x98, 0x88,
+	0xc1, 0xd9, 0x5f, 0xbd, 0x33, 0x91, 0x4e, 0x1a, 0xaa, 0x2d, 0x3f, 0xfd, 0xc7, 0x7f, 0x3e, 0xca,
+	0xcc, 0x91, 0x1b, 0x46, 0xf2, 0xab, 0x08, 0x71, 0x21, 0x5b, 0xa6, 0x9c, 0xdc, 0x1a, 0x29, 0x28,
+	0xfa, 0x0a, 0xa0, 0xde, 0x1e, 0x4f, 0x84, 0xaa, 0x36, 0x84, 0x2a, 0x95, 0xe4, 0x43, 0x55, 0xa7,
+	0x38, 0x23, 0xf6, 0x8c, 0xd3, 0xae, 0x6d, 0xf5, 0xc8, 0x6f, 0x14, 0xb8, 0x96, 0x98, 0x12, 0x49,
+	0x69, 0x9c, 0xe4, 0x81, 0x69, 0x56, 0xd5, 0xd3, 0x92, 0x23, 0xa4, 0x3b, 0x02, 0xd2, 0x26, 0x29,
+	0x84, 0x90, 0x10, 0x51, 0x0c, 0x9a, 0x18, 0xd1, 0x7e, 0x00, 0x53, 0xbe, 0x04, 0x32, 0xd6, 0xd2,
+	0xd0, 0xfb, 0x5b, 0x13, 0xa8, 0x50, 0xfb, 0xa2, 0xd0, 0x7e, 0x83, 0x5c, 0x33, 0x62, 0xdf, 0x9e,
+	0x3c, 0xf2, 0xb1, 0x02, 0xb3, 0xb1, 0xc9, 0x8a, 0x14, 0x47, 0xc7, 0x72, 0x60, 0x04, 0x54, 0xef,
+	0xa7, 0x23, 0x46, 0x04, 0x3b, 0x02, 0xc1, 0x6d, 0xa2, 0x25, 0x10, 0x18, 0x1d, 0x49, 0x6a, 0x9c,
+	0x46, 0x27, 0xab, 0x47, 0xfe, 0xa6, 0xc0, 0xfc, 0x90, 0x51, 0x86, 0x3c, 0x18, 0xa9, 0x71, 0xf4,
+	0x1c, 0xa6, 0xbe, 0xfa, 0xf9, 0x98, 0x10, 0xee, 0x7d, 0x01, 0x77, 0x9b, 0xdc, 0x4e, 0xc2, 0xf5,
+	0x24, 0x8b, 0x71, 0x1a, 0x1f, 0xc9, 0x7a, 0xe4, 0x43, 0x05, 0x20, 0x1a, 0xd0, 0xc9, 0xce, 0x84,
+	0xdc, 0x88, 0x7d, 0x00, 0x50, 0x8b, 0xa9, 0x68, 0x11, 0xd5, 0x96, 0x40, 0x55, 0x20, 0x6b, 0x09,
+	0x54, 0xa5, 0xda, 0x49, 0xc9, 0x9f, 0xe3, 0x8d, 0x53, 0xf1, 0xc9, 0xa0, 0x47, 0x3e, 0x92, 0xc9,
+	0x1d, 0x8d, 0x47, 0xe3, 0x93, 0x7b, 0x60, 0x28, 0x53, 0xf5, 0xb4, 0xe4, 0x88, 0xeb, 0x96, 0xc0,
+	0xb5, 0x46, 0x56, 0x43, 0x5c, 0x0d, 0x4a, 0x4b, 0xdc, 0xa6, 0xae, 0x71, 0x8a, 0xd5, 0xb3, 0x47,
+	0x7e, 0xa9, 0xc0, 0xd5, 0xf8, 0x80, 0x42, 0xee, 0x4f, 0xd0, 0x92, 0x98, 0x9b, 0xd4, 0x52, 0x4a,
+	0x6a, 0x84, 0xb4, 0x29, 0x20, 0xad, 0x92, 0x15, 0x23, 0xf9, 0xfd, 0x34, 0x06, 0xe8, 0x27, 0x0a,
+	0x40, 0x34, 0x50, 0x8c, 0x89, 0xda, 0xc0, 0xc8, 0xa4, 0x16, 0x53, 0xd1, 0x22, 0x94, 0x9b, 0x02,
+	0xca, 0x12, 0x59, 0x88, 0x8e, 0xbe, 0xd9, 0xaa, 0x97, 0xb0, 0x43, 0xfe, 0x83, 0x02, 0xd7, 0x93,
+	0x7d, 0x24, 0x19, 0xed, 0xfe, 0xa1, 0x1d, 0xaf, 0x6a, 0xa4, 0xa6, 0x47, 0x44, 0x45, 0x81, 0x68,
+	0x8b, 0xdc, 0x8a, 0xe2, 0xe5, 0x52, 0x5a, 0xaa, 0x51, 0x5e, 0xc2, 0x06, 0x34, 0xe6, 0xa6, 0x3f,
+	0x2a, 0x70, 0x2d, 0x21, 0x67, 0x4c, 0x36, 0x0d, 0xeb, 0x32, 0x55, 0x3d, 0x2d, 0x39, 0xa2, 0xdb,
+	0x15, 0xe8, 0x8a, 0xe4, 0x5e, 0x0a, 0x74, 0x58, 0xce, 0x9f, 0x2a, 0x00, 0x51, 0x5b, 0x37, 0x26,
+	0x94, 0x03, 0xed, 0xa5, 0x5a, 0x4c, 0x45, 0x8b, 0xd0, 0x56, 0x05, 0xb4, 0x45, 0x32, 0x6f, 0x0c,
+	0x7c, 0x51, 0xf7, 0xc8, 0xcf, 0x15, 0x98, 0x09, 0x79, 0xc8, 0xbd, 0xc9, 0x72, 0x03, 0x08, 0x3b,
+	0x69, 0x48, 0x47, 0x96, 0x80, 0x08, 0x41, 0x2c, 0x68, 0x3f, 0x55, 0x60, 0x3a, 0x68, 0x7e, 0xc8,
+	0xdd, 0x91, 0xf2, 0xfb, 0xfa, 0x30, 0xf5, 0x5e, 0x0a, 0xca, 0x91, 0x67, 0x3e, 0x68, 0xae, 0x3c,
+	0xe3, 0x54, 0xb6, 0x6f, 0x3d, 0xf2, 0x5b, 0x59, 0x18, 0xb1, 0xdb, 0x18, 0x5f, 0x18, 0x93, 0x7d,
+	0x98, 0x5a, 0x4c, 0x45, 0x8b, 0x60, 0x1e, 0x08, 0x30, 0x25, 0x52, 0x8c, 0x17, 0x46, 0xce, 0xdc,
+	0x52, 0xdd, 0xec, 0x24, 0xb2, 0x25, 0x76, 0xcd, 0x94, 0xbf, 0xf2, 0xc9, 0xb3, 0x75, 0xe5, 0xd3,
+	0x67, 0xeb, 0xca, 0xbf, 0x9f, 0xad, 0x2b, 0xbf, 0x7a, 0xbe, 0x7e, 0xe9, 0xd3, 0xe7, 0xeb, 0x97,
+	0xfe, 0xf9, 0x7c, 0xfd, 0xd2, 0x7b, 0xf1, 0xb1, 0xd4, 0x6b, 0xd2, 0x12, 0xc2, 0x10, 0xc2, 0x3f,
+	0x10, 0xe2, 0xc5, 0x68, 0x5a, 0xcb, 0x89, 0x7f, 0x8a, 0x3c, 0xf8, 0xdf, 0x00, 0xcc, 0xa4, 0x4a,
+	0xcd, 0x71, 0x1a, 0x00, 0x00,
 }
 
-func (m *QueryBetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Bet by uid.
+	Bet(ctx context.Context, in *QueryBetRequest, opts ...grpc.CallOption) (*QueryBetResponse, error)
+	// Queries list of Bet items of a certain creator sorted by timestamp.
+	BetsByCreator(ctx context.Context, in *QueryBetsByCreatorRequest, opts ...grpc.CallOption) (*QueryBetsByCreatorResponse, error)
+	// Queries list of Bet items.
+	Bets(ctx context.Context, in *QueryBetsRequest, opts ...grpc.CallOption) (*QueryBetsResponse, error)
+	// Queries list of pending Bet items.
+	PendingBets(ctx context.Context, in *QueryPendingBetsRequest, opts ...grpc.CallOption) (*QueryPendingBetsResponse, error)
+	// Queries list of settled Bet items of a certain height.
+	SettledBetsOfHeight(ctx context.Context, in *QuerySettledBetsOfHeightRequest, opts ...grpc.CallOption) (*QuerySettledBetsOfHeightResponse, error)
+	// Queries a list of Bet items filtered by uid list.
+	BetsByUIDs(ctx context.Context, in *QueryBetsByUIDsRequest, opts ...grpc.CallOption) (*QueryBetsByUIDsResponse, error)
+	// Queries the fee tier in effect for a bettor in a certain denom.
+	BettorFeeTier(ctx context.Context, in *QueryBettorFeeTierRequest, opts ...grpc.CallOption) (*QueryBettorFeeTierResponse, error)
+	// Queries the responsible gambling limits of a bettor.
+	BettorLimits(ctx context.Context, in *QueryBettorLimitsRequest, opts ...grpc.CallOption) (*QueryBettorLimitsResponse, error)
+	// Queries the payout of a certain bet amount and the odds value converted
+	// to all of the supported odds types.
+	CalcPayout(ctx context.Context, in *QueryCalcPayoutRequest, opts ...grpc.CallOption) (*QueryCalcPayoutResponse, error)
+	// Queries list of free bet credits of a bettor.
+	FreeBetCredits(ctx context.Context, in *QueryFreeBetCreditsRequest, opts ...grpc.CallOption) (*QueryFreeBetCreditsResponse, error)
+	// Queries a free bet credit of a bettor by uid.
+	FreeBetCredit(ctx context.Context, in *QueryFreeBetCreditRequest, opts ...grpc.CallOption) (*QueryFreeBetCreditResponse, error)
+	// Queries list of affiliates.
+	Affiliates(ctx context.Context, in *QueryAffiliatesRequest, opts ...grpc.CallOption) (*QueryAffiliatesResponse, error)
+	// Queries an affiliate and its accrued earnings by address.
+	Affiliate(ctx context.Context, in *QueryAffiliateRequest, opts ...grpc.CallOption) (*QueryAffiliateResponse, error)
+	// Queries the referral binding of a bettor.
+	Referral(ctx context.Context, in *QueryReferralRequest, opts ...grpc.CallOption) (*QueryReferralResponse, error)
+	// Queries the totals and the remaining amounts to the caps of a bettor on
+	// a market and optionally on an odds of the market.
+	BettorCaps(ctx context.Context, in *QueryBettorCapsRequest, opts ...grpc.CallOption) (*QueryBettorCapsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Bet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Market.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryBetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Bet(ctx context.Context, in *QueryBetRequest, opts ...grpc.CallOption) (*QueryBetResponse, error) {
+	out := new(QueryBetResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/Bet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BetsByCreator(ctx context.Context, in *QueryBetsByCreatorRequest, opts ...grpc.CallOption) (*QueryBetsByCreatorResponse, error) {
+	out := new(QueryBetsByCreatorResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/BetsByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Bets(ctx context.Context, in *QueryBetsRequest, opts ...grpc.CallOption) (*QueryBetsResponse, error) {
+	out := new(QueryBetsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/Bets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Bet) > 0 {
-		for _, e := range m.Bet {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) PendingBets(ctx context.Context, in *QueryPendingBetsRequest, opts ...grpc.CallOption) (*QueryPendingBetsResponse, error) {
+	out := new(QueryPendingBetsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/PendingBets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) SettledBetsOfHeight(ctx context.Context, in *QuerySettledBetsOfHeightRequest, opts ...grpc.CallOption) (*QuerySettledBetsOfHeightResponse, error) {
+	out := new(QuerySettledBetsOfHeightResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/SettledBetsOfHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBetsByCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BetsByUIDs(ctx context.Context, in *QueryBetsByUIDsRequest, opts ...grpc.CallOption) (*QueryBetsByUIDsResponse, error) {
+	out := new(QueryBetsByUIDsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/BetsByUIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BettorFeeTier(ctx context.Context, in *QueryBettorFeeTierRequest, opts ...grpc.CallOption) (*QueryBettorFeeTierResponse, error) {
+	out := new(QueryBettorFeeTierResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/BettorFeeTier", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BettorLimits(ctx context.Context, in *QueryBettorLimitsRequest, opts ...grpc.CallOption) (*QueryBettorLimitsResponse, error) {
+	out := new(QueryBettorLimitsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/BettorLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBetsByCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CalcPayout(ctx context.Context, in *QueryCalcPayoutRequest, opts ...grpc.CallOption) (*QueryCalcPayoutResponse, error) {
+	out := new(QueryCalcPayoutResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/CalcPayout", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Bet) > 0 {
-		for _, e := range m.Bet {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) FreeBetCredits(ctx context.Context, in *QueryFreeBetCreditsRequest, opts ...grpc.CallOption) (*QueryFreeBetCreditsResponse, error) {
+	out := new(QueryFreeBetCreditsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/FreeBetCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) FreeBetCredit(ctx context.Context, in *QueryFreeBetCreditRequest, opts ...grpc.CallOption) (*QueryFreeBetCreditResponse, error) {
+	out := new(QueryFreeBetCreditResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/FreeBetCredit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBetsByUIDsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Affiliates(ctx context.Context, in *QueryAffiliatesRequest, opts ...grpc.CallOption) (*QueryAffiliatesResponse, error) {
+	out := new(QueryAffiliatesResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/Affiliates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, s := range m.Items {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) Affiliate(ctx context.Context, in *QueryAffiliateRequest, opts ...grpc.CallOption) (*QueryAffiliateResponse, error) {
+	out := new(QueryAffiliateResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/Affiliate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBetsByUIDsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Referral(ctx context.Context, in *QueryReferralRequest, opts ...grpc.CallOption) (*QueryReferralResponse, error) {
+	out := new(QueryReferralResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/Referral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Bets) > 0 {
-		for _, e := range m.Bets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) BettorCaps(ctx context.Context, in *QueryBettorCapsRequest, opts ...grpc.CallOption) (*QueryBettorCapsResponse, error) {
+	out := new(QueryBettorCapsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Query/BettorCaps", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.NotFoundBetUids) > 0 {
-		for _, s := range m.NotFoundBetUids {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Bet by uid.
+	Bet(context.Context, *QueryBetRequest) (*QueryBetResponse, error)
+	// Queries list of Bet items of a certain creator sorted by timestamp.
+	BetsByCreator(context.Context, *QueryBetsByCreatorRequest) (*QueryBetsByCreatorResponse, error)
+	// Queries list of Bet items.
+	Bets(context.Context, *QueryBetsRequest) (*QueryBetsResponse, error)
+	// Queries list of pending Bet items.
+	PendingBets(context.Context, *QueryPendingBetsRequest) (*QueryPendingBetsResponse, error)
+	// Queries list of settled Bet items of a certain height.
+	SettledBetsOfHeight(context.Context, *QuerySettledBetsOfHeightRequest) (*QuerySettledBetsOfHeightResponse, error)
+	// Queries a list of Bet items filtered by uid list.
+	BetsByUIDs(context.Context, *QueryBetsByUIDsRequest) (*QueryBetsByUIDsResponse, error)
+	// Queries the fee tier in effect for a bettor in a certain denom.
+	BettorFeeTier(context.Context, *QueryBettorFeeTierRequest) (*QueryBettorFeeTierResponse, error)
+	// Queries the responsible gambling limits of a bettor.
+	BettorLimits(context.Context, *QueryBettorLimitsRequest) (*QueryBettorLimitsResponse, error)
+	// Queries the payout of a certain bet amount and the odds value converted
+	// to all of the supported odds types.
+	CalcPayout(context.Context, *QueryCalcPayoutRequest) (*QueryCalcPayoutResponse, error)
+	// Queries list of free bet credits of a bettor.
+	FreeBetCredits(context.Context, *QueryFreeBetCreditsRequest) (*QueryFreeBetCreditsResponse, error)
+	// Queries a free bet credit of a bettor by uid.
+	FreeBetCredit(context.Context, *QueryFreeBetCreditRequest) (*QueryFreeBetCreditResponse, error)
+	// Queries list of affiliates.
+	Affiliates(context.Context, *QueryAffiliatesRequest) (*QueryAffiliatesResponse, error)
+	// Queries an affiliate and its accrued earnings by address.
+	Affiliate(context.Context, *QueryAffiliateRequest) (*QueryAffiliateResponse, error)
+	// Queries the referral binding of a bettor.
+	Referral(context.Context, *QueryReferralRequest) (*QueryReferralResponse, error)
+	// Queries the totals and the remaining amounts to the caps of a bettor on
+	// a market and optionally on an odds of the market.
+	BettorCaps(context.Context, *QueryBettorCapsRequest) (*QueryBettorCapsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Bet(ctx context.Context, req *QueryBetRequest) (*QueryBetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bet not implemented")
+}
+func (*UnimplementedQueryServer) BetsByCreator(ctx context.Context, req *QueryBetsByCreatorRequest) (*QueryBetsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BetsByCreator not implemented")
+}
+func (*UnimplementedQueryServer) Bets(ctx context.Context, req *QueryBetsRequest) (*QueryBetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bets not implemented")
+}
+func (*UnimplementedQueryServer) PendingBets(ctx context.Context, req *QueryPendingBetsRequest) (*QueryPendingBetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBets not implemented")
+}
+func (*UnimplementedQueryServer) SettledBetsOfHeight(ctx context.Context, req *QuerySettledBetsOfHeightRequest) (*QuerySettledBetsOfHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettledBetsOfHeight not implemented")
+}
+func (*UnimplementedQueryServer) BetsByUIDs(ctx context.Context, req *QueryBetsByUIDsRequest) (*QueryBetsByUIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BetsByUIDs not implemented")
+}
+func (*UnimplementedQueryServer) BettorFeeTier(ctx context.Context, req *QueryBettorFeeTierRequest) (*QueryBettorFeeTierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BettorFeeTier not implemented")
+}
+func (*UnimplementedQueryServer) BettorLimits(ctx context.Context, req *QueryBettorLimitsRequest) (*QueryBettorLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BettorLimits not implemented")
+}
+func (*UnimplementedQueryServer) CalcPayout(ctx context.Context, req *QueryCalcPayoutRequest) (*QueryCalcPayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalcPayout not implemented")
+}
+func (*UnimplementedQueryServer) FreeBetCredits(ctx context.Context, req *QueryFreeBetCreditsRequest) (*QueryFreeBetCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreeBetCredits not implemented")
+}
+func (*UnimplementedQueryServer) FreeBetCredit(ctx context.Context, req *QueryFreeBetCreditRequest) (*QueryFreeBetCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreeBetCredit not implemented")
+}
+func (*UnimplementedQueryServer) Affiliates(ctx context.Context, req *QueryAffiliatesRequest) (*QueryAffiliatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Affiliates not implemented")
+}
+func (*UnimplementedQueryServer) Affiliate(ctx context.Context, req *QueryAffiliateRequest) (*QueryAffiliateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Affiliate not implemented")
+}
+func (*UnimplementedQueryServer) Referral(ctx context.Context, req *QueryReferralRequest) (*QueryReferralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Referral not implemented")
+}
+func (*UnimplementedQueryServer) BettorCaps(ctx context.Context, req *QueryBettorCapsRequest) (*QueryBettorCapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BettorCaps not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingBetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Bet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Bet(ctx, in)
 	}
-	l = len(m.MarketUid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/Bet",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bet(ctx, req.(*QueryBetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingBetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BetsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBetsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Bet) > 0 {
-		for _, e := range m.Bet {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).BetsByCreator(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/BetsByCreator",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BetsByCreator(ctx, req.(*QueryBetsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySettledBetsOfHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Bets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Bets(ctx, in)
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/Bets",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bets(ctx, req.(*QueryBetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySettledBetsOfHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PendingBets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Bet) > 0 {
-		for _, e := range m.Bet {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/PendingBets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBets(ctx, req.(*QueryPendingBetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettledBetsOfHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettledBetsOfHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettledBetsOfHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/SettledBetsOfHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettledBetsOfHeight(ctx, req.(*QuerySettledBetsOfHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BetsByUIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBetsByUIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BetsByUIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/BetsByUIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BetsByUIDs(ctx, req.(*QueryBetsByUIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BettorFeeTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBettorFeeTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BettorFeeTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/BettorFeeTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BettorFeeTier(ctx, req.(*QueryBettorFeeTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BettorLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBettorLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BettorLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/BettorLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BettorLimits(ctx, req.(*QueryBettorLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CalcPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCalcPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalcPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/CalcPayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalcPayout(ctx, req.(*QueryCalcPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FreeBetCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFreeBetCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FreeBetCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/FreeBetCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FreeBetCredits(ctx, req.(*QueryFreeBetCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FreeBetCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFreeBetCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FreeBetCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/FreeBetCredit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FreeBetCredit(ctx, req.(*QueryFreeBetCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Affiliates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAffiliatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Affiliates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/Affiliates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Affiliates(ctx, req.(*QueryAffiliatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Affiliate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAffiliateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Affiliate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/Affiliate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Affiliate(ctx, req.(*QueryAffiliateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Referral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReferralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Referral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/Referral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Referral(ctx, req.(*QueryReferralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BettorCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBettorCapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BettorCaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Query/BettorCaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BettorCaps(ctx, req.(*QueryBettorCapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sgenetwork.sge.bet.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Bet",
+			Handler:    _Query_Bet_Handler,
+		},
+		{
+			MethodName: "BetsByCreator",
+			Handler:    _Query_BetsByCreator_Handler,
+		},
+		{
+			MethodName: "Bets",
+			Handler:    _Query_Bets_Handler,
+		},
+		{
+			MethodName: "PendingBets",
+			Handler:    _Query_PendingBets_Handler,
+		},
+		{
+			MethodName: "SettledBetsOfHeight",
+			Handler:    _Query_SettledBetsOfHeight_Handler,
+		},
+		{
+			MethodName: "BetsByUIDs",
+			Handler:    _Query_BetsByUIDs_Handler,
+		},
+		{
+			MethodName: "BettorFeeTier",
+			Handler:    _Query_BettorFeeTier_Handler,
+		},
+		{
+			MethodName: "BettorLimits",
+			Handler:    _Query_BettorLimits_Handler,
+		},
+		{
+			MethodName: "CalcPayout",
+			Handler:    _Query_CalcPayout_Handler,
+		},
+		{
+			MethodName: "FreeBetCredits",
+			Handler:    _Query_FreeBetCredits_Handler,
+		},
+		{
+			MethodName: "FreeBetCredit",
+			Handler:    _Query_FreeBetCredit_Handler,
+		},
+		{
+			MethodName: "Affiliates",
+			Handler:    _Query_Affiliates_Handler,
+		},
+		{
+			MethodName: "Affiliate",
+			Handler:    _Query_Affiliate_Handler,
+		},
+		{
+			MethodName: "Referral",
+			Handler:    _Query_Referral_Handler,
+		},
+		{
+			MethodName: "BettorCaps",
+			Handler:    _Query_BettorCaps_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sge/bet/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Bet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bet) > 0 {
+		for iNdEx := len(m.Bet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBetsByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBetsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBetsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBetsByCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBetsByCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBetsByCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bet) > 0 {
+		for iNdEx := len(m.Bet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBetsByUIDsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBetsByUIDsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBetsByUIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Items[iNdEx])
+			copy(dAtA[i:], m.Items[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Items[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBetsByUIDsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBetsByUIDsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBetsByUIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NotFoundBetUids) > 0 {
+		for iNdEx := len(m.NotFoundBetUids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NotFoundBetUids[iNdEx])
+			copy(dAtA[i:], m.NotFoundBetUids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.NotFoundBetUids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bets) > 0 {
+		for iNdEx := len(m.Bets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketUid) > 0 {
+		i -= len(m.MarketUid)
+		copy(dAtA[i:], m.MarketUid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketUid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bet) > 0 {
+		for iNdEx := len(m.Bet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettledBetsOfHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettledBetsOfHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettledBetsOfHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettledBetsOfHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettledBetsOfHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettledBetsOfHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bet) > 0 {
+		for iNdEx := len(m.Bet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBettorFeeTierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBettorFeeTierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBettorFeeTierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBettorFeeTierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBettorFeeTierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBettorFeeTierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeTier.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBettorLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBettorLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBettorLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBettorLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBettorLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBettorLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcPayoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalcPayoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcPayoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OddsValue) > 0 {
+		i -= len(m.OddsValue)
+		copy(dAtA[i:], m.OddsValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OddsValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OddsType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OddsType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcPayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalcPayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcPayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conversions) > 0 {
+		for iNdEx := len(m.Conversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.PayoutProfit.Size()
+		i -= size
+		if _, err := m.PayoutProfit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Payout.Size()
+		i -= size
+		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OddsConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OddsConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OddsConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PayoutProfit.Size()
+		i -= size
+		if _, err := m.PayoutProfit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Payout.Size()
+		i -= size
+		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OddsValue) > 0 {
+		i -= len(m.OddsValue)
+		copy(dAtA[i:], m.OddsValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OddsValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OddsType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OddsType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFreeBetCreditsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFreeBetCreditsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFreeBetCreditsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFreeBetCreditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFreeBetCreditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFreeBetCreditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Credits) > 0 {
+		for iNdEx := len(m.Credits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFreeBetCreditRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFreeBetCreditRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFreeBetCreditRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFreeBetCreditResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFreeBetCreditResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFreeBetCreditResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Credit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAffiliatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAffiliatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAffiliatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAffiliatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAffiliatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAffiliatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Affiliates) > 0 {
+		for iNdEx := len(m.Affiliates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Affiliates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAffiliateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAffiliateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAffiliateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAffiliateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAffiliateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAffiliateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for iNdEx := len(m.Earnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Affiliate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bettor) > 0 {
+		i -= len(m.Bettor)
+		copy(dAtA[i:], m.Bettor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bettor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReferralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReferralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReferralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Referral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBettorCapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBettorCapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBettorCapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OddsUid) > 0 {
+		i -= len(m.OddsUid)
+		copy(dAtA[i:], m.OddsUid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OddsUid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarketUid) > 0 {
+		i -= len(m.MarketUid)
+		copy(dAtA[i:], m.MarketUid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketUid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBettorCapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBettorCapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBettorCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Odds != nil {
+		{
+			size, err := m.Odds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bet) > 0 {
+		for _, e := range m.Bet {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBetsByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBetsByCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bet) > 0 {
+		for _, e := range m.Bet {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBetsByUIDsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, s := range m.Items {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBetsByUIDsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bets) > 0 {
+		for _, e := range m.Bets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NotFoundBetUids) > 0 {
+		for _, s := range m.NotFoundBetUids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingBetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MarketUid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingBetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bet) > 0 {
+		for _, e := range m.Bet {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySettledBetsOfHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *QuerySettledBetsOfHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bet) > 0 {
+		for _, e := range m.Bet {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBettorFeeTierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBettorFeeTierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeTier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBettorLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBettorLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcPayoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OddsType != 0 {
+		n += 1 + sovQuery(uint64(m.OddsType))
+	}
+	l = len(m.OddsValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCalcPayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payout.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PayoutProfit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Conversions) > 0 {
+		for _, e := range m.Conversions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OddsConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OddsType != 0 {
+		n += 1 + sovQuery(uint64(m.OddsType))
+	}
+	l = len(m.OddsValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Payout.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PayoutProfit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFreeBetCreditsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFreeBetCreditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credits) > 0 {
+		for _, e := range m.Credits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFreeBetCreditRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFreeBetCreditResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Credit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAffiliatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAffiliatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Affiliates) > 0 {
+		for _, e := range m.Affiliates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAffiliateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAffiliateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Affiliate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Earnings) > 0 {
+		for _, e := range m.Earnings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryReferralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bettor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReferralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Referral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBettorCapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MarketUid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OddsUid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBettorCapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Odds != nil {
+		l = m.Odds.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bet = append(m.Bet, Bet{})
+			if err := m.Bet[len(m.Bet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBetsByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBetsByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBetsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBetsByCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBetsByCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBetsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bet = append(m.Bet, Bet{})
+			if err := m.Bet[len(m.Bet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBetsByUIDsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBetsByUIDsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBetsByUIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBetsByUIDsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBetsByUIDsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBetsByUIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bets = append(m.Bets, Bet{})
+			if err := m.Bets[len(m.Bets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotFoundBetUids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotFoundBetUids = append(m.NotFoundBetUids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bet = append(m.Bet, Bet{})
+			if err := m.Bet[len(m.Bet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettledBetsOfHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettledBetsOfHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettledBetsOfHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettledBetsOfHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettledBetsOfHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettledBetsOfHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bet = append(m.Bet, Bet{})
+			if err := m.Bet[len(m.Bet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBettorFeeTierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBettorFeeTierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBettorFeeTierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBettorFeeTierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBettorFeeTierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBettorFeeTierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeTier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBettorLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBettorLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBettorLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryBettorLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBettorLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBettorLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCalcPayoutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1973,65 +6327,218 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCalcPayoutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCalcPayoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsType", wireType)
+			}
+			m.OddsType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OddsType |= OddsType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalcPayoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalcPayoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalcPayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutProfit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PayoutProfit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2058,7 +6565,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conversions = append(m.Conversions, OddsConversion{})
+			if err := m.Conversions[len(m.Conversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2083,7 +6591,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBetRequest) Unmarshal(dAtA []byte) error {
+func (m *OddsConversion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2106,15 +6614,34 @@ func (m *QueryBetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OddsConversion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OddsConversion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsType", wireType)
+			}
+			m.OddsType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OddsType |= OddsType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2142,11 +6669,11 @@ func (m *QueryBetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.OddsValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2174,7 +6701,43 @@ func (m *QueryBetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutProfit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PayoutProfit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2197,7 +6760,7 @@ func (m *QueryBetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFreeBetCreditsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2220,17 +6783,17 @@ func (m *QueryBetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFreeBetCreditsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFreeBetCreditsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2240,28 +6803,27 @@ func (m *QueryBetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Bet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2288,7 +6850,10 @@ func (m *QueryBetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2313,7 +6878,7 @@ func (m *QueryBetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFreeBetCreditsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2336,13 +6901,47 @@ func (m *QueryBetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFreeBetCreditsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFreeBetCreditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credits = append(m.Credits, FreeBetCredit{})
+			if err := m.Credits[len(m.Credits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2372,7 +6971,7 @@ func (m *QueryBetsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2399,7 +6998,7 @@ func (m *QueryBetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFreeBetCreditRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2422,17 +7021,17 @@ func (m *QueryBetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFreeBetCreditRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFreeBetCreditRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2442,29 +7041,109 @@ func (m *QueryBetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFreeBetCreditResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bet = append(m.Bet, Bet{})
-			if err := m.Bet[len(m.Bet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFreeBetCreditResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFreeBetCreditResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2491,10 +7170,7 @@ func (m *QueryBetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Credit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2519,7 +7195,7 @@ func (m *QueryBetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBetsByCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAffiliatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2542,10 +7218,10 @@ func (m *QueryBetsByCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBetsByCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAffiliatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBetsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAffiliatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2584,38 +7260,6 @@ func (m *QueryBetsByCreatorRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2637,7 +7281,7 @@ func (m *QueryBetsByCreatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBetsByCreatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAffiliatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2660,15 +7304,15 @@ func (m *QueryBetsByCreatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBetsByCreatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAffiliatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBetsByCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAffiliatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2695,8 +7339,8 @@ func (m *QueryBetsByCreatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bet = append(m.Bet, Bet{})
-			if err := m.Bet[len(m.Bet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Affiliates = append(m.Affiliates, Affiliate{})
+			if err := m.Affiliates[len(m.Affiliates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2757,7 +7401,7 @@ func (m *QueryBetsByCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBetsByUIDsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAffiliateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2780,15 +7424,15 @@ func (m *QueryBetsByUIDsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBetsByUIDsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAffiliateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBetsByUIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAffiliateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2816,7 +7460,7 @@ func (m *QueryBetsByUIDsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2839,7 +7483,7 @@ func (m *QueryBetsByUIDsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBetsByUIDsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAffiliateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2862,15 +7506,15 @@ func (m *QueryBetsByUIDsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBetsByUIDsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAffiliateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBetsByUIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAffiliateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2897,16 +7541,15 @@ func (m *QueryBetsByUIDsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bets = append(m.Bets, Bet{})
-			if err := m.Bets[len(m.Bets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Affiliate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotFoundBetUids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2916,23 +7559,25 @@ func (m *QueryBetsByUIDsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotFoundBetUids = append(m.NotFoundBetUids, string(dAtA[iNdEx:postIndex]))
+			m.Earnings = append(m.Earnings, AffiliateEarnings{})
+			if err := m.Earnings[len(m.Earnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2955,7 +7600,7 @@ func (m *QueryBetsByUIDsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingBetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReferralRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2978,51 +7623,15 @@ func (m *QueryPendingBetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingBetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReferralRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingBetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReferralRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketUid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bettor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3050,7 +7659,7 @@ func (m *QueryPendingBetsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MarketUid = string(dAtA[iNdEx:postIndex])
+			m.Bettor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3073,7 +7682,7 @@ func (m *QueryPendingBetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingBetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReferralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3096,49 +7705,15 @@ func (m *QueryPendingBetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingBetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReferralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingBetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReferralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bet", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bet = append(m.Bet, Bet{})
-			if err := m.Bet[len(m.Bet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3165,10 +7740,7 @@ func (m *QueryPendingBetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Referral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3193,7 +7765,7 @@ func (m *QueryPendingBetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySettledBetsOfHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBettorCapsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3216,17 +7788,17 @@ func (m *QuerySettledBetsOfHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySettledBetsOfHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBettorCapsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySettledBetsOfHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBettorCapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3236,33 +7808,61 @@ func (m *QuerySettledBetsOfHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUid", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MarketUid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsUid", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3272,11 +7872,24 @@ func (m *QuerySettledBetsOfHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3298,7 +7911,7 @@ func (m *QuerySettledBetsOfHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySettledBetsOfHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBettorCapsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3321,15 +7934,15 @@ func (m *QuerySettledBetsOfHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySettledBetsOfHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBettorCapsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySettledBetsOfHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBettorCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3356,14 +7969,13 @@ func (m *QuerySettledBetsOfHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bet = append(m.Bet, Bet{})
-			if err := m.Bet[len(m.Bet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Odds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3390,10 +8002,10 @@ func (m *QuerySettledBetsOfHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Odds == nil {
+				m.Odds = &RemainingBettorCaps{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Odds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -68,24 +70,89 @@ func (m *BetStats) GetCount() uint64 {
 	return 0
 }
 
+// BettorStats is the type of statistics of the betting of a bettor
+// in a certain denom.
+type BettorStats struct {
+	// address is the bettor address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denomination of the wagered volume.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// volume is the total wagered amount of the bettor.
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+}
+
+func (m *BettorStats) Reset()         { *m = BettorStats{} }
+func (m *BettorStats) String() string { return proto.CompactTextString(m) }
+func (*BettorStats) ProtoMessage()    {}
+func (*BettorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7bd589ff6fa2d86, []int{1}
+}
+func (m *BettorStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BettorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BettorStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BettorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BettorStats.Merge(m, src)
+}
+func (m *BettorStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BettorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BettorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BettorStats proto.InternalMessageInfo
+
+func (m *BettorStats) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BettorStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BetStats)(nil), "sgenetwork.sge.bet.BetStats")
+	proto.RegisterType((*BettorStats)(nil), "sgenetwork.sge.bet.BettorStats")
 }
 
 func init() { proto.RegisterFile("sge/bet/stats.proto", fileDescriptor_e7bd589ff6fa2d86) }
 
 var fileDescriptor_e7bd589ff6fa2d86 = []byte{
-	// 155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2e, 0x4e, 0x4f, 0xd5,
-	0x4f, 0x4a, 0x2d, 0xd1, 0x2f, 0x2e, 0x49, 0x2c, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x2a, 0x4e, 0x4f, 0xcd, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x2b, 0x4e, 0x4f, 0xd5,
-	0x4b, 0x4a, 0x2d, 0x51, 0x52, 0xe0, 0xe2, 0x70, 0x4a, 0x2d, 0x09, 0x06, 0xa9, 0x12, 0x12, 0xe1,
-	0x62, 0x4d, 0xce, 0x2f, 0xcd, 0x2b, 0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x09, 0x82, 0x70, 0x9c,
-	0x1c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
-	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2d, 0x3d, 0xb3, 0x24,
-	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x38, 0x3d, 0x55, 0x17, 0x6a, 0x36, 0x88, 0xad,
-	0x5f, 0x01, 0xb6, 0xbd, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c, 0xbd, 0x31, 0x20, 0x00,
-	0x00, 0xff, 0xff, 0xe8, 0xa1, 0x55, 0x2b, 0x95, 0x00, 0x00, 0x00,
+	// 250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x50, 0x3d, 0x4f, 0xc3, 0x30,
+	0x14, 0x8c, 0xf9, 0x28, 0x60, 0xb6, 0xd0, 0x21, 0x62, 0x70, 0xab, 0x0e, 0x88, 0xa5, 0xf6, 0xc0,
+	0x1f, 0x40, 0x19, 0x90, 0x58, 0xc3, 0xc6, 0xd6, 0x24, 0x4f, 0x06, 0x95, 0xe4, 0x55, 0x79, 0x2f,
+	0x7c, 0xfc, 0x00, 0x76, 0x7e, 0x56, 0xc7, 0x8e, 0x88, 0x21, 0x42, 0xc9, 0x1f, 0x41, 0xb6, 0x83,
+	0xd4, 0xc9, 0x77, 0xe7, 0xbb, 0x93, 0xee, 0xc9, 0x0b, 0xb2, 0x60, 0x72, 0x60, 0x43, 0xbc, 0x62,
+	0xd2, 0x9b, 0x06, 0x19, 0xe3, 0x98, 0x2c, 0xd4, 0xc0, 0x6f, 0xd8, 0xac, 0x35, 0x59, 0xd0, 0x39,
+	0xf0, 0xe5, 0xd4, 0xa2, 0x45, 0xff, 0x6d, 0x1c, 0x0a, 0xce, 0xc5, 0x5c, 0x9e, 0xa6, 0xc0, 0x0f,
+	0x2e, 0x1b, 0x4f, 0xe5, 0x71, 0x81, 0x6d, 0xcd, 0x89, 0x98, 0x8b, 0xeb, 0xa3, 0x2c, 0x90, 0xc5,
+	0xa7, 0x90, 0xe7, 0x29, 0x30, 0x63, 0x13, 0x5c, 0x89, 0x3c, 0x59, 0x95, 0x65, 0x03, 0x44, 0xde,
+	0x77, 0x96, 0xfd, 0x53, 0x97, 0x2f, 0xa1, 0xc6, 0x2a, 0x39, 0xf0, 0x7a, 0x20, 0xf1, 0x9d, 0x9c,
+	0xbc, 0xe2, 0x4b, 0x5b, 0x41, 0x72, 0xe8, 0xe4, 0x54, 0x6f, 0xbb, 0x59, 0xf4, 0xd3, 0xcd, 0xae,
+	0xec, 0x33, 0x3f, 0xb5, 0xb9, 0x2e, 0xb0, 0x32, 0x05, 0x52, 0x85, 0x34, 0x3e, 0x4b, 0x2a, 0xd7,
+	0x86, 0x3f, 0x36, 0x40, 0xfa, 0xbe, 0xe6, 0x6c, 0x4c, 0xa7, 0xb7, 0xdb, 0x5e, 0x89, 0x5d, 0xaf,
+	0xc4, 0x6f, 0xaf, 0xc4, 0xd7, 0xa0, 0xa2, 0xdd, 0xa0, 0xa2, 0xef, 0x41, 0x45, 0x8f, 0xfb, 0x4d,
+	0x64, 0x61, 0x39, 0x2e, 0x77, 0xd8, 0xbc, 0xfb, 0xdb, 0xf8, 0xb6, 0x7c, 0xe2, 0x27, 0xdf, 0xfc,
+	0x0d, 0x00, 0x1e, 0x48, 0x10, 0x49, 0x33, 0x01, 0x00, 0x00,
 }
 
 func (m *BetStats) Marshal() (dAtA []byte, err error) {
@@ -116,6 +183,53 @@ func (m *BetStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BettorStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BettorStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BettorStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStats(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStats(v)
 	base := offset
@@ -139,6 +253,25 @@ func (m *BetStats) Size() (n int) {
 	return n
 }
 
+func (m *BettorStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovStats(uint64(l))
+	return n
+}
+
 func sovStats(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -214,6 +347,154 @@ func (m *BetStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BettorStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BettorStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BettorStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStats(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
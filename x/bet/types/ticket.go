@@ -8,19 +8,32 @@ import (
 	"github.com/sge-network/sge/utils"
 )
 
+const (
+	// minParlayLegs is the minimum count of legs of a parlay bet.
+	minParlayLegs = 2
+	// maxParlayLegs is the maximum count of legs of a parlay bet.
+	maxParlayLegs = 10
+)
+
 // Validate validates fields of the given ticketData
 func (payload *WagerTicketPayload) Validate(creator string) error {
-	if payload.SelectedOdds == nil {
-		return ErrOddsDataNotFound
-	}
+	if payload.IsParlay() {
+		if err := payload.validateParlayLegs(); err != nil {
+			return err
+		}
+	} else {
+		if payload.SelectedOdds == nil {
+			return ErrOddsDataNotFound
+		}
 
-	if err := payload.ValidateOdds(); err != nil {
-		return sdkerrors.Wrapf(err, "%s", payload.SelectedOdds.UID)
-	}
+		if err := payload.ValidateOdds(); err != nil {
+			return sdkerrors.Wrapf(err, "%s", payload.SelectedOdds.UID)
+		}
 
-	for _, odd := range payload.AllOdds {
-		if err := payload.ValidateCompactOdds(*odd); err != nil {
-			return sdkerrors.Wrapf(err, "%s", odd.UID)
+		for _, odd := range payload.AllOdds {
+			if err := payload.ValidateCompactOdds(*odd); err != nil {
+				return sdkerrors.Wrapf(err, "%s", odd.UID)
+			}
 		}
 	}
 
@@ -29,31 +42,75 @@ func (payload *WagerTicketPayload) Validate(creator string) error {
 	}
 
 	if payload.OddsType < OddsType_ODDS_TYPE_DECIMAL ||
-		payload.OddsType > OddsType_ODDS_TYPE_MONEYLINE {
+		payload.OddsType > OddsType_ODDS_TYPE_MALAY {
 		return ErrInvalidOddsType
 	}
 
 	return nil
 }
 
+// IsParlay returns true if the ticket contains a multi-leg (parlay) bet.
+func (payload *WagerTicketPayload) IsParlay() bool {
+	return len(payload.ParlayLegs) > 0
+}
+
+// validateParlayLegs validates the legs of a parlay bet ticket.
+func (payload *WagerTicketPayload) validateParlayLegs() error {
+	if payload.SelectedOdds != nil || len(payload.AllOdds) > 0 {
+		return ErrParlayWithSingleOdds
+	}
+
+	if len(payload.ParlayLegs) < minParlayLegs || len(payload.ParlayLegs) > maxParlayLegs {
+		return sdkerrors.Wrapf(ErrInvalidParlayLegsCount, "%d", len(payload.ParlayLegs))
+	}
+
+	marketUIDs := make(map[string]struct{}, len(payload.ParlayLegs))
+	for _, leg := range payload.ParlayLegs {
+		if leg.SelectedOdds == nil {
+			return ErrOddsDataNotFound
+		}
+
+		if err := validateBetOdds(leg.SelectedOdds); err != nil {
+			return sdkerrors.Wrapf(err, "%s", leg.SelectedOdds.UID)
+		}
+
+		if _, ok := marketUIDs[leg.SelectedOdds.MarketUID]; ok {
+			return sdkerrors.Wrapf(ErrDuplicateParlayMarket, "%s", leg.SelectedOdds.MarketUID)
+		}
+		marketUIDs[leg.SelectedOdds.MarketUID] = struct{}{}
+
+		for _, odd := range leg.AllOdds {
+			if err := payload.ValidateCompactOdds(*odd); err != nil {
+				return sdkerrors.Wrapf(err, "%s", odd.UID)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (payload *WagerTicketPayload) ValidateOdds() error {
-	if !utils.IsValidUID(payload.SelectedOdds.MarketUID) {
+	return validateBetOdds(payload.SelectedOdds)
+}
+
+func validateBetOdds(odds *BetOdds) error {
+	if !utils.IsValidUID(odds.MarketUID) {
 		return ErrInvalidMarketUID
 	}
 
-	if !utils.IsValidUID(payload.SelectedOdds.UID) {
+	if !utils.IsValidUID(odds.UID) {
 		return ErrInvalidOddsUID
 	}
 
-	if len(strings.TrimSpace(payload.SelectedOdds.Value)) == 0 {
+	if len(strings.TrimSpace(odds.Value)) == 0 {
 		return ErrEmptyOddsValue
 	}
 
-	if payload.SelectedOdds.MaxLossMultiplier.IsNil() || payload.SelectedOdds.MaxLossMultiplier.LTE(sdk.ZeroDec()) {
+	if odds.MaxLossMultiplier.IsNil() || odds.MaxLossMultiplier.LTE(sdk.ZeroDec()) {
 		return ErrMaxLossMultiplierCanNotBeZero
 	}
 
-	if payload.SelectedOdds.MaxLossMultiplier.GT(sdk.OneDec()) {
+	if odds.MaxLossMultiplier.GT(sdk.OneDec()) {
 		return ErrMaxLossMultiplierCanNotBeMoreThanOne
 	}
 
@@ -76,10 +133,124 @@ func (payload *WagerTicketPayload) ValidateCompactOdds(odds BetOddsCompact) erro
 	return nil
 }
 
+// OddsMap returns the map of all odds of the ticket.
 func (payload *WagerTicketPayload) OddsMap() map[string]*BetOddsCompact {
+	return oddsMap(payload.AllOdds)
+}
+
+// LegOddsMaps returns the odds map of each of the parlay legs by market uid.
+func (payload *WagerTicketPayload) LegOddsMaps() map[string]map[string]*BetOddsCompact {
+	legOddsMaps := make(map[string]map[string]*BetOddsCompact, len(payload.ParlayLegs))
+	for _, leg := range payload.ParlayLegs {
+		legOddsMaps[leg.SelectedOdds.MarketUID] = oddsMap(leg.AllOdds)
+	}
+	return legOddsMaps
+}
+
+func oddsMap(odds []*BetOddsCompact) map[string]*BetOddsCompact {
 	oddMap := make(map[string]*BetOddsCompact)
-	for _, odd := range payload.AllOdds {
+	for _, odd := range odds {
 		oddMap[odd.UID] = odd
 	}
 	return oddMap
 }
+
+// Validate validates fields of the given bet cancellation ticket
+func (payload *CancelBetTicketPayload) Validate() error {
+	if !utils.IsValidUID(payload.UID) {
+		return ErrInvalidBetUID
+	}
+
+	return nil
+}
+
+// Validate validates fields of the given bet cash-out ticket
+func (payload *CashOutTicketPayload) Validate() error {
+	if !utils.IsValidUID(payload.UID) {
+		return ErrInvalidBetUID
+	}
+
+	if payload.Amount.IsNil() || !payload.Amount.IsPositive() {
+		return ErrInvalidCashOutAmount
+	}
+
+	return nil
+}
+
+// Validate validates fields of the given bet voiding ticket
+func (payload *VoidBetsTicketPayload) Validate() error {
+	if len(payload.Bets) == 0 {
+		return ErrNoBetsToVoid
+	}
+
+	uids := make(map[string]struct{}, len(payload.Bets))
+	for _, bet := range payload.Bets {
+		if !utils.IsValidUID(bet.UID) {
+			return sdkerrors.Wrapf(ErrInvalidBetUID, "%s", bet.UID)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(bet.Creator); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+		}
+
+		if _, ok := uids[bet.UID]; ok {
+			return sdkerrors.Wrapf(ErrDuplicateUID, "%s", bet.UID)
+		}
+		uids[bet.UID] = struct{}{}
+	}
+
+	if strings.TrimSpace(payload.Reason) == "" {
+		return ErrEmptyVoidReason
+	}
+
+	return nil
+}
+
+// Validate validates fields of the given promo pool funding ticket
+func (payload *FundPromoPoolTicketPayload) Validate() error {
+	if err := sdk.ValidateDenom(payload.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidFreeBetCredit, "%s", err)
+	}
+
+	if payload.Amount.IsNil() || !payload.Amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+// Validate validates fields of the given free bet credit issuance ticket
+func (payload *IssueFreeBetTicketPayload) Validate(blockTime int64) error {
+	if !utils.IsValidUID(payload.UID) {
+		return sdkerrors.Wrapf(ErrInvalidFreeBetCredit, "invalid uid %s", payload.UID)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(payload.Address); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidFreeBetCredit, "%s", err)
+	}
+
+	if err := sdk.ValidateDenom(payload.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidFreeBetCredit, "%s", err)
+	}
+
+	if payload.Amount.IsNil() || !payload.Amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+
+	if payload.ExpiresAt != 0 && payload.ExpiresAt <= blockTime {
+		return sdkerrors.Wrapf(ErrInvalidFreeBetCredit, "expiration %d is in the past", payload.ExpiresAt)
+	}
+
+	return nil
+}
+
+// Validate validates fields of the given affiliate registration ticket
+func (payload *SetAffiliateTicketPayload) Validate() error {
+	affiliate := NewAffiliate(payload.Address, payload.FeeShare)
+	return affiliate.Validate()
+}
+
+// Validate validates fields of the given referral binding ticket
+func (payload *BindReferrerTicketPayload) Validate() error {
+	return ValidateReferral(payload.Bettor, payload.Referrer)
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/sge-network/sge/types"
@@ -35,6 +36,9 @@ type WagerTicketPayload struct {
 	OddsType OddsType `protobuf:"varint,3,opt,name=odds_type,json=oddsType,proto3,enum=sgenetwork.sge.bet.OddsType" json:"odds_type,omitempty"`
 	// all odds for the selected market.
 	AllOdds []*BetOddsCompact `protobuf:"bytes,4,rep,name=all_odds,json=allOdds,proto3" json:"all_odds,omitempty"`
+	// parlay_legs contains the selected odds of each leg of a multi-leg
+	// (parlay) bet, selected_odds and all_odds should be empty for parlays.
+	ParlayLegs []*WagerTicketLeg `protobuf:"bytes,5,rep,name=parlay_legs,json=parlayLegs,proto3" json:"parlay_legs,omitempty"`
 }
 
 func (m *WagerTicketPayload) Reset()         { *m = WagerTicketPayload{} }
@@ -98,143 +102,2337 @@ func (m *WagerTicketPayload) GetAllOdds() []*BetOddsCompact {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*WagerTicketPayload)(nil), "sgenetwork.sge.bet.WagerTicketPayload")
+func (m *WagerTicketPayload) GetParlayLegs() []*WagerTicketLeg {
+	if m != nil {
+		return m.ParlayLegs
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("sge/bet/ticket.proto", fileDescriptor_cf6959e7db451613) }
+// WagerTicketLeg indicates data of a single leg of a parlay bet placement
+// ticket.
+type WagerTicketLeg struct {
+	// selected_odds is the user-selected odds of the leg.
+	SelectedOdds *BetOdds `protobuf:"bytes,1,opt,name=selected_odds,json=selectedOdds,proto3" json:"selected_odds,omitempty"`
+	// all odds for the market of the leg.
+	AllOdds []*BetOddsCompact `protobuf:"bytes,2,rep,name=all_odds,json=allOdds,proto3" json:"all_odds,omitempty"`
+}
 
-var fileDescriptor_cf6959e7db451613 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbf, 0x4a, 0x03, 0x41,
-	0x10, 0xc6, 0xef, 0x92, 0x60, 0xe2, 0x45, 0x2d, 0x96, 0xa0, 0x21, 0xca, 0x19, 0x22, 0x48, 0x1a,
-	0x6f, 0x21, 0x56, 0x16, 0x42, 0x88, 0xe9, 0x2c, 0x94, 0x23, 0x20, 0xd8, 0x84, 0xbd, 0xdd, 0x61,
-	0x0d, 0x77, 0x71, 0x8f, 0xec, 0x88, 0xee, 0x5b, 0x58, 0xf9, 0x4c, 0x29, 0x53, 0x5a, 0x89, 0x24,
-	0x2f, 0x22, 0x7b, 0x7f, 0x2c, 0x54, 0xec, 0x66, 0xbe, 0x99, 0xef, 0x9b, 0x1f, 0xbb, 0x5e, 0x4b,
-	0x4b, 0xa0, 0x11, 0x20, 0xc5, 0x19, 0x8f, 0x01, 0x83, 0x74, 0xa1, 0x50, 0x11, 0xa2, 0x25, 0x3c,
-	0x02, 0x3e, 0xab, 0x45, 0x1c, 0x68, 0x09, 0x41, 0x04, 0xd8, 0x69, 0x49, 0x25, 0x55, 0x36, 0xa6,
-	0xb6, 0xca, 0x37, 0x3b, 0x76, 0x93, 0xa2, 0x49, 0x81, 0xc6, 0x86, 0x17, 0xda, 0x7e, 0x99, 0x19,
-	0x01, 0x4e, 0x95, 0x10, 0xba, 0xd0, 0x0f, 0x4a, 0xdd, 0x6a, 0x53, 0x6b, 0xca, 0x07, 0xbd, 0xb7,
-	0x8a, 0x47, 0xee, 0x98, 0x84, 0xc5, 0x24, 0x83, 0xb8, 0x65, 0x26, 0x51, 0x4c, 0x90, 0xa1, 0xb7,
-	0xab, 0x21, 0x01, 0x8e, 0x20, 0xb2, 0x98, 0xb6, 0xdb, 0x75, 0xfb, 0xcd, 0xc1, 0x61, 0xf0, 0x9b,
-	0x2e, 0x18, 0x01, 0xde, 0x08, 0xa1, 0xc3, 0x9d, 0xd2, 0x61, 0x3b, 0x32, 0xf6, 0x1a, 0xb1, 0xe1,
-	0x53, 0xc1, 0x90, 0xb5, 0x2b, 0x99, 0xf9, 0xe4, 0xa7, 0x39, 0xc3, 0xb8, 0x36, 0x7c, 0xcc, 0x90,
-	0x15, 0x87, 0x47, 0xb5, 0xe5, 0xc7, 0xb1, 0x13, 0xd6, 0xe3, 0x5c, 0x25, 0x17, 0xde, 0xf6, 0x37,
-	0x71, 0xbb, 0xda, 0x75, 0xfb, 0x7b, 0x83, 0xa3, 0xbf, 0x18, 0xec, 0xc9, 0x89, 0x49, 0x21, 0x6c,
-	0xa8, 0xa2, 0x22, 0x97, 0x5e, 0x83, 0x25, 0x49, 0x4e, 0x5f, 0xeb, 0x56, 0xfb, 0xcd, 0x41, 0xef,
-	0x1f, 0xfa, 0x2b, 0x35, 0x4f, 0x19, 0xc7, 0xb0, 0xce, 0x92, 0xc4, 0xf6, 0xa3, 0xe1, 0x72, 0xed,
-	0xbb, 0xab, 0xb5, 0xef, 0x7e, 0xae, 0x7d, 0xf7, 0x75, 0xe3, 0x3b, 0xab, 0x8d, 0xef, 0xbc, 0x6f,
-	0x7c, 0xe7, 0xfe, 0x54, 0xce, 0xf0, 0xe1, 0x29, 0x0a, 0xb8, 0x9a, 0x53, 0x2d, 0xe1, 0xac, 0x48,
-	0xb4, 0x35, 0x7d, 0xc9, 0x3f, 0xd4, 0xa4, 0xa0, 0xa3, 0xad, 0xec, 0x85, 0xcf, 0xbf, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x2c, 0x82, 0x98, 0x8e, 0xe8, 0x01, 0x00, 0x00,
+func (m *WagerTicketLeg) Reset()         { *m = WagerTicketLeg{} }
+func (m *WagerTicketLeg) String() string { return proto.CompactTextString(m) }
+func (*WagerTicketLeg) ProtoMessage()    {}
+func (*WagerTicketLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf6959e7db451613, []int{1}
+}
+func (m *WagerTicketLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WagerTicketLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WagerTicketLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WagerTicketLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WagerTicketLeg.Merge(m, src)
+}
+func (m *WagerTicketLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *WagerTicketLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_WagerTicketLeg.DiscardUnknown(m)
 }
 
-func (m *WagerTicketPayload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WagerTicketLeg proto.InternalMessageInfo
+
+func (m *WagerTicketLeg) GetSelectedOdds() *BetOdds {
+	if m != nil {
+		return m.SelectedOdds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WagerTicketPayload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WagerTicketLeg) GetAllOdds() []*BetOddsCompact {
+	if m != nil {
+		return m.AllOdds
+	}
+	return nil
 }
 
-func (m *WagerTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllOdds) > 0 {
-		for iNdEx := len(m.AllOdds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AllOdds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTicket(dAtA, i, uint64(size))
+// CancelBetTicketPayload indicates data of bet cancellation ticket.
+type CancelBetTicketPayload struct {
+	// uid is the universal unique identifier of the bet to be canceled.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// refund_fee determines if the bet fee should be refunded to the bettor.
+	RefundFee bool `protobuf:"varint,2,opt,name=refund_fee,json=refundFee,proto3" json:"refund_fee,omitempty"`
+}
+
+func (m *CancelBetTicketPayload) Reset()         { *m = CancelBetTicketPayload{} }
+func (m *CancelBetTicketPayload) String() string { return proto.CompactTextString(m) }
+func (*CancelBetTicketPayload) ProtoMessage()    {}
+func (*CancelBetTicketPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf6959e7db451613, []int{2}
+}
+func (m *CancelBetTicketPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelBetTicketPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelBetTicketPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelBetTicketPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelBetTicketPayload.Merge(m, src)
+}
+func (m *CancelBetTicketPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelBetTicketPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelBetTicketPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelBetTicketPayload proto.InternalMessageInfo
+
+func (m *CancelBetTicketPayload) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *CancelBetTicketPayload) GetRefundFee() bool {
+	if m != nil {
+		return m.RefundFee
+	}
+	return false
+}
+
+// CashOutTicketPayload indicates data of the cash-out quote ticket.
+type CashOutTicketPayload struct {
+	// uid is the universal unique identifier of the bet to be cashed out.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// amount is the quoted amount to be paid to the bettor.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *CashOutTicketPayload) Reset()         { *m = CashOutTicketPayload{} }
+func (m *CashOutTicketPayload) String() string { return proto.CompactTextString(m) }
+func (*CashOutTicketPayload) ProtoMessage()    {}
+func (*CashOutTicketPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf6959e7db451613, []int{3}
+}
+func (m *CashOutTicketPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CashOutTicketPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CashOutTicketPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CashOutTicketPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CashOutTicketPayload.Merge(m, src)
+}
+func (m *CashOutTicketPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *CashOutTicketPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_CashOutTicketPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CashOutTicketPayload proto.InternalMessageInfo
+
+func (m *CashOutTicketPayload) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+// FundPromoPoolTicketPayload indicates data of the promo pool funding ticket.
+type FundPromoPoolTicketPayload struct {
+	// denom is the denomination of the funded amount.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount to be transferred from the operator account to the
+	// promo pool.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *FundPromoPoolTicketPayload) Reset()         { *m = FundPromoPoolTicketPayload{} }
+func (m *FundPromoPoolTicketPayload) String() string { return proto.CompactTextString(m) }
+func (*FundPromoPoolTicketPayload) ProtoMessage()    {}
+func (*FundPromoPoolTicketPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf6959e7db451613, []int{4}
+}
+func (m *FundPromoPoolTicketPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundPromoPoolTicketPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundPromoPoolTicketPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundPromoPoolTicketPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundPromoPoolTicketPayload.Merge(m, src)
+}
+func (m *FundPromoPoolTicketPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundPromoPoolTicketPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundPromoPoolTicketPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundPromoPoolTicketPayload proto.InternalMessageInfo
+
+func (m *FundPromoPoolTicketPayload) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// IssueFreeBetTicketPayload indicates data of the free bet credit issuance
+// ticket.
+type IssueFreeBetTicketPayload struct {
+	// uid is the universal unique identifier of the credit.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// address is the bettor address that the credit is issued to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denomination of the credited amount.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the credited amount including the bet fee.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// expires_at is the timestamp that the credit can not be used after,
+	// zero means the credit does not expire.
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *IssueFreeBetTicketPayload) Reset()         { *m = IssueFreeBetTicketPayload{} }
+func (m *IssueFreeBetTicketPayload) String() string { return proto.CompactTextString(m) }
+func (*IssueFreeBetTicketPayload) ProtoMessage()    {}
+func (*IssueFreeBetTicketPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf6959e7db451613, []int{5}
+}
+func (m *IssueFreeBetTicketPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssueFreeBetTicketPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssueFreeBetTicketPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IssueFreeBetTicketPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueFreeBetTicketPayload.Merge(m, src)
+}
+func (m *IssueFreeBetTicketPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssueFreeBetTicketPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueFreeBetTicketPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueFreeBetTicketPayload proto.InternalMessageInfo
+
+func (m *IssueFreeBetTicketPayload) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *IssueFreeBetTicketPayload) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *IssueFreeBetTicketPayload) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *IssueFreeBetTicketPayload) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// SetAffiliateTicketPayload indicates data of the affiliate registration
+// ticket.
+type SetAffiliateTicketPayload struct {
+	// address is the affiliate address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// fee_share is the ratio of the bet fee of the referred bettors that is
+	// paid to the affiliate.
+	FeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_share,json=feeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_share"`
+}
+
+func (m *SetAffiliateTicketPayload) Reset()         { *m = SetAffiliateTicketPayload{} }
+func (m *SetAffiliateTicketPayload) String() string { return proto.CompactTextString(m) }
+func (*SetAffiliateTicketPayload) ProtoMessage()    {}
+func (*SetAffiliateTicketPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf6959e7db451613, []int{6}
+}
+func (m *SetAffiliateTicketPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAffiliateTicketPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAffiliateTicketPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetAffiliateTicketPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAffiliateTicketPayload.Merge(m, src)
+}
+func (m *SetAffiliateTicketPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAffiliateTicketPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAffiliateTicketPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAffiliateTicketPayload proto.InternalMessageInfo
+
+func (m *SetAffiliateTicketPayload) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// BindReferrerTicketPayload indicates data of the referral binding ticket.
+type BindReferrerTicketPayload struct {
+	// bettor is the referred bettor address.
+	Bettor string `protobuf:"bytes,1,opt,name=bettor,proto3" json:"bettor,omitempty"`
+	// referrer is the affiliate address that referred the bettor.
+	Referrer string `protobuf:"bytes,2,opt,name=referrer,proto3" json:"referrer,omitempty"`
+}
+
+func (m *BindReferrerTicketPayload) Reset()         { *m = BindReferrerTicketPayload{} }
+func (m *BindReferrerTicketPayload) String() string { return proto.CompactTextString(m) }
+func (*BindReferrerTicketPayload) ProtoMessage()    {}
+func (*BindReferrerTicketPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf6959e7db451613, []int{7}
+}
+func (m *BindReferrerTicketPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BindReferrerTicketPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BindReferrerTicketPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BindReferrerTicketPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BindReferrerTicketPayload.Merge(m, src)
+}
+func (m *BindReferrerTicketPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *BindReferrerTicketPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_BindReferrerTicketPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BindReferrerTicketPayload proto.InternalMessageInfo
+
+func (m *BindReferrerTicketPayload) GetBettor() string {
+	if m != nil {
+		return m.Bettor
+	}
+	return ""
+}
+
+func (m *BindReferrerTicketPayload) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+// VoidBetsTicketPayload indicates data of the bet voiding ticket.
+type VoidBetsTicketPayload struct {
+	// bets is the list of the bets to be voided.
+	Bets []VoidBetItem `protobuf:"bytes,1,rep,name=bets,proto3" json:"bets"`
+	// reason is the reason of voiding the bets such as the palpable error in
+	// the odds.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *VoidBetsTicketPayload) Reset()         { *m = VoidBetsTicketPayload{} }
+func (m *VoidBetsTicketPayload) String() string { return proto.CompactTextString(m) }
+func (*VoidBetsTicketPayload) ProtoMessage()    {}
+func (*VoidBetsTicketPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf6959e7db451613, []int{8}
+}
+func (m *VoidBetsTicketPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoidBetsTicketPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoidBetsTicketPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoidBetsTicketPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoidBetsTicketPayload.Merge(m, src)
+}
+func (m *VoidBetsTicketPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoidBetsTicketPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoidBetsTicketPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoidBetsTicketPayload proto.InternalMessageInfo
+
+func (m *VoidBetsTicketPayload) GetBets() []VoidBetItem {
+	if m != nil {
+		return m.Bets
+	}
+	return nil
+}
+
+func (m *VoidBetsTicketPayload) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// VoidBetItem indicates a bet to be voided by the bet voiding ticket.
+type VoidBetItem struct {
+	// uid is the universal unique identifier of the bet.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// creator is the bettor address.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *VoidBetItem) Reset()         { *m = VoidBetItem{} }
+func (m *VoidBetItem) String() string { return proto.CompactTextString(m) }
+func (*VoidBetItem) ProtoMessage()    {}
+func (*VoidBetItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf6959e7db451613, []int{9}
+}
+func (m *VoidBetItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoidBetItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoidBetItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoidBetItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoidBetItem.Merge(m, src)
+}
+func (m *VoidBetItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoidBetItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoidBetItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoidBetItem proto.InternalMessageInfo
+
+func (m *VoidBetItem) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *VoidBetItem) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*WagerTicketPayload)(nil), "sgenetwork.sge.bet.WagerTicketPayload")
+	proto.RegisterType((*WagerTicketLeg)(nil), "sgenetwork.sge.bet.WagerTicketLeg")
+	proto.RegisterType((*CancelBetTicketPayload)(nil), "sgenetwork.sge.bet.CancelBetTicketPayload")
+	proto.RegisterType((*CashOutTicketPayload)(nil), "sgenetwork.sge.bet.CashOutTicketPayload")
+	proto.RegisterType((*FundPromoPoolTicketPayload)(nil), "sgenetwork.sge.bet.FundPromoPoolTicketPayload")
+	proto.RegisterType((*IssueFreeBetTicketPayload)(nil), "sgenetwork.sge.bet.IssueFreeBetTicketPayload")
+	proto.RegisterType((*SetAffiliateTicketPayload)(nil), "sgenetwork.sge.bet.SetAffiliateTicketPayload")
+	proto.RegisterType((*BindReferrerTicketPayload)(nil), "sgenetwork.sge.bet.BindReferrerTicketPayload")
+	proto.RegisterType((*VoidBetsTicketPayload)(nil), "sgenetwork.sge.bet.VoidBetsTicketPayload")
+	proto.RegisterType((*VoidBetItem)(nil), "sgenetwork.sge.bet.VoidBetItem")
+}
+
+func init() { proto.RegisterFile("sge/bet/ticket.proto", fileDescriptor_cf6959e7db451613) }
+
+var fileDescriptor_cf6959e7db451613 = []byte{
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x6f, 0xd3, 0x3c,
+	0x18, 0xc7, 0x9b, 0xb5, 0xdb, 0x5a, 0xf7, 0x7d, 0x77, 0x88, 0xfa, 0xee, 0xed, 0x0a, 0xb4, 0x55,
+	0x90, 0x50, 0x2f, 0x4b, 0xa5, 0x71, 0xda, 0x01, 0x69, 0x4b, 0xab, 0x4a, 0xd5, 0x26, 0x6d, 0xca,
+	0x06, 0x08, 0x2e, 0x95, 0x1b, 0x3f, 0xc9, 0x42, 0x93, 0xb8, 0xb2, 0x1d, 0xb1, 0x70, 0x42, 0x7c,
+	0x02, 0xf8, 0x56, 0x3b, 0xee, 0xc0, 0x01, 0x81, 0x54, 0xa1, 0xee, 0xc6, 0xa7, 0x40, 0x4e, 0xdc,
+	0xd1, 0x8e, 0x81, 0xd8, 0xd8, 0xa5, 0xf5, 0xf3, 0x77, 0xfe, 0x8f, 0x7f, 0xcf, 0x63, 0xc7, 0x41,
+	0x15, 0xee, 0x41, 0x7b, 0x08, 0xa2, 0x2d, 0x7c, 0x67, 0x04, 0xc2, 0x1c, 0x33, 0x2a, 0xa8, 0xae,
+	0x73, 0x0f, 0x22, 0x10, 0xaf, 0x29, 0x1b, 0x99, 0xdc, 0x03, 0x73, 0x08, 0xa2, 0x56, 0xf1, 0xa8,
+	0x47, 0xd3, 0xe9, 0xb6, 0x1c, 0x65, 0x4f, 0xd6, 0xe4, 0x93, 0x6d, 0x91, 0x8c, 0xa1, 0x3d, 0x4a,
+	0x1c, 0xa5, 0xad, 0xcf, 0x72, 0x0e, 0x41, 0x0c, 0x28, 0x21, 0x5c, 0xe9, 0xff, 0xcf, 0x74, 0xa9,
+	0x0d, 0xa4, 0x29, 0x9b, 0x30, 0xbe, 0x2c, 0x21, 0xfd, 0x39, 0xf6, 0x80, 0x1d, 0xa7, 0x10, 0x87,
+	0x38, 0x09, 0x28, 0x26, 0xfa, 0x0e, 0xfa, 0x97, 0x43, 0x00, 0x8e, 0x00, 0x92, 0xa6, 0xa9, 0x6a,
+	0x4d, 0xad, 0x55, 0xde, 0xba, 0x67, 0xfe, 0x4c, 0x67, 0x5a, 0x20, 0x0e, 0x08, 0xe1, 0xf6, 0x3f,
+	0x33, 0x87, 0x8c, 0xf4, 0x2e, 0x2a, 0x8e, 0x12, 0x67, 0x40, 0xb0, 0xc0, 0xd5, 0xa5, 0xd4, 0xfc,
+	0xf0, 0xaa, 0x39, 0xc5, 0xd8, 0x4b, 0x9c, 0x2e, 0x16, 0x58, 0x2d, 0x6c, 0x15, 0xce, 0x26, 0x8d,
+	0x9c, 0xbd, 0x3a, 0xca, 0x54, 0x7d, 0x1b, 0x95, 0x2e, 0x89, 0xab, 0xf9, 0xa6, 0xd6, 0x5a, 0xdb,
+	0xba, 0x7f, 0x1d, 0x83, 0x5c, 0xf2, 0x38, 0x19, 0x83, 0x5d, 0xa4, 0x6a, 0xa4, 0x3f, 0x41, 0x45,
+	0x1c, 0x04, 0x19, 0x7d, 0xa1, 0x99, 0x6f, 0x95, 0xb7, 0x8c, 0xdf, 0xd0, 0x77, 0x68, 0x38, 0xc6,
+	0x8e, 0xb0, 0x57, 0x71, 0x10, 0xa4, 0xfc, 0x1d, 0x54, 0x1e, 0x63, 0x16, 0xe0, 0x64, 0x10, 0x80,
+	0xc7, 0xab, 0xcb, 0xbf, 0xce, 0x30, 0xd7, 0xbe, 0x7d, 0xf0, 0x6c, 0x94, 0xd9, 0xf6, 0xc1, 0xe3,
+	0xc6, 0x07, 0x0d, 0xad, 0x2d, 0x4e, 0xdf, 0x41, 0x67, 0xe7, 0x0b, 0x5b, 0xba, 0x71, 0x61, 0xc6,
+	0x0b, 0xb4, 0xde, 0xc1, 0x91, 0x03, 0x81, 0x05, 0x62, 0x71, 0xd3, 0x9b, 0x28, 0x1f, 0xfb, 0x24,
+	0x05, 0x2a, 0x59, 0x6b, 0xd3, 0x49, 0x23, 0xff, 0xb4, 0xdf, 0xfd, 0x36, 0x69, 0x48, 0xd5, 0x96,
+	0x3f, 0xfa, 0x03, 0x84, 0x18, 0xb8, 0x71, 0x44, 0x06, 0x2e, 0x40, 0xba, 0xad, 0x45, 0xbb, 0x94,
+	0x29, 0x3d, 0x00, 0xe3, 0xad, 0x86, 0x2a, 0x1d, 0xcc, 0x4f, 0x0e, 0xe2, 0x1b, 0x67, 0xee, 0xa1,
+	0x15, 0x1c, 0xd2, 0x38, 0x12, 0x69, 0xd6, 0x92, 0x65, 0xca, 0x73, 0xf0, 0x79, 0xd2, 0x78, 0xe4,
+	0xf9, 0xe2, 0x24, 0x1e, 0x9a, 0x0e, 0x0d, 0xdb, 0x0e, 0xe5, 0x21, 0xe5, 0xea, 0x6f, 0x93, 0x93,
+	0x51, 0x7a, 0xfa, 0xb9, 0xd9, 0x8f, 0x84, 0xad, 0xdc, 0xc6, 0x1b, 0x54, 0xeb, 0xc5, 0x11, 0x39,
+	0x64, 0x34, 0xa4, 0x87, 0x94, 0x06, 0x8b, 0x1c, 0x15, 0xb4, 0x4c, 0x20, 0xa2, 0x61, 0x46, 0x62,
+	0x67, 0xc1, 0x9d, 0xad, 0xfd, 0x51, 0x43, 0x1b, 0x7d, 0xce, 0x63, 0xe8, 0x31, 0x80, 0x5b, 0x74,
+	0xb7, 0x8a, 0x56, 0x31, 0x21, 0x0c, 0x38, 0xcf, 0x40, 0xec, 0x59, 0xf8, 0x83, 0x3b, 0x7f, 0x3d,
+	0x77, 0xe1, 0x6f, 0xb8, 0xe5, 0xae, 0xc2, 0xe9, 0xd8, 0x67, 0xc0, 0x07, 0x58, 0x54, 0x97, 0x9b,
+	0x5a, 0x2b, 0x6f, 0x97, 0x94, 0xb2, 0x2b, 0x8c, 0x77, 0x1a, 0xda, 0x38, 0x02, 0xb1, 0xeb, 0xba,
+	0x7e, 0xe0, 0x63, 0x01, 0x8b, 0x65, 0xcd, 0x41, 0x6b, 0x8b, 0xd0, 0x7b, 0xa8, 0xe4, 0x02, 0x0c,
+	0xf8, 0x09, 0x66, 0x70, 0x8b, 0xce, 0x76, 0xc1, 0xb1, 0x8b, 0x2e, 0xc0, 0x91, 0xf4, 0x1b, 0x07,
+	0x68, 0xc3, 0xf2, 0x23, 0x62, 0x83, 0x0b, 0x8c, 0x5d, 0xbd, 0xad, 0xd6, 0xd1, 0xca, 0x10, 0x84,
+	0xa0, 0x4c, 0x21, 0xa8, 0x48, 0xaf, 0xa1, 0x22, 0x53, 0x06, 0xd5, 0xd1, 0xcb, 0xd8, 0x78, 0x85,
+	0xfe, 0x7b, 0x46, 0x7d, 0x62, 0x81, 0xe0, 0x8b, 0xc9, 0xb6, 0x51, 0x61, 0x08, 0x42, 0x56, 0x23,
+	0x5f, 0xad, 0xc6, 0x75, 0xaf, 0x96, 0x32, 0xf6, 0x05, 0x84, 0xea, 0xc2, 0x4a, 0x2d, 0x92, 0x83,
+	0x01, 0xe6, 0x34, 0x52, 0xab, 0xa9, 0xc8, 0xe8, 0xa3, 0xf2, 0x9c, 0xe5, 0xcf, 0x4e, 0x82, 0xc3,
+	0x00, 0xcb, 0x8a, 0xd4, 0x49, 0x50, 0xa1, 0xb5, 0x73, 0x36, 0xad, 0x6b, 0xe7, 0xd3, 0xba, 0xf6,
+	0x75, 0x5a, 0xd7, 0xde, 0x5f, 0xd4, 0x73, 0xe7, 0x17, 0xf5, 0xdc, 0xa7, 0x8b, 0x7a, 0xee, 0xe5,
+	0x7c, 0x4f, 0xb9, 0x07, 0x9b, 0x0a, 0x5a, 0x8e, 0xdb, 0xa7, 0xd9, 0x77, 0x46, 0xf6, 0x75, 0xb8,
+	0x92, 0x5e, 0xfc, 0x8f, 0xbf, 0x0f, 0x00, 0x9d, 0x78, 0x9e, 0x89, 0x7f, 0x06, 0x00, 0x00,
+}
+
+func (m *WagerTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WagerTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WagerTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParlayLegs) > 0 {
+		for iNdEx := len(m.ParlayLegs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParlayLegs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllOdds) > 0 {
+		for iNdEx := len(m.AllOdds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllOdds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.OddsType != 0 {
+		i = encodeVarintTicket(dAtA, i, uint64(m.OddsType))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.KycData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTicket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SelectedOdds != nil {
+		{
+			size, err := m.SelectedOdds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTicket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WagerTicketLeg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WagerTicketLeg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WagerTicketLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllOdds) > 0 {
+		for iNdEx := len(m.AllOdds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllOdds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SelectedOdds != nil {
+		{
+			size, err := m.SelectedOdds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTicket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelBetTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelBetTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelBetTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundFee {
+		i--
+		if m.RefundFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CashOutTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CashOutTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CashOutTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTicket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundPromoPoolTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundPromoPoolTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundPromoPoolTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTicket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IssueFreeBetTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueFreeBetTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueFreeBetTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTicket(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTicket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetAffiliateTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAffiliateTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetAffiliateTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeShare.Size()
+		i -= size
+		if _, err := m.FeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTicket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BindReferrerTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BindReferrerTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BindReferrerTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bettor) > 0 {
+		i -= len(m.Bettor)
+		copy(dAtA[i:], m.Bettor)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Bettor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoidBetsTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoidBetsTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoidBetsTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bets) > 0 {
+		for iNdEx := len(m.Bets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoidBetItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoidBetItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoidBetItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTicket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTicket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WagerTicketPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SelectedOdds != nil {
+		l = m.SelectedOdds.Size()
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = m.KycData.Size()
+	n += 1 + l + sovTicket(uint64(l))
+	if m.OddsType != 0 {
+		n += 1 + sovTicket(uint64(m.OddsType))
+	}
+	if len(m.AllOdds) > 0 {
+		for _, e := range m.AllOdds {
+			l = e.Size()
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	if len(m.ParlayLegs) > 0 {
+		for _, e := range m.ParlayLegs {
+			l = e.Size()
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WagerTicketLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SelectedOdds != nil {
+		l = m.SelectedOdds.Size()
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	if len(m.AllOdds) > 0 {
+		for _, e := range m.AllOdds {
+			l = e.Size()
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CancelBetTicketPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	if m.RefundFee {
+		n += 2
+	}
+	return n
+}
+
+func (m *CashOutTicketPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTicket(uint64(l))
+	return n
+}
+
+func (m *FundPromoPoolTicketPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTicket(uint64(l))
+	return n
+}
+
+func (m *IssueFreeBetTicketPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTicket(uint64(l))
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTicket(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *SetAffiliateTicketPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = m.FeeShare.Size()
+	n += 1 + l + sovTicket(uint64(l))
+	return n
+}
+
+func (m *BindReferrerTicketPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bettor)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	return n
+}
+
+func (m *VoidBetsTicketPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bets) > 0 {
+		for _, e := range m.Bets {
+			l = e.Size()
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	return n
+}
+
+func (m *VoidBetItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	return n
+}
+
+func sovTicket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTicket(x uint64) (n int) {
+	return sovTicket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WagerTicketPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WagerTicketPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WagerTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedOdds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SelectedOdds == nil {
+				m.SelectedOdds = &BetOdds{}
+			}
+			if err := m.SelectedOdds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KycData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KycData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsType", wireType)
+			}
+			m.OddsType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OddsType |= OddsType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOdds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllOdds = append(m.AllOdds, &BetOddsCompact{})
+			if err := m.AllOdds[len(m.AllOdds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParlayLegs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParlayLegs = append(m.ParlayLegs, &WagerTicketLeg{})
+			if err := m.ParlayLegs[len(m.ParlayLegs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WagerTicketLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WagerTicketLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WagerTicketLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedOdds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SelectedOdds == nil {
+				m.SelectedOdds = &BetOdds{}
+			}
+			if err := m.SelectedOdds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOdds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllOdds = append(m.AllOdds, &BetOddsCompact{})
+			if err := m.AllOdds[len(m.AllOdds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelBetTicketPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelBetTicketPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelBetTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFee = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CashOutTicketPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CashOutTicketPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CashOutTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundPromoPoolTicketPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundPromoPoolTicketPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundPromoPoolTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssueFreeBetTicketPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssueFreeBetTicketPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssueFreeBetTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAffiliateTicketPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAffiliateTicketPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAffiliateTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i--
-			dAtA[i] = 0x22
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.OddsType != 0 {
-		i = encodeVarintTicket(dAtA, i, uint64(m.OddsType))
-		i--
-		dAtA[i] = 0x18
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	{
-		size, err := m.KycData.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	return nil
+}
+func (m *BindReferrerTicketPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-		i -= size
-		i = encodeVarintTicket(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.SelectedOdds != nil {
-		{
-			size, err := m.SelectedOdds.MarshalToSizedBuffer(dAtA[:i])
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BindReferrerTicketPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BindReferrerTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bettor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bettor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintTicket(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTicket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTicket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *WagerTicketPayload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SelectedOdds != nil {
-		l = m.SelectedOdds.Size()
-		n += 1 + l + sovTicket(uint64(l))
-	}
-	l = m.KycData.Size()
-	n += 1 + l + sovTicket(uint64(l))
-	if m.OddsType != 0 {
-		n += 1 + sovTicket(uint64(m.OddsType))
-	}
-	if len(m.AllOdds) > 0 {
-		for _, e := range m.AllOdds {
-			l = e.Size()
-			n += 1 + l + sovTicket(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTicket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTicket(x uint64) (n int) {
-	return sovTicket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *WagerTicketPayload) Unmarshal(dAtA []byte) error {
+func (m *VoidBetsTicketPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -257,15 +2455,15 @@ func (m *WagerTicketPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WagerTicketPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoidBetsTicketPayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WagerTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoidBetsTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelectedOdds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -292,18 +2490,16 @@ func (m *WagerTicketPayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SelectedOdds == nil {
-				m.SelectedOdds = &BetOdds{}
-			}
-			if err := m.SelectedOdds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bets = append(m.Bets, VoidBetItem{})
+			if err := m.Bets[len(m.Bets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KycData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicket
@@ -313,30 +2509,79 @@ func (m *WagerTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTicket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTicket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KycData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OddsType", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
 			}
-			m.OddsType = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoidBetItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoidBetItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoidBetItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicket
@@ -346,16 +2591,29 @@ func (m *WagerTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OddsType |= OddsType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllOdds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicket
@@ -365,25 +2623,23 @@ func (m *WagerTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTicket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTicket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllOdds = append(m.AllOdds, &BetOddsCompact{})
-			if err := m.AllOdds[len(m.AllOdds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/google/uuid"
+	"github.com/sge-network/sge/testutil/sample"
 	sgetypes "github.com/sge-network/sge/types"
 	"github.com/sge-network/sge/x/bet/types"
 	"github.com/stretchr/testify/require"
@@ -120,3 +123,148 @@ func TestTicketFieldsValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestParlayTicketValidation(t *testing.T) {
+	kyc := sgetypes.KycDataPayload{
+		Approved: true,
+		ID:       testAddress,
+	}
+	newLeg := func(marketUID string) *types.WagerTicketLeg {
+		return &types.WagerTicketLeg{
+			SelectedOdds: &types.BetOdds{
+				MarketUID:         marketUID,
+				UID:               "6e31c60f-2025-48ce-ae79-1dc110f16355",
+				Value:             "1.5",
+				MaxLossMultiplier: sdk.MustNewDecFromStr("0.1"),
+			},
+		}
+	}
+
+	tcs := []struct {
+		desc         string
+		selectedOdds *types.BetOdds
+		legs         []*types.WagerTicketLeg
+		err          error
+	}{
+		{
+			desc: "single leg",
+			legs: []*types.WagerTicketLeg{
+				newLeg("6e31c60f-2025-48ce-ae79-1dc110f16355"),
+			},
+			err: types.ErrInvalidParlayLegsCount,
+		},
+		{
+			desc:         "selected odds is set",
+			selectedOdds: newLeg("6e31c60f-2025-48ce-ae79-1dc110f16355").SelectedOdds,
+			legs: []*types.WagerTicketLeg{
+				newLeg("6e31c60f-2025-48ce-ae79-1dc110f16355"),
+				newLeg("7e31c60f-2025-48ce-ae79-1dc110f16355"),
+			},
+			err: types.ErrParlayWithSingleOdds,
+		},
+		{
+			desc: "duplicate market",
+			legs: []*types.WagerTicketLeg{
+				newLeg("6e31c60f-2025-48ce-ae79-1dc110f16355"),
+				newLeg("6e31c60f-2025-48ce-ae79-1dc110f16355"),
+			},
+			err: types.ErrDuplicateParlayMarket,
+		},
+		{
+			desc: "invalid leg odds",
+			legs: []*types.WagerTicketLeg{
+				newLeg("6e31c60f-2025-48ce-ae79-1dc110f16355"),
+				newLeg(" "),
+			},
+			err: types.ErrInvalidMarketUID,
+		},
+		{
+			desc: "valid parlay",
+			legs: []*types.WagerTicketLeg{
+				newLeg("6e31c60f-2025-48ce-ae79-1dc110f16355"),
+				newLeg("7e31c60f-2025-48ce-ae79-1dc110f16355"),
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.desc, func(t *testing.T) {
+			p := types.WagerTicketPayload{
+				SelectedOdds: tc.selectedOdds,
+				KycData:      kyc,
+				OddsType:     types.OddsType_ODDS_TYPE_DECIMAL,
+				ParlayLegs:   tc.legs,
+			}
+			err := p.Validate(testAddress)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVoidBetsTicketValidation(t *testing.T) {
+	bettor := sample.AccAddress()
+	betUID := uuid.NewString()
+
+	tcs := []struct {
+		desc    string
+		payload types.VoidBetsTicketPayload
+		err     error
+	}{
+		{
+			desc:    "no bets",
+			payload: types.VoidBetsTicketPayload{Reason: "palpable error"},
+			err:     types.ErrNoBetsToVoid,
+		},
+		{
+			desc: "invalid bet uid",
+			payload: types.VoidBetsTicketPayload{
+				Bets:   []types.VoidBetItem{{UID: "invalid", Creator: bettor}},
+				Reason: "palpable error",
+			},
+			err: types.ErrInvalidBetUID,
+		},
+		{
+			desc: "invalid creator",
+			payload: types.VoidBetsTicketPayload{
+				Bets:   []types.VoidBetItem{{UID: betUID, Creator: "invalid"}},
+				Reason: "palpable error",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc: "duplicate bet uid",
+			payload: types.VoidBetsTicketPayload{
+				Bets:   []types.VoidBetItem{{UID: betUID, Creator: bettor}, {UID: betUID, Creator: bettor}},
+				Reason: "palpable error",
+			},
+			err: types.ErrDuplicateUID,
+		},
+		{
+			desc: "empty reason",
+			payload: types.VoidBetsTicketPayload{
+				Bets: []types.VoidBetItem{{UID: betUID, Creator: bettor}},
+			},
+			err: types.ErrEmptyVoidReason,
+		},
+		{
+			desc: "valid",
+			payload: types.VoidBetsTicketPayload{
+				Bets:   []types.VoidBetItem{{UID: betUID, Creator: bettor}},
+				Reason: "palpable error",
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.payload.Validate()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -128,239 +130,5897 @@ func (m *MsgWagerResponse) GetProps() *WagerProps {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgWager)(nil), "sgenetwork.sge.bet.MsgWager")
-	proto.RegisterType((*MsgWagerResponse)(nil), "sgenetwork.sge.bet.MsgWagerResponse")
+// MsgWagerBatch defines a message to place many bets with the given data.
+type MsgWagerBatch struct {
+	// creator is the bettor address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// props contains the properties of the bets.
+	Props []*WagerProps `protobuf:"bytes,2,rep,name=props,proto3" json:"props,omitempty"`
+	// mode is the execution mode of the bets.
+	Mode WagerBatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=sgenetwork.sge.bet.WagerBatchMode" json:"mode,omitempty"`
 }
 
-func init() { proto.RegisterFile("sge/bet/tx.proto", fileDescriptor_38b4167f68c2a7f8) }
+func (m *MsgWagerBatch) Reset()         { *m = MsgWagerBatch{} }
+func (m *MsgWagerBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgWagerBatch) ProtoMessage()    {}
+func (*MsgWagerBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{2}
+}
+func (m *MsgWagerBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWagerBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWagerBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWagerBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWagerBatch.Merge(m, src)
+}
+func (m *MsgWagerBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWagerBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWagerBatch.DiscardUnknown(m)
+}
 
-var fileDescriptor_38b4167f68c2a7f8 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x28, 0x4e, 0x4f, 0xd5,
-	0x4f, 0x4a, 0x2d, 0xd1, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2a, 0x4e,
-	0x4f, 0xcd, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x2b, 0x4e, 0x4f, 0xd5, 0x4b, 0x4a, 0x2d,
-	0x91, 0x12, 0x86, 0xa9, 0x2a, 0x4f, 0x4c, 0x4f, 0x2d, 0x82, 0x28, 0x54, 0x8a, 0xe2, 0xe2, 0xf0,
-	0x2d, 0x4e, 0x0f, 0x07, 0x89, 0x08, 0x49, 0x70, 0xb1, 0x27, 0x17, 0xa5, 0x26, 0x96, 0xe4, 0x17,
-	0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8, 0x42, 0x26, 0x5c, 0xac, 0x05, 0x45, 0xf9,
-	0x05, 0xc5, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x72, 0x7a, 0x98, 0xc6, 0xeb, 0x81, 0xcd,
-	0x08, 0x00, 0xa9, 0x0a, 0x82, 0x28, 0x56, 0xf2, 0xe0, 0x12, 0x80, 0x99, 0x1d, 0x94, 0x5a, 0x5c,
-	0x90, 0x9f, 0x57, 0x9c, 0x8a, 0x30, 0x89, 0x91, 0x04, 0x93, 0x8c, 0x82, 0xb8, 0x98, 0x7d, 0x8b,
-	0xd3, 0x85, 0xbc, 0xb9, 0x58, 0x21, 0x2e, 0x95, 0xc1, 0xa6, 0x0d, 0x66, 0x97, 0x94, 0x0a, 0x3e,
-	0x59, 0x98, 0x4b, 0x9c, 0x1c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x2d, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x38, 0x3d, 0x55, 0x17,
-	0x6a, 0x14, 0x88, 0xad, 0x5f, 0x01, 0x09, 0xe7, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x10,
-	0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x41, 0x9b, 0xc2, 0x84, 0x7f, 0x01, 0x00, 0x00,
+var xxx_messageInfo_MsgWagerBatch proto.InternalMessageInfo
+
+func (m *MsgWagerBatch) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgWagerBatch) GetProps() []*WagerProps {
+	if m != nil {
+		return m.Props
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgWagerBatch) GetMode() WagerBatchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return WagerBatchMode_WAGER_BATCH_MODE_UNSPECIFIED
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Wager defines a method to place a bet with the given data.
-	Wager(ctx context.Context, in *MsgWager, opts ...grpc.CallOption) (*MsgWagerResponse, error)
+// MsgWagerBatchResponse is the returning value in the response
+// of MsgWagerBatch request.
+type MsgWagerBatchResponse struct {
+	// results contains the placement result of each of the bets in the order
+	// of the request.
+	Results []WagerBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgWagerBatchResponse) Reset()         { *m = MsgWagerBatchResponse{} }
+func (m *MsgWagerBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWagerBatchResponse) ProtoMessage()    {}
+func (*MsgWagerBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{3}
+}
+func (m *MsgWagerBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWagerBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWagerBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWagerBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWagerBatchResponse.Merge(m, src)
+}
+func (m *MsgWagerBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWagerBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWagerBatchResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgWagerBatchResponse proto.InternalMessageInfo
+
+func (m *MsgWagerBatchResponse) GetResults() []WagerBatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-func (c *msgClient) Wager(ctx context.Context, in *MsgWager, opts ...grpc.CallOption) (*MsgWagerResponse, error) {
-	out := new(MsgWagerResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/Wager", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgCancelBet defines a message to cancel a placed bet.
+type MsgCancelBet struct {
+	// creator is the bettor address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ticket is the jwt ticket data containing the bet uid.
+	Ticket string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (m *MsgCancelBet) Reset()         { *m = MsgCancelBet{} }
+func (m *MsgCancelBet) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBet) ProtoMessage()    {}
+func (*MsgCancelBet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{4}
+}
+func (m *MsgCancelBet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelBet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBet.Merge(m, src)
+}
+func (m *MsgCancelBet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBet.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Wager defines a method to place a bet with the given data.
-	Wager(context.Context, *MsgWager) (*MsgWagerResponse, error)
+var xxx_messageInfo_MsgCancelBet proto.InternalMessageInfo
+
+func (m *MsgCancelBet) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCancelBet) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) Wager(ctx context.Context, req *MsgWager) (*MsgWagerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Wager not implemented")
+// MsgCancelBetResponse is the returning value in the response
+// of MsgCancelBet request.
+type MsgCancelBetResponse struct {
+	// uid is the universal unique identifier of the canceled bet.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgCancelBetResponse) Reset()         { *m = MsgCancelBetResponse{} }
+func (m *MsgCancelBetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBetResponse) ProtoMessage()    {}
+func (*MsgCancelBetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{5}
+}
+func (m *MsgCancelBetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelBetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBetResponse.Merge(m, src)
+}
+func (m *MsgCancelBetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBetResponse.DiscardUnknown(m)
 }
 
-func _Msg_Wager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWager)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelBetResponse proto.InternalMessageInfo
+
+func (m *MsgCancelBetResponse) GetUID() string {
+	if m != nil {
+		return m.UID
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Wager(ctx, in)
+	return ""
+}
+
+// MsgCashOut defines a message to settle an open bet early at the
+// oracle-quoted price.
+type MsgCashOut struct {
+	// creator is the bettor address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ticket is the jwt ticket data containing the cash-out quote.
+	Ticket string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (m *MsgCashOut) Reset()         { *m = MsgCashOut{} }
+func (m *MsgCashOut) String() string { return proto.CompactTextString(m) }
+func (*MsgCashOut) ProtoMessage()    {}
+func (*MsgCashOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{6}
+}
+func (m *MsgCashOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCashOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCashOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.bet.Msg/Wager",
+}
+func (m *MsgCashOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCashOut.Merge(m, src)
+}
+func (m *MsgCashOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCashOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCashOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCashOut proto.InternalMessageInfo
+
+func (m *MsgCashOut) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Wager(ctx, req.(*MsgWager))
+	return ""
+}
+
+func (m *MsgCashOut) GetTicket() string {
+	if m != nil {
+		return m.Ticket
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sgenetwork.sge.bet.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Wager",
-			Handler:    _Msg_Wager_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sge/bet/tx.proto",
+// MsgCashOutResponse is the returning value in the response
+// of MsgCashOut request.
+type MsgCashOutResponse struct {
+	// uid is the universal unique identifier of the cashed out bet.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// amount is the paid cash-out amount.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *MsgWager) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCashOutResponse) Reset()         { *m = MsgCashOutResponse{} }
+func (m *MsgCashOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCashOutResponse) ProtoMessage()    {}
+func (*MsgCashOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{7}
+}
+func (m *MsgCashOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCashOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCashOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCashOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCashOutResponse.Merge(m, src)
+}
+func (m *MsgCashOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCashOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCashOutResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWager) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCashOutResponse proto.InternalMessageInfo
+
+func (m *MsgCashOutResponse) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
 }
 
-func (m *MsgWager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Props != nil {
-		{
-			size, err := m.Props.MarshalToSizedBuffer(dAtA[:i])
+// MsgVoidBets defines a message to void and refund the bets accepted at an
+// erroneous price.
+type MsgVoidBets struct {
+	// creator is the operator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ticket is the jwt ticket data containing the bets and the void reason.
+	Ticket string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (m *MsgVoidBets) Reset()         { *m = MsgVoidBets{} }
+func (m *MsgVoidBets) String() string { return proto.CompactTextString(m) }
+func (*MsgVoidBets) ProtoMessage()    {}
+func (*MsgVoidBets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{8}
+}
+func (m *MsgVoidBets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoidBets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoidBets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoidBets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoidBets.Merge(m, src)
+}
+func (m *MsgVoidBets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoidBets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoidBets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoidBets proto.InternalMessageInfo
+
+func (m *MsgVoidBets) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgVoidBets) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
+}
+
+// MsgVoidBetsResponse is the returning value in the response
+// of MsgVoidBets request.
+type MsgVoidBetsResponse struct {
+	// uids is the list of the universal unique identifiers of the voided bets.
+	UIDs []string `protobuf:"bytes,1,rep,name=uids,proto3" json:"uids"`
+}
+
+func (m *MsgVoidBetsResponse) Reset()         { *m = MsgVoidBetsResponse{} }
+func (m *MsgVoidBetsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoidBetsResponse) ProtoMessage()    {}
+func (*MsgVoidBetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{9}
+}
+func (m *MsgVoidBetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoidBetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoidBetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoidBetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoidBetsResponse.Merge(m, src)
+}
+func (m *MsgVoidBetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoidBetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoidBetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoidBetsResponse proto.InternalMessageInfo
+
+func (m *MsgVoidBetsResponse) GetUIDs() []string {
+	if m != nil {
+		return m.UIDs
+	}
+	return nil
+}
+
+// MsgSettleBets defines a message to settle the bets of the resolved markets,
+// it can be sent by the bettor or any other account such as a keeper bot.
+type MsgSettleBets struct {
+	// creator is the address of the account that settles the bets.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// bets is the list of the bets to be settled.
+	Bets []SettleBetItem `protobuf:"bytes,2,rep,name=bets,proto3" json:"bets"`
+}
+
+func (m *MsgSettleBets) Reset()         { *m = MsgSettleBets{} }
+func (m *MsgSettleBets) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleBets) ProtoMessage()    {}
+func (*MsgSettleBets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{10}
+}
+func (m *MsgSettleBets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleBets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleBets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSettleBets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleBets.Merge(m, src)
+}
+func (m *MsgSettleBets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleBets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleBets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSettleBets proto.InternalMessageInfo
+
+func (m *MsgSettleBets) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSettleBets) GetBets() []SettleBetItem {
+	if m != nil {
+		return m.Bets
+	}
+	return nil
+}
+
+// SettleBetItem indicates a bet to be settled by the settle bets message.
+type SettleBetItem struct {
+	// uid is the universal unique identifier of the bet.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// creator is the bettor address.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *SettleBetItem) Reset()         { *m = SettleBetItem{} }
+func (m *SettleBetItem) String() string { return proto.CompactTextString(m) }
+func (*SettleBetItem) ProtoMessage()    {}
+func (*SettleBetItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{11}
+}
+func (m *SettleBetItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettleBetItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettleBetItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettleBetItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleBetItem.Merge(m, src)
+}
+func (m *SettleBetItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettleBetItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleBetItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleBetItem proto.InternalMessageInfo
+
+func (m *SettleBetItem) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *SettleBetItem) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgSettleBetsResponse is the returning value in the response
+// of MsgSettleBets request.
+type MsgSettleBetsResponse struct {
+	// uids is the list of the universal unique identifiers of the settled bets.
+	UIDs []string `protobuf:"bytes,1,rep,name=uids,proto3" json:"uids"`
+}
+
+func (m *MsgSettleBetsResponse) Reset()         { *m = MsgSettleBetsResponse{} }
+func (m *MsgSettleBetsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleBetsResponse) ProtoMessage()    {}
+func (*MsgSettleBetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{12}
+}
+func (m *MsgSettleBetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleBetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleBetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSettleBetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleBetsResponse.Merge(m, src)
+}
+func (m *MsgSettleBetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleBetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleBetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSettleBetsResponse proto.InternalMessageInfo
+
+func (m *MsgSettleBetsResponse) GetUIDs() []string {
+	if m != nil {
+		return m.UIDs
+	}
+	return nil
+}
+
+// MsgSetSelfExclusion defines a message to exclude the bettor from wagering
+// until a certain time.
+type MsgSetSelfExclusion struct {
+	// creator is the bettor address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// until is the unix timestamp until that the bettor is excluded.
+	Until int64 `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (m *MsgSetSelfExclusion) Reset()         { *m = MsgSetSelfExclusion{} }
+func (m *MsgSetSelfExclusion) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSelfExclusion) ProtoMessage()    {}
+func (*MsgSetSelfExclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{13}
+}
+func (m *MsgSetSelfExclusion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSelfExclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSelfExclusion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSelfExclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSelfExclusion.Merge(m, src)
+}
+func (m *MsgSetSelfExclusion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSelfExclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSelfExclusion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSelfExclusion proto.InternalMessageInfo
+
+func (m *MsgSetSelfExclusion) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetSelfExclusion) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+// MsgSetSelfExclusionResponse is the returning value in the response
+// of MsgSetSelfExclusion request.
+type MsgSetSelfExclusionResponse struct {
+}
+
+func (m *MsgSetSelfExclusionResponse) Reset()         { *m = MsgSetSelfExclusionResponse{} }
+func (m *MsgSetSelfExclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSelfExclusionResponse) ProtoMessage()    {}
+func (*MsgSetSelfExclusionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{14}
+}
+func (m *MsgSetSelfExclusionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSelfExclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSelfExclusionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSelfExclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSelfExclusionResponse.Merge(m, src)
+}
+func (m *MsgSetSelfExclusionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSelfExclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSelfExclusionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSelfExclusionResponse proto.InternalMessageInfo
+
+// MsgSetBettorLimit defines a message to set a stake or net loss limit
+// of the bettor, tightened limits take effect immediately and loosened
+// limits take effect after the cooling-off period.
+type MsgSetBettorLimit struct {
+	// creator is the bettor address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// limit_type is the type of the limit.
+	LimitType LimitType `protobuf:"varint,2,opt,name=limit_type,json=limitType,proto3,enum=sgenetwork.sge.bet.LimitType" json:"limit_type,omitempty"`
+	// period is the rolling period of the limit.
+	Period LimitPeriod `protobuf:"varint,3,opt,name=period,proto3,enum=sgenetwork.sge.bet.LimitPeriod" json:"period,omitempty"`
+	// denom is the denomination of the limited amount.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the limited amount, zero removes the limit.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *MsgSetBettorLimit) Reset()         { *m = MsgSetBettorLimit{} }
+func (m *MsgSetBettorLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBettorLimit) ProtoMessage()    {}
+func (*MsgSetBettorLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{15}
+}
+func (m *MsgSetBettorLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBettorLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBettorLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBettorLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBettorLimit.Merge(m, src)
+}
+func (m *MsgSetBettorLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBettorLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBettorLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBettorLimit proto.InternalMessageInfo
+
+func (m *MsgSetBettorLimit) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetBettorLimit) GetLimitType() LimitType {
+	if m != nil {
+		return m.LimitType
+	}
+	return LimitType_LIMIT_TYPE_UNSPECIFIED
+}
+
+func (m *MsgSetBettorLimit) GetPeriod() LimitPeriod {
+	if m != nil {
+		return m.Period
+	}
+	return LimitPeriod_LIMIT_PERIOD_UNSPECIFIED
+}
+
+func (m *MsgSetBettorLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetBettorLimitResponse is the returning value in the response
+// of MsgSetBettorLimit request.
+type MsgSetBettorLimitResponse struct {
+	// limit is the limit after the update.
+	Limit BettorLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+}
+
+func (m *MsgSetBettorLimitResponse) Reset()         { *m = MsgSetBettorLimitResponse{} }
+func (m *MsgSetBettorLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBettorLimitResponse) ProtoMessage()    {}
+func (*MsgSetBettorLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{16}
+}
+func (m *MsgSetBettorLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBettorLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBettorLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBettorLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBettorLimitResponse.Merge(m, src)
+}
+func (m *MsgSetBettorLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBettorLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBettorLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBettorLimitResponse proto.InternalMessageInfo
+
+func (m *MsgSetBettorLimitResponse) GetLimit() BettorLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return BettorLimit{}
+}
+
+// MsgFundPromoPool defines a message to fund the promo pool.
+type MsgFundPromoPool struct {
+	// creator is the operator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ticket is the jwt ticket data containing the funding amount.
+	Ticket string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (m *MsgFundPromoPool) Reset()         { *m = MsgFundPromoPool{} }
+func (m *MsgFundPromoPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundPromoPool) ProtoMessage()    {}
+func (*MsgFundPromoPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{17}
+}
+func (m *MsgFundPromoPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundPromoPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundPromoPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundPromoPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundPromoPool.Merge(m, src)
+}
+func (m *MsgFundPromoPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundPromoPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundPromoPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundPromoPool proto.InternalMessageInfo
+
+func (m *MsgFundPromoPool) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgFundPromoPool) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
+}
+
+// MsgFundPromoPoolResponse is the returning value in the response
+// of MsgFundPromoPool request.
+type MsgFundPromoPoolResponse struct {
+}
+
+func (m *MsgFundPromoPoolResponse) Reset()         { *m = MsgFundPromoPoolResponse{} }
+func (m *MsgFundPromoPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundPromoPoolResponse) ProtoMessage()    {}
+func (*MsgFundPromoPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{18}
+}
+func (m *MsgFundPromoPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundPromoPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundPromoPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundPromoPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundPromoPoolResponse.Merge(m, src)
+}
+func (m *MsgFundPromoPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundPromoPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundPromoPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundPromoPoolResponse proto.InternalMessageInfo
+
+// MsgIssueFreeBet defines a message to issue a free bet credit.
+type MsgIssueFreeBet struct {
+	// creator is the operator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ticket is the jwt ticket data containing the free bet credit.
+	Ticket string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (m *MsgIssueFreeBet) Reset()         { *m = MsgIssueFreeBet{} }
+func (m *MsgIssueFreeBet) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueFreeBet) ProtoMessage()    {}
+func (*MsgIssueFreeBet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{19}
+}
+func (m *MsgIssueFreeBet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssueFreeBet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssueFreeBet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIssueFreeBet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueFreeBet.Merge(m, src)
+}
+func (m *MsgIssueFreeBet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssueFreeBet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueFreeBet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIssueFreeBet proto.InternalMessageInfo
+
+func (m *MsgIssueFreeBet) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgIssueFreeBet) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
+}
+
+// MsgIssueFreeBetResponse is the returning value in the response
+// of MsgIssueFreeBet request.
+type MsgIssueFreeBetResponse struct {
+	// credit is the issued free bet credit.
+	Credit FreeBetCredit `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit"`
+}
+
+func (m *MsgIssueFreeBetResponse) Reset()         { *m = MsgIssueFreeBetResponse{} }
+func (m *MsgIssueFreeBetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueFreeBetResponse) ProtoMessage()    {}
+func (*MsgIssueFreeBetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{20}
+}
+func (m *MsgIssueFreeBetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssueFreeBetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssueFreeBetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIssueFreeBetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueFreeBetResponse.Merge(m, src)
+}
+func (m *MsgIssueFreeBetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssueFreeBetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueFreeBetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIssueFreeBetResponse proto.InternalMessageInfo
+
+func (m *MsgIssueFreeBetResponse) GetCredit() FreeBetCredit {
+	if m != nil {
+		return m.Credit
+	}
+	return FreeBetCredit{}
+}
+
+// MsgSetAffiliate defines a message to register an affiliate or update its
+// bet fee share.
+type MsgSetAffiliate struct {
+	// creator is the operator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ticket is the jwt ticket data containing the affiliate and its fee share.
+	Ticket string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (m *MsgSetAffiliate) Reset()         { *m = MsgSetAffiliate{} }
+func (m *MsgSetAffiliate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAffiliate) ProtoMessage()    {}
+func (*MsgSetAffiliate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{21}
+}
+func (m *MsgSetAffiliate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAffiliate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAffiliate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAffiliate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAffiliate.Merge(m, src)
+}
+func (m *MsgSetAffiliate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAffiliate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAffiliate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAffiliate proto.InternalMessageInfo
+
+func (m *MsgSetAffiliate) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetAffiliate) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
+}
+
+// MsgSetAffiliateResponse is the returning value in the response
+// of MsgSetAffiliate request.
+type MsgSetAffiliateResponse struct {
+	// affiliate is the affiliate after the update.
+	Affiliate Affiliate `protobuf:"bytes,1,opt,name=affiliate,proto3" json:"affiliate"`
+}
+
+func (m *MsgSetAffiliateResponse) Reset()         { *m = MsgSetAffiliateResponse{} }
+func (m *MsgSetAffiliateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAffiliateResponse) ProtoMessage()    {}
+func (*MsgSetAffiliateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{22}
+}
+func (m *MsgSetAffiliateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAffiliateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAffiliateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAffiliateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAffiliateResponse.Merge(m, src)
+}
+func (m *MsgSetAffiliateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAffiliateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAffiliateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAffiliateResponse proto.InternalMessageInfo
+
+func (m *MsgSetAffiliateResponse) GetAffiliate() Affiliate {
+	if m != nil {
+		return m.Affiliate
+	}
+	return Affiliate{}
+}
+
+// MsgSetReferrer defines a message for the bettor to set its referrer,
+// the referrer can be set only once.
+type MsgSetReferrer struct {
+	// creator is the bettor address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// referrer is the affiliate address that referred the bettor.
+	Referrer string `protobuf:"bytes,2,opt,name=referrer,proto3" json:"referrer,omitempty"`
+}
+
+func (m *MsgSetReferrer) Reset()         { *m = MsgSetReferrer{} }
+func (m *MsgSetReferrer) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReferrer) ProtoMessage()    {}
+func (*MsgSetReferrer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{23}
+}
+func (m *MsgSetReferrer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReferrer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReferrer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReferrer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReferrer.Merge(m, src)
+}
+func (m *MsgSetReferrer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReferrer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReferrer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReferrer proto.InternalMessageInfo
+
+func (m *MsgSetReferrer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetReferrer) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+// MsgSetReferrerResponse is the returning value in the response
+// of MsgSetReferrer request.
+type MsgSetReferrerResponse struct {
+	// referral is the stored referral binding.
+	Referral Referral `protobuf:"bytes,1,opt,name=referral,proto3" json:"referral"`
+}
+
+func (m *MsgSetReferrerResponse) Reset()         { *m = MsgSetReferrerResponse{} }
+func (m *MsgSetReferrerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReferrerResponse) ProtoMessage()    {}
+func (*MsgSetReferrerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{24}
+}
+func (m *MsgSetReferrerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReferrerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReferrerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReferrerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReferrerResponse.Merge(m, src)
+}
+func (m *MsgSetReferrerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReferrerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReferrerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReferrerResponse proto.InternalMessageInfo
+
+func (m *MsgSetReferrerResponse) GetReferral() Referral {
+	if m != nil {
+		return m.Referral
+	}
+	return Referral{}
+}
+
+// MsgBindReferrer defines a message to bind a bettor to a referrer by ticket,
+// the referrer can be set only once.
+type MsgBindReferrer struct {
+	// creator is the operator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ticket is the jwt ticket data containing the bettor and the referrer.
+	Ticket string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (m *MsgBindReferrer) Reset()         { *m = MsgBindReferrer{} }
+func (m *MsgBindReferrer) String() string { return proto.CompactTextString(m) }
+func (*MsgBindReferrer) ProtoMessage()    {}
+func (*MsgBindReferrer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{25}
+}
+func (m *MsgBindReferrer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindReferrer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindReferrer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBindReferrer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindReferrer.Merge(m, src)
+}
+func (m *MsgBindReferrer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindReferrer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindReferrer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBindReferrer proto.InternalMessageInfo
+
+func (m *MsgBindReferrer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgBindReferrer) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
+}
+
+// MsgBindReferrerResponse is the returning value in the response
+// of MsgBindReferrer request.
+type MsgBindReferrerResponse struct {
+	// referral is the stored referral binding.
+	Referral Referral `protobuf:"bytes,1,opt,name=referral,proto3" json:"referral"`
+}
+
+func (m *MsgBindReferrerResponse) Reset()         { *m = MsgBindReferrerResponse{} }
+func (m *MsgBindReferrerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBindReferrerResponse) ProtoMessage()    {}
+func (*MsgBindReferrerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38b4167f68c2a7f8, []int{26}
+}
+func (m *MsgBindReferrerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindReferrerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindReferrerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBindReferrerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindReferrerResponse.Merge(m, src)
+}
+func (m *MsgBindReferrerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindReferrerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindReferrerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBindReferrerResponse proto.InternalMessageInfo
+
+func (m *MsgBindReferrerResponse) GetReferral() Referral {
+	if m != nil {
+		return m.Referral
+	}
+	return Referral{}
+}
+
+func init() {
+	proto.RegisterType((*MsgWager)(nil), "sgenetwork.sge.bet.MsgWager")
+	proto.RegisterType((*MsgWagerResponse)(nil), "sgenetwork.sge.bet.MsgWagerResponse")
+	proto.RegisterType((*MsgWagerBatch)(nil), "sgenetwork.sge.bet.MsgWagerBatch")
+	proto.RegisterType((*MsgWagerBatchResponse)(nil), "sgenetwork.sge.bet.MsgWagerBatchResponse")
+	proto.RegisterType((*MsgCancelBet)(nil), "sgenetwork.sge.bet.MsgCancelBet")
+	proto.RegisterType((*MsgCancelBetResponse)(nil), "sgenetwork.sge.bet.MsgCancelBetResponse")
+	proto.RegisterType((*MsgCashOut)(nil), "sgenetwork.sge.bet.MsgCashOut")
+	proto.RegisterType((*MsgCashOutResponse)(nil), "sgenetwork.sge.bet.MsgCashOutResponse")
+	proto.RegisterType((*MsgVoidBets)(nil), "sgenetwork.sge.bet.MsgVoidBets")
+	proto.RegisterType((*MsgVoidBetsResponse)(nil), "sgenetwork.sge.bet.MsgVoidBetsResponse")
+	proto.RegisterType((*MsgSettleBets)(nil), "sgenetwork.sge.bet.MsgSettleBets")
+	proto.RegisterType((*SettleBetItem)(nil), "sgenetwork.sge.bet.SettleBetItem")
+	proto.RegisterType((*MsgSettleBetsResponse)(nil), "sgenetwork.sge.bet.MsgSettleBetsResponse")
+	proto.RegisterType((*MsgSetSelfExclusion)(nil), "sgenetwork.sge.bet.MsgSetSelfExclusion")
+	proto.RegisterType((*MsgSetSelfExclusionResponse)(nil), "sgenetwork.sge.bet.MsgSetSelfExclusionResponse")
+	proto.RegisterType((*MsgSetBettorLimit)(nil), "sgenetwork.sge.bet.MsgSetBettorLimit")
+	proto.RegisterType((*MsgSetBettorLimitResponse)(nil), "sgenetwork.sge.bet.MsgSetBettorLimitResponse")
+	proto.RegisterType((*MsgFundPromoPool)(nil), "sgenetwork.sge.bet.MsgFundPromoPool")
+	proto.RegisterType((*MsgFundPromoPoolResponse)(nil), "sgenetwork.sge.bet.MsgFundPromoPoolResponse")
+	proto.RegisterType((*MsgIssueFreeBet)(nil), "sgenetwork.sge.bet.MsgIssueFreeBet")
+	proto.RegisterType((*MsgIssueFreeBetResponse)(nil), "sgenetwork.sge.bet.MsgIssueFreeBetResponse")
+	proto.RegisterType((*MsgSetAffiliate)(nil), "sgenetwork.sge.bet.MsgSetAffiliate")
+	proto.RegisterType((*MsgSetAffiliateResponse)(nil), "sgenetwork.sge.bet.MsgSetAffiliateResponse")
+	proto.RegisterType((*MsgSetReferrer)(nil), "sgenetwork.sge.bet.MsgSetReferrer")
+	proto.RegisterType((*MsgSetReferrerResponse)(nil), "sgenetwork.sge.bet.MsgSetReferrerResponse")
+	proto.RegisterType((*MsgBindReferrer)(nil), "sgenetwork.sge.bet.MsgBindReferrer")
+	proto.RegisterType((*MsgBindReferrerResponse)(nil), "sgenetwork.sge.bet.MsgBindReferrerResponse")
+}
+
+func init() { proto.RegisterFile("sge/bet/tx.proto", fileDescriptor_38b4167f68c2a7f8) }
+
+var fileDescriptor_38b4167f68c2a7f8 = []byte{
+	// 1064 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0x8e, 0xe3, 0x1f, 0x89, 0x5f, 0x1a, 0x13, 0xdc, 0xd0, 0x18, 0x41, 0x6d, 0x57, 0x0d, 0x6d,
+	0x4a, 0x88, 0x3d, 0x13, 0x18, 0x60, 0x26, 0xd0, 0x1f, 0x4a, 0x9a, 0xc1, 0x53, 0x3c, 0x04, 0xa5,
+	0xa5, 0x25, 0x43, 0x07, 0x6c, 0x6b, 0xad, 0x68, 0x22, 0x6b, 0x3d, 0xda, 0xd5, 0x34, 0xbd, 0x70,
+	0xe0, 0x2f, 0xe0, 0xc6, 0xbf, 0xd4, 0x03, 0x87, 0x1e, 0x19, 0x0e, 0x1e, 0xc6, 0xb9, 0xf5, 0xaf,
+	0x60, 0xb4, 0xda, 0x5d, 0x4b, 0x8e, 0x65, 0xab, 0x86, 0x8b, 0x2d, 0x69, 0xbf, 0xef, 0x7b, 0xdf,
+	0x7b, 0x7a, 0x7e, 0xbb, 0x86, 0x35, 0x62, 0xa2, 0x7a, 0x1b, 0xd1, 0x3a, 0x3d, 0xaf, 0xf5, 0x5d,
+	0x4c, 0x71, 0xb1, 0x48, 0x4c, 0xe4, 0x20, 0xfa, 0x02, 0xbb, 0x67, 0x35, 0x62, 0xa2, 0x5a, 0x1b,
+	0x51, 0x65, 0xdd, 0xc4, 0x26, 0x66, 0xcb, 0x75, 0xff, 0x2a, 0x40, 0x2a, 0x57, 0x05, 0xf7, 0x45,
+	0xcb, 0x44, 0x2e, 0x7f, 0xb8, 0x2e, 0x1e, 0xda, 0x56, 0xcf, 0xa2, 0x64, 0x1c, 0xda, 0x77, 0x71,
+	0x4f, 0xf0, 0x37, 0xc4, 0xc3, 0x56, 0xb7, 0x6b, 0xd9, 0x56, 0x8b, 0xa2, 0x60, 0x41, 0x3d, 0x81,
+	0xe5, 0x26, 0x31, 0x9f, 0xfa, 0xaa, 0xc5, 0x12, 0x2c, 0x75, 0x5c, 0xd4, 0xa2, 0xd8, 0x2d, 0xa5,
+	0xaa, 0xa9, 0xad, 0xbc, 0x2e, 0x6e, 0x8b, 0x9f, 0x41, 0xb6, 0xef, 0xe2, 0x3e, 0x29, 0x2d, 0x56,
+	0x53, 0x5b, 0x2b, 0xbb, 0xe5, 0xda, 0x65, 0xe3, 0x35, 0xa6, 0x71, 0xe4, 0xa3, 0xf4, 0x00, 0xac,
+	0x7e, 0x03, 0x6b, 0x42, 0x5b, 0x47, 0xa4, 0x8f, 0x1d, 0x82, 0x46, 0x4a, 0xa9, 0xb7, 0x51, 0xfa,
+	0x23, 0x05, 0xab, 0x42, 0x4a, 0x6b, 0xd1, 0xce, 0x69, 0x32, 0xaf, 0xe9, 0xc4, 0x11, 0x8a, 0x9f,
+	0x43, 0xa6, 0x87, 0x0d, 0x54, 0x4a, 0x57, 0x53, 0x5b, 0x85, 0x5d, 0x35, 0x96, 0xc4, 0xa2, 0x37,
+	0xb1, 0x81, 0x74, 0x86, 0x57, 0x9f, 0xc3, 0x7b, 0x11, 0x63, 0x32, 0xd1, 0x03, 0x58, 0x72, 0x11,
+	0xf1, 0x6c, 0xea, 0xa7, 0xea, 0x1b, 0xd9, 0x9c, 0xae, 0xa9, 0x33, 0xb0, 0x96, 0x79, 0x35, 0xa8,
+	0x2c, 0xe8, 0x82, 0xaa, 0xde, 0x87, 0x2b, 0x4d, 0x62, 0xee, 0xb7, 0x9c, 0x0e, 0xb2, 0x35, 0x44,
+	0xa7, 0xa4, 0x7d, 0x0d, 0x72, 0xd4, 0xea, 0x9c, 0x21, 0xca, 0xde, 0x51, 0x5e, 0xe7, 0x77, 0xea,
+	0x97, 0xb0, 0x1e, 0x56, 0x90, 0xfe, 0xaa, 0x90, 0xf6, 0x2c, 0x23, 0x50, 0xd1, 0x0a, 0xc3, 0x41,
+	0x25, 0xfd, 0xa4, 0x71, 0xf0, 0x66, 0x50, 0xf1, 0x9f, 0xea, 0xfe, 0x87, 0x7a, 0x17, 0x80, 0x31,
+	0xc9, 0xe9, 0x77, 0xde, 0x3c, 0x91, 0x7f, 0x85, 0xe2, 0x88, 0x9f, 0x3c, 0x6e, 0xf1, 0x10, 0x72,
+	0xad, 0x1e, 0xf6, 0x1c, 0xae, 0xa7, 0xd5, 0xfc, 0x92, 0xfc, 0x3d, 0xa8, 0xdc, 0x32, 0x2d, 0x7a,
+	0xea, 0xb5, 0x6b, 0x1d, 0xdc, 0xab, 0x77, 0x30, 0xe9, 0x61, 0xc2, 0xbf, 0x76, 0x88, 0x71, 0x56,
+	0xa7, 0x2f, 0xfb, 0x88, 0xd4, 0x1a, 0x0e, 0xd5, 0x39, 0x5b, 0xbd, 0x07, 0x2b, 0x4d, 0x62, 0xfe,
+	0x80, 0x2d, 0x43, 0x43, 0x94, 0xcc, 0x91, 0xc0, 0x1e, 0x5c, 0x0d, 0x09, 0xc8, 0x0c, 0x36, 0x21,
+	0xe3, 0x59, 0x46, 0xf0, 0x5a, 0xf3, 0xda, 0xda, 0x70, 0x50, 0xc9, 0x3c, 0x69, 0x1c, 0x90, 0x37,
+	0x83, 0x0a, 0x7b, 0xae, 0xb3, 0x4f, 0xb5, 0xcb, 0x3a, 0xf6, 0x18, 0x51, 0x6a, 0xa3, 0x19, 0xf1,
+	0xf7, 0x20, 0xd3, 0x46, 0x54, 0x34, 0xec, 0x8d, 0x49, 0x7d, 0x22, 0x75, 0x1a, 0x14, 0xf5, 0x78,
+	0x93, 0x30, 0x92, 0xfa, 0x08, 0x56, 0x23, 0x8b, 0x09, 0x0a, 0x1c, 0x72, 0xb2, 0x18, 0x71, 0xa2,
+	0x7e, 0xcd, 0xba, 0x79, 0x64, 0xfa, 0x2d, 0x73, 0x7e, 0xc8, 0x0a, 0x76, 0x8c, 0xe8, 0x31, 0xb2,
+	0xbb, 0x0f, 0xcf, 0x3b, 0xb6, 0x47, 0x2c, 0xec, 0x4c, 0xc9, 0x7c, 0x1d, 0xb2, 0x9e, 0x43, 0x2d,
+	0x9b, 0xf9, 0x48, 0xeb, 0xc1, 0x8d, 0x7a, 0x1d, 0x3e, 0x98, 0x20, 0x23, 0xbc, 0xa8, 0xbf, 0x2d,
+	0xc2, 0xbb, 0xc1, 0xba, 0x86, 0x28, 0xc5, 0xee, 0xb7, 0xfe, 0xf4, 0x9b, 0x12, 0xe4, 0x2b, 0x00,
+	0x36, 0x20, 0x7f, 0xf6, 0x5b, 0x84, 0x45, 0x2a, 0xec, 0x5e, 0x9f, 0x54, 0x64, 0x26, 0xf4, 0xf8,
+	0x65, 0x1f, 0xe9, 0x79, 0x5b, 0x5c, 0x16, 0xbf, 0x80, 0x5c, 0x1f, 0xb9, 0x16, 0x36, 0xf8, 0x68,
+	0xa8, 0xc4, 0x32, 0x8f, 0x18, 0x4c, 0xe7, 0x70, 0x3f, 0x37, 0x03, 0x39, 0xb8, 0x57, 0xca, 0x30,
+	0x3b, 0xc1, 0x4d, 0xa8, 0xb9, 0xb3, 0xff, 0xa9, 0xb9, 0x9f, 0xc1, 0xfb, 0x97, 0x6a, 0x20, 0xdf,
+	0xd6, 0x1e, 0x64, 0x59, 0x02, 0x7c, 0xc8, 0x4e, 0xb4, 0x1c, 0xe2, 0xf1, 0x7e, 0x0a, 0x38, 0xea,
+	0x01, 0x9b, 0xda, 0x87, 0x9e, 0x63, 0x1c, 0xf9, 0x1b, 0xc8, 0x11, 0xc6, 0xf6, 0x1c, 0xbf, 0x1d,
+	0x05, 0x4a, 0xe3, 0x2a, 0xf2, 0x05, 0xee, 0xc3, 0x3b, 0x4d, 0x62, 0x36, 0x08, 0xf1, 0xd0, 0xa1,
+	0x8b, 0xd0, 0x7c, 0x73, 0xed, 0x04, 0x36, 0xc6, 0x44, 0x64, 0xfa, 0xf7, 0x20, 0xd7, 0x71, 0x91,
+	0x21, 0xf3, 0x9f, 0xf8, 0x8b, 0xe2, 0xa4, 0x7d, 0x06, 0xe4, 0x15, 0xe0, 0x34, 0x6e, 0xf0, 0x18,
+	0xd1, 0x07, 0x62, 0xb7, 0x9c, 0xc3, 0xe0, 0x4f, 0xb0, 0x31, 0x26, 0x22, 0x0d, 0x3e, 0x80, 0xbc,
+	0xdc, 0x87, 0xb9, 0xc7, 0x89, 0x0d, 0x29, 0x99, 0xdc, 0xdf, 0x88, 0xa5, 0x1e, 0x42, 0x21, 0x50,
+	0xd7, 0x51, 0x17, 0xb9, 0xee, 0xd4, 0xdd, 0x5b, 0x81, 0x65, 0x97, 0xa3, 0xb8, 0x47, 0x79, 0xaf,
+	0x3e, 0x83, 0x6b, 0x51, 0x1d, 0x69, 0xf2, 0xae, 0x60, 0xb5, 0x6c, 0xee, 0xf1, 0xc3, 0x49, 0x1e,
+	0x75, 0x8e, 0xe1, 0x16, 0x25, 0x87, 0x17, 0x51, 0xb3, 0x1c, 0x23, 0x81, 0xc5, 0xb8, 0x22, 0xfe,
+	0x08, 0x1b, 0x63, 0x22, 0xff, 0x97, 0xbf, 0xdd, 0x3f, 0xf3, 0x90, 0x6e, 0x12, 0xb3, 0xf8, 0x08,
+	0xb2, 0xc1, 0xf1, 0x67, 0x22, 0x5d, 0x6c, 0xee, 0xca, 0xe6, 0xb4, 0x55, 0x69, 0xea, 0x04, 0x20,
+	0x74, 0x48, 0xb9, 0x31, 0x8d, 0xc3, 0x20, 0xca, 0x9d, 0x99, 0x10, 0xa9, 0xfd, 0x14, 0xf2, 0xa3,
+	0x83, 0x40, 0x35, 0x86, 0x27, 0x11, 0xca, 0xd6, 0x2c, 0x84, 0x14, 0xfe, 0x1e, 0x96, 0xc4, 0x2e,
+	0x5f, 0x8e, 0x25, 0xb1, 0x75, 0xe5, 0xd6, 0xf4, 0x75, 0x29, 0xf9, 0x18, 0x96, 0xe5, 0xc6, 0x5b,
+	0x89, 0xe1, 0x08, 0x80, 0x72, 0x7b, 0x06, 0x20, 0x5c, 0xdd, 0xd0, 0x86, 0x1a, 0x57, 0xdd, 0x11,
+	0x44, 0xb9, 0x33, 0x13, 0x22, 0xb5, 0x6d, 0x58, 0xbb, 0xb4, 0x71, 0xdd, 0x8e, 0xa7, 0x47, 0x80,
+	0x4a, 0x3d, 0x21, 0x50, 0x46, 0xeb, 0x42, 0x61, 0x6c, 0xff, 0xfa, 0x28, 0x5e, 0x22, 0x04, 0x53,
+	0x76, 0x12, 0xc1, 0x64, 0x9c, 0x0e, 0xac, 0x46, 0x27, 0x79, 0x5c, 0x1b, 0x47, 0x50, 0xca, 0x27,
+	0x49, 0x50, 0x32, 0xc8, 0x2f, 0x70, 0x25, 0x32, 0xcc, 0x6f, 0xc6, 0xb0, 0xc3, 0x20, 0x65, 0x3b,
+	0x01, 0x28, 0x1c, 0x21, 0x32, 0x8d, 0x6f, 0xc6, 0x57, 0x41, 0x82, 0x94, 0xed, 0x04, 0x20, 0x19,
+	0xe1, 0x39, 0xac, 0x84, 0x87, 0xa9, 0x1a, 0xcf, 0x15, 0x18, 0xe5, 0xe3, 0xd9, 0x98, 0x70, 0x02,
+	0x91, 0x49, 0x18, 0x97, 0x40, 0x18, 0xa4, 0x6c, 0x27, 0x00, 0x89, 0x08, 0xda, 0xfd, 0x57, 0xc3,
+	0x72, 0xea, 0xf5, 0xb0, 0x9c, 0xfa, 0x67, 0x58, 0x4e, 0xfd, 0x7e, 0x51, 0x5e, 0x78, 0x7d, 0x51,
+	0x5e, 0xf8, 0xeb, 0xa2, 0xbc, 0x70, 0x12, 0x3e, 0x5a, 0x10, 0x13, 0xed, 0x70, 0x45, 0xff, 0xba,
+	0x7e, 0x1e, 0xfc, 0x21, 0xf5, 0x8f, 0x17, 0xed, 0x1c, 0xfb, 0x47, 0xf8, 0xe9, 0xbf, 0x03, 0x00,
+	0x70, 0xf2, 0x9d, 0x7e, 0xa8, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Wager defines a method to place a bet with the given data.
+	Wager(ctx context.Context, in *MsgWager, opts ...grpc.CallOption) (*MsgWagerResponse, error)
+	// WagerBatch defines a method to place many bets in a single message.
+	WagerBatch(ctx context.Context, in *MsgWagerBatch, opts ...grpc.CallOption) (*MsgWagerBatchResponse, error)
+	// CancelBet defines a method to cancel a placed bet before the market start.
+	CancelBet(ctx context.Context, in *MsgCancelBet, opts ...grpc.CallOption) (*MsgCancelBetResponse, error)
+	// CashOut defines a method to settle an open bet early at the quoted price.
+	CashOut(ctx context.Context, in *MsgCashOut, opts ...grpc.CallOption) (*MsgCashOutResponse, error)
+	// VoidBets defines a method to void and refund the bets accepted at an
+	// erroneous price.
+	VoidBets(ctx context.Context, in *MsgVoidBets, opts ...grpc.CallOption) (*MsgVoidBetsResponse, error)
+	// SettleBets defines a method to settle the bets of the resolved markets
+	// before the batch settlement of the end-blocker.
+	SettleBets(ctx context.Context, in *MsgSettleBets, opts ...grpc.CallOption) (*MsgSettleBetsResponse, error)
+	// SetSelfExclusion defines a method to exclude the bettor from wagering
+	// until a certain time.
+	SetSelfExclusion(ctx context.Context, in *MsgSetSelfExclusion, opts ...grpc.CallOption) (*MsgSetSelfExclusionResponse, error)
+	// SetBettorLimit defines a method to set a stake or net loss limit.
+	SetBettorLimit(ctx context.Context, in *MsgSetBettorLimit, opts ...grpc.CallOption) (*MsgSetBettorLimitResponse, error)
+	// FundPromoPool defines a method to transfer funds from the operator account
+	// to the promo pool.
+	FundPromoPool(ctx context.Context, in *MsgFundPromoPool, opts ...grpc.CallOption) (*MsgFundPromoPoolResponse, error)
+	// IssueFreeBet defines a method to issue a free bet credit to a bettor.
+	IssueFreeBet(ctx context.Context, in *MsgIssueFreeBet, opts ...grpc.CallOption) (*MsgIssueFreeBetResponse, error)
+	// SetAffiliate defines a method to register an affiliate or update its
+	// bet fee share.
+	SetAffiliate(ctx context.Context, in *MsgSetAffiliate, opts ...grpc.CallOption) (*MsgSetAffiliateResponse, error)
+	// SetReferrer defines a method for the bettor to set its referrer.
+	SetReferrer(ctx context.Context, in *MsgSetReferrer, opts ...grpc.CallOption) (*MsgSetReferrerResponse, error)
+	// BindReferrer defines a method to bind a bettor to a referrer by ticket.
+	BindReferrer(ctx context.Context, in *MsgBindReferrer, opts ...grpc.CallOption) (*MsgBindReferrerResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Wager(ctx context.Context, in *MsgWager, opts ...grpc.CallOption) (*MsgWagerResponse, error) {
+	out := new(MsgWagerResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/Wager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WagerBatch(ctx context.Context, in *MsgWagerBatch, opts ...grpc.CallOption) (*MsgWagerBatchResponse, error) {
+	out := new(MsgWagerBatchResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/WagerBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBet(ctx context.Context, in *MsgCancelBet, opts ...grpc.CallOption) (*MsgCancelBetResponse, error) {
+	out := new(MsgCancelBetResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/CancelBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CashOut(ctx context.Context, in *MsgCashOut, opts ...grpc.CallOption) (*MsgCashOutResponse, error) {
+	out := new(MsgCashOutResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/CashOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoidBets(ctx context.Context, in *MsgVoidBets, opts ...grpc.CallOption) (*MsgVoidBetsResponse, error) {
+	out := new(MsgVoidBetsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/VoidBets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SettleBets(ctx context.Context, in *MsgSettleBets, opts ...grpc.CallOption) (*MsgSettleBetsResponse, error) {
+	out := new(MsgSettleBetsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/SettleBets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSelfExclusion(ctx context.Context, in *MsgSetSelfExclusion, opts ...grpc.CallOption) (*MsgSetSelfExclusionResponse, error) {
+	out := new(MsgSetSelfExclusionResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/SetSelfExclusion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBettorLimit(ctx context.Context, in *MsgSetBettorLimit, opts ...grpc.CallOption) (*MsgSetBettorLimitResponse, error) {
+	out := new(MsgSetBettorLimitResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/SetBettorLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundPromoPool(ctx context.Context, in *MsgFundPromoPool, opts ...grpc.CallOption) (*MsgFundPromoPoolResponse, error) {
+	out := new(MsgFundPromoPoolResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/FundPromoPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IssueFreeBet(ctx context.Context, in *MsgIssueFreeBet, opts ...grpc.CallOption) (*MsgIssueFreeBetResponse, error) {
+	out := new(MsgIssueFreeBetResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/IssueFreeBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAffiliate(ctx context.Context, in *MsgSetAffiliate, opts ...grpc.CallOption) (*MsgSetAffiliateResponse, error) {
+	out := new(MsgSetAffiliateResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/SetAffiliate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReferrer(ctx context.Context, in *MsgSetReferrer, opts ...grpc.CallOption) (*MsgSetReferrerResponse, error) {
+	out := new(MsgSetReferrerResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/SetReferrer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BindReferrer(ctx context.Context, in *MsgBindReferrer, opts ...grpc.CallOption) (*MsgBindReferrerResponse, error) {
+	out := new(MsgBindReferrerResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.bet.Msg/BindReferrer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Wager defines a method to place a bet with the given data.
+	Wager(context.Context, *MsgWager) (*MsgWagerResponse, error)
+	// WagerBatch defines a method to place many bets in a single message.
+	WagerBatch(context.Context, *MsgWagerBatch) (*MsgWagerBatchResponse, error)
+	// CancelBet defines a method to cancel a placed bet before the market start.
+	CancelBet(context.Context, *MsgCancelBet) (*MsgCancelBetResponse, error)
+	// CashOut defines a method to settle an open bet early at the quoted price.
+	CashOut(context.Context, *MsgCashOut) (*MsgCashOutResponse, error)
+	// VoidBets defines a method to void and refund the bets accepted at an
+	// erroneous price.
+	VoidBets(context.Context, *MsgVoidBets) (*MsgVoidBetsResponse, error)
+	// SettleBets defines a method to settle the bets of the resolved markets
+	// before the batch settlement of the end-blocker.
+	SettleBets(context.Context, *MsgSettleBets) (*MsgSettleBetsResponse, error)
+	// SetSelfExclusion defines a method to exclude the bettor from wagering
+	// until a certain time.
+	SetSelfExclusion(context.Context, *MsgSetSelfExclusion) (*MsgSetSelfExclusionResponse, error)
+	// SetBettorLimit defines a method to set a stake or net loss limit.
+	SetBettorLimit(context.Context, *MsgSetBettorLimit) (*MsgSetBettorLimitResponse, error)
+	// FundPromoPool defines a method to transfer funds from the operator account
+	// to the promo pool.
+	FundPromoPool(context.Context, *MsgFundPromoPool) (*MsgFundPromoPoolResponse, error)
+	// IssueFreeBet defines a method to issue a free bet credit to a bettor.
+	IssueFreeBet(context.Context, *MsgIssueFreeBet) (*MsgIssueFreeBetResponse, error)
+	// SetAffiliate defines a method to register an affiliate or update its
+	// bet fee share.
+	SetAffiliate(context.Context, *MsgSetAffiliate) (*MsgSetAffiliateResponse, error)
+	// SetReferrer defines a method for the bettor to set its referrer.
+	SetReferrer(context.Context, *MsgSetReferrer) (*MsgSetReferrerResponse, error)
+	// BindReferrer defines a method to bind a bettor to a referrer by ticket.
+	BindReferrer(context.Context, *MsgBindReferrer) (*MsgBindReferrerResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Wager(ctx context.Context, req *MsgWager) (*MsgWagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wager not implemented")
+}
+func (*UnimplementedMsgServer) WagerBatch(ctx context.Context, req *MsgWagerBatch) (*MsgWagerBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WagerBatch not implemented")
+}
+func (*UnimplementedMsgServer) CancelBet(ctx context.Context, req *MsgCancelBet) (*MsgCancelBetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBet not implemented")
+}
+func (*UnimplementedMsgServer) CashOut(ctx context.Context, req *MsgCashOut) (*MsgCashOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CashOut not implemented")
+}
+func (*UnimplementedMsgServer) VoidBets(ctx context.Context, req *MsgVoidBets) (*MsgVoidBetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidBets not implemented")
+}
+func (*UnimplementedMsgServer) SettleBets(ctx context.Context, req *MsgSettleBets) (*MsgSettleBetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleBets not implemented")
+}
+func (*UnimplementedMsgServer) SetSelfExclusion(ctx context.Context, req *MsgSetSelfExclusion) (*MsgSetSelfExclusionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSelfExclusion not implemented")
+}
+func (*UnimplementedMsgServer) SetBettorLimit(ctx context.Context, req *MsgSetBettorLimit) (*MsgSetBettorLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBettorLimit not implemented")
+}
+func (*UnimplementedMsgServer) FundPromoPool(ctx context.Context, req *MsgFundPromoPool) (*MsgFundPromoPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundPromoPool not implemented")
+}
+func (*UnimplementedMsgServer) IssueFreeBet(ctx context.Context, req *MsgIssueFreeBet) (*MsgIssueFreeBetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueFreeBet not implemented")
+}
+func (*UnimplementedMsgServer) SetAffiliate(ctx context.Context, req *MsgSetAffiliate) (*MsgSetAffiliateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAffiliate not implemented")
+}
+func (*UnimplementedMsgServer) SetReferrer(ctx context.Context, req *MsgSetReferrer) (*MsgSetReferrerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReferrer not implemented")
+}
+func (*UnimplementedMsgServer) BindReferrer(ctx context.Context, req *MsgBindReferrer) (*MsgBindReferrerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindReferrer not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Wager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWager)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Wager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/Wager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Wager(ctx, req.(*MsgWager))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WagerBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWagerBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WagerBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/WagerBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WagerBatch(ctx, req.(*MsgWagerBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/CancelBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBet(ctx, req.(*MsgCancelBet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CashOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCashOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CashOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/CashOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CashOut(ctx, req.(*MsgCashOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoidBets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoidBets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoidBets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/VoidBets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoidBets(ctx, req.(*MsgVoidBets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SettleBets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleBets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettleBets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/SettleBets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleBets(ctx, req.(*MsgSettleBets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSelfExclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSelfExclusion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSelfExclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/SetSelfExclusion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSelfExclusion(ctx, req.(*MsgSetSelfExclusion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBettorLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBettorLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBettorLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/SetBettorLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBettorLimit(ctx, req.(*MsgSetBettorLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundPromoPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundPromoPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundPromoPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/FundPromoPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundPromoPool(ctx, req.(*MsgFundPromoPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IssueFreeBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIssueFreeBet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IssueFreeBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/IssueFreeBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IssueFreeBet(ctx, req.(*MsgIssueFreeBet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAffiliate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAffiliate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAffiliate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/SetAffiliate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAffiliate(ctx, req.(*MsgSetAffiliate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReferrer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReferrer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReferrer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/SetReferrer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReferrer(ctx, req.(*MsgSetReferrer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BindReferrer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindReferrer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BindReferrer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.bet.Msg/BindReferrer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindReferrer(ctx, req.(*MsgBindReferrer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sgenetwork.sge.bet.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Wager",
+			Handler:    _Msg_Wager_Handler,
+		},
+		{
+			MethodName: "WagerBatch",
+			Handler:    _Msg_WagerBatch_Handler,
+		},
+		{
+			MethodName: "CancelBet",
+			Handler:    _Msg_CancelBet_Handler,
+		},
+		{
+			MethodName: "CashOut",
+			Handler:    _Msg_CashOut_Handler,
+		},
+		{
+			MethodName: "VoidBets",
+			Handler:    _Msg_VoidBets_Handler,
+		},
+		{
+			MethodName: "SettleBets",
+			Handler:    _Msg_SettleBets_Handler,
+		},
+		{
+			MethodName: "SetSelfExclusion",
+			Handler:    _Msg_SetSelfExclusion_Handler,
+		},
+		{
+			MethodName: "SetBettorLimit",
+			Handler:    _Msg_SetBettorLimit_Handler,
+		},
+		{
+			MethodName: "FundPromoPool",
+			Handler:    _Msg_FundPromoPool_Handler,
+		},
+		{
+			MethodName: "IssueFreeBet",
+			Handler:    _Msg_IssueFreeBet_Handler,
+		},
+		{
+			MethodName: "SetAffiliate",
+			Handler:    _Msg_SetAffiliate_Handler,
+		},
+		{
+			MethodName: "SetReferrer",
+			Handler:    _Msg_SetReferrer_Handler,
+		},
+		{
+			MethodName: "BindReferrer",
+			Handler:    _Msg_BindReferrer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sge/bet/tx.proto",
+}
+
+func (m *MsgWager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Props != nil {
+		{
+			size, err := m.Props.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Props != nil {
+		{
+			size, err := m.Props.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWagerBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWagerBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWagerBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Props) > 0 {
+		for iNdEx := len(m.Props) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Props[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWagerBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWagerBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWagerBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ticket) > 0 {
+		i -= len(m.Ticket)
+		copy(dAtA[i:], m.Ticket)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticket)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCashOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCashOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCashOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ticket) > 0 {
+		i -= len(m.Ticket)
+		copy(dAtA[i:], m.Ticket)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticket)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCashOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCashOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCashOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoidBets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoidBets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoidBets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ticket) > 0 {
+		i -= len(m.Ticket)
+		copy(dAtA[i:], m.Ticket)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticket)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoidBetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoidBetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoidBetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UIDs) > 0 {
+		for iNdEx := len(m.UIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UIDs[iNdEx])
+			copy(dAtA[i:], m.UIDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleBets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleBets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleBets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bets) > 0 {
+		for iNdEx := len(m.Bets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SettleBetItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettleBetItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettleBetItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleBetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleBetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleBetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UIDs) > 0 {
+		for iNdEx := len(m.UIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UIDs[iNdEx])
+			copy(dAtA[i:], m.UIDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSelfExclusion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSelfExclusion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSelfExclusion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Until != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Until))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSelfExclusionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSelfExclusionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSelfExclusionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBettorLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBettorLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBettorLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Period != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LimitType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LimitType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBettorLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBettorLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBettorLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundPromoPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundPromoPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundPromoPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ticket) > 0 {
+		i -= len(m.Ticket)
+		copy(dAtA[i:], m.Ticket)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticket)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundPromoPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundPromoPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundPromoPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssueFreeBet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueFreeBet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueFreeBet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ticket) > 0 {
+		i -= len(m.Ticket)
+		copy(dAtA[i:], m.Ticket)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticket)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssueFreeBetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueFreeBetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueFreeBetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Credit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAffiliate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAffiliate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAffiliate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ticket) > 0 {
+		i -= len(m.Ticket)
+		copy(dAtA[i:], m.Ticket)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticket)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAffiliateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAffiliateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAffiliateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Affiliate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReferrer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReferrer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReferrer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReferrerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReferrerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReferrerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Referral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindReferrer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindReferrer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindReferrer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ticket) > 0 {
+		i -= len(m.Ticket)
+		copy(dAtA[i:], m.Ticket)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticket)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindReferrerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindReferrerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindReferrerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Referral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Props != nil {
+		l = m.Props.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Props != nil {
+		l = m.Props.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWagerBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Props) > 0 {
+		for _, e := range m.Props {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *MsgWagerBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelBet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelBetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCashOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCashOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVoidBets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoidBetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UIDs) > 0 {
+		for _, s := range m.UIDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSettleBets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Bets) > 0 {
+		for _, e := range m.Bets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SettleBetItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSettleBetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UIDs) > 0 {
+		for _, s := range m.UIDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSelfExclusion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Until != 0 {
+		n += 1 + sovTx(uint64(m.Until))
+	}
+	return n
+}
+
+func (m *MsgSetSelfExclusionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBettorLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitType != 0 {
+		n += 1 + sovTx(uint64(m.LimitType))
+	}
+	if m.Period != 0 {
+		n += 1 + sovTx(uint64(m.Period))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetBettorLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundPromoPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundPromoPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIssueFreeBet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIssueFreeBetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Credit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAffiliate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAffiliateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Affiliate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetReferrer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetReferrerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Referral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBindReferrer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBindReferrerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Referral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Props", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Props == nil {
+				m.Props = &WagerProps{}
+			}
+			if err := m.Props.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWagerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWagerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Props", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Props == nil {
+				m.Props = &WagerProps{}
+			}
+			if err := m.Props.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWagerBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWagerBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWagerBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Props", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Props = append(m.Props, &WagerProps{})
+			if err := m.Props[len(m.Props)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= WagerBatchMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWagerBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWagerBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWagerBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, WagerBatchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelBet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelBet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelBet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelBetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelBetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelBetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCashOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCashOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCashOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCashOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCashOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCashOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoidBets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoidBets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoidBets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoidBetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoidBetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoidBetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UIDs = append(m.UIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettleBets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettleBets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettleBets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bets = append(m.Bets, SettleBetItem{})
+			if err := m.Bets[len(m.Bets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SettleBetItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettleBetItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettleBetItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettleBetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettleBetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettleBetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UIDs = append(m.UIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSelfExclusion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSelfExclusion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSelfExclusion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSelfExclusionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSelfExclusionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSelfExclusionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBettorLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBettorLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBettorLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitType", wireType)
+			}
+			m.LimitType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitType |= LimitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= LimitPeriod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBettorLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBettorLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBettorLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundPromoPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundPromoPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundPromoPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundPromoPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundPromoPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundPromoPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIssueFreeBet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueFreeBet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueFreeBet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIssueFreeBetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssueFreeBetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssueFreeBetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Credit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAffiliate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAffiliate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAffiliate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAffiliateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAffiliateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAffiliateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affiliate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Affiliate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReferrer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReferrer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReferrer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *MsgWagerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWagerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *MsgWagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Props != nil {
-		{
-			size, err := m.Props.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetReferrerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReferrerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReferrerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Referral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgWager) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Props != nil {
-		l = m.Props.Size()
-		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
 
-func (m *MsgWagerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Props != nil {
-		l = m.Props.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgWager) Unmarshal(dAtA []byte) error {
+func (m *MsgBindReferrer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -383,10 +6043,10 @@ func (m *MsgWager) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWager: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBindReferrer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWager: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBindReferrer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -423,9 +6083,9 @@ func (m *MsgWager) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Props", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -435,27 +6095,23 @@ func (m *MsgWager) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Props == nil {
-				m.Props = &WagerProps{}
-			}
-			if err := m.Props.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ticket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -478,7 +6134,7 @@ func (m *MsgWager) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWagerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBindReferrerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -501,15 +6157,15 @@ func (m *MsgWagerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWagerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBindReferrerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBindReferrerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Props", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -536,10 +6192,7 @@ func (m *MsgWagerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Props == nil {
-				m.Props = &WagerProps{}
-			}
-			if err := m.Props.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Referral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3,6 +3,8 @@ package types
 import (
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/sge-network/sge/utils"
 )
 
@@ -20,5 +22,62 @@ func WagerValidation(props *WagerProps) error {
 		return ErrInvalidTicket
 	}
 
+	if !props.MinFillRatio.IsNil() &&
+		(props.MinFillRatio.IsNegative() || props.MinFillRatio.GT(sdk.OneDec())) {
+		return ErrInvalidMinFillRatio
+	}
+
+	if props.FreeBetCreditUID != "" {
+		if !utils.IsValidUID(props.FreeBetCreditUID) {
+			return sdkerrors.Wrapf(ErrInvalidFreeBetCredit, "invalid uid %s", props.FreeBetCreditUID)
+		}
+
+		if props.HasMinFillRatio() {
+			return ErrPartialFillNotAllowedForFreeBet
+		}
+	}
+
+	if props.MinOddsValue != "" || props.MinOddsType != OddsType_ODDS_TYPE_UNSPECIFIED {
+		if _, err := CalculateDecimalOdds(props.MinOddsType, props.MinOddsValue); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidMinOdds, "%s", err)
+		}
+	}
+
+	return nil
+}
+
+// HasMinFillRatio returns true if partial fulfillment is requested for the wager,
+// zero value is considered as not set because empty decimal fields are
+// decoded as zero.
+func (props *WagerProps) HasMinFillRatio() bool {
+	return !props.MinFillRatio.IsNil() && props.MinFillRatio.IsPositive()
+}
+
+// HasMinOdds returns true if the minimum acceptable odds is set for the wager.
+func (props *WagerProps) HasMinOdds() bool {
+	return props.MinOddsValue != ""
+}
+
+// ValidateMinOdds checks the odds of the ticket against the minimum acceptable odds
+// of the bettor, both of the odds are converted to decimal odds to be comparable.
+func (props *WagerProps) ValidateMinOdds(oddsType OddsType, oddsVal string) error {
+	if !props.HasMinOdds() {
+		return nil
+	}
+
+	minOdds, err := CalculateDecimalOdds(props.MinOddsType, props.MinOddsValue)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMinOdds, "%s", err)
+	}
+
+	odds, err := CalculateDecimalOdds(oddsType, oddsVal)
+	if err != nil {
+		return err
+	}
+
+	if odds.LT(minOdds) {
+		return sdkerrors.Wrapf(ErrOddsLowerThanMinOdds, "ticket odds %s, minimum odds %s", odds, minOdds)
+	}
+
 	return nil
 }
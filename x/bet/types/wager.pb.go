@@ -24,6 +24,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// WagerBatchMode is the execution mode of the bets of a batch wager.
+type WagerBatchMode int32
+
+const (
+	// unspecified batch mode.
+	WagerBatchMode_WAGER_BATCH_MODE_UNSPECIFIED WagerBatchMode = 0
+	// all of the bets are placed or the whole batch fails.
+	WagerBatchMode_WAGER_BATCH_MODE_ATOMIC WagerBatchMode = 1
+	// each of the bets is placed independently and the failed bets are
+	// reported in the results.
+	WagerBatchMode_WAGER_BATCH_MODE_BEST_EFFORT WagerBatchMode = 2
+)
+
+var WagerBatchMode_name = map[int32]string{
+	0: "WAGER_BATCH_MODE_UNSPECIFIED",
+	1: "WAGER_BATCH_MODE_ATOMIC",
+	2: "WAGER_BATCH_MODE_BEST_EFFORT",
+}
+
+var WagerBatchMode_value = map[string]int32{
+	"WAGER_BATCH_MODE_UNSPECIFIED": 0,
+	"WAGER_BATCH_MODE_ATOMIC":      1,
+	"WAGER_BATCH_MODE_BEST_EFFORT": 2,
+}
+
+func (x WagerBatchMode) String() string {
+	return proto.EnumName(WagerBatchMode_name, int32(x))
+}
+
+func (WagerBatchMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b14a4fe747361920, []int{0}
+}
+
 // WagerProps contains attributes which come in wager tx request.
 type WagerProps struct {
 	// uid is the universal unique identifier assigned to bet.
@@ -32,6 +65,20 @@ type WagerProps struct {
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 	// ticket is a signed string containing important info such as `oddsValue`.
 	Ticket string `protobuf:"bytes,3,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	// min_fill_ratio is the minimum ratio of the amount that should be fulfilled
+	// by the order book, if it is set the bet is accepted with the largest
+	// fulfillable amount, otherwise the whole amount should be fulfilled.
+	MinFillRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_fill_ratio,json=minFillRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fill_ratio"`
+	// min_odds_type is the odds type of the min_odds_value.
+	MinOddsType OddsType `protobuf:"varint,5,opt,name=min_odds_type,json=minOddsType,proto3,enum=sgenetwork.sge.bet.OddsType" json:"min_odds_type,omitempty"`
+	// min_odds_value is the minimum acceptable odds of the bettor, if it is set
+	// the wager is rejected when the odds of the ticket are lower after
+	// conversion of both to decimal odds.
+	MinOddsValue string `protobuf:"bytes,6,opt,name=min_odds_value,json=minOddsValue,proto3" json:"min_odds_value,omitempty"`
+	// free_bet_credit_uid is the universal unique identifier of the free bet
+	// credit of the bettor, if it is set the bet is placed with the credited
+	// amount from the promo pool instead of the balance of the bettor.
+	FreeBetCreditUID string `protobuf:"bytes,7,opt,name=free_bet_credit_uid,proto3" json:"free_bet_credit_uid"`
 }
 
 func (m *WagerProps) Reset()         { *m = WagerProps{} }
@@ -81,30 +128,133 @@ func (m *WagerProps) GetTicket() string {
 	return ""
 }
 
+func (m *WagerProps) GetMinOddsType() OddsType {
+	if m != nil {
+		return m.MinOddsType
+	}
+	return OddsType_ODDS_TYPE_UNSPECIFIED
+}
+
+func (m *WagerProps) GetMinOddsValue() string {
+	if m != nil {
+		return m.MinOddsValue
+	}
+	return ""
+}
+
+func (m *WagerProps) GetFreeBetCreditUID() string {
+	if m != nil {
+		return m.FreeBetCreditUID
+	}
+	return ""
+}
+
+// WagerBatchResult is the result of the placement of a bet of a batch wager.
+type WagerBatchResult struct {
+	// uid is the universal unique identifier of the bet.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// success is true if the bet is placed.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the reason of the failure of the bet placement.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *WagerBatchResult) Reset()         { *m = WagerBatchResult{} }
+func (m *WagerBatchResult) String() string { return proto.CompactTextString(m) }
+func (*WagerBatchResult) ProtoMessage()    {}
+func (*WagerBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b14a4fe747361920, []int{1}
+}
+func (m *WagerBatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WagerBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WagerBatchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WagerBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WagerBatchResult.Merge(m, src)
+}
+func (m *WagerBatchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *WagerBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_WagerBatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WagerBatchResult proto.InternalMessageInfo
+
+func (m *WagerBatchResult) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *WagerBatchResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *WagerBatchResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("sgenetwork.sge.bet.WagerBatchMode", WagerBatchMode_name, WagerBatchMode_value)
 	proto.RegisterType((*WagerProps)(nil), "sgenetwork.sge.bet.WagerProps")
+	proto.RegisterType((*WagerBatchResult)(nil), "sgenetwork.sge.bet.WagerBatchResult")
 }
 
 func init() { proto.RegisterFile("sge/bet/wager.proto", fileDescriptor_b14a4fe747361920) }
 
 var fileDescriptor_b14a4fe747361920 = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2e, 0x4e, 0x4f, 0xd5,
-	0x4f, 0x4a, 0x2d, 0xd1, 0x2f, 0x4f, 0x4c, 0x4f, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x2a, 0x4e, 0x4f, 0xcd, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x2b, 0x4e, 0x4f, 0xd5,
-	0x4b, 0x4a, 0x2d, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95,
-	0x4a, 0x7d, 0x8c, 0x5c, 0x5c, 0xe1, 0x20, 0x9d, 0x01, 0x45, 0xf9, 0x05, 0xc5, 0x42, 0x0a, 0x5c,
-	0xcc, 0xa5, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x7c, 0x8f, 0xee, 0xc9, 0x33,
-	0x87, 0x7a, 0xba, 0xbc, 0xba, 0x27, 0x0f, 0x12, 0x0d, 0x02, 0x11, 0x42, 0x6e, 0x5c, 0x6c, 0x89,
-	0xb9, 0xf9, 0xa5, 0x79, 0x25, 0x12, 0x4c, 0x60, 0x45, 0x7a, 0x27, 0xee, 0xc9, 0x33, 0xdc, 0xba,
-	0x27, 0xaf, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9c, 0x5f,
-	0x9c, 0x9b, 0x5f, 0x0c, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0xf5,
-	0x3c, 0xf3, 0x4a, 0x82, 0xa0, 0xba, 0x85, 0xc4, 0xb8, 0xd8, 0x4a, 0x32, 0x93, 0xb3, 0x53, 0x4b,
-	0x24, 0x98, 0x41, 0xe6, 0x04, 0x41, 0x79, 0x4e, 0x0e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0x85, 0x6c, 0x43, 0x71, 0x7a, 0xaa, 0x2e, 0xd4, 0x83, 0x20, 0xb6, 0x7e, 0x05,
-	0x38, 0x08, 0xc0, 0xb6, 0x24, 0xb1, 0x81, 0x7d, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x5d,
-	0x87, 0x3f, 0x36, 0x1a, 0x01, 0x00, 0x00,
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x1b, 0x9a, 0xc2, 0x52, 0xa2, 0x68, 0x5b, 0x51, 0xab, 0x54, 0x4e, 0x54, 0x21, 0x54,
+	0x81, 0x6a, 0x4b, 0xf0, 0x03, 0x8d, 0x13, 0x1b, 0x72, 0x08, 0xa9, 0xb6, 0x2e, 0x95, 0x90, 0x90,
+	0x95, 0xd8, 0x53, 0x77, 0x15, 0xdb, 0x1b, 0x76, 0xd7, 0x94, 0xfe, 0x05, 0xff, 0xc1, 0x8f, 0xf4,
+	0xd8, 0x23, 0xe2, 0x10, 0xa1, 0xe4, 0xc6, 0x57, 0xa0, 0xdd, 0x38, 0x05, 0xa9, 0x39, 0xd0, 0x8b,
+	0xbd, 0x33, 0xf3, 0xde, 0x9b, 0xdd, 0x79, 0x83, 0xb6, 0x44, 0x02, 0xce, 0x08, 0xa4, 0x73, 0x39,
+	0x4c, 0x80, 0xdb, 0x13, 0xce, 0x24, 0xc3, 0x58, 0x24, 0x90, 0x83, 0xbc, 0x64, 0x7c, 0x6c, 0x8b,
+	0x04, 0xec, 0x11, 0xc8, 0xdd, 0xed, 0x84, 0x25, 0x4c, 0x97, 0x1d, 0x75, 0x5a, 0x20, 0x77, 0x77,
+	0x96, 0x74, 0x16, 0xc7, 0x22, 0x94, 0x57, 0x13, 0x58, 0x14, 0xf6, 0xbf, 0x57, 0x11, 0x3a, 0x53,
+	0x92, 0xc7, 0x9c, 0x4d, 0x04, 0x6e, 0xa1, 0x6a, 0x41, 0x63, 0xd3, 0x68, 0x19, 0x07, 0x8f, 0xdc,
+	0xfa, 0x6c, 0xda, 0xac, 0x9e, 0xf6, 0xba, 0xbf, 0xa7, 0x4d, 0x95, 0x25, 0xea, 0x83, 0x7d, 0x54,
+	0x1b, 0x66, 0xac, 0xc8, 0xa5, 0xb9, 0xa6, 0x41, 0xf6, 0xf5, 0xb4, 0x59, 0xf9, 0x39, 0x6d, 0xbe,
+	0x48, 0xa8, 0xbc, 0x28, 0x46, 0x76, 0xc4, 0x32, 0x27, 0x62, 0x22, 0x63, 0xa2, 0xfc, 0x1d, 0x8a,
+	0x78, 0xec, 0xa8, 0x8e, 0xc2, 0xee, 0xe5, 0x92, 0x94, 0x6c, 0xfc, 0x14, 0xd5, 0x24, 0x8d, 0xc6,
+	0x20, 0xcd, 0xaa, 0xd2, 0x21, 0x65, 0x84, 0x03, 0x54, 0xcf, 0x68, 0x1e, 0x9e, 0xd3, 0x34, 0x0d,
+	0xf9, 0x50, 0x52, 0x66, 0x3e, 0xb8, 0x77, 0x9f, 0x2e, 0x44, 0x64, 0x33, 0xa3, 0xb9, 0x4f, 0xd3,
+	0x94, 0x28, 0x0d, 0x7c, 0x84, 0x9e, 0x28, 0xd5, 0xdb, 0xd7, 0x9b, 0xeb, 0x2d, 0xe3, 0xa0, 0xfe,
+	0x7a, 0xcf, 0xbe, 0x3b, 0x41, 0x7b, 0x10, 0xc7, 0x22, 0xb8, 0x9a, 0x00, 0x79, 0x9c, 0xd1, 0x7c,
+	0x19, 0xe0, 0xe7, 0xa8, 0x7e, 0xab, 0xf0, 0x65, 0x98, 0x16, 0x60, 0xd6, 0xf4, 0xbd, 0x37, 0x4b,
+	0xd0, 0x07, 0x95, 0xc3, 0x9f, 0xd0, 0xd6, 0x39, 0x07, 0x08, 0x47, 0x20, 0xc3, 0x88, 0x43, 0x4c,
+	0x65, 0xa8, 0xe6, 0xb9, 0xa1, 0x9f, 0xf0, 0x6a, 0x36, 0x6d, 0x36, 0x7c, 0x0e, 0xe0, 0x82, 0xec,
+	0xe8, 0xe2, 0x62, 0xb8, 0xab, 0x28, 0x64, 0x55, 0x72, 0x3f, 0x46, 0x0d, 0x6d, 0x96, 0x3b, 0x94,
+	0xd1, 0x05, 0x01, 0x51, 0xa4, 0xf2, 0x3f, 0x2c, 0x33, 0xd1, 0x86, 0x28, 0xa2, 0x08, 0x84, 0xd0,
+	0x9e, 0x3d, 0x24, 0xcb, 0x10, 0x6f, 0xa3, 0x75, 0xe0, 0x9c, 0xf1, 0xd2, 0x83, 0x45, 0xf0, 0xf2,
+	0x33, 0xaa, 0xff, 0xed, 0xd2, 0x67, 0x31, 0xe0, 0x16, 0xda, 0x3b, 0x6b, 0xbf, 0xf5, 0x48, 0xe8,
+	0xb6, 0x83, 0xce, 0xbb, 0xb0, 0x3f, 0xe8, 0x7a, 0xe1, 0xe9, 0xfb, 0x93, 0x63, 0xaf, 0xd3, 0xf3,
+	0x7b, 0x5e, 0xb7, 0x51, 0xc1, 0xcf, 0xd0, 0xce, 0x1d, 0x44, 0x3b, 0x18, 0xf4, 0x7b, 0x9d, 0x86,
+	0xb1, 0x92, 0xee, 0x7a, 0x27, 0x41, 0xe8, 0xf9, 0xfe, 0x80, 0x04, 0x8d, 0x35, 0xf7, 0xe8, 0x7a,
+	0x66, 0x19, 0x37, 0x33, 0xcb, 0xf8, 0x35, 0xb3, 0x8c, 0x6f, 0x73, 0xab, 0x72, 0x33, 0xb7, 0x2a,
+	0x3f, 0xe6, 0x56, 0xe5, 0xe3, 0xbf, 0x7e, 0x8b, 0x04, 0x0e, 0x4b, 0xb7, 0xd4, 0xd9, 0xf9, 0xaa,
+	0x57, 0x5a, 0x7b, 0x3e, 0xaa, 0xe9, 0x7d, 0x7e, 0xf3, 0x67, 0x00, 0x33, 0x87, 0x28, 0xe7, 0x29,
+	0x03, 0x00, 0x00,
 }
 
 func (m *WagerProps) Marshal() (dAtA []byte, err error) {
@@ -127,6 +277,35 @@ func (m *WagerProps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FreeBetCreditUID) > 0 {
+		i -= len(m.FreeBetCreditUID)
+		copy(dAtA[i:], m.FreeBetCreditUID)
+		i = encodeVarintWager(dAtA, i, uint64(len(m.FreeBetCreditUID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MinOddsValue) > 0 {
+		i -= len(m.MinOddsValue)
+		copy(dAtA[i:], m.MinOddsValue)
+		i = encodeVarintWager(dAtA, i, uint64(len(m.MinOddsValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinOddsType != 0 {
+		i = encodeVarintWager(dAtA, i, uint64(m.MinOddsType))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinFillRatio.Size()
+		i -= size
+		if _, err := m.MinFillRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWager(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Ticket) > 0 {
 		i -= len(m.Ticket)
 		copy(dAtA[i:], m.Ticket)
@@ -154,6 +333,53 @@ func (m *WagerProps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WagerBatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WagerBatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WagerBatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintWager(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintWager(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWager(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWager(v)
 	base := offset
@@ -181,6 +407,39 @@ func (m *WagerProps) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWager(uint64(l))
 	}
+	l = m.MinFillRatio.Size()
+	n += 1 + l + sovWager(uint64(l))
+	if m.MinOddsType != 0 {
+		n += 1 + sovWager(uint64(m.MinOddsType))
+	}
+	l = len(m.MinOddsValue)
+	if l > 0 {
+		n += 1 + l + sovWager(uint64(l))
+	}
+	l = len(m.FreeBetCreditUID)
+	if l > 0 {
+		n += 1 + l + sovWager(uint64(l))
+	}
+	return n
+}
+
+func (m *WagerBatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovWager(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovWager(uint64(l))
+	}
 	return n
 }
 
@@ -317,6 +576,257 @@ func (m *WagerProps) Unmarshal(dAtA []byte) error {
 			}
 			m.Ticket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFillRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFillRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOddsType", wireType)
+			}
+			m.MinOddsType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinOddsType |= OddsType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOddsValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinOddsValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeBetCreditUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreeBetCreditUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWager(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWager
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WagerBatchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWager
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WagerBatchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WagerBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWager
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWager
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWager
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWager(dAtA[iNdEx:])
@@ -58,6 +58,85 @@ func TestBetFieldsValidation(t *testing.T) {
 			},
 			err: types.ErrInvalidTicket,
 		},
+		{
+			desc: "negative min fill ratio",
+			bet: &types.WagerProps{
+				UID:          "6e31c60f-2025-48ce-ae79-1dc110f16355",
+				Amount:       sdk.NewInt(int64(10)),
+				Ticket:       "Ticket",
+				MinFillRatio: sdk.MustNewDecFromStr("-0.5"),
+			},
+			err: types.ErrInvalidMinFillRatio,
+		},
+		{
+			desc: "zero min fill ratio as not set",
+			bet: &types.WagerProps{
+				UID:          "6e31c60f-2025-48ce-ae79-1dc110f16355",
+				Amount:       sdk.NewInt(int64(10)),
+				Ticket:       "Ticket",
+				MinFillRatio: sdk.ZeroDec(),
+			},
+		},
+		{
+			desc: "min fill ratio more than one",
+			bet: &types.WagerProps{
+				UID:          "6e31c60f-2025-48ce-ae79-1dc110f16355",
+				Amount:       sdk.NewInt(int64(10)),
+				Ticket:       "Ticket",
+				MinFillRatio: sdk.MustNewDecFromStr("1.1"),
+			},
+			err: types.ErrInvalidMinFillRatio,
+		},
+		{
+			desc: "valid message with min fill ratio",
+			bet: &types.WagerProps{
+				UID:          "6e31c60f-2025-48ce-ae79-1dc110f16355",
+				Amount:       sdk.NewInt(int64(10)),
+				Ticket:       "Ticket",
+				MinFillRatio: sdk.MustNewDecFromStr("0.5"),
+			},
+		},
+		{
+			desc: "invalid min odds",
+			bet: &types.WagerProps{
+				UID:          "6e31c60f-2025-48ce-ae79-1dc110f16355",
+				Amount:       sdk.NewInt(int64(10)),
+				Ticket:       "Ticket",
+				MinOddsType:  types.OddsType_ODDS_TYPE_DECIMAL,
+				MinOddsValue: "0.5",
+			},
+			err: types.ErrInvalidMinOdds,
+		},
+		{
+			desc: "min odds type without value",
+			bet: &types.WagerProps{
+				UID:         "6e31c60f-2025-48ce-ae79-1dc110f16355",
+				Amount:      sdk.NewInt(int64(10)),
+				Ticket:      "Ticket",
+				MinOddsType: types.OddsType_ODDS_TYPE_DECIMAL,
+			},
+			err: types.ErrInvalidMinOdds,
+		},
+		{
+			desc: "min odds value without type",
+			bet: &types.WagerProps{
+				UID:          "6e31c60f-2025-48ce-ae79-1dc110f16355",
+				Amount:       sdk.NewInt(int64(10)),
+				Ticket:       "Ticket",
+				MinOddsValue: "1.5",
+			},
+			err: types.ErrInvalidMinOdds,
+		},
+		{
+			desc: "valid message with min odds",
+			bet: &types.WagerProps{
+				UID:          "6e31c60f-2025-48ce-ae79-1dc110f16355",
+				Amount:       sdk.NewInt(int64(10)),
+				Ticket:       "Ticket",
+				MinOddsType:  types.OddsType_ODDS_TYPE_MONEYLINE,
+				MinOddsValue: "-110",
+			},
+		},
 		{
 			desc: "valid message",
 			bet: &types.WagerProps{
@@ -78,3 +157,18 @@ func TestBetFieldsValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMinOdds(t *testing.T) {
+	props := &types.WagerProps{
+		MinOddsType:  types.OddsType_ODDS_TYPE_FRACTIONAL,
+		MinOddsValue: "5/4",
+	}
+
+	require.NoError(t, props.ValidateMinOdds(types.OddsType_ODDS_TYPE_DECIMAL, "2.25"))
+	require.NoError(t, props.ValidateMinOdds(types.OddsType_ODDS_TYPE_MONEYLINE, "+130"))
+	require.ErrorIs(t, props.ValidateMinOdds(types.OddsType_ODDS_TYPE_DECIMAL, "2.2"), types.ErrOddsLowerThanMinOdds)
+	require.ErrorIs(t, props.ValidateMinOdds(types.OddsType_ODDS_TYPE_INDONESIAN, "-1.25"), types.ErrOddsLowerThanMinOdds)
+
+	// any odds is accepted if the min odds is not set
+	require.NoError(t, (&types.WagerProps{}).ValidateMinOdds(types.OddsType_ODDS_TYPE_DECIMAL, "1.01"))
+}
@@ -56,8 +56,14 @@ func (k Keeper) GetAllDeposits(ctx sdk.Context) (list []types.Deposit, err error
 func (k Keeper) Deposit(ctx sdk.Context, creator, depositor string,
 	marketUID string, amount sdkmath.Int,
 ) (participationIndex uint64, err error) {
-	// Create the deposit object
-	deposit := types.NewDeposit(creator, depositor, marketUID, amount, sdk.ZeroInt(), 0)
+	market, found := k.marketKeeper.GetMarket(ctx, marketUID)
+	if !found {
+		err = sdkerrors.Wrapf(types.ErrMarketNotFound, "%s", marketUID)
+		return
+	}
+
+	// Create the deposit object in the accepted denom of the market
+	deposit := types.NewDeposit(creator, depositor, marketUID, market.Denom, amount, sdk.ZeroInt(), 0)
 
 	feeAmount := deposit.CalcHouseParticipationFeeAmount(k.GetHouseParticipationFee(ctx))
 
@@ -15,6 +15,7 @@ type Keeper struct {
 	paramstore      paramtypes.Subspace
 	authzKeeper     types.AuthzKeeper
 	orderbookKeeper types.OrderbookKeeper
+	marketKeeper    types.MarketKeeper
 	ovmKeeper       types.OVMKeeper
 }
 
@@ -28,6 +29,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
 	orderbookKeeper types.OrderbookKeeper,
+	marketKeeper types.MarketKeeper,
 	ovmKeeper types.OVMKeeper,
 	ps paramtypes.Subspace,
 	expectedKeepers SdkExpectedKeepers,
@@ -41,6 +43,7 @@ func NewKeeper(
 		storeKey:        key,
 		cdc:             cdc,
 		orderbookKeeper: orderbookKeeper,
+		marketKeeper:    marketKeeper,
 		ovmKeeper:       ovmKeeper,
 		paramstore:      ps,
 		authzKeeper:     expectedKeepers.AuthzKeeper,
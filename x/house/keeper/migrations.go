@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sge-network/sge/app/params"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, the deposits and withdrawals
+// without denom are set to the bond denom.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	deposits, err := m.keeper.GetAllDeposits(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, deposit := range deposits {
+		if deposit.Denom == "" {
+			deposit.Denom = params.DefaultBondDenom
+			m.keeper.SetDeposit(ctx, deposit)
+		}
+	}
+
+	withdrawals, err := m.keeper.GetAllWithdrawals(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, withdrawal := range withdrawals {
+		if withdrawal.Denom == "" {
+			withdrawal.Denom = params.DefaultBondDenom
+			m.keeper.SetWithdrawal(ctx, withdrawal)
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/sge-network/sge/app/params"
 	simappUtil "github.com/sge-network/sge/testutil/simapp"
 	sgetypes "github.com/sge-network/sge/types"
 	"github.com/sge-network/sge/x/house/types"
@@ -27,6 +29,7 @@ func TestMsgServerDeposit(t *testing.T) {
 		EndTS:   cast.ToUint64(ctx.BlockTime().Unix()) + 1000,
 		Odds:    testMarketOdds,
 		Status:  markettypes.MarketStatus_MARKET_STATUS_ACTIVE,
+		Denom:   params.DefaultBondDenom,
 	}
 
 	tApp.MarketKeeper.SetMarket(ctx, marketItem)
@@ -35,7 +38,7 @@ func TestMsgServerDeposit(t *testing.T) {
 	for _, v := range marketItem.Odds {
 		oddsUIDs = append(oddsUIDs, v.UID)
 	}
-	err := tApp.OrderbookKeeper.InitiateOrderBook(ctx, marketItem.UID, oddsUIDs)
+	err := tApp.OrderbookKeeper.InitiateOrderBook(ctx, marketItem.UID, params.DefaultBondDenom, oddsUIDs)
 	require.NoError(t, err)
 
 	t.Run("min deposit", func(t *testing.T) {
@@ -83,6 +86,30 @@ func TestMsgServerDeposit(t *testing.T) {
 		require.ErrorIs(t, types.ErrAuthorizationNotFound, err)
 	})
 
+	t.Run("market not found", func(t *testing.T) {
+		testKyc := &sgetypes.KycDataPayload{
+			Approved: true,
+			ID:       depositor.Address.String(),
+		}
+		ticketClaim := jwt.MapClaims{
+			"exp":      time.Now().Add(time.Minute * 5).Unix(),
+			"iat":      time.Now().Unix(),
+			"kyc_data": testKyc,
+		}
+		ticket, err := simappUtil.CreateJwtTicket(ticketClaim)
+		require.Nil(t, err)
+
+		inputDeposit := &types.MsgDeposit{
+			Creator:   depositor.Address.String(),
+			MarketUID: uuid.NewString(),
+			Amount:    sdk.NewInt(1000),
+			Ticket:    ticket,
+		}
+
+		_, err = msgk.Deposit(wctx, inputDeposit)
+		require.ErrorIs(t, err, types.ErrMarketNotFound)
+	})
+
 	t.Run("success without authorization", func(t *testing.T) {
 		testKyc := &sgetypes.KycDataPayload{
 			Approved: true,
@@ -112,6 +139,7 @@ func TestMsgServerDeposit(t *testing.T) {
 		)
 		require.True(t, found)
 		require.Equal(t, inputDeposit.Creator, rst.Creator)
+		require.Equal(t, params.DefaultBondDenom, rst.Denom)
 	})
 
 	t.Run("success with authorization", func(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang-jwt/jwt"
+	"github.com/sge-network/sge/app/params"
 	simappUtil "github.com/sge-network/sge/testutil/simapp"
 	sgetypes "github.com/sge-network/sge/types"
 	"github.com/sge-network/sge/x/house/types"
@@ -27,6 +28,7 @@ func TestMsgServerWithdraw(t *testing.T) {
 		EndTS:   cast.ToUint64(ctx.BlockTime().Unix()) + 1000,
 		Odds:    testMarketOdds,
 		Status:  markettypes.MarketStatus_MARKET_STATUS_ACTIVE,
+		Denom:   params.DefaultBondDenom,
 	}
 
 	tApp.MarketKeeper.SetMarket(ctx, marketItem)
@@ -35,7 +37,7 @@ func TestMsgServerWithdraw(t *testing.T) {
 	for _, v := range marketItem.Odds {
 		oddsUIDs = append(oddsUIDs, v.UID)
 	}
-	err := tApp.OrderbookKeeper.InitiateOrderBook(ctx, marketItem.UID, oddsUIDs)
+	err := tApp.OrderbookKeeper.InitiateOrderBook(ctx, marketItem.UID, params.DefaultBondDenom, oddsUIDs)
 	require.NoError(t, err)
 
 	expTime := time.Now().Add(5 * time.Minute)
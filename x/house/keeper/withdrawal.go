@@ -85,6 +85,7 @@ func (k Keeper) Withdraw(
 		creator,
 		depositorAddr,
 		marketUID,
+		deposit.Denom,
 		participationIndex,
 		withdrawableAmount,
 		mode,
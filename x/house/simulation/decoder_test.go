@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/sge-network/sge/app"
+	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/testutil/sample"
 	"github.com/sge-network/sge/x/house/simulation"
 	"github.com/sge-network/sge/x/house/types"
@@ -23,6 +24,7 @@ func TestDecodeStore(t *testing.T) {
 		sample.AccAddress(),
 		sample.AccAddress(),
 		uuid.NewString(),
+		params.DefaultBondDenom,
 		sdk.NewInt(100),
 		sdk.NewInt(1000),
 		1,
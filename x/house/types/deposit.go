@@ -8,7 +8,7 @@ import (
 
 // NewDeposit creates a new deposit object
 func NewDeposit(
-	creator, depositorAddress, marketUID string,
+	creator, depositorAddress, marketUID, denom string,
 	amount, totalAmount sdkmath.Int,
 	withdrawalCount uint64,
 ) Deposit {
@@ -16,6 +16,7 @@ func NewDeposit(
 		Creator:               creator,
 		DepositorAddress:      depositorAddress,
 		MarketUID:             marketUID,
+		Denom:                 denom,
 		Amount:                amount,
 		WithdrawalCount:       withdrawalCount,
 		TotalWithdrawalAmount: totalAmount,
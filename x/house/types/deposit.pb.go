@@ -43,6 +43,8 @@ type Deposit struct {
 	// total_withdrawal_amount is the total amount withdrawn from the liquidity
 	// provided
 	TotalWithdrawalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_withdrawal_amount,json=totalWithdrawalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_withdrawal_amount" yaml:"total_withdrawal_amount"`
+	// denom is the denomination of the deposit amount.
+	Denom string `protobuf:"bytes,8,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 }
 
 func (m *Deposit) Reset()      { *m = Deposit{} }
@@ -84,35 +86,36 @@ func init() {
 func init() { proto.RegisterFile("sge/house/deposit.proto", fileDescriptor_c6f2840908fc45a1) }
 
 var fileDescriptor_c6f2840908fc45a1 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
-	0x18, 0xc6, 0x13, 0x38, 0xee, 0x54, 0x8b, 0x3f, 0xc5, 0x14, 0x1a, 0x15, 0x64, 0x57, 0x1e, 0xd0,
-	0x0d, 0x34, 0x19, 0xd8, 0xca, 0x80, 0xee, 0xe8, 0x72, 0x03, 0x02, 0x45, 0x42, 0x95, 0x58, 0x22,
-	0x37, 0xb1, 0x72, 0xd6, 0x5d, 0xe2, 0xc8, 0xf6, 0xe9, 0xda, 0x6f, 0xd0, 0x91, 0x91, 0xf1, 0xbe,
-	0x0c, 0x52, 0xc7, 0x8e, 0x88, 0xc1, 0x42, 0xb9, 0x05, 0x31, 0xe6, 0x13, 0xa0, 0xb3, 0x43, 0x1b,
-	0x04, 0x0c, 0x9d, 0xfc, 0xfa, 0x79, 0x9f, 0xf7, 0x79, 0x7f, 0xc3, 0x0b, 0x76, 0x55, 0xce, 0xa2,
-	0xa9, 0x58, 0x28, 0x16, 0x65, 0xac, 0x12, 0x8a, 0xeb, 0xb0, 0x92, 0x42, 0x0b, 0xb8, 0xa3, 0x72,
-	0x56, 0x32, 0xbd, 0x14, 0x72, 0x16, 0xaa, 0x9c, 0x85, 0xd6, 0xb3, 0xb7, 0x93, 0x8b, 0x5c, 0x58,
-	0x43, 0xb4, 0xa9, 0x9c, 0x97, 0x7c, 0xe9, 0x81, 0xc1, 0x91, 0x9b, 0x86, 0x2f, 0xc0, 0x20, 0x95,
-	0x8c, 0x6a, 0x21, 0x03, 0x7f, 0xdf, 0x1f, 0x6e, 0x8d, 0x61, 0x63, 0xf0, 0xfd, 0x33, 0x5a, 0xcc,
-	0x0f, 0x49, 0xdb, 0x20, 0xf1, 0x6f, 0x0b, 0x9c, 0x80, 0x87, 0xed, 0x5a, 0x21, 0x13, 0x9a, 0x65,
-	0x92, 0x29, 0x15, 0xdc, 0xb2, 0x73, 0xcf, 0x1a, 0x83, 0x03, 0x37, 0xf7, 0x97, 0x85, 0xc4, 0xdb,
-	0x57, 0xda, 0xc8, 0x49, 0xf0, 0x15, 0x00, 0x05, 0x95, 0x33, 0xa6, 0x93, 0x05, 0xcf, 0x82, 0xdb,
-	0x36, 0xe3, 0x69, 0x6d, 0xf0, 0xd6, 0x5b, 0xab, 0x7e, 0x98, 0x1c, 0xfd, 0x34, 0xb8, 0x63, 0x89,
-	0x3b, 0x35, 0x7c, 0x07, 0x1e, 0x55, 0x54, 0x6a, 0x9e, 0xf2, 0x8a, 0x6a, 0x2e, 0xca, 0x84, 0x97,
-	0x19, 0x3b, 0x0d, 0x7a, 0xfb, 0xfe, 0xb0, 0x37, 0x46, 0x8d, 0xc1, 0x7b, 0x8e, 0xe4, 0x1f, 0x26,
-	0x12, 0xc3, 0x3f, 0xd4, 0xc9, 0x46, 0x84, 0xc7, 0xa0, 0x4f, 0x0b, 0xb1, 0x28, 0x75, 0x70, 0xc7,
-	0x92, 0xbc, 0xbe, 0x30, 0xd8, 0xfb, 0x66, 0xf0, 0xf3, 0x9c, 0xeb, 0xe9, 0xe2, 0x24, 0x4c, 0x45,
-	0x11, 0xa5, 0x42, 0x15, 0x42, 0xb5, 0xcf, 0x81, 0xca, 0x66, 0x91, 0x3e, 0xab, 0x98, 0x0a, 0x27,
-	0xa5, 0x6e, 0x0c, 0xbe, 0xe7, 0x36, 0xba, 0x14, 0x12, 0xb7, 0x71, 0x70, 0x04, 0xb6, 0x97, 0x5c,
-	0x4f, 0x33, 0x49, 0x97, 0x74, 0x9e, 0xa4, 0x76, 0x45, 0xdf, 0x62, 0x3e, 0x69, 0x0c, 0x86, 0x6e,
-	0xe8, 0xda, 0xa1, 0x48, 0xfc, 0xe0, 0xfa, 0xf7, 0xc6, 0x46, 0x9c, 0xfb, 0x60, 0x57, 0x0b, 0x4d,
-	0xe7, 0x49, 0x27, 0xa9, 0xa5, 0x1d, 0x58, 0xda, 0xf7, 0x37, 0xa6, 0x45, 0x6e, 0xf1, 0x7f, 0x62,
-	0x49, 0xfc, 0xd8, 0x76, 0x8e, 0xaf, 0x1a, 0x23, 0xab, 0x1f, 0xde, 0x3d, 0x5f, 0x61, 0xef, 0xf3,
-	0x0a, 0x7b, 0x3f, 0x56, 0xd8, 0x1b, 0x8f, 0x2f, 0x6a, 0xe4, 0x5f, 0xd6, 0xc8, 0xff, 0x5e, 0x23,
-	0xff, 0xd3, 0x1a, 0x79, 0x97, 0x6b, 0xe4, 0x7d, 0x5d, 0x23, 0xef, 0xe3, 0xb0, 0x03, 0xa2, 0x72,
-	0x76, 0xd0, 0x5e, 0xe6, 0xa6, 0x8e, 0x4e, 0xdb, 0xfb, 0xb5, 0x38, 0x27, 0x7d, 0x7b, 0x92, 0x2f,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x52, 0xf4, 0xc7, 0xd9, 0x02, 0x00, 0x00,
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xb7, 0x21, 0x4d, 0xe8, 0xa9, 0x40, 0x38, 0x0a, 0xb5, 0x0a, 0xf2, 0x55, 0x37, 0x54, 0x19,
+	0xa8, 0x3d, 0xb0, 0x95, 0x01, 0x25, 0x74, 0xc9, 0x80, 0x40, 0x96, 0x50, 0x25, 0x16, 0xeb, 0x6a,
+	0x9f, 0x1c, 0x2b, 0xb1, 0x9f, 0x75, 0x77, 0x51, 0xda, 0x6f, 0xd0, 0x91, 0x91, 0x31, 0x1f, 0xa7,
+	0x63, 0x47, 0xc4, 0x70, 0x42, 0xc9, 0x82, 0x90, 0x58, 0xfc, 0x09, 0x50, 0xee, 0xdc, 0x26, 0x08,
+	0x18, 0x98, 0xfc, 0xfc, 0x7b, 0xbf, 0x7f, 0x96, 0x1f, 0xda, 0x93, 0x19, 0x0f, 0x47, 0x30, 0x95,
+	0x3c, 0x4c, 0x79, 0x05, 0x32, 0x57, 0x41, 0x25, 0x40, 0x01, 0xde, 0x95, 0x19, 0x2f, 0xb9, 0x9a,
+	0x81, 0x18, 0x07, 0x32, 0xe3, 0x81, 0xe1, 0xec, 0xef, 0x66, 0x90, 0x81, 0x21, 0x84, 0xab, 0xc9,
+	0x72, 0xe9, 0xcf, 0x16, 0xea, 0x9c, 0x58, 0x35, 0x7e, 0x81, 0x3a, 0x89, 0xe0, 0x4c, 0x81, 0xf0,
+	0xdc, 0x03, 0xb7, 0xb7, 0x3d, 0xc0, 0xb5, 0x26, 0x0f, 0x2e, 0x58, 0x31, 0x39, 0xa6, 0xcd, 0x82,
+	0x46, 0x37, 0x14, 0x3c, 0x44, 0x8f, 0x9a, 0x58, 0x10, 0x31, 0x4b, 0x53, 0xc1, 0xa5, 0xf4, 0xee,
+	0x18, 0xdd, 0xf3, 0x5a, 0x13, 0xcf, 0xea, 0xfe, 0xa0, 0xd0, 0xa8, 0x7b, 0x8b, 0xf5, 0x2d, 0x84,
+	0x5f, 0x21, 0x54, 0x30, 0x31, 0xe6, 0x2a, 0x9e, 0xe6, 0xa9, 0x77, 0xd7, 0x78, 0x3c, 0x5b, 0x68,
+	0xb2, 0xfd, 0xd6, 0xa0, 0x1f, 0x86, 0x27, 0x3f, 0x34, 0xd9, 0xa0, 0x44, 0x1b, 0x33, 0x7e, 0x87,
+	0x1e, 0x57, 0x4c, 0xa8, 0x3c, 0xc9, 0x2b, 0xa6, 0x72, 0x28, 0xe3, 0xbc, 0x4c, 0xf9, 0xb9, 0xd7,
+	0x3a, 0x70, 0x7b, 0xad, 0x81, 0x5f, 0x6b, 0xb2, 0x6f, 0x9b, 0xfc, 0x85, 0x44, 0x23, 0xfc, 0x1b,
+	0x3a, 0x5c, 0x81, 0xf8, 0x14, 0xb5, 0x59, 0x01, 0xd3, 0x52, 0x79, 0x5b, 0xa6, 0xc9, 0xeb, 0x2b,
+	0x4d, 0x9c, 0xaf, 0x9a, 0x1c, 0x66, 0xb9, 0x1a, 0x4d, 0xcf, 0x82, 0x04, 0x8a, 0x30, 0x01, 0x59,
+	0x80, 0x6c, 0x1e, 0x47, 0x32, 0x1d, 0x87, 0xea, 0xa2, 0xe2, 0x32, 0x18, 0x96, 0xaa, 0xd6, 0xe4,
+	0xbe, 0x4d, 0xb4, 0x2e, 0x34, 0x6a, 0xec, 0x70, 0x1f, 0x75, 0x67, 0xb9, 0x1a, 0xa5, 0x82, 0xcd,
+	0xd8, 0x24, 0x4e, 0x4c, 0x44, 0xdb, 0xd4, 0x7c, 0x5a, 0x6b, 0x82, 0xad, 0x68, 0xcd, 0x90, 0x34,
+	0x7a, 0xb8, 0x7e, 0x7b, 0x63, 0x2c, 0x2e, 0x5d, 0xb4, 0xa7, 0x40, 0xb1, 0x49, 0xbc, 0xe1, 0xd4,
+	0xb4, 0xed, 0x98, 0xb6, 0xef, 0xff, 0xbb, 0xad, 0x6f, 0x83, 0xff, 0x61, 0x4b, 0xa3, 0x27, 0x66,
+	0x73, 0x7a, 0xbb, 0xe8, 0xdb, 0xaf, 0x39, 0x44, 0x5b, 0x29, 0x2f, 0xa1, 0xf0, 0xee, 0x99, 0xdc,
+	0x6e, 0xad, 0xc9, 0xce, 0xcd, 0x3f, 0x2f, 0xa1, 0xa0, 0x91, 0x5d, 0x1f, 0xef, 0x5c, 0xce, 0x89,
+	0xf3, 0x79, 0x4e, 0x9c, 0xef, 0x73, 0xe2, 0x0c, 0x06, 0x57, 0x0b, 0xdf, 0xbd, 0x5e, 0xf8, 0xee,
+	0xb7, 0x85, 0xef, 0x7e, 0x5a, 0xfa, 0xce, 0xf5, 0xd2, 0x77, 0xbe, 0x2c, 0x7d, 0xe7, 0x63, 0x6f,
+	0xa3, 0xb0, 0xcc, 0xf8, 0x51, 0x73, 0xc1, 0xab, 0x39, 0x3c, 0x6f, 0xee, 0xdc, 0xd4, 0x3e, 0x6b,
+	0x9b, 0xd3, 0x7d, 0xf9, 0x6b, 0x00, 0x28, 0x07, 0xe5, 0xdc, 0x01, 0x03, 0x00, 0x00,
 }
 
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
@@ -135,6 +138,13 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDeposit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.TotalWithdrawalAmount.Size()
 		i -= size
@@ -228,6 +238,10 @@ func (m *Deposit) Size() (n int) {
 	}
 	l = m.TotalWithdrawalAmount.Size()
 	n += 1 + l + sovDeposit(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDeposit(uint64(l))
+	}
 	return n
 }
 
@@ -468,6 +482,38 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeposit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeposit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDeposit(dAtA[iNdEx:])
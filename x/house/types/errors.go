@@ -22,4 +22,5 @@ var (
 	ErrUserKycFailed             = sdkerrors.Register(ModuleName, 5012, "the account failed the KYC Validation")
 	ErrAuthorizationNotFound     = sdkerrors.Register(ModuleName, 5013, "no authorization found")
 	ErrAuthorizationNotAccepted  = sdkerrors.Register(ModuleName, 5014, "authorization not accepted")
+	ErrMarketNotFound            = sdkerrors.Register(ModuleName, 5015, "market not found")
 )
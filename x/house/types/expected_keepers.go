@@ -7,8 +7,15 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	markettypes "github.com/sge-network/sge/x/market/types"
 )
 
+// MarketKeeper defines the expected market keeper.
+type MarketKeeper interface {
+	GetMarket(ctx sdk.Context, marketUID string) (markettypes.Market, bool)
+}
+
 // OrderbookKeeper defines the expected orderbook keeper.
 type OrderbookKeeper interface {
 	InitiateOrderBookParticipation(ctx sdk.Context, addr sdk.AccAddress, bookUID string,
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/google/uuid"
+	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/testutil/sample"
 	"github.com/sge-network/sge/x/house/types"
 	"github.com/stretchr/testify/require"
@@ -70,6 +71,7 @@ func TestNewDeposit(t *testing.T) {
 			Creator:               uuid.NewString(),
 			DepositorAddress:      uuid.NewString(),
 			MarketUID:             uuid.NewString(),
+			Denom:                 params.DefaultBondDenom,
 			Amount:                sdk.NewInt(100),
 			ParticipationIndex:    0,
 			WithdrawalCount:       0,
@@ -79,6 +81,7 @@ func TestNewDeposit(t *testing.T) {
 			expected.Creator,
 			expected.DepositorAddress,
 			expected.MarketUID,
+			expected.Denom,
 			expected.Amount,
 			expected.TotalWithdrawalAmount,
 			expected.WithdrawalCount,
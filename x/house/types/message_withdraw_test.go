@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/google/uuid"
+	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/testutil/sample"
 	"github.com/sge-network/sge/x/house/types"
 	"github.com/stretchr/testify/require"
@@ -95,6 +96,7 @@ func TestNewWithdraw(t *testing.T) {
 			ID:                 1,
 			Creator:            uuid.NewString(),
 			MarketUID:          uuid.NewString(),
+			Denom:              params.DefaultBondDenom,
 			Amount:             sdk.NewInt(100),
 			ParticipationIndex: 0,
 			Address:            sample.AccAddress(),
@@ -105,6 +107,7 @@ func TestNewWithdraw(t *testing.T) {
 			expected.Creator,
 			expected.Address,
 			expected.MarketUID,
+			expected.Denom,
 			0,
 			expected.Amount,
 			expected.Mode,
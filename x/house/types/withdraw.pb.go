@@ -73,6 +73,8 @@ type Withdrawal struct {
 	Mode WithdrawalMode `protobuf:"varint,6,opt,name=mode,proto3,enum=sgenetwork.sge.house.WithdrawalMode" json:"mode,omitempty" yaml:"mode"`
 	// amount is the amount being withdrawn.
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	// denom is the denomination of the withdrawal amount.
+	Denom string `protobuf:"bytes,8,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 }
 
 func (m *Withdrawal) Reset()      { *m = Withdrawal{} }
@@ -115,38 +117,39 @@ func init() {
 func init() { proto.RegisterFile("sge/house/withdraw.proto", fileDescriptor_9ca852402ebf549d) }
 
 var fileDescriptor_9ca852402ebf549d = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6a, 0xdb, 0x4c,
-	0x10, 0xc7, 0x25, 0xc5, 0x9f, 0x83, 0xf7, 0x6b, 0x5d, 0xb3, 0x35, 0x54, 0x8d, 0x41, 0x6b, 0x44,
-	0x29, 0x6e, 0x69, 0x24, 0x68, 0x6f, 0xe9, 0xa1, 0xd8, 0xb5, 0x43, 0x05, 0x76, 0x13, 0xd4, 0x18,
-	0x43, 0x2f, 0x46, 0xf1, 0x2e, 0xf2, 0xe2, 0x48, 0x6b, 0xb4, 0x6b, 0x9c, 0xbc, 0x41, 0x8f, 0x3d,
-	0xf6, 0xe8, 0xc7, 0xc9, 0x31, 0xd0, 0x4b, 0xe9, 0x61, 0x29, 0xf2, 0xa5, 0xf4, 0xa8, 0x27, 0x28,
-	0x5a, 0x2b, 0xd4, 0x31, 0x39, 0xed, 0x30, 0xf3, 0x9b, 0xff, 0xec, 0xfc, 0x19, 0x60, 0xf2, 0x90,
-	0xb8, 0x53, 0xb6, 0xe0, 0xc4, 0x5d, 0x52, 0x31, 0xc5, 0x49, 0xb0, 0x74, 0xe6, 0x09, 0x13, 0x0c,
-	0xd6, 0x79, 0x48, 0x62, 0x22, 0x96, 0x2c, 0x99, 0x39, 0x3c, 0x24, 0x8e, 0x82, 0x0e, 0xea, 0x21,
-	0x0b, 0x99, 0x02, 0xdc, 0x3c, 0xda, 0xb0, 0xf6, 0xf7, 0x3d, 0x00, 0x46, 0x45, 0x7b, 0x70, 0x01,
-	0x5f, 0x81, 0xfd, 0x49, 0x42, 0x02, 0xc1, 0x12, 0x53, 0x6f, 0xea, 0xad, 0x4a, 0x07, 0x66, 0x12,
-	0x55, 0xaf, 0x82, 0xe8, 0xe2, 0xc8, 0x2e, 0x0a, 0xb6, 0x7f, 0x8b, 0xc0, 0x17, 0xc0, 0xa0, 0xd8,
-	0x34, 0x9a, 0x7a, 0xab, 0xd4, 0x79, 0x9a, 0x4a, 0x64, 0x78, 0xdd, 0x3f, 0x12, 0x19, 0x14, 0x67,
-	0x12, 0x55, 0x36, 0x4d, 0x14, 0xdb, 0xbe, 0x41, 0x71, 0x2e, 0x1c, 0x60, 0x9c, 0x10, 0xce, 0xcd,
-	0xbd, 0x5d, 0xe1, 0xa2, 0x60, 0xfb, 0xb7, 0x08, 0x7c, 0x0b, 0x40, 0x14, 0x24, 0x33, 0x22, 0xc6,
-	0x0b, 0x8a, 0xcd, 0x92, 0x6a, 0x68, 0xa4, 0x12, 0x55, 0x06, 0x2a, 0x3b, 0x54, 0x73, 0xb6, 0x10,
-	0x7f, 0x2b, 0x86, 0x27, 0xe0, 0xf1, 0x3c, 0x48, 0x04, 0x9d, 0xd0, 0x79, 0x20, 0x28, 0x8b, 0xc7,
-	0x34, 0xc6, 0xe4, 0xd2, 0xfc, 0x4f, 0x7d, 0xd3, 0xca, 0x24, 0x3a, 0xd8, 0x8c, 0xbd, 0x07, 0xb2,
-	0x7d, 0x78, 0x27, 0xeb, 0xe5, 0x49, 0xe8, 0x81, 0x52, 0xc4, 0x30, 0x31, 0xcb, 0x4d, 0xbd, 0x55,
-	0x7d, 0xfd, 0xcc, 0xb9, 0xcf, 0x5e, 0xe7, 0x9f, 0x89, 0x03, 0x86, 0x49, 0xe7, 0x51, 0x26, 0xd1,
-	0xff, 0x9b, 0x39, 0x79, 0xaf, 0xed, 0x2b, 0x09, 0x38, 0x02, 0xe5, 0x20, 0x62, 0x8b, 0x58, 0x98,
-	0xfb, 0x6a, 0xa9, 0x77, 0xd7, 0x12, 0x69, 0x3f, 0x25, 0x7a, 0x1e, 0x52, 0x31, 0x5d, 0x9c, 0x3b,
-	0x13, 0x16, 0xb9, 0x13, 0xc6, 0x23, 0xc6, 0x8b, 0xe7, 0x90, 0xe3, 0x99, 0x2b, 0xae, 0xe6, 0x84,
-	0x3b, 0x5e, 0x2c, 0x32, 0x89, 0x1e, 0x16, 0x9e, 0x29, 0x15, 0xdb, 0x2f, 0xe4, 0x8e, 0x1e, 0x7c,
-	0x59, 0x21, 0xed, 0xdb, 0x0a, 0x69, 0xbf, 0x57, 0x48, 0x7b, 0x39, 0x05, 0xd5, 0xbb, 0xff, 0x81,
-	0x08, 0x34, 0x46, 0xde, 0xd9, 0x87, 0xae, 0xdf, 0x1e, 0xb5, 0xfb, 0xe3, 0xc1, 0x49, 0xb7, 0x37,
-	0x1e, 0x7e, 0xfc, 0x74, 0xda, 0x7b, 0xef, 0x1d, 0x7b, 0xbd, 0x6e, 0x4d, 0x83, 0x26, 0xa8, 0xef,
-	0x02, 0xc7, 0xc3, 0x7e, 0xbf, 0xa6, 0xc3, 0x06, 0x78, 0xb2, 0x5b, 0x39, 0x6d, 0xfb, 0x67, 0x5e,
-	0xbb, 0x5f, 0x33, 0x3a, 0x9d, 0xeb, 0xd4, 0xd2, 0x6f, 0x52, 0x4b, 0xff, 0x95, 0x5a, 0xfa, 0xd7,
-	0xb5, 0xa5, 0xdd, 0xac, 0x2d, 0xed, 0xc7, 0xda, 0xd2, 0x3e, 0xb7, 0xb6, 0x56, 0xe2, 0x21, 0x39,
-	0x2c, 0x2c, 0xcb, 0x63, 0xf7, 0xb2, 0x38, 0x5c, 0xb5, 0xd8, 0x79, 0x59, 0x9d, 0xe2, 0x9b, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x82, 0xd0, 0x4f, 0xd2, 0x02, 0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6b, 0xdb, 0x3e,
+	0x18, 0xc6, 0x6d, 0x37, 0x4d, 0xff, 0xd1, 0xbf, 0xcb, 0x82, 0x16, 0x98, 0xd7, 0x80, 0x15, 0xcc,
+	0x28, 0xd9, 0x58, 0x6d, 0xd8, 0x6e, 0xdd, 0x61, 0x24, 0x4b, 0xca, 0x0c, 0xc9, 0x5a, 0xbc, 0x86,
+	0xc0, 0x2e, 0xc1, 0x8d, 0x84, 0x23, 0x52, 0x5b, 0xc1, 0x52, 0x48, 0xfb, 0x0d, 0x76, 0xdc, 0x71,
+	0xc7, 0x7c, 0x9c, 0x1e, 0x7b, 0x1c, 0x3b, 0x88, 0x91, 0x5c, 0xc6, 0x8e, 0xbe, 0xee, 0x32, 0x22,
+	0xbb, 0x2c, 0x0d, 0x3d, 0xe9, 0xe5, 0x7d, 0x7f, 0xcf, 0xf3, 0x4a, 0x0f, 0x02, 0x26, 0x0f, 0x89,
+	0x3b, 0x66, 0x33, 0x4e, 0xdc, 0x39, 0x15, 0x63, 0x9c, 0x04, 0x73, 0x67, 0x9a, 0x30, 0xc1, 0x60,
+	0x95, 0x87, 0x24, 0x26, 0x62, 0xce, 0x92, 0x89, 0xc3, 0x43, 0xe2, 0x28, 0xe8, 0xa0, 0x1a, 0xb2,
+	0x90, 0x29, 0xc0, 0x5d, 0x57, 0x19, 0x6b, 0xff, 0xd9, 0x01, 0x60, 0x90, 0xcb, 0x83, 0x4b, 0xf8,
+	0x0a, 0xec, 0x8d, 0x12, 0x12, 0x08, 0x96, 0x98, 0x7a, 0x5d, 0x6f, 0x94, 0x5a, 0x30, 0x95, 0xa8,
+	0x7c, 0x1d, 0x44, 0x97, 0xc7, 0x76, 0x3e, 0xb0, 0xfd, 0x3b, 0x04, 0xbe, 0x00, 0x06, 0xc5, 0xa6,
+	0x51, 0xd7, 0x1b, 0x85, 0xd6, 0xb3, 0xa5, 0x44, 0x86, 0xd7, 0xfe, 0x2d, 0x91, 0x41, 0x71, 0x2a,
+	0x51, 0x29, 0x13, 0x51, 0x6c, 0xfb, 0x06, 0xc5, 0x6b, 0xe3, 0x00, 0xe3, 0x84, 0x70, 0x6e, 0xee,
+	0x6c, 0x1b, 0xe7, 0x03, 0xdb, 0xbf, 0x43, 0xe0, 0x5b, 0x00, 0xa2, 0x20, 0x99, 0x10, 0x31, 0x9c,
+	0x51, 0x6c, 0x16, 0x94, 0xa0, 0xb6, 0x94, 0xa8, 0xd4, 0x53, 0xdd, 0xbe, 0xda, 0xb3, 0x81, 0xf8,
+	0x1b, 0x35, 0x3c, 0x05, 0x4f, 0xa6, 0x41, 0x22, 0xe8, 0x88, 0x4e, 0x03, 0x41, 0x59, 0x3c, 0xa4,
+	0x31, 0x26, 0x57, 0xe6, 0xae, 0xba, 0xa6, 0x95, 0x4a, 0x74, 0x90, 0xad, 0x7d, 0x00, 0xb2, 0x7d,
+	0x78, 0xaf, 0xeb, 0xad, 0x9b, 0xd0, 0x03, 0x85, 0x88, 0x61, 0x62, 0x16, 0xeb, 0x7a, 0xa3, 0xfc,
+	0xfa, 0xb9, 0xf3, 0x50, 0xbc, 0xce, 0xbf, 0x10, 0x7b, 0x0c, 0x93, 0xd6, 0xe3, 0x54, 0xa2, 0xff,
+	0xb3, 0x3d, 0x6b, 0xad, 0xed, 0x2b, 0x0b, 0x38, 0x00, 0xc5, 0x20, 0x62, 0xb3, 0x58, 0x98, 0x7b,
+	0xea, 0x51, 0xef, 0x6e, 0x24, 0xd2, 0x7e, 0x48, 0x74, 0x18, 0x52, 0x31, 0x9e, 0x5d, 0x38, 0x23,
+	0x16, 0xb9, 0x23, 0xc6, 0x23, 0xc6, 0xf3, 0xe3, 0x88, 0xe3, 0x89, 0x2b, 0xae, 0xa7, 0x84, 0x3b,
+	0x5e, 0x2c, 0x52, 0x89, 0x1e, 0xe5, 0x99, 0x29, 0x17, 0xdb, 0xcf, 0xed, 0xe0, 0x21, 0xd8, 0xc5,
+	0x24, 0x66, 0x91, 0xf9, 0x9f, 0xf2, 0xad, 0xa4, 0x12, 0xed, 0x67, 0xa4, 0x6a, 0xdb, 0x7e, 0x36,
+	0x3e, 0xde, 0xff, 0xb2, 0x40, 0xda, 0xb7, 0x05, 0xd2, 0x7e, 0x2d, 0x90, 0xf6, 0x72, 0x0c, 0xca,
+	0xf7, 0xef, 0x0d, 0x11, 0xa8, 0x0d, 0xbc, 0xf3, 0x0f, 0x6d, 0xbf, 0x39, 0x68, 0x76, 0x87, 0xbd,
+	0xd3, 0x76, 0x67, 0xd8, 0xff, 0xf8, 0xe9, 0xac, 0xf3, 0xde, 0x3b, 0xf1, 0x3a, 0xed, 0x8a, 0x06,
+	0x4d, 0x50, 0xdd, 0x06, 0x4e, 0xfa, 0xdd, 0x6e, 0x45, 0x87, 0x35, 0xf0, 0x74, 0x7b, 0x72, 0xd6,
+	0xf4, 0xcf, 0xbd, 0x66, 0xb7, 0x62, 0xb4, 0x5a, 0x37, 0x4b, 0x4b, 0xbf, 0x5d, 0x5a, 0xfa, 0xcf,
+	0xa5, 0xa5, 0x7f, 0x5d, 0x59, 0xda, 0xed, 0xca, 0xd2, 0xbe, 0xaf, 0x2c, 0xed, 0x73, 0x63, 0xe3,
+	0xe9, 0x3c, 0x24, 0x47, 0x79, 0xb4, 0xeb, 0xda, 0xbd, 0xca, 0x3f, 0xb8, 0x0a, 0xe0, 0xa2, 0xa8,
+	0xbe, 0xec, 0x9b, 0xbf, 0x03, 0x00, 0xf9, 0xb8, 0x8d, 0xaf, 0xfa, 0x02, 0x00, 0x00,
 }
 
 func (m *Withdrawal) Marshal() (dAtA []byte, err error) {
@@ -169,6 +172,13 @@ func (m *Withdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintWithdraw(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -258,6 +268,10 @@ func (m *Withdrawal) Size() (n int) {
 	}
 	l = m.Amount.Size()
 	n += 1 + l + sovWithdraw(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovWithdraw(uint64(l))
+	}
 	return n
 }
 
@@ -483,6 +497,38 @@ func (m *Withdrawal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdraw
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdraw
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdraw
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWithdraw(dAtA[iNdEx:])
@@ -11,7 +11,7 @@ import (
 //nolint:interface
 func NewWithdrawal(
 	id uint64,
-	creator, depositorAddr, marketUID string,
+	creator, depositorAddr, marketUID, denom string,
 	participationIndex uint64,
 	amount sdkmath.Int,
 	mode WithdrawalMode,
@@ -21,6 +21,7 @@ func NewWithdrawal(
 		ID:                 id,
 		Address:            depositorAddr,
 		MarketUID:          marketUID,
+		Denom:              denom,
 		ParticipationIndex: participationIndex,
 		Mode:               mode,
 		Amount:             amount,
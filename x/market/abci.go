@@ -0,0 +1,20 @@
+package market
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sge-network/sge/x/market/keeper"
+)
+
+// EndBlocker inactivates the ended markets, aborts the markets that are not resolved in the grace period
+// and resolves the markets that the dispute period of their pending resolution is passed
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if err := k.ProcessPendingResolutions(ctx); err != nil {
+		panic(fmt.Sprintf("end block no %d failed : %s", ctx.BlockHeight(), err.Error()))
+	}
+
+	if err := k.ProcessEndedMarkets(ctx); err != nil {
+		panic(fmt.Sprintf("end block no %d failed : %s", ctx.BlockHeight(), err.Error()))
+	}
+}
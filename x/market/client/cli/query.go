@@ -28,6 +28,12 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListMarkets(),
 		CmdGetMarket(),
 		CmdListMarketByUIDs(),
+		CmdListFixtures(),
+		CmdGetFixture(),
+		CmdListFixtureMarkets(),
+		CmdListPendingResolutions(),
+		CmdGetPendingResolution(),
+		CmdListResolutionAttestations(),
 	)
 
 	return cmd
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sge-network/sge/x/market/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdListFixtures implements a command to return all fixtures
+func CmdListFixtures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fixtures",
+		Short: "list fixtures",
+		Long:  "Get list of fixtures in paginated response.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFixturesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Fixtures(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdGetFixture implements a command to return a specific fixture based on its UID
+func CmdGetFixture() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fixture [uid]",
+		Short: "get fixture",
+		Long:  "Get fixture by uid.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFixtureRequest{
+				Uid: args[0],
+			}
+
+			res, err := queryClient.Fixture(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdListFixtureMarkets implements a command to return the markets of a fixture
+func CmdListFixtureMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fixture-markets [fixture-uid]",
+		Short: "list markets of a fixture",
+		Long:  "Get list of the markets of a fixture in paginated response.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFixtureMarketsRequest{
+				FixtureUid: args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FixtureMarkets(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,7 +1,6 @@
 package cli_test
 
 import (
-	"encoding/json"
 	"testing"
 
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
@@ -40,7 +39,7 @@ func TestQueryParams(t *testing.T) {
 			}
 
 			var params types.QueryParamsResponse
-			err = json.Unmarshal(res.Bytes(), &params)
+			err = net.Config.Codec.UnmarshalJSON(res.Bytes(), &params)
 			require.NoError(t, err)
 
 			defaultParams := types.DefaultParams()
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sge-network/sge/x/market/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdListPendingResolutions implements a command to return all pending resolutions
+func CmdListPendingResolutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-resolutions",
+		Short: "list pending resolutions",
+		Long:  "Get list of the resolutions held in the dispute period in paginated response.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingResolutionsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingResolutions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdGetPendingResolution implements a command to return the pending resolution of a market
+func CmdGetPendingResolution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-resolution [market-uid]",
+		Short: "get pending resolution",
+		Long:  "Get the pending resolution of a market by market uid.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingResolutionRequest{
+				MarketUid: args[0],
+			}
+
+			res, err := queryClient.PendingResolution(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdListResolutionAttestations implements a command to return the resolution attestations of a market
+func CmdListResolutionAttestations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolution-attestations [market-uid]",
+		Short: "list resolution attestations of a market",
+		Long:  "Get list of the resolution attestations of a market in paginated response.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryResolutionAttestationsRequest{
+				MarketUid:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ResolutionAttestations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
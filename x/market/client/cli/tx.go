@@ -25,6 +25,9 @@ func GetTxCmd() *cobra.Command {
 		CmdAdd(),
 		CmdResolve(),
 		CmdUpdate(),
+		CmdAddFixture(),
+		CmdUpdateFixture(),
+		CmdDisputeResolution(),
 	)
 
 	return cmd
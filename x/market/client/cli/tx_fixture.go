@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sge-network/sge/x/market/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdAddFixture CLI registration for add fixture command
+func CmdAddFixture() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-fixture [ticket]",
+		Short: "create new fixture",
+		Long:  "Create a fixture with ticket.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddFixture(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdUpdateFixture CLI registration for update fixture command
+func CmdUpdateFixture() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-fixture [ticket]",
+		Short: "update fixture",
+		Long:  "Update a fixture with ticket, postponing or canceling the fixture is applied to its markets.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateFixture(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sge-network/sge/x/market/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdDisputeResolution CLI registration for dispute resolution command
+func CmdDisputeResolution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute-resolution [market-uid] [winner-odds-uids] [reason]",
+		Short: "dispute the pending resolution of a market",
+		Long:  "Dispute the pending resolution of a market by a bonded validator operator, the winner odds uids are comma separated. The dispute bond is held until the oracle confirms the result.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisputeResolution(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				strings.Split(args[1], ","),
+				nil,
+				args[2],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
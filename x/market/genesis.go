@@ -9,17 +9,35 @@ import (
 // InitGenesis initializes the module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	// Set all the fixtures
+	for _, elem := range genState.FixtureList {
+		k.SetFixture(ctx, elem)
+	}
+
 	// Set all the markets
 	for _, elem := range genState.MarketList {
 		k.SetMarket(ctx, elem)
 	}
 
+	// Set all the pending resolutions
+	for _, elem := range genState.PendingResolutionList {
+		k.SetPendingResolution(ctx, elem)
+	}
+
+	// Set all the resolution attestations
+	for _, elem := range genState.ResolutionAttestationList {
+		k.SetResolutionAttestation(ctx, elem)
+	}
+
 	k.SetMarketStats(ctx, genState.Stats)
 }
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
 
 	var err error
 	genesis.MarketList, err = k.GetMarkets(ctx)
@@ -27,6 +45,21 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	genesis.FixtureList, err = k.GetFixtures(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.PendingResolutionList, err = k.GetPendingResolutions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.ResolutionAttestationList, err = k.GetAllResolutionAttestations(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	genesis.Stats = k.GetMarketStats(ctx)
 
 	return genesis
@@ -19,7 +19,28 @@ func TestGenesis(t *testing.T) {
 				UID: "0",
 			},
 			{
-				UID: "1",
+				UID:        "1",
+				FixtureUID: "0",
+			},
+			{
+				UID:    "2",
+				Status: types.MarketStatus_MARKET_STATUS_RESULT_PENDING,
+			},
+		},
+		FixtureList: []types.Fixture{
+			{
+				UID: "0",
+			},
+		},
+		PendingResolutionList: []types.PendingResolution{
+			{
+				MarketUID: "2",
+			},
+		},
+		ResolutionAttestationList: []types.ResolutionAttestation{
+			{
+				MarketUID: "0",
+				Signer:    "0",
 			},
 		},
 	}
@@ -34,5 +55,13 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.Equal(t, genesisState.Params, got.Params)
 	require.ElementsMatch(t, genesisState.MarketList, got.MarketList)
+	require.ElementsMatch(t, genesisState.FixtureList, got.FixtureList)
+	require.ElementsMatch(t, genesisState.PendingResolutionList, got.PendingResolutionList)
+	require.ElementsMatch(t, genesisState.ResolutionAttestationList, got.ResolutionAttestationList)
+
+	marketUIDs, err := tApp.MarketKeeper.GetFixtureMarketUIDs(ctx, "0")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, marketUIDs)
 }
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	"github.com/sge-network/sge/utils"
+	"github.com/sge-network/sge/x/market/types"
+)
+
+// SetFixture sets a specific fixture in the store
+func (k Keeper) SetFixture(ctx sdk.Context, fixture types.Fixture) {
+	store := k.getFixturesStore(ctx)
+	b := k.cdc.MustMarshal(&fixture)
+	store.Set(utils.StrBytes(fixture.UID), b)
+}
+
+// GetFixture returns a specific fixture by its UID
+func (k Keeper) GetFixture(ctx sdk.Context, fixtureUID string) (val types.Fixture, found bool) {
+	store := k.getFixturesStore(ctx)
+	b := store.Get(utils.StrBytes(fixtureUID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+
+	return val, true
+}
+
+// GetFixtures returns all fixtures
+func (k Keeper) GetFixtures(ctx sdk.Context) (list []types.Fixture, err error) {
+	store := k.getFixturesStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Fixture
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// setFixtureMarket adds the market to the market list of the fixture
+func (k Keeper) setFixtureMarket(ctx sdk.Context, fixtureUID, marketUID string) {
+	store := k.getFixtureMarketsStore(ctx)
+	store.Set(types.FixtureMarketKey(fixtureUID, marketUID), utils.StrBytes(marketUID))
+}
+
+// GetFixtureMarketUIDs returns the uid list of the markets of a fixture
+func (k Keeper) GetFixtureMarketUIDs(ctx sdk.Context, fixtureUID string) (list []string, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FixtureMarketListOfFixturePrefix(fixtureUID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
+
+// cascadeFixtureStatus applies the status of the fixture to its markets,
+// the active markets of a postponed fixture are inactivated, the markets
+// inactivated by the postponement are shifted by the change of the scheduled
+// timestamp and activated if they are not ended when the fixture is scheduled
+// again and the unresolved markets of a canceled fixture are canceled.
+// It returns the uid list of the markets that their status is changed.
+func (k Keeper) cascadeFixtureStatus(
+	ctx sdk.Context,
+	fixture types.Fixture,
+	previous types.Fixture,
+) ([]string, error) {
+	marketUIDs, err := k.GetFixtureMarketUIDs(ctx, fixture.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := cast.ToUint64(ctx.BlockTime().Unix())
+	scheduleShift := cast.ToInt64(fixture.ScheduledTS) - cast.ToInt64(previous.ScheduledTS)
+
+	var changed []string
+	for _, marketUID := range marketUIDs {
+		market, found := k.GetMarket(ctx, marketUID)
+		if !found {
+			continue
+		}
+
+		switch fixture.Status {
+		case types.FixtureStatus_FIXTURE_STATUS_POSTPONED:
+			if market.Status != types.MarketStatus_MARKET_STATUS_ACTIVE {
+				continue
+			}
+			market.Status = types.MarketStatus_MARKET_STATUS_INACTIVE
+			market.Postponed = true
+			k.SetMarket(ctx, market)
+		case types.FixtureStatus_FIXTURE_STATUS_SCHEDULED:
+			if previous.Status != types.FixtureStatus_FIXTURE_STATUS_POSTPONED || !market.Postponed {
+				continue
+			}
+			market.Postponed = false
+			if market.Status == types.MarketStatus_MARKET_STATUS_INACTIVE {
+				market.StartTS = cast.ToUint64(cast.ToInt64(market.StartTS) + scheduleShift)
+				market.EndTS = cast.ToUint64(cast.ToInt64(market.EndTS) + scheduleShift)
+			}
+			isActivated := market.Status == types.MarketStatus_MARKET_STATUS_INACTIVE &&
+				market.EndTS > blockTime
+			if isActivated {
+				market.Status = types.MarketStatus_MARKET_STATUS_ACTIVE
+			}
+			k.SetMarket(ctx, market)
+			if !isActivated {
+				continue
+			}
+		case types.FixtureStatus_FIXTURE_STATUS_CANCELED:
+			if !market.IsResolveAllowed() {
+				continue
+			}
+			k.Resolve(ctx, market, &types.MarketResolutionTicketPayload{
+				UID:          market.UID,
+				ResolutionTS: blockTime,
+				Status:       types.MarketStatus_MARKET_STATUS_CANCELED,
+			})
+		default:
+			continue
+		}
+
+		changed = append(changed, market.UID)
+	}
+
+	return changed, nil
+}
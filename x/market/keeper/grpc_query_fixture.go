@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sge-network/sge/consts"
+	"github.com/sge-network/sge/x/market/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Fixtures returns all the fixtures
+func (k Keeper) Fixtures(
+	c context.Context,
+	req *types.QueryFixturesRequest,
+) (*types.QueryFixturesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	var fixtures []types.Fixture
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fixtureStore := k.getFixturesStore(ctx)
+
+	pageRes, err := query.Paginate(fixtureStore, req.Pagination, func(key []byte, value []byte) error {
+		var fixture types.Fixture
+		if err := k.cdc.Unmarshal(value, &fixture); err != nil {
+			return err
+		}
+
+		fixtures = append(fixtures, fixture)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFixturesResponse{Fixtures: fixtures, Pagination: pageRes}, nil
+}
+
+// Fixture returns a specific fixture by its UID
+func (k Keeper) Fixture(
+	c context.Context,
+	req *types.QueryFixtureRequest,
+) (*types.QueryFixtureResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetFixture(ctx, req.Uid)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryFixtureResponse{Fixture: val}, nil
+}
+
+// FixtureMarkets returns the markets of a fixture
+func (k Keeper) FixtureMarkets(
+	c context.Context,
+	req *types.QueryFixtureMarketsRequest,
+) (*types.QueryFixtureMarketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	var markets []types.Market
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetFixture(ctx, req.FixtureUid); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	fixtureMarketStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FixtureMarketListOfFixturePrefix(req.FixtureUid))
+
+	pageRes, err := query.Paginate(fixtureMarketStore, req.Pagination, func(key []byte, value []byte) error {
+		market, found := k.GetMarket(ctx, string(value))
+		if !found {
+			return types.ErrMarketNotFound
+		}
+
+		markets = append(markets, market)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFixtureMarketsResponse{Markets: markets, Pagination: pageRes}, nil
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sge-network/sge/consts"
+	"github.com/sge-network/sge/x/market/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PendingResolutions returns all the resolutions held in the dispute period
+func (k Keeper) PendingResolutions(
+	c context.Context,
+	req *types.QueryPendingResolutionsRequest,
+) (*types.QueryPendingResolutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	var pendingResolutions []types.PendingResolution
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingResolutionStore := k.getPendingResolutionsStore(ctx)
+
+	pageRes, err := query.Paginate(pendingResolutionStore, req.Pagination, func(key []byte, value []byte) error {
+		var pendingResolution types.PendingResolution
+		if err := k.cdc.Unmarshal(value, &pendingResolution); err != nil {
+			return err
+		}
+
+		pendingResolutions = append(pendingResolutions, pendingResolution)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingResolutionsResponse{PendingResolutions: pendingResolutions, Pagination: pageRes}, nil
+}
+
+// PendingResolution returns the pending resolution of a market by its UID
+func (k Keeper) PendingResolution(
+	c context.Context,
+	req *types.QueryPendingResolutionRequest,
+) (*types.QueryPendingResolutionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetPendingResolution(ctx, req.MarketUid)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryPendingResolutionResponse{PendingResolution: val}, nil
+}
+
+// ResolutionAttestations returns the resolution attestations of a market
+func (k Keeper) ResolutionAttestations(
+	c context.Context,
+	req *types.QueryResolutionAttestationsRequest,
+) (*types.QueryResolutionAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	var attestations []types.ResolutionAttestation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	attestationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ResolutionAttestationListOfMarketPrefix(req.MarketUid))
+
+	pageRes, err := query.Paginate(attestationStore, req.Pagination, func(key []byte, value []byte) error {
+		var attestation types.ResolutionAttestation
+		if err := k.cdc.Unmarshal(value, &attestation); err != nil {
+			return err
+		}
+
+		attestations = append(attestations, attestation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryResolutionAttestationsResponse{ResolutionAttestations: attestations, Pagination: pageRes}, nil
+}
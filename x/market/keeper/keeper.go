@@ -21,6 +21,8 @@ type Keeper struct {
 	paramStore      paramtypes.Subspace
 	ovmKeeper       types.OVMKeeper
 	orderbookKeeper types.OrderbookKeeper
+	stakingKeeper   types.StakingKeeper
+	bankKeeper      types.BankKeeper
 }
 
 // NewKeeper creates new keeper object
@@ -53,6 +55,16 @@ func (k *Keeper) SetOVMKeeper(ovmKeeper types.OVMKeeper) {
 	k.ovmKeeper = ovmKeeper
 }
 
+// SetStakingKeeper sets the staking module keeper to the market keeper.
+func (k *Keeper) SetStakingKeeper(stakingKeeper types.StakingKeeper) {
+	k.stakingKeeper = stakingKeeper
+}
+
+// SetBankKeeper sets the bank module keeper to the market keeper.
+func (k *Keeper) SetBankKeeper(bankKeeper types.BankKeeper) {
+	k.bankKeeper = bankKeeper
+}
+
 // Logger returns the logger of the keeper
 func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
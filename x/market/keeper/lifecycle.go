@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+
+	"github.com/sge-network/sge/utils"
+	"github.com/sge-network/sge/x/market/types"
+)
+
+// marketLifecycleTS returns the timestamp that the next lifecycle transition
+// of the market is due, the active markets are inactivated at their end timestamp
+// and the inactive markets are aborted after the abort grace period. The markets
+// that are not waiting for a lifecycle transition are not indexed.
+func marketLifecycleTS(market types.Market, params types.Params) (uint64, bool) {
+	switch market.Status {
+	case types.MarketStatus_MARKET_STATUS_ACTIVE:
+		return market.EndTS, true
+	case types.MarketStatus_MARKET_STATUS_INACTIVE:
+		if params.AbortGracePeriod == 0 {
+			return 0, false
+		}
+		return market.EndTS + params.AbortGracePeriod, true
+	default:
+		return 0, false
+	}
+}
+
+// setMarketEndTS adds the market to the end timestamp index.
+func (k Keeper) setMarketEndTS(ctx sdk.Context, endTS uint64, marketUID string) {
+	store := k.getMarketEndTSStore(ctx)
+	store.Set(types.MarketEndTSKey(endTS, marketUID), utils.StrBytes(marketUID))
+}
+
+// removeMarketEndTS removes the market from the end timestamp index.
+func (k Keeper) removeMarketEndTS(ctx sdk.Context, endTS uint64, marketUID string) {
+	store := k.getMarketEndTSStore(ctx)
+	store.Delete(types.MarketEndTSKey(endTS, marketUID))
+}
+
+// GetEndedMarketUIDs returns the unresolved markets that their next lifecycle
+// transition is due at the timestamp, ordered by the due timestamp.
+func (k Keeper) GetEndedMarketUIDs(ctx sdk.Context, ts uint64) (list []string, err error) {
+	entries, err := k.getDueMarketEndTSEntries(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		list = append(list, entry.marketUID)
+	}
+
+	return list, nil
+}
+
+// marketEndTSEntry is an entry of the end timestamp index.
+type marketEndTSEntry struct {
+	ts        uint64
+	marketUID string
+}
+
+// getDueMarketEndTSEntries returns the entries of the end timestamp index
+// that are due at the timestamp.
+func (k Keeper) getDueMarketEndTSEntries(ctx sdk.Context, ts uint64) (list []marketEndTSEntry, err error) {
+	store := k.getMarketEndTSStore(ctx)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(utils.Uint64ToBytes(ts)))
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, marketEndTSEntry{
+			ts:        utils.Uint64FromBytes(iterator.Key()[:8]),
+			marketUID: string(iterator.Value()),
+		})
+	}
+
+	return
+}
+
+// ProcessEndedMarkets inactivates the active markets that their end timestamp is passed
+// and aborts the unresolved markets that the abort grace period after their end timestamp
+// is passed, the aborted markets are settled and refunded as the resolved markets.
+// The inactivated markets are moved in the index to their abort timestamp, so each
+// market is processed only when its next transition is due.
+func (k Keeper) ProcessEndedMarkets(ctx sdk.Context) error {
+	blockTime := cast.ToUint64(ctx.BlockTime().Unix())
+	params := k.GetParams(ctx)
+
+	entries, err := k.getDueMarketEndTSEntries(ctx, blockTime)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		// the entry is removed explicitly, it may be stale if the grace period is changed
+		k.removeMarketEndTS(ctx, entry.ts, entry.marketUID)
+
+		market, found := k.GetMarket(ctx, entry.marketUID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrMarketNotFound, "%s", entry.marketUID)
+		}
+
+		switch {
+		case !market.IsResolveAllowed():
+			// the market is resolved or waiting for the dispute period
+		case params.IsAbortDue(market.EndTS, blockTime):
+			k.Resolve(ctx, market, &types.MarketResolutionTicketPayload{
+				UID:          market.UID,
+				ResolutionTS: blockTime,
+				Status:       types.MarketStatus_MARKET_STATUS_ABORTED,
+			})
+		case market.Status == types.MarketStatus_MARKET_STATUS_ACTIVE:
+			market.Status = types.MarketStatus_MARKET_STATUS_INACTIVE
+			k.SetMarket(ctx, market)
+		default:
+			// the inactive market is indexed again at its current abort timestamp
+			if ts, ok := marketLifecycleTS(market, params); ok {
+				k.setMarketEndTS(ctx, ts, market.UID)
+			}
+		}
+	}
+
+	return nil
+}
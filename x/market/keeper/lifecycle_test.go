@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	"github.com/sge-network/sge/x/market/types"
+)
+
+func TestProcessEndedMarkets(t *testing.T) {
+	_, k, ctx := setupKeeperAndApp(t)
+	k.SetParams(ctx, types.NewParams([]string{params.DefaultBondDenom}, 100, 0, 0, types.DefaultParams().DisputeBond))
+	now := cast.ToUint64(ctx.BlockTime().Unix())
+
+	addMarket := func(endTS uint64, status types.MarketStatus) types.Market {
+		market := types.Market{
+			UID:     uuid.NewString(),
+			StartTS: endTS - 1000,
+			EndTS:   endTS,
+			Status:  status,
+		}
+		k.SetMarket(ctx, market)
+		return market
+	}
+
+	ended := addMarket(now-10, types.MarketStatus_MARKET_STATUS_ACTIVE)
+	unresolved := addMarket(now-200, types.MarketStatus_MARKET_STATUS_INACTIVE)
+	running := addMarket(now+100, types.MarketStatus_MARKET_STATUS_ACTIVE)
+	declared := addMarket(now-300, types.MarketStatus_MARKET_STATUS_RESULT_DECLARED)
+
+	endedUIDs, err := k.GetEndedMarketUIDs(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, []string{unresolved.UID, ended.UID}, endedUIDs)
+
+	require.NoError(t, k.ProcessEndedMarkets(ctx))
+
+	market, found := k.GetMarket(ctx, ended.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_INACTIVE, market.Status)
+
+	market, found = k.GetMarket(ctx, unresolved.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_ABORTED, market.Status)
+	require.Equal(t, now, market.ResolutionTS)
+	require.Equal(t, []string{unresolved.UID}, k.GetMarketStats(ctx).ResolvedUnsettled)
+
+	market, found = k.GetMarket(ctx, running.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_ACTIVE, market.Status)
+
+	market, found = k.GetMarket(ctx, declared.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_RESULT_DECLARED, market.Status)
+
+	// the inactive market is moved in the index to be aborted after the grace period
+	endedUIDs, err = k.GetEndedMarketUIDs(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, endedUIDs)
+	endedUIDs, err = k.GetEndedMarketUIDs(ctx, now+90)
+	require.NoError(t, err)
+	require.Equal(t, []string{ended.UID}, endedUIDs)
+
+	// extending the end timestamp moves the market in the index
+	market, found = k.GetMarket(ctx, ended.UID)
+	require.True(t, found)
+	market.EndTS = now + 50
+	k.SetMarket(ctx, market)
+	endedUIDs, err = k.GetEndedMarketUIDs(ctx, now+149)
+	require.NoError(t, err)
+	require.Equal(t, []string{running.UID}, endedUIDs)
+	endedUIDs, err = k.GetEndedMarketUIDs(ctx, now+150)
+	require.NoError(t, err)
+	require.Equal(t, []string{running.UID, ended.UID}, endedUIDs)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(150 * time.Second))
+	require.NoError(t, k.ProcessEndedMarkets(ctx))
+
+	market, found = k.GetMarket(ctx, ended.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_ABORTED, market.Status)
+
+	market, found = k.GetMarket(ctx, running.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_INACTIVE, market.Status)
+}
+
+func TestProcessEndedMarketsWithoutAbort(t *testing.T) {
+	_, k, ctx := setupKeeperAndApp(t)
+	k.SetParams(ctx, types.NewParams([]string{params.DefaultBondDenom}, 0, 0, 0, types.DefaultParams().DisputeBond))
+	now := cast.ToUint64(ctx.BlockTime().Unix())
+
+	market := types.Market{
+		UID:     uuid.NewString(),
+		StartTS: now - 2000,
+		EndTS:   now - 1000,
+		Status:  types.MarketStatus_MARKET_STATUS_ACTIVE,
+	}
+	k.SetMarket(ctx, market)
+
+	require.NoError(t, k.ProcessEndedMarkets(ctx))
+
+	// the zero grace period disables the automatic abort
+	market, found := k.GetMarket(ctx, market.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_INACTIVE, market.Status)
+	require.Empty(t, k.GetMarketStats(ctx).ResolvedUnsettled)
+
+	// the inactive market is not processed in the next blocks
+	endedUIDs, err := k.GetEndedMarketUIDs(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, endedUIDs)
+}
+
+func TestProcessEndedMarketsStaleEntry(t *testing.T) {
+	_, k, ctx := setupKeeperAndApp(t)
+	k.SetParams(ctx, types.NewParams([]string{params.DefaultBondDenom}, 100, 0, 0, types.DefaultParams().DisputeBond))
+	now := cast.ToUint64(ctx.BlockTime().Unix())
+
+	market := types.Market{
+		UID:     uuid.NewString(),
+		StartTS: now - 2000,
+		EndTS:   now - 150,
+		Status:  types.MarketStatus_MARKET_STATUS_INACTIVE,
+	}
+	k.SetMarket(ctx, market)
+
+	// the extended grace period leaves the entry at the former abort timestamp
+	k.SetParams(ctx, types.NewParams([]string{params.DefaultBondDenom}, 200, 0, 0, types.DefaultParams().DisputeBond))
+	require.NoError(t, k.ProcessEndedMarkets(ctx))
+
+	market, found := k.GetMarket(ctx, market.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_INACTIVE, market.Status)
+
+	endedUIDs, err := k.GetEndedMarketUIDs(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, endedUIDs)
+	endedUIDs, err = k.GetEndedMarketUIDs(ctx, now+50)
+	require.NoError(t, err)
+	require.Equal(t, []string{market.UID}, endedUIDs)
+}
@@ -6,11 +6,30 @@ import (
 	"github.com/sge-network/sge/x/market/types"
 )
 
-// SetMarket sets a specific market in the store
+// SetMarket sets a specific market in the store, the market is added
+// to the market list of its fixture and the end timestamp index as well.
 func (k Keeper) SetMarket(ctx sdk.Context, market types.Market) {
+	params := k.GetParams(ctx)
+
+	// the end timestamp or the status of the stored market may be changed by the update
+	if stored, found := k.GetMarket(ctx, market.UID); found {
+		if ts, ok := marketLifecycleTS(stored, params); ok {
+			k.removeMarketEndTS(ctx, ts, stored.UID)
+		}
+	}
+
 	store := k.getMarketsStore(ctx)
 	b := k.cdc.MustMarshal(&market)
 	store.Set(utils.StrBytes(market.UID), b)
+
+	// only the unresolved markets are waiting for the lifecycle transitions
+	if ts, ok := marketLifecycleTS(market, params); ok {
+		k.setMarketEndTS(ctx, ts, market.UID)
+	}
+
+	if market.FixtureUID != "" {
+		k.setFixtureMarket(ctx, market.FixtureUID, market.UID)
+	}
 }
 
 // GetMarket returns a specific market by its UID
@@ -59,9 +78,11 @@ func (k Keeper) Resolve(
 	storedMarket.ResolutionTS = resolutionMarket.ResolutionTS
 	storedMarket.Status = resolutionMarket.Status
 
-	// if the result is declared for the market, we need to update the winner odds uids.
+	// if the result is declared for the market, we need to update the winner odds uids
+	// and the outcomes of the odds.
 	if resolutionMarket.Status == types.MarketStatus_MARKET_STATUS_RESULT_DECLARED {
 		storedMarket.WinnerOddsUIDs = resolutionMarket.WinnerOddsUIDs
+		storedMarket.OddsOutcomes = resolutionMarket.OddsOutcomes
 	}
 
 	// if the result is declared or the market is canceled or aborted, it should be added
@@ -69,6 +90,9 @@ func (k Keeper) Resolve(
 	if storedMarket.IsResolved() {
 		// append market id to the unsettled resolved in statistics.
 		k.appendUnsettledResolvedMarket(ctx, storedMarket.UID)
+
+		// the attestations that have not reached the threshold are not needed anymore.
+		k.removeResolutionAttestations(ctx, storedMarket.UID)
 	}
 
 	k.SetMarket(ctx, storedMarket)
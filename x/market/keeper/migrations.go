@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	"github.com/sge-network/sge/app/params"
+	"github.com/sge-network/sge/x/market/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, the missing params are set to
+// the default values, so the resolutions declared after the upgrade are held
+// in the default dispute period of one hour. The markets without denom accept
+// the bond denom and the unresolved markets are added to the end timestamp index,
+// the ended markets are inactivated and the markets that their abort grace period
+// is already passed are given a fresh grace period from the upgrade block time.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	moduleParams := types.DefaultParams()
+	m.keeper.paramStore.GetParamSetIfExists(ctx, &moduleParams)
+	m.keeper.SetParams(ctx, moduleParams)
+
+	markets, err := m.keeper.GetMarkets(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockTime := cast.ToUint64(ctx.BlockTime().Unix())
+	for _, market := range markets {
+		if market.Denom == "" {
+			market.Denom = params.DefaultBondDenom
+		}
+		if market.Status == types.MarketStatus_MARKET_STATUS_ACTIVE && market.EndTS <= blockTime {
+			market.Status = types.MarketStatus_MARKET_STATUS_INACTIVE
+		}
+		m.keeper.SetMarket(ctx, market)
+
+		// the markets are not aborted right after the upgrade
+		if ts, ok := marketLifecycleTS(market, moduleParams); ok && ts <= blockTime {
+			m.keeper.removeMarketEndTS(ctx, ts, market.UID)
+			m.keeper.setMarketEndTS(ctx, blockTime+moduleParams.AbortGracePeriod, market.UID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	"github.com/sge-network/sge/x/market/keeper"
+	"github.com/sge-network/sge/x/market/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+
+	originalParams := k.GetParams(ctx)
+	t.Cleanup(func() { k.SetParams(ctx, originalParams) })
+
+	items := createNMarket(k, ctx, 3)
+
+	now := cast.ToUint64(ctx.BlockTime().Unix())
+	ended := types.Market{
+		UID:    uuid.NewString(),
+		EndTS:  now - 8*24*60*60,
+		Status: types.MarketStatus_MARKET_STATUS_ACTIVE,
+	}
+	k.SetMarket(ctx, ended)
+
+	// the markets of version 1 are not indexed
+	endTSStore := prefix.NewStore(ctx.KVStore(tApp.GetKey(types.StoreKey)), types.MarketEndTSListPrefix)
+	endTSStore.Delete(types.MarketEndTSKey(ended.EndTS, ended.UID))
+
+	// the params of version 1 are empty
+	paramStore := prefix.NewStore(ctx.KVStore(tApp.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range []string{"AllowedDenoms", "AbortGracePeriod", "DisputePeriod", "ResolutionThreshold", "DisputeBond"} {
+		paramStore.Delete([]byte(key))
+	}
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate1to2(ctx))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	for _, item := range items {
+		market, found := k.GetMarket(ctx, item.UID)
+		require.True(t, found)
+		require.Equal(t, params.DefaultBondDenom, market.Denom)
+	}
+
+	// the market ended before the upgrade is inactivated and given a fresh grace period
+	market, found := k.GetMarket(ctx, ended.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_INACTIVE, market.Status)
+
+	gracePeriod := types.DefaultParams().AbortGracePeriod
+	require.NoError(t, k.ProcessEndedMarkets(ctx))
+	market, found = k.GetMarket(ctx, ended.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_INACTIVE, market.Status)
+
+	endedUIDs, err := k.GetEndedMarketUIDs(ctx, now+gracePeriod-1)
+	require.NoError(t, err)
+	require.Empty(t, endedUIDs)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(gracePeriod) * time.Second))
+	require.NoError(t, k.ProcessEndedMarkets(ctx))
+	market, found = k.GetMarket(ctx, ended.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_ABORTED, market.Status)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/sge-network/sge/x/market/types"
+)
+
+// AddFixture accepts ticket containing creation fixture and return response after processing
+func (k msgServer) AddFixture(
+	goCtx context.Context,
+	msg *types.MsgAddFixture,
+) (*types.MsgAddFixtureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var addPayload types.FixtureAddTicketPayload
+	if err := k.ovmKeeper.VerifyTicketUnmarshal(goCtx, msg.Ticket, &addPayload); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
+	}
+
+	if err := addPayload.Validate(ctx); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketPayloadValidation, "%s", err)
+	}
+
+	if _, found := k.Keeper.GetFixture(ctx, addPayload.UID); found {
+		return nil, types.ErrFixtureAlreadyExist
+	}
+
+	fixture := types.NewFixture(
+		addPayload.UID,
+		msg.Creator,
+		addPayload.Sport,
+		addPayload.Competition,
+		addPayload.Participants,
+		addPayload.ScheduledTS,
+		addPayload.Meta,
+	)
+
+	k.Keeper.SetFixture(ctx, fixture)
+
+	msg.EmitEvent(&ctx, fixture.UID)
+
+	return &types.MsgAddFixtureResponse{Data: &fixture}, nil
+}
+
+// UpdateFixture accepts ticket containing update fixture and return response after processing,
+// the postponement and cancellation of the fixture are cascaded to its markets.
+func (k msgServer) UpdateFixture(
+	goCtx context.Context,
+	msg *types.MsgUpdateFixture,
+) (*types.MsgUpdateFixtureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var updatePayload types.FixtureUpdateTicketPayload
+	if err := k.ovmKeeper.VerifyTicketUnmarshal(goCtx, msg.Ticket, &updatePayload); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
+	}
+
+	if err := updatePayload.Validate(ctx); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInTicketPayloadValidation, "%s", err)
+	}
+
+	fixture, found := k.Keeper.GetFixture(ctx, updatePayload.UID)
+	if !found {
+		return nil, types.ErrFixtureNotFound
+	}
+
+	if !fixture.IsUpdateAllowed() {
+		return nil, sdkerrors.Wrapf(types.ErrFixtureCanNotBeAltered, "%s", fixture.Status)
+	}
+
+	previous := fixture
+	fixture.Status = updatePayload.Status
+	if updatePayload.ScheduledTS != 0 {
+		fixture.ScheduledTS = updatePayload.ScheduledTS
+	}
+
+	k.Keeper.SetFixture(ctx, fixture)
+
+	marketUIDs, err := k.Keeper.cascadeFixtureStatus(ctx, fixture, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.EmitEvent(&ctx, &fixture, marketUIDs)
+
+	return &types.MsgUpdateFixtureResponse{Data: &fixture}, nil
+}
@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/sge-network/sge/testutil/sample"
+	"github.com/sge-network/sge/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServerFixture(t *testing.T) {
+	k, msgk, ctx, wctx := setupMsgServerAndKeeper(t)
+	creator := sample.AccAddress()
+
+	addFixture := func(uid string) (*types.MsgAddFixtureResponse, error) {
+		ticket, err := createJwtTicket(jwt.MapClaims{
+			"uid":          uid,
+			"sport":        "football",
+			"competition":  "premier league",
+			"participants": []string{"home", "away"},
+			"scheduled_ts": uint64(time.Now().Add(time.Hour).Unix()),
+			"exp":          9999999999,
+			"iat":          1111111111,
+		})
+		require.NoError(t, err)
+		return msgk.AddFixture(wctx, types.NewMsgAddFixture(creator, ticket))
+	}
+
+	updateFixtureAt := func(blockTime time.Time, uid string, status types.FixtureStatus, scheduledTS uint64) (*types.MsgUpdateFixtureResponse, error) {
+		ticket, err := createJwtTicket(jwt.MapClaims{
+			"uid":          uid,
+			"status":       status,
+			"scheduled_ts": scheduledTS,
+			"exp":          9999999999,
+			"iat":          1111111111,
+		})
+		require.NoError(t, err)
+		return msgk.UpdateFixture(sdk.WrapSDKContext(ctx.WithBlockTime(blockTime)), types.NewMsgUpdateFixture(creator, ticket))
+	}
+
+	updateFixture := func(uid string, status types.FixtureStatus) (*types.MsgUpdateFixtureResponse, error) {
+		return updateFixtureAt(ctx.BlockTime(), uid, status, uint64(ctx.BlockTime().Add(time.Hour).Unix()))
+	}
+
+	addMarket := func(fixtureUID string, status types.MarketStatus) (*types.MsgAddResponse, error) {
+		ticket, err := createJwtTicket(jwt.MapClaims{
+			"uid":      uuid.NewString(),
+			"start_ts": uint64(time.Now().Add(time.Minute).Unix()),
+			"end_ts":   uint64(time.Now().Add(time.Minute * 5).Unix()),
+			"odds": []types.Odds{
+				{UID: uuid.NewString(), Meta: "odds 1"},
+				{UID: uuid.NewString(), Meta: "odds 2"},
+			},
+			"exp":         9999999999,
+			"iat":         1111111111,
+			"meta":        "Winner of x:y",
+			"status":      status,
+			"fixture_uid": fixtureUID,
+		})
+		require.NoError(t, err)
+		return msgk.Add(wctx, types.NewMsgAdd(creator, ticket))
+	}
+
+	fixtureUID := uuid.NewString()
+	res, err := addFixture(fixtureUID)
+	require.NoError(t, err)
+	require.Equal(t, types.FixtureStatus_FIXTURE_STATUS_SCHEDULED, res.Data.Status)
+
+	_, err = addFixture(fixtureUID)
+	require.ErrorIs(t, err, types.ErrFixtureAlreadyExist)
+
+	_, err = addMarket(uuid.NewString(), types.MarketStatus_MARKET_STATUS_ACTIVE)
+	require.ErrorIs(t, err, types.ErrFixtureNotFound)
+
+	var marketUIDs []string
+	for _, status := range []types.MarketStatus{
+		types.MarketStatus_MARKET_STATUS_ACTIVE,
+		types.MarketStatus_MARKET_STATUS_ACTIVE,
+		types.MarketStatus_MARKET_STATUS_INACTIVE,
+	} {
+		marketRes, err := addMarket(fixtureUID, status)
+		require.NoError(t, err)
+		require.Equal(t, fixtureUID, marketRes.Data.FixtureUID)
+		marketUIDs = append(marketUIDs, marketRes.Data.UID)
+	}
+
+	// standalone market is not in the markets of the fixture
+	_, err = addMarket("", types.MarketStatus_MARKET_STATUS_ACTIVE)
+	require.NoError(t, err)
+
+	queryRes, err := k.FixtureMarkets(wctx, &types.QueryFixtureMarketsRequest{FixtureUid: fixtureUID})
+	require.NoError(t, err)
+	require.Len(t, queryRes.Markets, len(marketUIDs))
+
+	// resolved markets are not affected by the fixture updates
+	resolvedMarket, found := k.GetMarket(ctx, marketUIDs[1])
+	require.True(t, found)
+	k.Resolve(ctx, resolvedMarket, &types.MarketResolutionTicketPayload{
+		UID:            resolvedMarket.UID,
+		ResolutionTS:   uint64(time.Now().Add(time.Minute * 2).Unix()),
+		WinnerOddsUIDs: []string{resolvedMarket.Odds[0].UID},
+		Status:         types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+	})
+
+	_, err = updateFixture(uuid.NewString(), types.FixtureStatus_FIXTURE_STATUS_POSTPONED)
+	require.ErrorIs(t, err, types.ErrFixtureNotFound)
+
+	_, err = updateFixture(fixtureUID, types.FixtureStatus_FIXTURE_STATUS_POSTPONED)
+	require.NoError(t, err)
+
+	expectedStatuses := []types.MarketStatus{
+		types.MarketStatus_MARKET_STATUS_INACTIVE,
+		types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		types.MarketStatus_MARKET_STATUS_INACTIVE,
+	}
+	for i, marketUID := range marketUIDs {
+		market, found := k.GetMarket(ctx, marketUID)
+		require.True(t, found)
+		require.Equal(t, expectedStatuses[i], market.Status)
+	}
+
+	postponedMarket, found := k.GetMarket(ctx, marketUIDs[0])
+	require.True(t, found)
+	require.True(t, postponedMarket.Postponed)
+	inactiveMarket, found := k.GetMarket(ctx, marketUIDs[2])
+	require.True(t, found)
+	require.False(t, inactiveMarket.Postponed)
+
+	// the markets inactivated by the postponement are shifted and activated if the fixture
+	// is scheduled again, the market inactivated before the postponement remains inactive
+	fixture, found := k.GetFixture(ctx, fixtureUID)
+	require.True(t, found)
+	_, err = updateFixtureAt(ctx.BlockTime(), fixtureUID, types.FixtureStatus_FIXTURE_STATUS_SCHEDULED, fixture.ScheduledTS+1800)
+	require.NoError(t, err)
+
+	expectedStatuses = []types.MarketStatus{
+		types.MarketStatus_MARKET_STATUS_ACTIVE,
+		types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		types.MarketStatus_MARKET_STATUS_INACTIVE,
+	}
+	for i, marketUID := range marketUIDs {
+		market, found := k.GetMarket(ctx, marketUID)
+		require.True(t, found)
+		require.Equal(t, expectedStatuses[i], market.Status)
+		require.False(t, market.Postponed)
+	}
+
+	market, found := k.GetMarket(ctx, marketUIDs[0])
+	require.True(t, found)
+	require.Equal(t, postponedMarket.StartTS+1800, market.StartTS)
+	require.Equal(t, postponedMarket.EndTS+1800, market.EndTS)
+
+	market, found = k.GetMarket(ctx, marketUIDs[2])
+	require.True(t, found)
+	require.Equal(t, inactiveMarket.StartTS, market.StartTS)
+	require.Equal(t, inactiveMarket.EndTS, market.EndTS)
+
+	// the markets that are ended after the shift remain inactive
+	_, err = updateFixture(fixtureUID, types.FixtureStatus_FIXTURE_STATUS_POSTPONED)
+	require.NoError(t, err)
+	blockTime := time.Now().Add(time.Hour * 10)
+	_, err = updateFixtureAt(blockTime, fixtureUID, types.FixtureStatus_FIXTURE_STATUS_SCHEDULED, uint64(blockTime.Add(time.Minute).Unix()))
+	require.NoError(t, err)
+
+	expectedStatuses = []types.MarketStatus{
+		types.MarketStatus_MARKET_STATUS_INACTIVE,
+		types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		types.MarketStatus_MARKET_STATUS_INACTIVE,
+	}
+	for i, marketUID := range marketUIDs {
+		market, found := k.GetMarket(ctx, marketUID)
+		require.True(t, found)
+		require.Equal(t, expectedStatuses[i], market.Status)
+	}
+
+	_, err = updateFixture(fixtureUID, types.FixtureStatus_FIXTURE_STATUS_CANCELED)
+	require.NoError(t, err)
+
+	expectedStatuses = []types.MarketStatus{
+		types.MarketStatus_MARKET_STATUS_CANCELED,
+		types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		types.MarketStatus_MARKET_STATUS_CANCELED,
+	}
+	for i, marketUID := range marketUIDs {
+		market, found := k.GetMarket(ctx, marketUID)
+		require.True(t, found)
+		require.Equal(t, expectedStatuses[i], market.Status)
+	}
+	require.ElementsMatch(t, marketUIDs, k.GetMarketStats(ctx).ResolvedUnsettled)
+
+	fixture, found = k.GetFixture(ctx, fixtureUID)
+	require.True(t, found)
+	require.Equal(t, types.FixtureStatus_FIXTURE_STATUS_CANCELED, fixture.Status)
+
+	_, err = updateFixture(fixtureUID, types.FixtureStatus_FIXTURE_STATUS_POSTPONED)
+	require.ErrorIs(t, err, types.ErrFixtureCanNotBeAltered)
+
+	_, err = addMarket(fixtureUID, types.MarketStatus_MARKET_STATUS_ACTIVE)
+	require.ErrorIs(t, err, types.ErrFixtureIsCanceled)
+}
+
+func TestFixtureQuery(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	fixture := types.NewFixture(uuid.NewString(), sample.AccAddress(), "football", "premier league",
+		[]string{"home", "away"}, uint64(time.Now().Add(time.Hour).Unix()), "")
+	k.SetFixture(ctx, fixture)
+
+	res, err := k.Fixture(wctx, &types.QueryFixtureRequest{Uid: fixture.UID})
+	require.NoError(t, err)
+	require.Equal(t, fixture, res.Fixture)
+
+	_, err = k.Fixture(wctx, &types.QueryFixtureRequest{Uid: uuid.NewString()})
+	require.Error(t, err)
+
+	listRes, err := k.Fixtures(wctx, &types.QueryFixturesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Fixture{fixture}, listRes.Fixtures)
+
+	_, err = k.FixtureMarkets(wctx, &types.QueryFixtureMarketsRequest{FixtureUid: uuid.NewString()})
+	require.Error(t, err)
+}
@@ -5,6 +5,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+
+	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/x/market/types"
 )
 
@@ -29,11 +32,32 @@ func (k msgServer) Add(
 		return nil, types.ErrMarketAlreadyExist
 	}
 
+	if addPayload.FixtureUID != "" {
+		fixture, found := k.Keeper.GetFixture(ctx, addPayload.FixtureUID)
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrFixtureNotFound, "%s", addPayload.FixtureUID)
+		}
+		if fixture.Status == types.FixtureStatus_FIXTURE_STATUS_CANCELED {
+			return nil, sdkerrors.Wrapf(types.ErrFixtureIsCanceled, "%s", fixture.UID)
+		}
+	}
+
+	denom := addPayload.Denom
+	if denom == "" {
+		denom = params.DefaultBondDenom
+	}
+	if !k.Keeper.GetParams(ctx).IsDenomAllowed(denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotAllowed, "%s", denom)
+	}
+
 	var oddsUIDs []string
 	for _, odds := range addPayload.Odds {
+		if odds.Status == types.OddsStatus_ODDS_STATUS_UNSPECIFIED {
+			odds.Status = types.OddsStatus_ODDS_STATUS_ACTIVE
+		}
 		oddsUIDs = append(oddsUIDs, odds.UID)
 	}
-	err := k.orderbookKeeper.InitiateOrderBook(ctx, addPayload.UID, oddsUIDs)
+	err := k.orderbookKeeper.InitiateOrderBook(ctx, addPayload.UID, denom, oddsUIDs)
 	if err != nil {
 		return nil, sdkerrors.Wrapf(types.ErrInOrderBookInitiation, "%s", err)
 	}
@@ -47,6 +71,9 @@ func (k msgServer) Add(
 		addPayload.Meta,
 		addPayload.UID,
 		addPayload.Status,
+		denom,
+		addPayload.BettorCaps,
+		addPayload.FixtureUID,
 	)
 
 	k.Keeper.SetMarket(ctx, market)
@@ -91,6 +118,53 @@ func (k msgServer) Update(
 	market.StartTS = updatePayload.StartTS
 	market.EndTS = updatePayload.EndTS
 	market.Status = updatePayload.Status
+	// the explicit update of the oracle overrides the postponement of the fixture
+	market.Postponed = false
+	if updatePayload.BettorCaps != nil {
+		market.BettorCaps = updatePayload.BettorCaps
+	}
+	for _, oddsCaps := range updatePayload.OddsBettorCaps {
+		if err := market.SetOddsBettorCaps(oddsCaps.OddsUID, oddsCaps.BettorCaps); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInTicketPayloadValidation, "%s", err)
+		}
+	}
+	for _, oddsUID := range updatePayload.MovedOddsUIDs {
+		odds, found := market.OddsByUID(oddsUID)
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrInTicketPayloadValidation, "odds %s does not exist in the market", oddsUID)
+		}
+		odds.MovedTS = cast.ToUint64(ctx.BlockTime().Unix())
+	}
+	for _, oddsUID := range updatePayload.SuspendedOddsUIDs {
+		if err := market.SetOddsStatus(oddsUID, types.OddsStatus_ODDS_STATUS_SUSPENDED); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInTicketPayloadValidation, "%s", err)
+		}
+	}
+	for _, oddsUID := range updatePayload.ReactivatedOddsUIDs {
+		if err := market.SetOddsStatus(oddsUID, types.OddsStatus_ODDS_STATUS_ACTIVE); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInTicketPayloadValidation, "%s", err)
+		}
+	}
+	for _, oddsUID := range updatePayload.RemovedOddsUIDs {
+		if err := market.SetOddsStatus(oddsUID, types.OddsStatus_ODDS_STATUS_REMOVED); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInTicketPayloadValidation, "%s", err)
+		}
+	}
+
+	// the new odds are added to the order book of the market to be fulfilled
+	// by the existing participations as well.
+	if len(updatePayload.NewOdds) > 0 {
+		var newOddsUIDs []string
+		for _, odds := range updatePayload.NewOdds {
+			if err := market.AddOdds(odds); err != nil {
+				return nil, sdkerrors.Wrapf(types.ErrInTicketPayloadValidation, "%s", err)
+			}
+			newOddsUIDs = append(newOddsUIDs, odds.UID)
+		}
+		if err := k.orderbookKeeper.AddOrderBookOdds(ctx, market.BookUID, newOddsUIDs); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInOrderBookOddsAddition, "%s", err)
+		}
+	}
 
 	// update market is successful, update the module state
 	k.Keeper.SetMarket(ctx, market)
@@ -15,8 +15,20 @@ func (k msgServer) Resolve(
 ) (*types.MsgResolveResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	var resolutionPayload types.MarketResolutionTicketPayload
-	err := k.ovmKeeper.VerifyTicketUnmarshal(goCtx, msg.Ticket, &resolutionPayload)
+	params := k.Keeper.GetParams(ctx)
+
+	// the ticket of the threshold resolution can be signed by any of the registered
+	// public keys, otherwise it should be signed by the leader public key.
+	var (
+		resolutionPayload types.MarketResolutionTicketPayload
+		signer            string
+		err               error
+	)
+	if params.IsThresholdResolution() {
+		signer, err = k.ovmKeeper.VerifyTicketUnmarshalBySigner(goCtx, msg.Ticket, &resolutionPayload)
+	} else {
+		err = k.ovmKeeper.VerifyTicketUnmarshal(goCtx, msg.Ticket, &resolutionPayload)
+	}
 	if err != nil {
 		return nil, sdkerrors.Wrapf(types.ErrInTicketVerification, "%s", err)
 	}
@@ -30,7 +42,9 @@ func (k msgServer) Resolve(
 		return nil, sdkerrors.Wrapf(types.ErrMarketNotFound, "%s", market.UID)
 	}
 
-	if !market.IsResolveAllowed() {
+	// the resolution of the market in the dispute period is corrected
+	// by the new resolution ticket.
+	if !market.IsResolveAllowed() && !market.IsResultPending() {
 		return nil, sdkerrors.Wrapf(types.ErrMarketResolutionNotAllowed, "%s", market.Status)
 	}
 
@@ -38,7 +52,18 @@ func (k msgServer) Resolve(
 		return nil, sdkerrors.Wrapf(types.ErrInvalidWinnerOdds, "%s", err)
 	}
 
-	resolvedMarket := k.Keeper.Resolve(ctx, market, &resolutionPayload)
+	var resolvedMarket *types.Market
+	if params.IsThresholdResolution() {
+		resolvedMarket, err = k.Keeper.AttestResolution(ctx, market, &resolutionPayload, signer, msg.Creator)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInResolutionAttestation, "%s", err)
+		}
+	} else {
+		resolvedMarket, err = k.Keeper.DeclareResolution(ctx, market, &resolutionPayload)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	msg.EmitEvent(&ctx, market.UID)
 
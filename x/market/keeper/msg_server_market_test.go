@@ -6,6 +6,7 @@ import (
 
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
+	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/testutil/sample"
 	"github.com/sge-network/sge/x/market/types"
 	"github.com/stretchr/testify/assert"
@@ -99,6 +100,60 @@ func TestMsgServerAddMarketResponse(t *testing.T) {
 		assert.ErrorIs(t, err, types.ErrMarketAlreadyExist)
 		assert.Nil(t, response)
 	})
+
+	t.Run("denom not allowed", func(t *testing.T) {
+		ticketClaims := jwt.MapClaims{
+			"uid":      uuid.NewString(),
+			"start_ts": uint64(time.Now().Add(time.Minute).Unix()),
+			"end_ts":   uint64(time.Now().Add(time.Minute * 5).Unix()),
+			"odds": []types.Odds{
+				{UID: uuid.NewString(), Meta: "odds 1"},
+				{UID: uuid.NewString(), Meta: "odds 2"},
+			},
+			"exp":    9999999999,
+			"iat":    1111111111,
+			"meta":   "Winner of x:y",
+			"status": types.MarketStatus_MARKET_STATUS_ACTIVE,
+			"denom":  "uatom",
+		}
+		ticket, err := createJwtTicket(ticketClaims)
+		require.NoError(t, err)
+
+		response, err := msgk.Add(
+			wctx,
+			types.NewMsgAdd(sample.AccAddress(), ticket),
+		)
+		assert.ErrorIs(t, err, types.ErrDenomNotAllowed)
+		assert.Nil(t, response)
+	})
+
+	t.Run("allowed custom denom", func(t *testing.T) {
+		k.SetParams(ctx, types.NewParams([]string{params.DefaultBondDenom, "uatom"}, 0, 0, 0, types.DefaultParams().DisputeBond))
+
+		ticketClaims := jwt.MapClaims{
+			"uid":      uuid.NewString(),
+			"start_ts": uint64(time.Now().Add(time.Minute).Unix()),
+			"end_ts":   uint64(time.Now().Add(time.Minute * 5).Unix()),
+			"odds": []types.Odds{
+				{UID: uuid.NewString(), Meta: "odds 1"},
+				{UID: uuid.NewString(), Meta: "odds 2"},
+			},
+			"exp":    9999999999,
+			"iat":    1111111111,
+			"meta":   "Winner of x:y",
+			"status": types.MarketStatus_MARKET_STATUS_ACTIVE,
+			"denom":  "uatom",
+		}
+		ticket, err := createJwtTicket(ticketClaims)
+		require.NoError(t, err)
+
+		response, err := msgk.Add(
+			wctx,
+			types.NewMsgAdd(sample.AccAddress(), ticket),
+		)
+		require.NoError(t, err)
+		require.Equal(t, "uatom", response.Data.Denom)
+	})
 }
 
 func TestMsgServerUpdate(t *testing.T) {
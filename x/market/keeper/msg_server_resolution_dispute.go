@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mrz1836/go-sanitize"
+	"github.com/spf13/cast"
+
+	"github.com/sge-network/sge/x/market/types"
+)
+
+// DisputeResolution accepts the dispute of a bonded validator to the pending resolution
+// of a market and holds the dispute bond until the oracle confirms the result.
+func (k msgServer) DisputeResolution(
+	goCtx context.Context,
+	msg *types.MsgDisputeResolution,
+) (*types.MsgDisputeResolutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s", err)
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(creator))
+	if !found || !validator.IsBonded() {
+		return nil, sdkerrors.Wrapf(types.ErrDisputerNotBonded, "%s", msg.Creator)
+	}
+
+	market, found := k.Keeper.GetMarket(ctx, msg.MarketUID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrMarketNotFound, "%s", msg.MarketUID)
+	}
+
+	pendingResolution, found := k.Keeper.GetPendingResolution(ctx, msg.MarketUID)
+	if !found || !market.IsResultPending() {
+		return nil, sdkerrors.Wrapf(types.ErrPendingResolutionNotFound, "%s", msg.MarketUID)
+	}
+
+	resolutionPayload := msg.ResolutionPayload(pendingResolution.ResolutionTS)
+	if err := resolutionPayload.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDispute, "%s", err)
+	}
+
+	if err := resolutionPayload.ValidateWinnerOdds(&market); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidWinnerOdds, "%s", err)
+	}
+
+	dispute := types.Dispute{
+		Creator:        msg.Creator,
+		WinnerOddsUIDs: msg.WinnerOddsUIDs,
+		OddsOutcomes:   msg.OddsOutcomes,
+		Reason:         sanitize.XSS(msg.Reason),
+		CreatedTS:      cast.ToUint64(ctx.BlockTime().Unix()),
+		Bond:           sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), k.Keeper.GetParams(ctx).DisputeBond),
+	}
+
+	if dispute.IsSameResult(pendingResolution.Payload()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidDispute, "the proposed result is the pending resolution")
+	}
+
+	pendingResolution, err = k.Keeper.DisputeResolution(ctx, pendingResolution, dispute)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.EmitEvent(&ctx, pendingResolution.DisputeEndTS, dispute.Bond)
+
+	return &types.MsgDisputeResolutionResponse{Data: &pendingResolution}, nil
+}
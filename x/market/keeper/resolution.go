@@ -0,0 +1,343 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+
+	"github.com/sge-network/sge/utils"
+	"github.com/sge-network/sge/x/market/types"
+	ovmtypes "github.com/sge-network/sge/x/ovm/types"
+)
+
+// SetPendingResolution sets a specific pending resolution in the store, the pending
+// resolution is added to the dispute end timestamp index as well.
+func (k Keeper) SetPendingResolution(ctx sdk.Context, pendingResolution types.PendingResolution) {
+	// the dispute end timestamp of the stored pending resolution may be changed by the update
+	if stored, found := k.GetPendingResolution(ctx, pendingResolution.MarketUID); found {
+		k.removePendingResolutionDisputeEndTS(ctx, stored.DisputeEndTS, stored.MarketUID)
+	}
+
+	store := k.getPendingResolutionsStore(ctx)
+	b := k.cdc.MustMarshal(&pendingResolution)
+	store.Set(utils.StrBytes(pendingResolution.MarketUID), b)
+
+	k.getPendingResolutionDisputeEndTSStore(ctx).Set(
+		types.PendingResolutionDisputeEndTSKey(pendingResolution.DisputeEndTS, pendingResolution.MarketUID),
+		utils.StrBytes(pendingResolution.MarketUID),
+	)
+}
+
+// GetPendingResolution returns the pending resolution of a market by its UID
+func (k Keeper) GetPendingResolution(ctx sdk.Context, marketUID string) (val types.PendingResolution, found bool) {
+	store := k.getPendingResolutionsStore(ctx)
+	b := store.Get(utils.StrBytes(marketUID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+
+	return val, true
+}
+
+// removePendingResolution removes the pending resolution of a market from the store
+// and the dispute end timestamp index.
+func (k Keeper) removePendingResolution(ctx sdk.Context, marketUID string) {
+	if stored, found := k.GetPendingResolution(ctx, marketUID); found {
+		k.removePendingResolutionDisputeEndTS(ctx, stored.DisputeEndTS, marketUID)
+	}
+
+	store := k.getPendingResolutionsStore(ctx)
+	store.Delete(utils.StrBytes(marketUID))
+}
+
+// removePendingResolutionDisputeEndTS removes the pending resolution from the dispute
+// end timestamp index.
+func (k Keeper) removePendingResolutionDisputeEndTS(ctx sdk.Context, disputeEndTS uint64, marketUID string) {
+	store := k.getPendingResolutionDisputeEndTSStore(ctx)
+	store.Delete(types.PendingResolutionDisputeEndTSKey(disputeEndTS, marketUID))
+}
+
+// getDuePendingResolutionMarketUIDs returns the uid list of the markets that the dispute
+// period of their pending resolution is ended at the timestamp, ordered by the dispute end.
+func (k Keeper) getDuePendingResolutionMarketUIDs(ctx sdk.Context, ts uint64) (list []string, err error) {
+	store := k.getPendingResolutionDisputeEndTSStore(ctx)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(utils.Uint64ToBytes(ts)))
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
+
+// GetPendingResolutions returns all pending resolutions
+func (k Keeper) GetPendingResolutions(ctx sdk.Context) (list []types.PendingResolution, err error) {
+	store := k.getPendingResolutionsStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingResolution
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// DeclareResolution holds the resolution of the market in the dispute period,
+// the corrective resolution of a market that is already in the dispute period
+// replaces the pending resolution and restarts the dispute period. The market is
+// resolved immediately if the dispute period is zero or the pending resolution is
+// disputed, the declaration of the disputed market is the confirmation of the oracle
+// that settles the dispute.
+func (k Keeper) DeclareResolution(
+	ctx sdk.Context,
+	storedMarket types.Market,
+	resolutionMarket *types.MarketResolutionTicketPayload,
+) (*types.Market, error) {
+	// the attestations are collected again for the corrective resolution
+	k.removeResolutionAttestations(ctx, storedMarket.UID)
+
+	previous, found := k.GetPendingResolution(ctx, storedMarket.UID)
+	if found && previous.IsDisputed() {
+		if err := k.settleDispute(ctx, previous.Disputes[0], resolutionMarket); err != nil {
+			return nil, err
+		}
+	}
+
+	params := k.GetParams(ctx)
+	if params.DisputePeriod == 0 || previous.IsDisputed() {
+		k.removePendingResolution(ctx, storedMarket.UID)
+		return k.Resolve(ctx, storedMarket, resolutionMarket), nil
+	}
+
+	blockTime := cast.ToUint64(ctx.BlockTime().Unix())
+	k.SetPendingResolution(ctx, types.NewPendingResolution(resolutionMarket, params.DisputeEndTS(blockTime)))
+
+	storedMarket.Status = types.MarketStatus_MARKET_STATUS_RESULT_PENDING
+	k.SetMarket(ctx, storedMarket)
+
+	return &storedMarket, nil
+}
+
+// DisputeResolution holds the bond of the dispute and records the dispute in the pending
+// resolution, the proposed result replaces the pending resolution only if the oracle
+// confirms it. A pending resolution can be disputed once and the dispute period is
+// extended to give the oracle a full dispute period to confirm the result.
+func (k Keeper) DisputeResolution(
+	ctx sdk.Context,
+	pendingResolution types.PendingResolution,
+	dispute types.Dispute,
+) (types.PendingResolution, error) {
+	if pendingResolution.IsDisputed() {
+		return pendingResolution, sdkerrors.Wrapf(types.ErrResolutionAlreadyDisputed, "%s", pendingResolution.MarketUID)
+	}
+
+	creatorAddress, err := sdk.AccAddressFromBech32(dispute.Creator)
+	if err != nil {
+		return pendingResolution, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		creatorAddress,
+		types.MarketDisputeBondFunder{}.GetModuleAcc(),
+		sdk.NewCoins(dispute.Bond),
+	); err != nil {
+		return pendingResolution, sdkerrors.Wrapf(types.ErrInDisputeBond, "%s", err)
+	}
+
+	blockTime := cast.ToUint64(ctx.BlockTime().Unix())
+	if disputeEndTS := k.GetParams(ctx).DisputeEndTS(blockTime); disputeEndTS > pendingResolution.DisputeEndTS {
+		pendingResolution.DisputeEndTS = disputeEndTS
+	}
+	pendingResolution.Disputes = append(pendingResolution.Disputes, dispute)
+	k.SetPendingResolution(ctx, pendingResolution)
+
+	return pendingResolution, nil
+}
+
+// settleDispute refunds the bond of the dispute if the result confirmed by the oracle is
+// the proposed result of the dispute, otherwise the dispute is rejected and the bond is burned.
+func (k Keeper) settleDispute(
+	ctx sdk.Context,
+	dispute types.Dispute,
+	confirmedResolution *types.MarketResolutionTicketPayload,
+) error {
+	bondPool := types.MarketDisputeBondFunder{}.GetModuleAcc()
+	bond := sdk.NewCoins(dispute.Bond)
+
+	if !dispute.IsSameResult(confirmedResolution) {
+		if err := k.bankKeeper.BurnCoins(ctx, bondPool, bond); err != nil {
+			return sdkerrors.Wrapf(types.ErrInDisputeBond, "%s", err)
+		}
+		return nil
+	}
+
+	creatorAddress, err := sdk.AccAddressFromBech32(dispute.Creator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, bondPool, creatorAddress, bond); err != nil {
+		return sdkerrors.Wrapf(types.ErrInDisputeBond, "%s", err)
+	}
+
+	return nil
+}
+
+// ProcessPendingResolutions resolves the markets that the dispute period of their
+// pending resolution is passed, the resolved markets are queued for the settlement.
+// The dispute that is not confirmed by the oracle in the dispute period is rejected.
+func (k Keeper) ProcessPendingResolutions(ctx sdk.Context) error {
+	blockTime := cast.ToUint64(ctx.BlockTime().Unix())
+
+	marketUIDs, err := k.getDuePendingResolutionMarketUIDs(ctx, blockTime)
+	if err != nil {
+		return err
+	}
+
+	for _, marketUID := range marketUIDs {
+		pendingResolution, found := k.GetPendingResolution(ctx, marketUID)
+		if !found {
+			continue
+		}
+
+		market, found := k.GetMarket(ctx, marketUID)
+		if !found {
+			// the pending resolution is kept for the inspection but is not processed again
+			k.Logger(ctx).Error("market of the pending resolution not found", "market_uid", marketUID)
+			k.removePendingResolutionDisputeEndTS(ctx, pendingResolution.DisputeEndTS, marketUID)
+			continue
+		}
+
+		for _, dispute := range pendingResolution.Disputes {
+			if err := k.settleDispute(ctx, dispute, pendingResolution.Payload()); err != nil {
+				return err
+			}
+		}
+
+		k.Resolve(ctx, market, pendingResolution.Payload())
+		k.removePendingResolution(ctx, marketUID)
+	}
+
+	return nil
+}
+
+// SetResolutionAttestation sets the resolution attestation of a signer in the store
+func (k Keeper) SetResolutionAttestation(ctx sdk.Context, attestation types.ResolutionAttestation) {
+	store := k.getResolutionAttestationsStore(ctx)
+	b := k.cdc.MustMarshal(&attestation)
+	store.Set(types.ResolutionAttestationKey(attestation.MarketUID, attestation.Signer), b)
+}
+
+// GetResolutionAttestations returns the resolution attestations of a market
+func (k Keeper) GetResolutionAttestations(ctx sdk.Context, marketUID string) (list []types.ResolutionAttestation, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ResolutionAttestationListOfMarketPrefix(marketUID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ResolutionAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllResolutionAttestations returns the resolution attestations of all markets
+func (k Keeper) GetAllResolutionAttestations(ctx sdk.Context) (list []types.ResolutionAttestation, err error) {
+	store := k.getResolutionAttestationsStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func() {
+		err = iterator.Close()
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ResolutionAttestation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// removeResolutionAttestations removes the resolution attestations of a market from the store
+func (k Keeper) removeResolutionAttestations(ctx sdk.Context, marketUID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ResolutionAttestationListOfMarketPrefix(marketUID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// AttestResolution records the resolution of the market attested by the signer public key,
+// the previous attestation of the signer is replaced. The resolution is declared when the
+// count of the attestations with the same result reaches the resolution threshold and
+// the attestations of the market are removed by the declaration.
+func (k Keeper) AttestResolution(
+	ctx sdk.Context,
+	storedMarket types.Market,
+	resolutionMarket *types.MarketResolutionTicketPayload,
+	signer, creator string,
+) (*types.Market, error) {
+	attestation := types.NewResolutionAttestation(
+		resolutionMarket,
+		signer,
+		creator,
+		cast.ToUint64(ctx.BlockTime().Unix()),
+	)
+	k.SetResolutionAttestation(ctx, attestation)
+
+	attestations, err := k.GetResolutionAttestations(ctx, storedMarket.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the attestations of the public keys that are removed from the key vault
+	// after attesting are not counted.
+	keyVault, found := k.ovmKeeper.GetKeyVault(ctx)
+	if !found {
+		return nil, ovmtypes.ErrKeyVaultNotFound
+	}
+	registeredKeys := make(map[string]struct{}, len(keyVault.PublicKeys))
+	for _, pubKey := range keyVault.PublicKeys {
+		registeredKeys[pubKey] = struct{}{}
+	}
+
+	sameResultCount := uint32(0)
+	for _, a := range attestations {
+		if _, ok := registeredKeys[a.Signer]; ok && a.IsSameResult(&attestation) {
+			sameResultCount++
+		}
+	}
+
+	if sameResultCount < k.GetParams(ctx).ResolutionThreshold {
+		return &storedMarket, nil
+	}
+
+	return k.DeclareResolution(ctx, storedMarket, resolutionMarket)
+}
@@ -0,0 +1,414 @@
+package keeper_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	"github.com/sge-network/sge/utils"
+	"github.com/sge-network/sge/x/market/types"
+)
+
+func TestPendingResolution(t *testing.T) {
+	tApp, k, msgk, ctx, wctx := setupMsgServerAndApp(t)
+	k.SetParams(ctx, types.NewParams([]string{params.DefaultBondDenom}, 0, 100, 0, sdkmath.NewInt(1000)))
+	now := cast.ToUint64(ctx.BlockTime().Unix())
+
+	oddsUID1, oddsUID2 := uuid.NewString(), uuid.NewString()
+	market := types.Market{
+		UID:     uuid.NewString(),
+		StartTS: now - 1000,
+		EndTS:   now - 10,
+		Odds:    []*types.Odds{{UID: oddsUID1}, {UID: oddsUID2}},
+		Status:  types.MarketStatus_MARKET_STATUS_INACTIVE,
+	}
+	k.SetMarket(ctx, market)
+
+	resolve := func(winnerOddsUID string) error {
+		ticket, err := createJwtTicket(jwt.MapClaims{
+			"uid":              market.UID,
+			"status":           types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+			"resolution_ts":    now,
+			"winner_odds_uids": []string{winnerOddsUID},
+			"exp":              9999999999,
+			"iat":              1111111111,
+		})
+		require.NoError(t, err)
+
+		_, err = msgk.Resolve(wctx, types.NewMsgResolve(simappUtil.TestParamUsers["user3"].Address.String(), ticket))
+		return err
+	}
+
+	// the declared result is held in the dispute period
+	require.NoError(t, resolve(oddsUID1))
+	stored, found := k.GetMarket(ctx, market.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_RESULT_PENDING, stored.Status)
+	require.Empty(t, stored.WinnerOddsUIDs)
+	require.Empty(t, k.GetMarketStats(ctx).ResolvedUnsettled)
+
+	pendingResolution, found := k.GetPendingResolution(ctx, market.UID)
+	require.True(t, found)
+	require.Equal(t, []string{oddsUID1}, pendingResolution.WinnerOddsUIDs)
+	require.Equal(t, now+100, pendingResolution.DisputeEndTS)
+
+	// the market in the dispute period is not aborted or inactivated
+	endedUIDs, err := k.GetEndedMarketUIDs(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, endedUIDs)
+
+	// the corrective ticket replaces the result and restarts the dispute period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(50 * time.Second))
+	wctx = sdk.WrapSDKContext(ctx)
+	require.NoError(t, resolve(oddsUID2))
+	pendingResolution, found = k.GetPendingResolution(ctx, market.UID)
+	require.True(t, found)
+	require.Equal(t, []string{oddsUID2}, pendingResolution.WinnerOddsUIDs)
+	require.Equal(t, now+150, pendingResolution.DisputeEndTS)
+
+	// only the bonded validators can dispute the result
+	_, err = msgk.DisputeResolution(wctx, types.NewMsgDisputeResolution(
+		simappUtil.TestParamUsers["user3"].Address.String(), market.UID, []string{oddsUID1}, nil, "wrong result",
+	))
+	require.ErrorIs(t, err, types.ErrDisputerNotBonded)
+
+	validator := simappUtil.TestParamUsers["user1"].Address
+	_, err = msgk.DisputeResolution(wctx, types.NewMsgDisputeResolution(
+		validator.String(), market.UID, []string{uuid.NewString()}, nil, "wrong result",
+	))
+	require.ErrorIs(t, err, types.ErrInvalidWinnerOdds)
+
+	_, err = msgk.DisputeResolution(wctx, types.NewMsgDisputeResolution(
+		validator.String(), uuid.NewString(), []string{oddsUID1}, nil, "wrong result",
+	))
+	require.ErrorIs(t, err, types.ErrMarketNotFound)
+
+	_, err = msgk.DisputeResolution(wctx, types.NewMsgDisputeResolution(
+		validator.String(), market.UID, []string{oddsUID2}, nil, "wrong result",
+	))
+	require.ErrorIs(t, err, types.ErrInvalidDispute)
+
+	// the dispute holds the bond and does not replace the pending result
+	validatorBalance := tApp.BankKeeper.GetBalance(ctx, validator, params.DefaultBondDenom).Amount
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(50 * time.Second))
+	wctx = sdk.WrapSDKContext(ctx)
+	res, err := msgk.DisputeResolution(wctx, types.NewMsgDisputeResolution(
+		validator.String(), market.UID, []string{oddsUID1}, nil, "wrong result",
+	))
+	require.NoError(t, err)
+	require.Equal(t, []string{oddsUID2}, res.Data.WinnerOddsUIDs)
+	require.Equal(t, now+200, res.Data.DisputeEndTS)
+	require.Len(t, res.Data.Disputes, 1)
+	require.Equal(t, validator.String(), res.Data.Disputes[0].Creator)
+	require.Equal(t, sdk.NewInt64Coin(params.DefaultBondDenom, 1000), res.Data.Disputes[0].Bond)
+	require.Equal(t,
+		validatorBalance.SubRaw(1000).String(),
+		tApp.BankKeeper.GetBalance(ctx, validator, params.DefaultBondDenom).Amount.String(),
+	)
+	require.Equal(t, "1000", disputeBondPoolBalance(ctx, tApp).String())
+
+	// the pending resolution can be disputed once
+	_, err = msgk.DisputeResolution(wctx, types.NewMsgDisputeResolution(
+		simappUtil.TestParamUsers["user2"].Address.String(), market.UID, []string{oddsUID1}, nil, "wrong result",
+	))
+	require.ErrorIs(t, err, types.ErrResolutionAlreadyDisputed)
+
+	// the market is resolved with the pending result after the dispute period
+	// and the dispute that is not confirmed by the oracle is rejected
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(99 * time.Second))
+	require.NoError(t, k.ProcessPendingResolutions(ctx))
+	_, found = k.GetPendingResolution(ctx, market.UID)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(1 * time.Second))
+	require.NoError(t, k.ProcessPendingResolutions(ctx))
+	_, found = k.GetPendingResolution(ctx, market.UID)
+	require.False(t, found)
+
+	stored, found = k.GetMarket(ctx, market.UID)
+	require.True(t, found)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_RESULT_DECLARED, stored.Status)
+	require.Equal(t, []string{oddsUID2}, stored.WinnerOddsUIDs)
+	require.Equal(t, now, stored.ResolutionTS)
+	require.Equal(t, []string{market.UID}, k.GetMarketStats(ctx).ResolvedUnsettled)
+
+	// the bond of the rejected dispute is burned
+	require.Equal(t,
+		validatorBalance.SubRaw(1000).String(),
+		tApp.BankKeeper.GetBalance(ctx, validator, params.DefaultBondDenom).Amount.String(),
+	)
+	require.True(t, disputeBondPoolBalance(ctx, tApp).IsZero())
+
+	// the resolved market can not be disputed or resolved again
+	_, err = msgk.DisputeResolution(sdk.WrapSDKContext(ctx), types.NewMsgDisputeResolution(
+		validator.String(), market.UID, []string{oddsUID1}, nil, "wrong result",
+	))
+	require.ErrorIs(t, err, types.ErrPendingResolutionNotFound)
+
+	wctx = sdk.WrapSDKContext(ctx)
+	require.ErrorIs(t, resolve(oddsUID2), types.ErrMarketResolutionNotAllowed)
+}
+
+func TestDisputeConfirmation(t *testing.T) {
+	for _, tc := range []struct {
+		desc            string
+		confirmedWinner int
+		bondRefunded    bool
+	}{
+		{
+			desc:            "accepted",
+			confirmedWinner: 1,
+			bondRefunded:    true,
+		},
+		{
+			desc:            "rejected",
+			confirmedWinner: 0,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tApp, k, msgk, ctx, wctx := setupMsgServerAndApp(t)
+			k.SetParams(ctx, types.NewParams([]string{params.DefaultBondDenom}, 0, 100, 0, sdkmath.NewInt(1000)))
+			now := cast.ToUint64(ctx.BlockTime().Unix())
+
+			oddsUIDs := []string{uuid.NewString(), uuid.NewString()}
+			market := types.Market{
+				UID:     uuid.NewString(),
+				StartTS: now - 1000,
+				EndTS:   now - 10,
+				Odds:    []*types.Odds{{UID: oddsUIDs[0]}, {UID: oddsUIDs[1]}},
+				Status:  types.MarketStatus_MARKET_STATUS_INACTIVE,
+			}
+			k.SetMarket(ctx, market)
+
+			resolve := func(winnerOddsUID string) {
+				ticket, err := createJwtTicket(jwt.MapClaims{
+					"uid":              market.UID,
+					"status":           types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+					"resolution_ts":    now,
+					"winner_odds_uids": []string{winnerOddsUID},
+					"exp":              9999999999,
+					"iat":              1111111111,
+				})
+				require.NoError(t, err)
+
+				_, err = msgk.Resolve(wctx, types.NewMsgResolve(simappUtil.TestParamUsers["user3"].Address.String(), ticket))
+				require.NoError(t, err)
+			}
+
+			resolve(oddsUIDs[0])
+
+			validator := simappUtil.TestParamUsers["user1"].Address
+			validatorBalance := tApp.BankKeeper.GetBalance(ctx, validator, params.DefaultBondDenom).Amount
+			_, err := msgk.DisputeResolution(wctx, types.NewMsgDisputeResolution(
+				validator.String(), market.UID, []string{oddsUIDs[1]}, nil, "wrong result",
+			))
+			require.NoError(t, err)
+
+			// the confirmation of the oracle resolves the disputed market immediately
+			resolve(oddsUIDs[tc.confirmedWinner])
+
+			_, found := k.GetPendingResolution(ctx, market.UID)
+			require.False(t, found)
+
+			stored, found := k.GetMarket(ctx, market.UID)
+			require.True(t, found)
+			require.Equal(t, types.MarketStatus_MARKET_STATUS_RESULT_DECLARED, stored.Status)
+			require.Equal(t, []string{oddsUIDs[tc.confirmedWinner]}, stored.WinnerOddsUIDs)
+			require.Equal(t, []string{market.UID}, k.GetMarketStats(ctx).ResolvedUnsettled)
+
+			expectedBalance := validatorBalance.SubRaw(1000)
+			if tc.bondRefunded {
+				expectedBalance = validatorBalance
+			}
+			require.Equal(t,
+				expectedBalance.String(),
+				tApp.BankKeeper.GetBalance(ctx, validator, params.DefaultBondDenom).Amount.String(),
+			)
+			require.True(t, disputeBondPoolBalance(ctx, tApp).IsZero())
+		})
+	}
+}
+
+func disputeBondPoolBalance(ctx sdk.Context, tApp *simappUtil.TestApp) sdkmath.Int {
+	return tApp.BankKeeper.GetBalance(
+		ctx,
+		tApp.AccountKeeper.GetModuleAddress(types.MarketDisputeBondFunder{}.GetModuleAcc()),
+		params.DefaultBondDenom,
+	).Amount
+}
+
+func TestPendingResolutionWithoutDisputePeriod(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetParams(ctx, types.NewParams([]string{params.DefaultBondDenom}, 0, 0, 0, types.DefaultParams().DisputeBond))
+
+	market := types.Market{
+		UID:    uuid.NewString(),
+		Status: types.MarketStatus_MARKET_STATUS_ACTIVE,
+	}
+	k.SetMarket(ctx, market)
+
+	// the zero dispute period resolves the market immediately
+	resolved, err := k.DeclareResolution(ctx, market, &types.MarketResolutionTicketPayload{
+		UID:          market.UID,
+		ResolutionTS: 1,
+		Status:       types.MarketStatus_MARKET_STATUS_CANCELED,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_CANCELED, resolved.Status)
+	require.Equal(t, []string{market.UID}, k.GetMarketStats(ctx).ResolvedUnsettled)
+
+	_, found := k.GetPendingResolution(ctx, market.UID)
+	require.False(t, found)
+}
+
+func TestThresholdResolution(t *testing.T) {
+	k, msgk, ctx, wctx := setupMsgServerAndKeeper(t)
+	k.SetParams(ctx, types.NewParams([]string{params.DefaultBondDenom}, 0, 0, 2, types.DefaultParams().DisputeBond))
+	now := cast.ToUint64(ctx.BlockTime().Unix())
+
+	oddsUID1, oddsUID2 := uuid.NewString(), uuid.NewString()
+	market := types.Market{
+		UID:     uuid.NewString(),
+		StartTS: now - 1000,
+		EndTS:   now - 10,
+		Odds:    []*types.Odds{{UID: oddsUID1}, {UID: oddsUID2}},
+		Status:  types.MarketStatus_MARKET_STATUS_INACTIVE,
+	}
+	k.SetMarket(ctx, market)
+
+	creator := simappUtil.TestParamUsers["user1"].Address.String()
+	resolve := func(privateKey ed25519.PrivateKey, winnerOddsUID string) (*types.MsgResolveResponse, error) {
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+			"uid":              market.UID,
+			"status":           types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+			"resolution_ts":    now,
+			"winner_odds_uids": []string{winnerOddsUID},
+			"exp":              9999999999,
+			"iat":              1111111111,
+		})
+		ticket, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+
+		return msgk.Resolve(wctx, types.NewMsgResolve(creator, ticket))
+	}
+
+	attestations := func() []types.ResolutionAttestation {
+		res, err := k.ResolutionAttestations(wctx, &types.QueryResolutionAttestationsRequest{MarketUid: market.UID})
+		require.NoError(t, err)
+		return res.ResolutionAttestations
+	}
+
+	// the unregistered keys can not attest the resolution
+	_, unregisteredKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, err = resolve(unregisteredKey, oddsUID1)
+	require.ErrorIs(t, err, types.ErrInTicketVerification)
+
+	// the single attestation does not resolve the market
+	res, err := resolve(simappUtil.TestOVMPrivateKeys[1], oddsUID1)
+	require.NoError(t, err)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_INACTIVE, res.Data.Status)
+	require.Len(t, attestations(), 1)
+
+	// the same key can not reach the threshold by attesting again
+	_, err = resolve(simappUtil.TestOVMPrivateKeys[1], oddsUID1)
+	require.NoError(t, err)
+	require.Len(t, attestations(), 1)
+
+	// the attestations of the different results are not counted together
+	res, err = resolve(simappUtil.TestOVMPrivateKeys[2], oddsUID2)
+	require.NoError(t, err)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_INACTIVE, res.Data.Status)
+	require.Len(t, attestations(), 2)
+	require.Empty(t, k.GetMarketStats(ctx).ResolvedUnsettled)
+
+	// the threshold is met by the second key attesting the same result
+	res, err = resolve(simappUtil.TestOVMPrivateKeys[0], oddsUID1)
+	require.NoError(t, err)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_RESULT_DECLARED, res.Data.Status)
+	require.Equal(t, []string{oddsUID1}, res.Data.WinnerOddsUIDs)
+	require.Equal(t, []string{market.UID}, k.GetMarketStats(ctx).ResolvedUnsettled)
+	require.Empty(t, attestations())
+}
+
+func TestThresholdResolutionRemovedKey(t *testing.T) {
+	tApp, k, msgk, ctx, wctx := setupMsgServerAndApp(t)
+	k.SetParams(ctx, types.NewParams([]string{params.DefaultBondDenom}, 0, 0, 2, types.DefaultParams().DisputeBond))
+	now := cast.ToUint64(ctx.BlockTime().Unix())
+
+	oddsUID1, oddsUID2 := uuid.NewString(), uuid.NewString()
+	market := types.Market{
+		UID:     uuid.NewString(),
+		StartTS: now - 1000,
+		EndTS:   now - 10,
+		Odds:    []*types.Odds{{UID: oddsUID1}, {UID: oddsUID2}},
+		Status:  types.MarketStatus_MARKET_STATUS_INACTIVE,
+	}
+	k.SetMarket(ctx, market)
+
+	creator := simappUtil.TestParamUsers["user1"].Address.String()
+	resolve := func(privateKey ed25519.PrivateKey) *types.MsgResolveResponse {
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+			"uid":              market.UID,
+			"status":           types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+			"resolution_ts":    now,
+			"winner_odds_uids": []string{oddsUID1},
+			"exp":              9999999999,
+			"iat":              1111111111,
+		})
+		ticket, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+
+		res, err := msgk.Resolve(wctx, types.NewMsgResolve(creator, ticket))
+		require.NoError(t, err)
+		return res
+	}
+
+	res := resolve(simappUtil.TestOVMPrivateKeys[1])
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_INACTIVE, res.Data.Status)
+
+	// replace the attested public key in the key vault
+	newPubKey, newPrivKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	bs, err := x509.MarshalPKIXPublicKey(newPubKey)
+	require.NoError(t, err)
+	keyVault, found := tApp.OVMKeeper.GetKeyVault(ctx)
+	require.True(t, found)
+	keyVault.PublicKeys[1] = string(utils.NewPubKeyMemory(bs))
+	tApp.OVMKeeper.SetKeyVault(ctx, keyVault)
+
+	// the attestation of the removed key is not counted
+	res = resolve(simappUtil.TestOVMPrivateKeys[0])
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_INACTIVE, res.Data.Status)
+
+	res = resolve(newPrivKey)
+	require.Equal(t, types.MarketStatus_MARKET_STATUS_RESULT_DECLARED, res.Data.Status)
+}
+
+func TestProcessPendingResolutionMarketNotFound(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	now := cast.ToUint64(ctx.BlockTime().Unix())
+
+	k.SetPendingResolution(ctx, types.NewPendingResolution(&types.MarketResolutionTicketPayload{
+		UID:          uuid.NewString(),
+		ResolutionTS: now,
+		Status:       types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+	}, now))
+
+	// the pending resolution of the missing market does not halt the end-blocker
+	require.NoError(t, k.ProcessPendingResolutions(ctx))
+	pendingResolutions, err := k.GetPendingResolutions(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendingResolutions, 1)
+}
@@ -12,6 +12,43 @@ func (k Keeper) getMarketsStore(ctx sdk.Context) prefix.Store {
 	return prefix.NewStore(store, types.MarketKeyPrefix)
 }
 
+// getFixturesStore gets the store containing all fixtures.
+func (k Keeper) getFixturesStore(ctx sdk.Context) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.FixtureKeyPrefix)
+}
+
+// getFixtureMarketsStore gets the store containing the markets of the fixtures.
+func (k Keeper) getFixtureMarketsStore(ctx sdk.Context) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.FixtureMarketListPrefix)
+}
+
+// getMarketEndTSStore gets the store containing the unresolved markets by their end timestamp.
+func (k Keeper) getMarketEndTSStore(ctx sdk.Context) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.MarketEndTSListPrefix)
+}
+
+// getPendingResolutionsStore gets the store containing the resolutions held in the dispute period.
+func (k Keeper) getPendingResolutionsStore(ctx sdk.Context) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.PendingResolutionKeyPrefix)
+}
+
+// getPendingResolutionDisputeEndTSStore gets the store containing the pending resolutions
+// by the end timestamp of their dispute period.
+func (k Keeper) getPendingResolutionDisputeEndTSStore(ctx sdk.Context) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.PendingResolutionDisputeEndTSListPrefix)
+}
+
+// getResolutionAttestationsStore gets the store containing the resolution attestations of the markets.
+func (k Keeper) getResolutionAttestationsStore(ctx sdk.Context) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.ResolutionAttestationKeyPrefix)
+}
+
 // getMarketStatsStore returns market stats store ready for iterating.
 func (k Keeper) getMarketStatsStore(ctx sdk.Context) prefix.Store {
 	store := ctx.KVStore(k.storeKey)
@@ -19,6 +19,25 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &marketA)
 			cdc.MustUnmarshal(kvB.Value, &marketB)
 			return fmt.Sprintf("%v\n%v", marketA, marketB)
+		case bytes.Equal(kvA.Key, types.FixtureKeyPrefix):
+			var fixtureA, fixtureB types.Fixture
+			cdc.MustUnmarshal(kvA.Value, &fixtureA)
+			cdc.MustUnmarshal(kvB.Value, &fixtureB)
+			return fmt.Sprintf("%v\n%v", fixtureA, fixtureB)
+		case bytes.Equal(kvA.Key, types.FixtureMarketListPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key, types.MarketEndTSListPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key, types.PendingResolutionKeyPrefix):
+			var pendingResolutionA, pendingResolutionB types.PendingResolution
+			cdc.MustUnmarshal(kvA.Value, &pendingResolutionA)
+			cdc.MustUnmarshal(kvB.Value, &pendingResolutionB)
+			return fmt.Sprintf("%v\n%v", pendingResolutionA, pendingResolutionB)
+		case bytes.Equal(kvA.Key, types.ResolutionAttestationKeyPrefix):
+			var attestationA, attestationB types.ResolutionAttestation
+			cdc.MustUnmarshal(kvA.Value, &attestationA)
+			cdc.MustUnmarshal(kvB.Value, &attestationB)
+			return fmt.Sprintf("%v\n%v", attestationA, attestationB)
 		case bytes.Equal(kvA.Key, types.MarketStatsKey):
 			var marketStatsA, marketStatsB types.MarketStats
 			cdc.MustUnmarshal(kvA.Value, &marketStatsA)
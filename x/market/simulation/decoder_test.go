@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/sge-network/sge/app"
+	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/testutil/sample"
 	"github.com/sge-network/sge/x/market/simulation"
 	"github.com/sge-network/sge/x/market/types"
@@ -35,6 +36,34 @@ func TestDecodeStore(t *testing.T) {
 		"custom metadata",
 		uID,
 		types.MarketStatus_MARKET_STATUS_ACTIVE,
+		params.DefaultBondDenom,
+		nil,
+		"",
+	)
+
+	fixture := types.NewFixture(
+		uuid.NewString(),
+		sample.AccAddress(),
+		"football",
+		"premier league",
+		[]string{"home", "away"},
+		cast.ToUint64(time.Now().Add(1*time.Hour).Unix()),
+		"custom metadata",
+	)
+
+	pendingResolution := types.PendingResolution{
+		MarketUID:      market.UID,
+		ResolutionTS:   cast.ToUint64(time.Now().Unix()),
+		Status:         types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		WinnerOddsUIDs: []string{market.Odds[0].UID},
+		DisputeEndTS:   cast.ToUint64(time.Now().Add(1 * time.Hour).Unix()),
+	}
+
+	attestation := types.NewResolutionAttestation(
+		pendingResolution.Payload(),
+		"signer",
+		sample.AccAddress(),
+		cast.ToUint64(time.Now().Unix()),
 	)
 
 	stats := types.MarketStats{
@@ -45,6 +74,11 @@ func TestDecodeStore(t *testing.T) {
 		Pairs: []kv.Pair{
 			{Key: types.MarketKeyPrefix, Value: cdc.MustMarshal(&market)},
 			{Key: types.MarketStatsKey, Value: cdc.MustMarshal(&stats)},
+			{Key: types.FixtureKeyPrefix, Value: cdc.MustMarshal(&fixture)},
+			{Key: types.FixtureMarketListPrefix, Value: []byte(market.UID)},
+			{Key: types.MarketEndTSListPrefix, Value: []byte(market.UID)},
+			{Key: types.PendingResolutionKeyPrefix, Value: cdc.MustMarshal(&pendingResolution)},
+			{Key: types.ResolutionAttestationKeyPrefix, Value: cdc.MustMarshal(&attestation)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -54,6 +88,11 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"market", fmt.Sprintf("%v\n%v", market, market)},
 		{"market_stats", fmt.Sprintf("%v\n%v", stats, stats)},
+		{"fixture", fmt.Sprintf("%v\n%v", fixture, fixture)},
+		{"fixture_market", fmt.Sprintf("%s\n%s", market.UID, market.UID)},
+		{"market_end_ts", fmt.Sprintf("%s\n%s", market.UID, market.UID)},
+		{"pending_resolution", fmt.Sprintf("%v\n%v", pendingResolution, pendingResolution)},
+		{"resolution_attestation", fmt.Sprintf("%v\n%v", attestation, attestation)},
 		{"other", ""},
 	}
 
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate validates the bettor caps, the caps that are not set are ignored.
+func (c *BettorCaps) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if !c.MaxStake.IsNil() && c.MaxStake.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max stake of the bettor caps can not be negative")
+	}
+
+	if !c.MaxPayout.IsNil() && c.MaxPayout.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max payout of the bettor caps can not be negative")
+	}
+
+	return nil
+}
+
+// HasMaxStake returns true if the stake of the bettors is capped,
+// zero value is considered as not set because empty integer fields are
+// decoded as zero.
+func (c *BettorCaps) HasMaxStake() bool {
+	return c != nil && !c.MaxStake.IsNil() && c.MaxStake.IsPositive()
+}
+
+// HasMaxPayout returns true if the potential payout of the bettors is capped.
+func (c *BettorCaps) HasMaxPayout() bool {
+	return c != nil && !c.MaxPayout.IsNil() && c.MaxPayout.IsPositive()
+}
+
+// OddsBettorCaps returns the bettor caps of an odds of the market,
+// nil is returned if the odds does not exist or is not capped.
+func (m *Market) OddsBettorCaps(oddsUID string) *BettorCaps {
+	for _, o := range m.Odds {
+		if o.UID == oddsUID {
+			return o.BettorCaps
+		}
+	}
+	return nil
+}
+
+// SetOddsBettorCaps replaces the bettor caps of an odds of the market.
+func (m *Market) SetOddsBettorCaps(oddsUID string, caps *BettorCaps) error {
+	for _, o := range m.Odds {
+		if o.UID == oddsUID {
+			o.BettorCaps = caps
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds %s does not exist in the market", oddsUID)
+}
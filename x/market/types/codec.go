@@ -16,6 +16,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgAdd{}, "market/Add")
 	legacy.RegisterAminoMsg(cdc, &MsgResolve{}, "market/Resolve")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdate{}, "market/Update")
+	legacy.RegisterAminoMsg(cdc, &MsgAddFixture{}, "market/AddFixture")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFixture{}, "market/UpdateFixture")
+	legacy.RegisterAminoMsg(cdc, &MsgDisputeResolution{}, "market/DisputeResolution")
 }
 
 // RegisterInterfaces registers the module interface types
@@ -30,6 +33,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdate{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddFixture{},
+		&MsgUpdateFixture{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDisputeResolution{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
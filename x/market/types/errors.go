@@ -11,10 +11,22 @@ var (
 	ErrMarketCanNotBeAltered           = sdkerrors.Register(ModuleName, 1001, "market cannot be altered if it is not active or inactive")
 	ErrMarketAlreadyExist              = sdkerrors.Register(ModuleName, 1002, "market already exist")
 	ErrMarketNotFound                  = sdkerrors.Register(ModuleName, 1003, "market not found")
-	ErrMarketResolutionNotAllowed      = sdkerrors.Register(ModuleName, 1004, "market resolution is allowed for active or inactive status")
+	ErrMarketResolutionNotAllowed      = sdkerrors.Register(ModuleName, 1004, "market resolution is allowed for active, inactive or result pending status")
 	ErrInvalidWinnerOdds               = sdkerrors.Register(ModuleName, 1005, "the provided winner odds does not exist in the market odds")
 	ErrInTicketVerification            = sdkerrors.Register(ModuleName, 1006, "error in ticket verification process")
 	ErrInTicketPayloadValidation       = sdkerrors.Register(ModuleName, 1007, "error in ticket payload validation")
 	ErrResolutionTimeLessThenStartTime = sdkerrors.Register(ModuleName, 1008, "resolution time cannot be less than market start time")
 	ErrInOrderBookInitiation           = sdkerrors.Register(ModuleName, 1009, "error in order book initiation")
+	ErrDenomNotAllowed                 = sdkerrors.Register(ModuleName, 1010, "denom is not in the allowed denoms list")
+	ErrFixtureAlreadyExist             = sdkerrors.Register(ModuleName, 1011, "fixture already exist")
+	ErrFixtureNotFound                 = sdkerrors.Register(ModuleName, 1012, "fixture not found")
+	ErrFixtureCanNotBeAltered          = sdkerrors.Register(ModuleName, 1013, "fixture cannot be altered if it is canceled")
+	ErrFixtureIsCanceled               = sdkerrors.Register(ModuleName, 1014, "markets can not be added to the canceled fixture")
+	ErrInOrderBookOddsAddition         = sdkerrors.Register(ModuleName, 1015, "error in adding the odds to the order book")
+	ErrPendingResolutionNotFound       = sdkerrors.Register(ModuleName, 1016, "pending resolution not found, the market is not in the dispute period")
+	ErrDisputerNotBonded               = sdkerrors.Register(ModuleName, 1017, "disputer is not the operator of a bonded validator")
+	ErrInvalidDispute                  = sdkerrors.Register(ModuleName, 1018, "invalid dispute of the pending resolution")
+	ErrInResolutionAttestation         = sdkerrors.Register(ModuleName, 1019, "error in the resolution attestation")
+	ErrResolutionAlreadyDisputed       = sdkerrors.Register(ModuleName, 1020, "pending resolution is already disputed")
+	ErrInDisputeBond                   = sdkerrors.Register(ModuleName, 1021, "error in the dispute bond")
 )
@@ -4,4 +4,8 @@ const (
 	attributeValueCategory         = ModuleName
 	attributeKeyMarketUID          = "uid"
 	attributeKeyMarketOrderBookUID = "orderbook_uid"
+	attributeKeyFixtureUID         = "fixture_uid"
+	attributeKeyFixtureStatus      = "fixture_status"
+	attributeKeyDisputeEndTS       = "dispute_end_ts"
+	attributeKeyDisputeBond        = "dispute_bond"
 )
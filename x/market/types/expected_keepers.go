@@ -5,6 +5,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	ovmtypes "github.com/sge-network/sge/x/ovm/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -16,15 +19,28 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
 // OVMKeeper defines the expected interface needed to verify ticket and unmarshal it
 type OVMKeeper interface {
 	VerifyTicketUnmarshal(goCtx context.Context, ticket string, clm interface{}) error
+	VerifyTicketUnmarshalBySigner(goCtx context.Context, ticket string, clm interface{}) (string, error)
+	GetKeyVault(ctx sdk.Context) (keyVault ovmtypes.KeyVault, found bool)
 }
 
 // OrderbookKeeper defines the expected interface needed to initiate an order book for a market
 type OrderbookKeeper interface {
-	InitiateOrderBook(ctx sdk.Context, marketUID string, oddsUIDs []string) error
+	InitiateOrderBook(ctx sdk.Context, marketUID, denom string, oddsUIDs []string) error
+	AddOrderBookOdds(ctx sdk.Context, orderBookUID string, oddsUIDs []string) error
+}
+
+// StakingKeeper defines the expected interface needed to check the bonded validators
+// and the denom of the dispute bond
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	BondDenom(ctx sdk.Context) string
 }
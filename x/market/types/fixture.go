@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/mrz1836/go-sanitize"
+)
+
+// NewFixture creates a new fixture object with the scheduled status.
+func NewFixture(
+	uid, creator string,
+	sport, competition string,
+	participants []string,
+	scheduledTS uint64,
+	meta string,
+) Fixture {
+	return Fixture{
+		UID:          uid,
+		Creator:      creator,
+		Sport:        sport,
+		Competition:  competition,
+		Participants: participants,
+		ScheduledTS:  scheduledTS,
+		Status:       FixtureStatus_FIXTURE_STATUS_SCHEDULED,
+		Meta:         sanitize.XSS(meta),
+	}
+}
+
+// IsUpdateAllowed returns true if updating the fixture is allowed.
+func (f *Fixture) IsUpdateAllowed() bool {
+	return f.Status != FixtureStatus_FIXTURE_STATUS_CANCELED
+}
@@ -0,0 +1,707 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sge/market/fixture.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FixtureStatus is the fixture status enumeration
+type FixtureStatus int32
+
+const (
+	// unspecified fixture
+	FixtureStatus_FIXTURE_STATUS_UNSPECIFIED FixtureStatus = 0
+	// fixture is scheduled
+	FixtureStatus_FIXTURE_STATUS_SCHEDULED FixtureStatus = 1
+	// fixture is postponed, the active markets of the fixture are inactivated
+	FixtureStatus_FIXTURE_STATUS_POSTPONED FixtureStatus = 2
+	// fixture is canceled, the markets of the fixture are canceled
+	FixtureStatus_FIXTURE_STATUS_CANCELED FixtureStatus = 3
+)
+
+var FixtureStatus_name = map[int32]string{
+	0: "FIXTURE_STATUS_UNSPECIFIED",
+	1: "FIXTURE_STATUS_SCHEDULED",
+	2: "FIXTURE_STATUS_POSTPONED",
+	3: "FIXTURE_STATUS_CANCELED",
+}
+
+var FixtureStatus_value = map[string]int32{
+	"FIXTURE_STATUS_UNSPECIFIED": 0,
+	"FIXTURE_STATUS_SCHEDULED":   1,
+	"FIXTURE_STATUS_POSTPONED":   2,
+	"FIXTURE_STATUS_CANCELED":    3,
+}
+
+func (x FixtureStatus) String() string {
+	return proto.EnumName(FixtureStatus_name, int32(x))
+}
+
+func (FixtureStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_286622f4a51c9871, []int{0}
+}
+
+// Fixture is the sports event that groups the related markets of a match
+// such as the moneyline, spread and totals markets.
+type Fixture struct {
+	// uid is the universal unique identifier of the fixture.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// creator is the address of the creator of the fixture.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// sport is the sport of the fixture.
+	Sport string `protobuf:"bytes,3,opt,name=sport,proto3" json:"sport,omitempty"`
+	// competition is the competition or league of the fixture.
+	Competition string `protobuf:"bytes,4,opt,name=competition,proto3" json:"competition,omitempty"`
+	// participants is the list of the teams or players of the fixture.
+	Participants []string `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	// scheduled_ts is the scheduled start timestamp of the fixture.
+	ScheduledTS uint64 `protobuf:"varint,6,opt,name=scheduled_ts,proto3" json:"scheduled_ts"`
+	// status is the current status of the fixture.
+	Status FixtureStatus `protobuf:"varint,7,opt,name=status,proto3,enum=sgenetwork.sge.market.FixtureStatus" json:"status,omitempty"`
+	// meta contains human-readable metadata of the fixture.
+	Meta string `protobuf:"bytes,8,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (m *Fixture) Reset()         { *m = Fixture{} }
+func (m *Fixture) String() string { return proto.CompactTextString(m) }
+func (*Fixture) ProtoMessage()    {}
+func (*Fixture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_286622f4a51c9871, []int{0}
+}
+func (m *Fixture) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fixture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fixture.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fixture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fixture.Merge(m, src)
+}
+func (m *Fixture) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fixture) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fixture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fixture proto.InternalMessageInfo
+
+func (m *Fixture) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *Fixture) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Fixture) GetSport() string {
+	if m != nil {
+		return m.Sport
+	}
+	return ""
+}
+
+func (m *Fixture) GetCompetition() string {
+	if m != nil {
+		return m.Competition
+	}
+	return ""
+}
+
+func (m *Fixture) GetParticipants() []string {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *Fixture) GetScheduledTS() uint64 {
+	if m != nil {
+		return m.ScheduledTS
+	}
+	return 0
+}
+
+func (m *Fixture) GetStatus() FixtureStatus {
+	if m != nil {
+		return m.Status
+	}
+	return FixtureStatus_FIXTURE_STATUS_UNSPECIFIED
+}
+
+func (m *Fixture) GetMeta() string {
+	if m != nil {
+		return m.Meta
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("sgenetwork.sge.market.FixtureStatus", FixtureStatus_name, FixtureStatus_value)
+	proto.RegisterType((*Fixture)(nil), "sgenetwork.sge.market.Fixture")
+}
+
+func init() { proto.RegisterFile("sge/market/fixture.proto", fileDescriptor_286622f4a51c9871) }
+
+var fileDescriptor_286622f4a51c9871 = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6a, 0xdb, 0x40,
+	0x14, 0x45, 0x3d, 0x96, 0x63, 0x37, 0x93, 0x34, 0x98, 0x21, 0xa5, 0x43, 0x5a, 0x24, 0x11, 0xba,
+	0x70, 0x0b, 0x95, 0xa0, 0xdd, 0x76, 0x13, 0x4b, 0x32, 0x35, 0x14, 0xc7, 0x68, 0x24, 0x28, 0xdd,
+	0x18, 0x45, 0x9e, 0x2a, 0x22, 0xb5, 0x47, 0xcc, 0x3c, 0xd1, 0xf4, 0x0f, 0xba, 0xec, 0xe7, 0xf4,
+	0x13, 0xba, 0xcc, 0xb2, 0x2b, 0x51, 0xe4, 0x5d, 0xbe, 0xa2, 0x48, 0xb2, 0x21, 0x0a, 0xde, 0x0c,
+	0xef, 0xde, 0x7b, 0xee, 0x62, 0x1e, 0x0f, 0x53, 0x95, 0x70, 0x7b, 0x15, 0xc9, 0x1b, 0x0e, 0xf6,
+	0xd7, 0xf4, 0x16, 0x72, 0xc9, 0xad, 0x4c, 0x0a, 0x10, 0xe4, 0x99, 0x4a, 0xf8, 0x9a, 0xc3, 0x77,
+	0x21, 0x6f, 0x2c, 0x95, 0x70, 0xab, 0x81, 0xce, 0x4e, 0x13, 0x91, 0x88, 0x9a, 0xb0, 0xab, 0xa9,
+	0x81, 0xcf, 0x7f, 0x77, 0xf1, 0x60, 0xd2, 0xd4, 0x89, 0x89, 0xb5, 0x3c, 0x5d, 0x52, 0x64, 0xa2,
+	0xd1, 0xe1, 0xf8, 0xa4, 0x2c, 0x0c, 0x2d, 0x9c, 0xba, 0xf7, 0x85, 0x51, 0xb9, 0x7e, 0xf5, 0x10,
+	0x8a, 0x07, 0xb1, 0xe4, 0x11, 0x08, 0x49, 0xbb, 0x15, 0xe5, 0xef, 0x24, 0x39, 0xc5, 0x07, 0x2a,
+	0x13, 0x12, 0xa8, 0x56, 0xfb, 0x8d, 0x20, 0x26, 0x3e, 0x8a, 0xc5, 0x2a, 0xe3, 0x90, 0x42, 0x2a,
+	0xd6, 0xb4, 0x57, 0x67, 0x0f, 0x2d, 0x72, 0x8e, 0x8f, 0xb3, 0x48, 0x42, 0x1a, 0xa7, 0x59, 0xb4,
+	0x06, 0x45, 0x0f, 0x4c, 0x6d, 0x74, 0xe8, 0xb7, 0x3c, 0xe2, 0xe0, 0x63, 0x15, 0x5f, 0xf3, 0x65,
+	0xfe, 0x8d, 0x2f, 0x17, 0xa0, 0x68, 0xdf, 0x44, 0xa3, 0xde, 0xd8, 0x28, 0x0b, 0xe3, 0x88, 0xed,
+	0xfc, 0x80, 0xdd, 0x17, 0x46, 0x0b, 0xf3, 0x5b, 0x8a, 0x7c, 0xc0, 0x7d, 0x05, 0x11, 0xe4, 0x8a,
+	0x0e, 0x4c, 0x34, 0x3a, 0x79, 0xf7, 0xca, 0xda, 0xbb, 0x26, 0x6b, 0xbb, 0x0c, 0x56, 0xb3, 0xfe,
+	0xb6, 0x43, 0x08, 0xee, 0xad, 0x38, 0x44, 0xf4, 0x49, 0xfd, 0x83, 0x7a, 0x7e, 0xf3, 0x13, 0xe1,
+	0xa7, 0x2d, 0x9a, 0xe8, 0xf8, 0x6c, 0x32, 0xfd, 0x1c, 0x84, 0xbe, 0xb7, 0x60, 0xc1, 0x45, 0x10,
+	0xb2, 0x45, 0x38, 0x63, 0x73, 0xcf, 0x99, 0x4e, 0xa6, 0x9e, 0x3b, 0xec, 0x90, 0x97, 0x98, 0x3e,
+	0xca, 0x99, 0xf3, 0xd1, 0x73, 0xc3, 0x4f, 0x9e, 0x3b, 0x44, 0x7b, 0xd2, 0xf9, 0x25, 0x0b, 0xe6,
+	0x97, 0x33, 0xcf, 0x1d, 0x76, 0xc9, 0x0b, 0xfc, 0xfc, 0x51, 0xea, 0x5c, 0xcc, 0x1c, 0xaf, 0xaa,
+	0x6a, 0x63, 0xe7, 0x4f, 0xa9, 0xa3, 0xbb, 0x52, 0x47, 0xff, 0x4a, 0x1d, 0xfd, 0xda, 0xe8, 0x9d,
+	0xbb, 0x8d, 0xde, 0xf9, 0xbb, 0xd1, 0x3b, 0x5f, 0x5e, 0x27, 0x29, 0x5c, 0xe7, 0x57, 0x56, 0x2c,
+	0x56, 0xb6, 0x4a, 0xf8, 0xdb, 0xed, 0x8f, 0xab, 0xd9, 0xbe, 0xdd, 0xdd, 0x0f, 0xfc, 0xc8, 0xb8,
+	0xba, 0xea, 0xd7, 0x17, 0xf1, 0xfe, 0xff, 0x00, 0xde, 0xf2, 0xf4, 0x43, 0x5a, 0x02, 0x00, 0x00,
+}
+
+func (m *Fixture) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fixture) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fixture) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Meta) > 0 {
+		i -= len(m.Meta)
+		copy(dAtA[i:], m.Meta)
+		i = encodeVarintFixture(dAtA, i, uint64(len(m.Meta)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintFixture(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ScheduledTS != 0 {
+		i = encodeVarintFixture(dAtA, i, uint64(m.ScheduledTS))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Participants[iNdEx])
+			copy(dAtA[i:], m.Participants[iNdEx])
+			i = encodeVarintFixture(dAtA, i, uint64(len(m.Participants[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Competition) > 0 {
+		i -= len(m.Competition)
+		copy(dAtA[i:], m.Competition)
+		i = encodeVarintFixture(dAtA, i, uint64(len(m.Competition)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sport) > 0 {
+		i -= len(m.Sport)
+		copy(dAtA[i:], m.Sport)
+		i = encodeVarintFixture(dAtA, i, uint64(len(m.Sport)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintFixture(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintFixture(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFixture(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFixture(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fixture) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovFixture(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovFixture(uint64(l))
+	}
+	l = len(m.Sport)
+	if l > 0 {
+		n += 1 + l + sovFixture(uint64(l))
+	}
+	l = len(m.Competition)
+	if l > 0 {
+		n += 1 + l + sovFixture(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
+			n += 1 + l + sovFixture(uint64(l))
+		}
+	}
+	if m.ScheduledTS != 0 {
+		n += 1 + sovFixture(uint64(m.ScheduledTS))
+	}
+	if m.Status != 0 {
+		n += 1 + sovFixture(uint64(m.Status))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovFixture(uint64(l))
+	}
+	return n
+}
+
+func sovFixture(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFixture(x uint64) (n int) {
+	return sovFixture(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Fixture) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFixture
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fixture: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fixture: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixture
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFixture
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFixture
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixture
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFixture
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFixture
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sport", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixture
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFixture
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFixture
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sport = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Competition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixture
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFixture
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFixture
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Competition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixture
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFixture
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFixture
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTS", wireType)
+			}
+			m.ScheduledTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixture
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixture
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= FixtureStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFixture
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFixture
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFixture
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFixture(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFixture
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFixture(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFixture
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFixture
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFixture
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFixture
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFixture
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFixture
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFixture        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFixture          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFixture = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,7 @@
+package types
+
+type MarketDisputeBondFunder struct{}
+
+func (MarketDisputeBondFunder) GetModuleAcc() string {
+	return marketDisputeBond
+}
@@ -12,7 +12,10 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default  genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		MarketList: []Market{},
+		MarketList:                []Market{},
+		FixtureList:               []Fixture{},
+		PendingResolutionList:     []PendingResolution{},
+		ResolutionAttestationList: []ResolutionAttestation{},
 		Stats: MarketStats{
 			ResolvedUnsettled: []string{},
 		},
@@ -23,15 +26,65 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated uid in fixture
+	fixtureUIDMap := make(map[string]struct{})
+
+	for _, elem := range gs.FixtureList {
+		uid := string(utils.StrBytes(elem.UID))
+		if _, ok := fixtureUIDMap[uid]; ok {
+			return fmt.Errorf("duplicated uid for fixture")
+		}
+		fixtureUIDMap[uid] = struct{}{}
+	}
+
 	// Check for duplicated uid in market
-	marketUIDMap := make(map[string]struct{})
+	marketUIDMap := make(map[string]MarketStatus)
 
 	for _, elem := range gs.MarketList {
 		uid := string(utils.StrBytes(elem.UID))
 		if _, ok := marketUIDMap[uid]; ok {
 			return fmt.Errorf("duplicated uid for market")
 		}
-		marketUIDMap[uid] = struct{}{}
+		marketUIDMap[uid] = elem.Status
+
+		if elem.FixtureUID != "" {
+			if _, ok := fixtureUIDMap[elem.FixtureUID]; !ok {
+				return fmt.Errorf("fixture %s of market %s does not exist", elem.FixtureUID, elem.UID)
+			}
+		}
+	}
+
+	// Check the pending resolutions belong to the markets in the dispute period
+	pendingResolutionMap := make(map[string]struct{})
+
+	for _, elem := range gs.PendingResolutionList {
+		if _, ok := pendingResolutionMap[elem.MarketUID]; ok {
+			return fmt.Errorf("duplicated pending resolution for market %s", elem.MarketUID)
+		}
+		pendingResolutionMap[elem.MarketUID] = struct{}{}
+
+		if status, ok := marketUIDMap[elem.MarketUID]; !ok || status != MarketStatus_MARKET_STATUS_RESULT_PENDING {
+			return fmt.Errorf("market %s of the pending resolution is not in the dispute period", elem.MarketUID)
+		}
+
+		if len(elem.Disputes) > 1 {
+			return fmt.Errorf("pending resolution of market %s is disputed more than once", elem.MarketUID)
+		}
+	}
+
+	// Check the resolution attestations belong to the existing markets
+	resolutionAttestationMap := make(map[string]struct{})
+
+	for _, elem := range gs.ResolutionAttestationList {
+		key := string(ResolutionAttestationKey(elem.MarketUID, elem.Signer))
+		if _, ok := resolutionAttestationMap[key]; ok {
+			return fmt.Errorf("duplicated resolution attestation of a signer for market %s", elem.MarketUID)
+		}
+		resolutionAttestationMap[key] = struct{}{}
+
+		if _, ok := marketUIDMap[elem.MarketUID]; !ok {
+			return fmt.Errorf("market %s of the resolution attestation does not exist", elem.MarketUID)
+		}
 	}
 
 	return gs.Params.Validate()
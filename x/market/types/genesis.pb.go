@@ -32,6 +32,15 @@ type GenesisState struct {
 	MarketList []Market `protobuf:"bytes,2,rep,name=market_list,json=marketList,proto3" json:"market_list"`
 	// stats is the statistics of the markets
 	Stats MarketStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats"`
+	// fixture_list is the list of fixtures that are available in the
+	// chain init.
+	FixtureList []Fixture `protobuf:"bytes,4,rep,name=fixture_list,json=fixtureList,proto3" json:"fixture_list"`
+	// pending_resolution_list is the list of the resolutions that are held in
+	// the dispute period in the chain init.
+	PendingResolutionList []PendingResolution `protobuf:"bytes,5,rep,name=pending_resolution_list,json=pendingResolutionList,proto3" json:"pending_resolution_list"`
+	// resolution_attestation_list is the list of the resolution attestations
+	// that have not reached the resolution threshold in the chain init.
+	ResolutionAttestationList []ResolutionAttestation `protobuf:"bytes,6,rep,name=resolution_attestation_list,json=resolutionAttestationList,proto3" json:"resolution_attestation_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +97,27 @@ func (m *GenesisState) GetStats() MarketStats {
 	return MarketStats{}
 }
 
+func (m *GenesisState) GetFixtureList() []Fixture {
+	if m != nil {
+		return m.FixtureList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingResolutionList() []PendingResolution {
+	if m != nil {
+		return m.PendingResolutionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetResolutionAttestationList() []ResolutionAttestation {
+	if m != nil {
+		return m.ResolutionAttestationList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sgenetwork.sge.market.GenesisState")
 }
@@ -95,24 +125,30 @@ func init() {
 func init() { proto.RegisterFile("sge/market/genesis.proto", fileDescriptor_e4ffd0e85fa3c489) }
 
 var fileDescriptor_e4ffd0e85fa3c489 = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0x4e, 0x4f, 0xd5,
-	0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2d, 0x06, 0xf1, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0x8a,
-	0xd3, 0x53, 0xf5, 0x20, 0x8a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x2a, 0xf4, 0x41, 0x2c,
-	0x88, 0x62, 0x29, 0x71, 0x24, 0x63, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xb1, 0x48, 0x40, 0x28,
-	0xa8, 0x84, 0x18, 0x92, 0x44, 0x71, 0x49, 0x62, 0x09, 0x54, 0x83, 0xd2, 0x45, 0x46, 0x2e, 0x1e,
-	0x77, 0x88, 0x43, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xac, 0xb9, 0xd8, 0x20, 0x26, 0x4a, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xea, 0x61, 0x75, 0x98, 0x5e, 0x00, 0x58, 0x91, 0x13, 0xcb,
-	0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x2d, 0x42, 0x2e, 0x5c, 0xdc, 0x10, 0xe9, 0xf8, 0x9c, 0xcc,
-	0xe2, 0x12, 0x09, 0x26, 0x05, 0x66, 0x3c, 0x26, 0xf8, 0x82, 0x29, 0xa8, 0x09, 0x5c, 0x10, 0x41,
-	0x9f, 0xcc, 0xe2, 0x12, 0x21, 0x3b, 0x2e, 0x56, 0xb0, 0x13, 0x25, 0x98, 0xc1, 0x2e, 0x50, 0xc2,
-	0xab, 0x1f, 0xe4, 0x6a, 0x98, 0x33, 0x20, 0xda, 0x9c, 0x9c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x33, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
-	0xbf, 0x38, 0x3d, 0x55, 0x17, 0x6a, 0x2a, 0x88, 0xad, 0x5f, 0x01, 0x0b, 0x9e, 0x92, 0xca, 0x82,
-	0xd4, 0xe2, 0x24, 0x36, 0x70, 0xf8, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x25, 0x6c, 0xab,
-	0xfc, 0xb2, 0x01, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcd, 0x4e, 0xea, 0x40,
+	0x14, 0x6e, 0x2f, 0x3f, 0x8b, 0x81, 0x55, 0x73, 0xb9, 0xf4, 0x42, 0x1c, 0x09, 0x2b, 0x4c, 0xb4,
+	0x4d, 0x74, 0x69, 0x62, 0x22, 0x1a, 0xd9, 0x68, 0x62, 0x70, 0xe7, 0x86, 0x14, 0x1d, 0xc6, 0x09,
+	0xd0, 0x69, 0x66, 0x0e, 0x11, 0xdf, 0xc2, 0xc7, 0x62, 0xc9, 0xd2, 0x95, 0x31, 0xf0, 0x0e, 0xae,
+	0x4d, 0xe7, 0x0c, 0xd0, 0x18, 0x60, 0x35, 0xd3, 0x7e, 0xbf, 0xe7, 0x64, 0x88, 0xaf, 0x39, 0x0b,
+	0xc7, 0x91, 0x1a, 0x32, 0x08, 0x39, 0x8b, 0x99, 0x16, 0x3a, 0x48, 0x94, 0x04, 0xe9, 0x55, 0x74,
+	0xfa, 0x0d, 0xaf, 0x52, 0x0d, 0x03, 0xcd, 0x59, 0x80, 0xa4, 0xda, 0x5f, 0x2e, 0xb9, 0x34, 0x8c,
+	0x30, 0xbd, 0x21, 0xb9, 0x56, 0xcd, 0xd8, 0x24, 0x91, 0x8a, 0xc6, 0x7a, 0x0b, 0x80, 0x87, 0x05,
+	0xfe, 0x65, 0x00, 0x0d, 0x11, 0xac, 0x04, 0xd9, 0x42, 0x03, 0x31, 0x85, 0x89, 0x62, 0x16, 0xa9,
+	0x67, 0x10, 0xc5, 0xb4, 0x1c, 0x4d, 0x40, 0xc8, 0x18, 0xc1, 0xe6, 0x77, 0x8e, 0x94, 0x3b, 0xd8,
+	0xff, 0x01, 0x22, 0x60, 0xde, 0x39, 0x29, 0x62, 0x11, 0xdf, 0x6d, 0xb8, 0xad, 0xd2, 0xe9, 0x41,
+	0xb0, 0x75, 0x9e, 0xe0, 0xde, 0x90, 0xda, 0xf9, 0xd9, 0xe7, 0xa1, 0xd3, 0xb5, 0x12, 0xef, 0x9a,
+	0x94, 0x10, 0xee, 0x8d, 0x84, 0x06, 0xff, 0x4f, 0x23, 0xb7, 0xc7, 0xe1, 0xce, 0x1c, 0xd6, 0x81,
+	0xe0, 0xcf, 0x5b, 0xa1, 0xc1, 0xbb, 0x20, 0x05, 0x33, 0x99, 0x9f, 0x33, 0x0d, 0x9a, 0x7b, 0xf5,
+	0x69, 0xeb, 0x55, 0x0d, 0x94, 0x79, 0x1d, 0x52, 0xb6, 0x1b, 0xc0, 0x1a, 0x79, 0x53, 0x83, 0xee,
+	0xb0, 0xb9, 0x41, 0xaa, 0xb5, 0x28, 0x59, 0xa5, 0x29, 0x32, 0x20, 0xd5, 0x84, 0xc5, 0xcf, 0x22,
+	0xe6, 0xbd, 0xcd, 0xe2, 0xd0, 0xb3, 0x60, 0x3c, 0x5b, 0xbb, 0x96, 0x83, 0xaa, 0xee, 0x5a, 0x64,
+	0xdd, 0x2b, 0xc9, 0x6f, 0xc0, 0xe4, 0x28, 0x52, 0xcf, 0xf8, 0x47, 0x00, 0x2c, 0x1d, 0x64, 0x9d,
+	0x55, 0x34, 0x59, 0xc7, 0x3b, 0xb2, 0x36, 0x5e, 0x97, 0x1b, 0xa1, 0xcd, 0xfb, 0xaf, 0xb6, 0x81,
+	0x69, 0x66, 0xfb, 0x6a, 0xb6, 0xa0, 0xee, 0x7c, 0x41, 0xdd, 0xaf, 0x05, 0x75, 0xdf, 0x97, 0xd4,
+	0x99, 0x2f, 0xa9, 0xf3, 0xb1, 0xa4, 0xce, 0xe3, 0x11, 0x17, 0xf0, 0x32, 0xe9, 0x07, 0x4f, 0x72,
+	0x1c, 0x6a, 0xce, 0x4e, 0x6c, 0x66, 0x7a, 0x0f, 0xa7, 0xab, 0x87, 0x04, 0x6f, 0x09, 0xd3, 0xfd,
+	0xa2, 0x79, 0x44, 0x67, 0x3f, 0x03, 0x00, 0x77, 0x1f, 0x7f, 0xfe, 0x0e, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +171,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ResolutionAttestationList) > 0 {
+		for iNdEx := len(m.ResolutionAttestationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResolutionAttestationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingResolutionList) > 0 {
+		for iNdEx := len(m.PendingResolutionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingResolutionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FixtureList) > 0 {
+		for iNdEx := len(m.FixtureList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FixtureList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -199,6 +277,24 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Stats.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FixtureList) > 0 {
+		for _, e := range m.FixtureList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingResolutionList) > 0 {
+		for _, e := range m.PendingResolutionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ResolutionAttestationList) > 0 {
+		for _, e := range m.ResolutionAttestationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -337,6 +433,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixtureList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixtureList = append(m.FixtureList, Fixture{})
+			if err := m.FixtureList[len(m.FixtureList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingResolutionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingResolutionList = append(m.PendingResolutionList, PendingResolution{})
+			if err := m.PendingResolutionList[len(m.PendingResolutionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionAttestationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolutionAttestationList = append(m.ResolutionAttestationList, ResolutionAttestation{})
+			if err := m.ResolutionAttestationList[len(m.ResolutionAttestationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -21,6 +21,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				MarketList: []types.Market{
 					{
 						UID: "0",
@@ -35,6 +36,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "duplicated market",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				MarketList: []types.Market{
 					{
 						UID: "0",
@@ -46,6 +48,192 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid fixture markets",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FixtureList: []types.Fixture{
+					{
+						UID: "0",
+					},
+				},
+				MarketList: []types.Market{
+					{
+						UID:        "0",
+						FixtureUID: "0",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated fixture",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FixtureList: []types.Fixture{
+					{
+						UID: "0",
+					},
+					{
+						UID: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "not found fixture of market",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MarketList: []types.Market{
+					{
+						UID:        "0",
+						FixtureUID: "1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid pending resolution",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MarketList: []types.Market{
+					{
+						UID:    "0",
+						Status: types.MarketStatus_MARKET_STATUS_RESULT_PENDING,
+					},
+				},
+				PendingResolutionList: []types.PendingResolution{
+					{
+						MarketUID: "0",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated pending resolution",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MarketList: []types.Market{
+					{
+						UID:    "0",
+						Status: types.MarketStatus_MARKET_STATUS_RESULT_PENDING,
+					},
+				},
+				PendingResolutionList: []types.PendingResolution{
+					{
+						MarketUID: "0",
+					},
+					{
+						MarketUID: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending resolution of not pending market",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MarketList: []types.Market{
+					{
+						UID:    "0",
+						Status: types.MarketStatus_MARKET_STATUS_ACTIVE,
+					},
+				},
+				PendingResolutionList: []types.PendingResolution{
+					{
+						MarketUID: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending resolution disputed more than once",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MarketList: []types.Market{
+					{
+						UID:    "0",
+						Status: types.MarketStatus_MARKET_STATUS_RESULT_PENDING,
+					},
+				},
+				PendingResolutionList: []types.PendingResolution{
+					{
+						MarketUID: "0",
+						Disputes:  []types.Dispute{{Creator: "0"}, {Creator: "1"}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid resolution attestations",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MarketList: []types.Market{
+					{
+						UID: "0",
+					},
+				},
+				ResolutionAttestationList: []types.ResolutionAttestation{
+					{
+						MarketUID: "0",
+						Signer:    "0",
+					},
+					{
+						MarketUID: "0",
+						Signer:    "1",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated resolution attestation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MarketList: []types.Market{
+					{
+						UID: "0",
+					},
+				},
+				ResolutionAttestationList: []types.ResolutionAttestation{
+					{
+						MarketUID: "0",
+						Signer:    "0",
+					},
+					{
+						MarketUID: "0",
+						Signer:    "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "not found market of resolution attestation",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ResolutionAttestationList: []types.ResolutionAttestation{
+					{
+						MarketUID: "0",
+						Signer:    "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty allowed denoms",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{}, 0, 0, 0, types.DefaultParams().DisputeBond),
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
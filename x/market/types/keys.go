@@ -1,6 +1,10 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+
+	"github.com/sge-network/sge/utils"
+)
 
 var _ binary.ByteOrder
 
@@ -19,6 +23,10 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_market"
+
+	// marketDisputeBond is the module account name for the escrow module account
+	// that holds the bond of the open disputes.
+	marketDisputeBond = "market_dispute_bond"
 )
 
 var (
@@ -27,4 +35,61 @@ var (
 
 	// MarketStatsKey is the key for the market statistics
 	MarketStatsKey = []byte{0x01}
+
+	// FixtureKeyPrefix is the prefix to retrieve all Fixture
+	FixtureKeyPrefix = []byte{0x02}
+
+	// FixtureMarketListPrefix is the prefix to retrieve the markets of the fixtures
+	FixtureMarketListPrefix = []byte{0x03}
+
+	// MarketEndTSListPrefix is the prefix to retrieve the unresolved markets
+	// in the order of their end timestamp
+	MarketEndTSListPrefix = []byte{0x04}
+
+	// PendingResolutionKeyPrefix is the prefix to retrieve the resolutions
+	// that are held in the dispute period
+	PendingResolutionKeyPrefix = []byte{0x05}
+
+	// ResolutionAttestationKeyPrefix is the prefix to retrieve the resolution
+	// attestations of the markets
+	ResolutionAttestationKeyPrefix = []byte{0x06}
+
+	// PendingResolutionDisputeEndTSListPrefix is the prefix to retrieve the pending
+	// resolutions in the order of the end timestamp of their dispute period
+	PendingResolutionDisputeEndTSListPrefix = []byte{0x07}
 )
+
+// FixtureMarketListOfFixturePrefix returns prefix of
+// the market list of a certain fixture.
+func FixtureMarketListOfFixturePrefix(fixtureUID string) []byte {
+	return append(FixtureMarketListPrefix, utils.StrBytes(fixtureUID)...)
+}
+
+// FixtureMarketKey returns the key of a certain market of a fixture.
+func FixtureMarketKey(fixtureUID, marketUID string) []byte {
+	return append(utils.StrBytes(fixtureUID), utils.StrBytes(marketUID)...)
+}
+
+// MarketEndTSKey returns the key of a certain market in the end timestamp index,
+// the timestamp is big endian encoded to keep the markets ordered by their end time.
+func MarketEndTSKey(endTS uint64, marketUID string) []byte {
+	return append(utils.Uint64ToBytes(endTS), utils.StrBytes(marketUID)...)
+}
+
+// PendingResolutionDisputeEndTSKey returns the key of the pending resolution of a market
+// in the dispute end timestamp index, the timestamp is big endian encoded to keep the
+// pending resolutions ordered by the end of their dispute period.
+func PendingResolutionDisputeEndTSKey(disputeEndTS uint64, marketUID string) []byte {
+	return append(utils.Uint64ToBytes(disputeEndTS), utils.StrBytes(marketUID)...)
+}
+
+// ResolutionAttestationListOfMarketPrefix returns prefix of
+// the resolution attestation list of a certain market.
+func ResolutionAttestationListOfMarketPrefix(marketUID string) []byte {
+	return append(ResolutionAttestationKeyPrefix, utils.StrBytes(marketUID)...)
+}
+
+// ResolutionAttestationKey returns the key of the resolution attestation of a signer for a market.
+func ResolutionAttestationKey(marketUID, signer string) []byte {
+	return append(utils.StrBytes(marketUID), utils.StrBytes(signer)...)
+}
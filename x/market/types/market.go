@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/mrz1836/go-sanitize"
 )
 
@@ -11,16 +12,22 @@ func NewMarket(
 	meta string,
 	bookUID string,
 	status MarketStatus,
+	denom string,
+	bettorCaps *BettorCaps,
+	fixtureUID string,
 ) Market {
 	return Market{
-		UID:     uid,
-		Creator: creator,
-		StartTS: startTS,
-		EndTS:   endTS,
-		Odds:    odds,
-		Meta:    sanitize.XSS(meta),
-		BookUID: bookUID,
-		Status:  status,
+		UID:        uid,
+		Creator:    creator,
+		StartTS:    startTS,
+		EndTS:      endTS,
+		Odds:       odds,
+		Meta:       sanitize.XSS(meta),
+		BookUID:    bookUID,
+		Status:     status,
+		Denom:      denom,
+		BettorCaps: bettorCaps,
+		FixtureUID: fixtureUID,
 	}
 }
 
@@ -41,6 +48,12 @@ func (m *Market) IsResolveAllowed() bool {
 	return m.isActiveOrInactive()
 }
 
+// IsResultPending returns true if the declared resolution of the market
+// is held in the dispute period.
+func (m *Market) IsResultPending() bool {
+	return m.Status == MarketStatus_MARKET_STATUS_RESULT_PENDING
+}
+
 func (m *Market) isActiveOrInactive() bool {
 	return m.Status == MarketStatus_MARKET_STATUS_ACTIVE ||
 		m.Status == MarketStatus_MARKET_STATUS_INACTIVE
@@ -57,6 +70,64 @@ func (m *Market) HasOdds(oddsUID string) bool {
 	return false
 }
 
+// OddsResult returns the declared result of the odds, the odds that do not have
+// a declared outcome are pushed if they are removed from the market, win if they
+// are in the winner odds uids and lose otherwise.
+func (m *Market) OddsResult(oddsUID string) OddsResult {
+	for _, outcome := range m.OddsOutcomes {
+		if outcome.OddsUID == oddsUID {
+			return outcome.Result
+		}
+	}
+
+	if m.IsOddsRemoved(oddsUID) {
+		return OddsResult_ODDS_RESULT_PUSH
+	}
+
+	for _, wid := range m.WinnerOddsUIDs {
+		if wid == oddsUID {
+			return OddsResult_ODDS_RESULT_WIN
+		}
+	}
+
+	return OddsResult_ODDS_RESULT_LOSE
+}
+
+// DeadHeatFactor returns the dead heat factor of the odds, the odds that do not
+// have a dead heat factor in the declared outcomes are paid in full.
+func (m *Market) DeadHeatFactor(oddsUID string) sdk.Dec {
+	for _, outcome := range m.OddsOutcomes {
+		if outcome.OddsUID == oddsUID && outcome.HasDeadHeat() {
+			return outcome.DeadHeatFactor
+		}
+	}
+
+	return sdk.OneDec()
+}
+
+// HasDeadHeat returns true if the dead heat factor is set for the outcome,
+// zero value is considered as not set because empty decimal fields are
+// decoded as zero.
+func (o *OddsOutcome) HasDeadHeat() bool {
+	return !o.DeadHeatFactor.IsNil() && !o.DeadHeatFactor.IsZero()
+}
+
+// OddsByUID returns the odds of the market by its uid.
+func (m *Market) OddsByUID(oddsUID string) (*Odds, bool) {
+	for _, o := range m.Odds {
+		if o.UID == oddsUID {
+			return o, true
+		}
+	}
+	return nil, false
+}
+
+// IsOddsMovedSince returns true if the value of the odds is changed at or after the timestamp.
+func (m *Market) IsOddsMovedSince(oddsUID string, ts uint64) bool {
+	o, found := m.OddsByUID(oddsUID)
+	return found && o.MovedTS != 0 && o.MovedTS >= ts
+}
+
 // OddsUIDS get list of odd uids
 // This ensures that we loop over the odds in a non random order
 func (m *Market) OddsUIDS() []string {
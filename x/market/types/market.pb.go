@@ -39,6 +39,9 @@ const (
 	MarketStatus_MARKET_STATUS_ABORTED MarketStatus = 4
 	// result of the market is declared
 	MarketStatus_MARKET_STATUS_RESULT_DECLARED MarketStatus = 5
+	// resolution of the market is declared and held in the dispute period
+	// before the settlement
+	MarketStatus_MARKET_STATUS_RESULT_PENDING MarketStatus = 6
 )
 
 var MarketStatus_name = map[int32]string{
@@ -48,6 +51,7 @@ var MarketStatus_name = map[int32]string{
 	3: "MARKET_STATUS_CANCELED",
 	4: "MARKET_STATUS_ABORTED",
 	5: "MARKET_STATUS_RESULT_DECLARED",
+	6: "MARKET_STATUS_RESULT_PENDING",
 }
 
 var MarketStatus_value = map[string]int32{
@@ -57,6 +61,7 @@ var MarketStatus_value = map[string]int32{
 	"MARKET_STATUS_CANCELED":        3,
 	"MARKET_STATUS_ABORTED":         4,
 	"MARKET_STATUS_RESULT_DECLARED": 5,
+	"MARKET_STATUS_RESULT_PENDING":  6,
 }
 
 func (x MarketStatus) String() string {
@@ -90,6 +95,22 @@ type Market struct {
 	Meta string `protobuf:"bytes,9,opt,name=meta,proto3" json:"meta,omitempty"`
 	// book_uid is the unique identifier corresponding to the book
 	BookUID string `protobuf:"bytes,10,opt,name=book_uid,proto3" json:"book_uid"`
+	// denom is the accepted denomination of the bets and deposits of the market.
+	Denom string `protobuf:"bytes,11,opt,name=denom,proto3" json:"denom,omitempty"`
+	// odds_outcomes is the list of the declared outcomes of the odds, the odds
+	// that are not in this list win if they are in the winner odds uids and lose
+	// otherwise.
+	OddsOutcomes []*OddsOutcome `protobuf:"bytes,12,rep,name=odds_outcomes,json=oddsOutcomes,proto3" json:"odds_outcomes,omitempty"`
+	// bettor_caps is the maximum stake and potential payout of each bettor on
+	// the market, there is no cap if it is not set.
+	BettorCaps *BettorCaps `protobuf:"bytes,13,opt,name=bettor_caps,json=bettorCaps,proto3" json:"bettor_caps,omitempty"`
+	// fixture_uid is the universal unique identifier of the fixture that the
+	// market belongs to, it is empty for the standalone markets.
+	FixtureUID string `protobuf:"bytes,14,opt,name=fixture_uid,proto3" json:"fixture_uid"`
+	// postponed is true if the market is inactivated by the postponement of
+	// its fixture, only these markets are activated if the fixture is
+	// scheduled again.
+	Postponed bool `protobuf:"varint,15,opt,name=postponed,proto3" json:"postponed,omitempty"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
@@ -195,6 +216,41 @@ func (m *Market) GetBookUID() string {
 	return ""
 }
 
+func (m *Market) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Market) GetOddsOutcomes() []*OddsOutcome {
+	if m != nil {
+		return m.OddsOutcomes
+	}
+	return nil
+}
+
+func (m *Market) GetBettorCaps() *BettorCaps {
+	if m != nil {
+		return m.BettorCaps
+	}
+	return nil
+}
+
+func (m *Market) GetFixtureUID() string {
+	if m != nil {
+		return m.FixtureUID
+	}
+	return ""
+}
+
+func (m *Market) GetPostponed() bool {
+	if m != nil {
+		return m.Postponed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("sgenetwork.sge.market.MarketStatus", MarketStatus_name, MarketStatus_value)
 	proto.RegisterType((*Market)(nil), "sgenetwork.sge.market.Market")
@@ -203,40 +259,48 @@ func init() {
 func init() { proto.RegisterFile("sge/market/market.proto", fileDescriptor_935a8ad1d6bee065) }
 
 var fileDescriptor_935a8ad1d6bee065 = []byte{
-	// 528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x9b, 0xa5, 0x6b, 0x57, 0x33, 0xaa, 0xc8, 0xac, 0x2c, 0x2b, 0x5a, 0x92, 0x81, 0x84,
-	0x0a, 0x88, 0x54, 0xda, 0x8e, 0x9c, 0x9a, 0xc4, 0xa0, 0x88, 0xae, 0x43, 0x4e, 0x0a, 0x12, 0x97,
-	0xa8, 0x5d, 0xac, 0x50, 0x95, 0xc6, 0x53, 0xec, 0x6a, 0xf0, 0x2f, 0xf8, 0x43, 0xdc, 0x39, 0xee,
-	0xc8, 0x29, 0x42, 0xe9, 0xad, 0x3f, 0x81, 0x13, 0xb2, 0x9b, 0x55, 0x2b, 0x85, 0x4b, 0xfc, 0xf9,
-	0x7b, 0xde, 0xcf, 0xf2, 0x9b, 0x57, 0x06, 0x87, 0x2c, 0x21, 0xdd, 0xd9, 0x28, 0x9b, 0x12, 0x5e,
-	0x2e, 0xf6, 0x55, 0x46, 0x39, 0x85, 0x2d, 0x96, 0x90, 0x94, 0xf0, 0x6b, 0x9a, 0x4d, 0x6d, 0x96,
-	0x10, 0x7b, 0x05, 0xdb, 0x07, 0x09, 0x4d, 0xa8, 0x54, 0x74, 0x45, 0xb5, 0x12, 0xb7, 0x5b, 0x77,
-	0x4e, 0xa1, 0x71, 0xcc, 0x56, 0xed, 0xc7, 0xbf, 0x55, 0x50, 0x3b, 0x97, 0x5d, 0x68, 0x01, 0x75,
-	0x3e, 0x89, 0x75, 0xc5, 0x52, 0x3a, 0x0d, 0xa7, 0x59, 0xe4, 0xa6, 0x3a, 0xf4, 0xbd, 0x65, 0x6e,
-	0x8a, 0x2e, 0x16, 0x1f, 0x78, 0x06, 0xf6, 0x18, 0x1f, 0x65, 0x3c, 0xe2, 0x4c, 0xdf, 0xb1, 0x94,
-	0x4e, 0xd5, 0x39, 0x2c, 0x72, 0xb3, 0x1e, 0x88, 0x5e, 0x18, 0x2c, 0x73, 0x73, 0x8d, 0xf1, 0xba,
-	0x82, 0x2f, 0x40, 0x8d, 0xa4, 0xb1, 0x18, 0x51, 0xe5, 0xc8, 0x83, 0x22, 0x37, 0x77, 0x51, 0x1a,
-	0xcb, 0x81, 0x12, 0xe1, 0x72, 0x85, 0x5d, 0x50, 0x15, 0x97, 0xd3, 0xab, 0x96, 0xda, 0xb9, 0x77,
-	0xfa, 0xc8, 0xfe, 0xa7, 0x43, 0xfb, 0x22, 0x8e, 0x19, 0x96, 0x42, 0x88, 0x81, 0x76, 0x3d, 0x49,
-	0x53, 0x92, 0x45, 0x62, 0x1b, 0xcd, 0x27, 0x31, 0xd3, 0x77, 0x2d, 0xb5, 0xd3, 0x70, 0x9e, 0x16,
-	0xb9, 0xd9, 0xfc, 0x20, 0x99, 0xd0, 0x0f, 0x7d, 0x8f, 0x2d, 0x73, 0x73, 0x4b, 0x8d, 0xb7, 0x3a,
-	0xf0, 0x15, 0xa8, 0x31, 0x3e, 0xe2, 0x73, 0xa6, 0xd7, 0x2c, 0xa5, 0xd3, 0x3c, 0x7d, 0xf2, 0x9f,
-	0x6b, 0xac, 0xfe, 0x5b, 0x20, 0xa5, 0xb8, 0x1c, 0x81, 0x6f, 0xc0, 0xfd, 0x8c, 0x30, 0xfa, 0x79,
-	0xce, 0x27, 0x34, 0x15, 0xae, 0xeb, 0xd2, 0xf5, 0x49, 0x91, 0x9b, 0xfb, 0x78, 0x0d, 0xa4, 0xf9,
-	0x4d, 0x21, 0xde, 0xdc, 0x42, 0x1d, 0xd4, 0x2f, 0x33, 0x32, 0xe2, 0x34, 0xd3, 0xf7, 0x44, 0x24,
-	0xf8, 0x76, 0x0b, 0x21, 0xa8, 0xce, 0x08, 0x1f, 0xe9, 0x0d, 0xd9, 0x96, 0xb5, 0x88, 0x66, 0x4c,
-	0xe9, 0x54, 0x18, 0xd0, 0x81, 0x4c, 0x50, 0x46, 0xe3, 0x50, 0x3a, 0x5d, 0xa5, 0xb8, 0xc6, 0x78,
-	0x5d, 0x3d, 0xff, 0xae, 0x80, 0xfd, 0xbb, 0x26, 0xe0, 0x31, 0x38, 0x3a, 0xef, 0xe1, 0xb7, 0x28,
-	0x8c, 0x82, 0xb0, 0x17, 0x0e, 0x83, 0x68, 0x38, 0x08, 0xde, 0x21, 0xd7, 0x7f, 0xed, 0x23, 0x4f,
-	0xab, 0x40, 0x1d, 0x1c, 0x6c, 0xe2, 0x9e, 0x1b, 0xfa, 0xef, 0x91, 0xa6, 0xc0, 0x36, 0x78, 0xb8,
-	0x49, 0xfc, 0x41, 0xc9, 0x76, 0xb6, 0x99, 0xdb, 0x1b, 0xb8, 0xa8, 0x8f, 0x3c, 0x4d, 0x85, 0x47,
-	0xa0, 0xf5, 0xd7, 0x89, 0xce, 0x05, 0x0e, 0x91, 0xa7, 0x55, 0xe1, 0x09, 0x38, 0xde, 0x44, 0x18,
-	0x05, 0xc3, 0x7e, 0x18, 0x79, 0xc8, 0xed, 0xf7, 0x30, 0xf2, 0xb4, 0x5d, 0xc7, 0xfd, 0x51, 0x18,
-	0xca, 0x4d, 0x61, 0x28, 0xbf, 0x0a, 0x43, 0xf9, 0xb6, 0x30, 0x2a, 0x37, 0x0b, 0xa3, 0xf2, 0x73,
-	0x61, 0x54, 0x3e, 0x3e, 0x4b, 0x26, 0xfc, 0xd3, 0x7c, 0x6c, 0x5f, 0xd2, 0x59, 0x97, 0x25, 0xe4,
-	0x65, 0x99, 0x9e, 0xa8, 0xbb, 0x5f, 0x6e, 0x9f, 0x01, 0xff, 0x7a, 0x45, 0xd8, 0xb8, 0x26, 0x1f,
-	0xc2, 0xd9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x72, 0x74, 0xdd, 0x67, 0x03, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0x8d, 0xc9, 0x07, 0x64, 0x12, 0xf2, 0xa2, 0x79, 0xf0, 0x18, 0x78, 0x10, 0x1b, 0x9e, 0xf4,
+	0x94, 0xb6, 0x6a, 0x22, 0xc1, 0xb2, 0x8b, 0x2a, 0x8e, 0x0d, 0xb2, 0x0a, 0x01, 0x8d, 0x9d, 0x56,
+	0xea, 0xc6, 0x72, 0xe2, 0xa9, 0x1b, 0xa5, 0xf1, 0x44, 0x9e, 0xb1, 0xa0, 0xff, 0xa2, 0x3f, 0xab,
+	0x4b, 0x96, 0x5d, 0xb9, 0x95, 0xd9, 0xf1, 0x1b, 0xba, 0xa8, 0x66, 0x1c, 0x42, 0x52, 0xa0, 0x1b,
+	0xfb, 0xde, 0x73, 0xce, 0xbd, 0x9a, 0x33, 0x47, 0x1a, 0xb0, 0xc5, 0x02, 0xd2, 0x9e, 0x78, 0xd1,
+	0x98, 0xf0, 0xd9, 0xaf, 0x35, 0x8d, 0x28, 0xa7, 0x70, 0x93, 0x05, 0x24, 0x24, 0xfc, 0x92, 0x46,
+	0xe3, 0x16, 0x0b, 0x48, 0x2b, 0x23, 0x77, 0x36, 0x02, 0x1a, 0x50, 0xa9, 0x68, 0x8b, 0x2a, 0x13,
+	0xef, 0x6c, 0x2e, 0x6c, 0xa1, 0xbe, 0xcf, 0x32, 0xf8, 0xe0, 0x67, 0x11, 0x94, 0xce, 0x24, 0x0a,
+	0x35, 0x90, 0x8f, 0x47, 0x3e, 0x52, 0x34, 0xa5, 0x59, 0xd6, 0x6b, 0x69, 0xa2, 0xe6, 0xfb, 0x96,
+	0x71, 0x9b, 0xa8, 0x02, 0xc5, 0xe2, 0x03, 0x8f, 0xc0, 0x1a, 0xe3, 0x5e, 0xc4, 0x5d, 0xce, 0xd0,
+	0x8a, 0xa6, 0x34, 0x0b, 0xfa, 0x56, 0x9a, 0xa8, 0xab, 0xb6, 0xc0, 0x1c, 0xfb, 0x36, 0x51, 0xe7,
+	0x34, 0x9e, 0x57, 0xf0, 0x05, 0x28, 0x91, 0xd0, 0x17, 0x23, 0x79, 0x39, 0xf2, 0x77, 0x9a, 0xa8,
+	0x45, 0x33, 0xf4, 0xe5, 0xc0, 0x8c, 0xc2, 0xb3, 0x3f, 0x6c, 0x83, 0x82, 0x38, 0x1c, 0x2a, 0x68,
+	0xf9, 0x66, 0xe5, 0xf0, 0xdf, 0xd6, 0xa3, 0x0e, 0x5b, 0xe7, 0xbe, 0xcf, 0xb0, 0x14, 0x42, 0x0c,
+	0xea, 0x97, 0xa3, 0x30, 0x24, 0x91, 0x2b, 0x5a, 0x37, 0x1e, 0xf9, 0x0c, 0x15, 0xb5, 0x7c, 0xb3,
+	0xac, 0xff, 0x9f, 0x26, 0x6a, 0xed, 0x9d, 0xe4, 0x84, 0xbe, 0x6f, 0x19, 0xec, 0x36, 0x51, 0x1f,
+	0xa8, 0xf1, 0x03, 0x04, 0xbe, 0x02, 0x25, 0xc6, 0x3d, 0x1e, 0x33, 0x54, 0xd2, 0x94, 0x66, 0xed,
+	0xf0, 0xbf, 0x27, 0x8e, 0x91, 0xdd, 0x9b, 0x2d, 0xa5, 0x78, 0x36, 0x02, 0x4f, 0xc0, 0x7a, 0x44,
+	0x18, 0xfd, 0x14, 0xf3, 0x11, 0x0d, 0x85, 0xeb, 0x55, 0xe9, 0x7a, 0x3f, 0x4d, 0xd4, 0x2a, 0x9e,
+	0x13, 0xd2, 0xfc, 0xb2, 0x10, 0x2f, 0xb7, 0x10, 0x81, 0xd5, 0x61, 0x44, 0x3c, 0x4e, 0x23, 0xb4,
+	0x26, 0x22, 0xc1, 0x77, 0x2d, 0x84, 0xa0, 0x30, 0x21, 0xdc, 0x43, 0x65, 0x09, 0xcb, 0x5a, 0x44,
+	0x33, 0xa0, 0x74, 0x2c, 0x0c, 0x20, 0x20, 0x13, 0x94, 0xd1, 0xe8, 0x94, 0x8e, 0xb3, 0x14, 0xe7,
+	0x34, 0x9e, 0x57, 0x70, 0x03, 0x14, 0x7d, 0x12, 0xd2, 0x09, 0xaa, 0xc8, 0x4d, 0x59, 0x23, 0x1c,
+	0xc8, 0xbb, 0xa0, 0x31, 0x1f, 0xd2, 0x09, 0x61, 0xa8, 0x2a, 0xc3, 0x38, 0xf8, 0x43, 0x18, 0xe7,
+	0x99, 0x14, 0x57, 0xe9, 0x7d, 0xc3, 0xa0, 0x0e, 0x2a, 0x03, 0xc2, 0x39, 0x8d, 0xdc, 0xa1, 0x37,
+	0x65, 0x68, 0x5d, 0x53, 0x9a, 0x95, 0xc3, 0xfd, 0x27, 0xd6, 0xe8, 0x52, 0xd9, 0xf5, 0xa6, 0x0c,
+	0x83, 0xc1, 0xbc, 0x86, 0xaf, 0x41, 0xe5, 0xc3, 0xe8, 0x8a, 0xc7, 0x11, 0x91, 0xd6, 0x6a, 0xd2,
+	0xda, 0x5e, 0x9a, 0xa8, 0xe0, 0x38, 0x83, 0x33, 0x77, 0x8b, 0x22, 0xbc, 0xd8, 0xc0, 0x5d, 0x50,
+	0x9e, 0x52, 0xc6, 0xa7, 0x34, 0x24, 0x3e, 0xfa, 0x4b, 0x53, 0x9a, 0x6b, 0xf8, 0x1e, 0x78, 0xfe,
+	0x5d, 0x01, 0xd5, 0xc5, 0x18, 0xe1, 0x1e, 0xd8, 0x3e, 0xeb, 0xe0, 0x37, 0xa6, 0xe3, 0xda, 0x4e,
+	0xc7, 0xe9, 0xdb, 0x6e, 0xbf, 0x67, 0x5f, 0x98, 0x5d, 0xeb, 0xd8, 0x32, 0x8d, 0x7a, 0x0e, 0x22,
+	0xb0, 0xb1, 0x4c, 0x77, 0xba, 0x8e, 0xf5, 0xd6, 0xac, 0x2b, 0x70, 0x07, 0xfc, 0xb3, 0xcc, 0x58,
+	0xbd, 0x19, 0xb7, 0xf2, 0x90, 0xeb, 0x76, 0x7a, 0x5d, 0xf3, 0xd4, 0x34, 0xea, 0x79, 0xb8, 0x0d,
+	0x36, 0x7f, 0xdb, 0xa8, 0x9f, 0x63, 0xc7, 0x34, 0xea, 0x05, 0xb8, 0x0f, 0xf6, 0x96, 0x29, 0x6c,
+	0xda, 0xfd, 0x53, 0xc7, 0x35, 0xcc, 0xee, 0x69, 0x07, 0x9b, 0x46, 0xbd, 0x08, 0x35, 0xb0, 0xfb,
+	0xa8, 0xe4, 0xc2, 0xec, 0x19, 0x56, 0xef, 0xa4, 0x5e, 0xd2, 0xbb, 0x5f, 0xd3, 0x86, 0x72, 0x9d,
+	0x36, 0x94, 0x1f, 0x69, 0x43, 0xf9, 0x72, 0xd3, 0xc8, 0x5d, 0xdf, 0x34, 0x72, 0xdf, 0x6e, 0x1a,
+	0xb9, 0xf7, 0xcf, 0x82, 0x11, 0xff, 0x18, 0x0f, 0x5a, 0x43, 0x3a, 0x69, 0xb3, 0x80, 0xbc, 0x9c,
+	0x85, 0x22, 0xea, 0xf6, 0xd5, 0xdd, 0x53, 0xc1, 0x3f, 0x4f, 0x09, 0x1b, 0x94, 0xe4, 0x63, 0x71,
+	0xf4, 0x6b, 0x00, 0x45, 0xbe, 0x54, 0xb1, 0x8b, 0x04, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -259,6 +323,56 @@ func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Postponed {
+		i--
+		if m.Postponed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.FixtureUID) > 0 {
+		i -= len(m.FixtureUID)
+		copy(dAtA[i:], m.FixtureUID)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.FixtureUID)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.BettorCaps != nil {
+		{
+			size, err := m.BettorCaps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for iNdEx := len(m.OddsOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OddsOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.BookUID) > 0 {
 		i -= len(m.BookUID)
 		copy(dAtA[i:], m.BookUID)
@@ -390,6 +504,27 @@ func (m *Market) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMarket(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for _, e := range m.OddsOutcomes {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.BettorCaps != nil {
+		l = m.BettorCaps.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.FixtureUID)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Postponed {
+		n += 2
+	}
 	return n
 }
 
@@ -698,6 +833,160 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			}
 			m.BookUID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsOutcomes = append(m.OddsOutcomes, &OddsOutcome{})
+			if err := m.OddsOutcomes[len(m.OddsOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BettorCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BettorCaps == nil {
+				m.BettorCaps = &BettorCaps{}
+			}
+			if err := m.BettorCaps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixtureUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixtureUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Postponed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Postponed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
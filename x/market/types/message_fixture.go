@@ -0,0 +1,127 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sge-network/sge/utils"
+)
+
+const typeMsgAddFixture = "fixture_add"
+
+var _ sdk.Msg = &MsgAddFixture{}
+
+// NewMsgAddFixture creates the new input for adding a fixture to blockchain
+func NewMsgAddFixture(creator, ticket string) *MsgAddFixture {
+	return &MsgAddFixture{
+		Creator: creator,
+		Ticket:  ticket,
+	}
+}
+
+// Route return the message route for slashing
+func (*MsgAddFixture) Route() string { return RouterKey }
+
+// Type returns the msg add fixture type
+func (*MsgAddFixture) Type() string { return typeMsgAddFixture }
+
+// GetSigners return the creators address
+func (msg *MsgAddFixture) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes return the marshalled bytes of the msg
+func (msg *MsgAddFixture) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the input creation fixture
+func (msg *MsgAddFixture) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Ticket == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ticket param")
+	}
+	return nil
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgAddFixture) EmitEvent(ctx *sdk.Context, fixtureUID string) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgAddFixture, msg.Creator,
+		sdk.NewAttribute(attributeKeyFixtureUID, fixtureUID),
+	)
+	emitter.Emit()
+}
+
+// typeMsgUpdateFixture is the type of update fixture
+const typeMsgUpdateFixture = "fixture_update"
+
+var _ sdk.Msg = &MsgUpdateFixture{}
+
+// NewMsgUpdateFixture accepts the params to create new update fixture body
+func NewMsgUpdateFixture(creator, ticket string) *MsgUpdateFixture {
+	return &MsgUpdateFixture{
+		Creator: creator,
+		Ticket:  ticket,
+	}
+}
+
+// Route return the message route for slashing
+func (*MsgUpdateFixture) Route() string { return RouterKey }
+
+// Type return the update fixture type
+func (*MsgUpdateFixture) Type() string { return typeMsgUpdateFixture }
+
+// GetSigners return the creators address
+func (msg *MsgUpdateFixture) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes return the marshalled bytes of the msg
+func (msg *MsgUpdateFixture) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the input update fixture
+func (msg *MsgUpdateFixture) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.Ticket == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ticket param")
+	}
+
+	return nil
+}
+
+// EmitEvent emits the event for the message success, the markets that their
+// status is changed by the fixture update are emitted as well.
+func (msg *MsgUpdateFixture) EmitEvent(ctx *sdk.Context, fixture *Fixture, marketUIDs []string) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgUpdateFixture, msg.Creator,
+		sdk.NewAttribute(attributeKeyFixtureUID, fixture.UID),
+		sdk.NewAttribute(attributeKeyFixtureStatus, fixture.Status.String()),
+	)
+	for _, marketUID := range marketUIDs {
+		emitter.AddEvent(typeMsgUpdateFixture,
+			sdk.NewAttribute(attributeKeyFixtureUID, fixture.UID),
+			sdk.NewAttribute(attributeKeyMarketUID, marketUID),
+		)
+	}
+	emitter.Emit()
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mrz1836/go-sanitize"
+	"github.com/spf13/cast"
+
+	"github.com/sge-network/sge/utils"
+)
+
+// typeMsgDisputeResolution is the type of dispute resolution
+const typeMsgDisputeResolution = "market_dispute_resolution"
+
+var _ sdk.Msg = &MsgDisputeResolution{}
+
+// NewMsgDisputeResolution accepts the params to create new dispute resolution body
+func NewMsgDisputeResolution(
+	creator, marketUID string,
+	winnerOddsUIDs []string,
+	oddsOutcomes []*OddsOutcome,
+	reason string,
+) *MsgDisputeResolution {
+	return &MsgDisputeResolution{
+		Creator:        creator,
+		MarketUID:      marketUID,
+		WinnerOddsUIDs: winnerOddsUIDs,
+		OddsOutcomes:   oddsOutcomes,
+		Reason:         reason,
+	}
+}
+
+// Route return the message route for slashing
+func (*MsgDisputeResolution) Route() string { return RouterKey }
+
+// Type returns the msg dispute resolution type
+func (*MsgDisputeResolution) Type() string { return typeMsgDisputeResolution }
+
+// GetSigners return the creators address
+func (msg *MsgDisputeResolution) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// GetSignBytes return the marshalled bytes of the msg
+func (msg *MsgDisputeResolution) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validates the input dispute resolution
+func (msg *MsgDisputeResolution) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if !utils.IsValidUID(msg.MarketUID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid uid for the market")
+	}
+
+	reason := sanitize.XSS(msg.Reason)
+	if reason == "" || len(reason) > MaxAllowedCharactersForMeta {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"reason should not be empty and should be at most %d characters",
+			MaxAllowedCharactersForMeta,
+		)
+	}
+
+	return nil
+}
+
+// ResolutionPayload returns the result declaration payload of the dispute with
+// the resolution timestamp of the pending resolution.
+func (msg *MsgDisputeResolution) ResolutionPayload(resolutionTS uint64) *MarketResolutionTicketPayload {
+	return &MarketResolutionTicketPayload{
+		UID:            msg.MarketUID,
+		ResolutionTS:   resolutionTS,
+		Status:         MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		WinnerOddsUIDs: msg.WinnerOddsUIDs,
+		OddsOutcomes:   msg.OddsOutcomes,
+	}
+}
+
+// EmitEvent emits the event for the message success.
+func (msg *MsgDisputeResolution) EmitEvent(ctx *sdk.Context, disputeEndTS uint64, bond sdk.Coin) {
+	emitter := utils.NewEventEmitter(ctx, attributeValueCategory)
+	emitter.AddMsg(typeMsgDisputeResolution, msg.Creator,
+		sdk.NewAttribute(attributeKeyMarketUID, msg.MarketUID),
+		sdk.NewAttribute(attributeKeyDisputeEndTS, cast.ToString(disputeEndTS)),
+		sdk.NewAttribute(attributeKeyDisputeBond, bond.String()),
+	)
+	emitter.Emit()
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/mrz1836/go-sanitize"
+	"github.com/sge-network/sge/utils"
+)
+
+// Validate validates the odds declared by the market add or update ticket.
+func (o *Odds) Validate() error {
+	if o == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds is not set")
+	}
+	if o.Meta == "" {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"meta is mandatory for odds with uuid %s",
+			o.UID,
+		)
+	}
+	if len(o.Meta) > MaxAllowedCharactersForMeta {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"meta length should be less than %d characters",
+			MaxAllowedCharactersForMeta,
+		)
+	}
+	o.Meta = sanitize.XSS(o.Meta)
+	if !utils.IsValidUID(o.UID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds-uid passed is invalid")
+	}
+	if err := o.BettorCaps.Validate(); err != nil {
+		return sdkerrors.Wrapf(err, "odds %s", o.UID)
+	}
+	if o.MovedTS != 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "moved timestamp of the odds %s is set by the update ticket", o.UID)
+	}
+	if o.Status == OddsStatus_ODDS_STATUS_REMOVED {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds %s can not be added as removed", o.UID)
+	}
+	return nil
+}
+
+// IsActive returns true if the bets are accepted on the odds, the odds that
+// do not have a status are considered as active.
+func (o *Odds) IsActive() bool {
+	return o.Status == OddsStatus_ODDS_STATUS_UNSPECIFIED ||
+		o.Status == OddsStatus_ODDS_STATUS_ACTIVE
+}
+
+// IsOddsActive returns true if the odds exists in the market and bets are
+// accepted on it.
+func (m *Market) IsOddsActive(oddsUID string) bool {
+	o, found := m.OddsByUID(oddsUID)
+	return found && o.IsActive()
+}
+
+// IsOddsRemoved returns true if the odds is removed from the market.
+func (m *Market) IsOddsRemoved(oddsUID string) bool {
+	o, found := m.OddsByUID(oddsUID)
+	return found && o.Status == OddsStatus_ODDS_STATUS_REMOVED
+}
+
+// SetOddsStatus changes the status of an odds of the market, the removed odds
+// can not be altered anymore.
+func (m *Market) SetOddsStatus(oddsUID string, status OddsStatus) error {
+	o, found := m.OddsByUID(oddsUID)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds %s does not exist in the market", oddsUID)
+	}
+	if o.Status == OddsStatus_ODDS_STATUS_REMOVED {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds %s is removed from the market", oddsUID)
+	}
+	o.Status = status
+	return nil
+}
+
+// AddOdds appends a new odds to the market, the odds without status is added
+// as active.
+func (m *Market) AddOdds(o *Odds) error {
+	if m.HasOdds(o.UID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds %s already exists in the market", o.UID)
+	}
+	if o.Status == OddsStatus_ODDS_STATUS_UNSPECIFIED {
+		o.Status = OddsStatus_ODDS_STATUS_ACTIVE
+	}
+	m.Odds = append(m.Odds, o)
+	return nil
+}
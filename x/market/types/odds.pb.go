@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -23,12 +24,104 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OddsStatus is the enumeration of the statuses of an odds of the market.
+type OddsStatus int32
+
+const (
+	// the odds is created before the odds status is introduced and considered
+	// as active
+	OddsStatus_ODDS_STATUS_UNSPECIFIED OddsStatus = 0
+	// bets are accepted on the odds
+	OddsStatus_ODDS_STATUS_ACTIVE OddsStatus = 1
+	// bets are not accepted on the odds until it is reactivated
+	OddsStatus_ODDS_STATUS_SUSPENDED OddsStatus = 2
+	// the odds is removed from the market, bets are not accepted and the
+	// placed bets are refunded in the settlement
+	OddsStatus_ODDS_STATUS_REMOVED OddsStatus = 3
+)
+
+var OddsStatus_name = map[int32]string{
+	0: "ODDS_STATUS_UNSPECIFIED",
+	1: "ODDS_STATUS_ACTIVE",
+	2: "ODDS_STATUS_SUSPENDED",
+	3: "ODDS_STATUS_REMOVED",
+}
+
+var OddsStatus_value = map[string]int32{
+	"ODDS_STATUS_UNSPECIFIED": 0,
+	"ODDS_STATUS_ACTIVE":      1,
+	"ODDS_STATUS_SUSPENDED":   2,
+	"ODDS_STATUS_REMOVED":     3,
+}
+
+func (x OddsStatus) String() string {
+	return proto.EnumName(OddsStatus_name, int32(x))
+}
+
+func (OddsStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cf7f1000ed50889d, []int{0}
+}
+
+// OddsResult is the enumeration of the outcomes of an odds in the market
+// resolution.
+type OddsResult int32
+
+const (
+	// invalid or unknown
+	OddsResult_ODDS_RESULT_UNSPECIFIED OddsResult = 0
+	// the odds won
+	OddsResult_ODDS_RESULT_WIN OddsResult = 1
+	// the odds lost
+	OddsResult_ODDS_RESULT_LOSE OddsResult = 2
+	// the stake is returned to the bettor
+	OddsResult_ODDS_RESULT_PUSH OddsResult = 3
+	// half of the stake won and the other half is returned to the bettor
+	OddsResult_ODDS_RESULT_HALF_WIN OddsResult = 4
+	// half of the stake lost and the other half is returned to the bettor
+	OddsResult_ODDS_RESULT_HALF_LOSE OddsResult = 5
+)
+
+var OddsResult_name = map[int32]string{
+	0: "ODDS_RESULT_UNSPECIFIED",
+	1: "ODDS_RESULT_WIN",
+	2: "ODDS_RESULT_LOSE",
+	3: "ODDS_RESULT_PUSH",
+	4: "ODDS_RESULT_HALF_WIN",
+	5: "ODDS_RESULT_HALF_LOSE",
+}
+
+var OddsResult_value = map[string]int32{
+	"ODDS_RESULT_UNSPECIFIED": 0,
+	"ODDS_RESULT_WIN":         1,
+	"ODDS_RESULT_LOSE":        2,
+	"ODDS_RESULT_PUSH":        3,
+	"ODDS_RESULT_HALF_WIN":    4,
+	"ODDS_RESULT_HALF_LOSE":   5,
+}
+
+func (x OddsResult) String() string {
+	return proto.EnumName(OddsResult_name, int32(x))
+}
+
+func (OddsResult) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cf7f1000ed50889d, []int{1}
+}
+
 // Odds is a representation of market odds.
 type Odds struct {
 	// uid is the universal unique identifier of the odds.
 	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
 	// meta contains any human-readable metadata of the odds.
 	Meta string `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	// bettor_caps is the maximum stake and potential payout of each bettor on
+	// the odds, there is no cap if it is not set.
+	BettorCaps *BettorCaps `protobuf:"bytes,3,opt,name=bettor_caps,json=bettorCaps,proto3" json:"bettor_caps,omitempty"`
+	// moved_ts is the timestamp of the last change of the odds value declared
+	// by the update ticket, it is set by the blockchain.
+	MovedTS uint64 `protobuf:"varint,4,opt,name=moved_ts,proto3" json:"moved_ts"`
+	// status is the status of the odds, bets are accepted only on the active
+	// odds.
+	Status OddsStatus `protobuf:"varint,5,opt,name=status,proto3,enum=sgenetwork.sge.market.OddsStatus" json:"status,omitempty"`
 }
 
 func (m *Odds) Reset()         { *m = Odds{} }
@@ -78,26 +171,178 @@ func (m *Odds) GetMeta() string {
 	return ""
 }
 
+func (m *Odds) GetBettorCaps() *BettorCaps {
+	if m != nil {
+		return m.BettorCaps
+	}
+	return nil
+}
+
+func (m *Odds) GetMovedTS() uint64 {
+	if m != nil {
+		return m.MovedTS
+	}
+	return 0
+}
+
+func (m *Odds) GetStatus() OddsStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OddsStatus_ODDS_STATUS_UNSPECIFIED
+}
+
+// BettorCaps is the maximum cumulative stake and potential payout of each
+// bettor on a market or an odds, zero value means there is no cap.
+type BettorCaps struct {
+	// max_stake is the maximum total stake of each bettor.
+	MaxStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_stake,json=maxStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_stake"`
+	// max_payout is the maximum total potential payout, stake included, of
+	// each bettor.
+	MaxPayout github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_payout,json=maxPayout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_payout"`
+}
+
+func (m *BettorCaps) Reset()         { *m = BettorCaps{} }
+func (m *BettorCaps) String() string { return proto.CompactTextString(m) }
+func (*BettorCaps) ProtoMessage()    {}
+func (*BettorCaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf7f1000ed50889d, []int{1}
+}
+func (m *BettorCaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BettorCaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BettorCaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BettorCaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BettorCaps.Merge(m, src)
+}
+func (m *BettorCaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *BettorCaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_BettorCaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BettorCaps proto.InternalMessageInfo
+
+// OddsOutcome is the resolved outcome of an odds of the market.
+type OddsOutcome struct {
+	// odds_uid is the universal unique identifier of the odds.
+	OddsUID string `protobuf:"bytes,1,opt,name=odds_uid,proto3" json:"odds_uid"`
+	// result is the outcome of the odds.
+	Result OddsResult `protobuf:"varint,2,opt,name=result,proto3,enum=sgenetwork.sge.market.OddsResult" json:"result,omitempty"`
+	// dead_heat_factor is the portion of the stake paid at the full odds when
+	// the odds ties with other winners, the rest of the stake is lost. It is
+	// only allowed for the won odds and zero value means no dead heat.
+	DeadHeatFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=dead_heat_factor,json=deadHeatFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"dead_heat_factor"`
+}
+
+func (m *OddsOutcome) Reset()         { *m = OddsOutcome{} }
+func (m *OddsOutcome) String() string { return proto.CompactTextString(m) }
+func (*OddsOutcome) ProtoMessage()    {}
+func (*OddsOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf7f1000ed50889d, []int{2}
+}
+func (m *OddsOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OddsOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OddsOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OddsOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OddsOutcome.Merge(m, src)
+}
+func (m *OddsOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *OddsOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_OddsOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OddsOutcome proto.InternalMessageInfo
+
+func (m *OddsOutcome) GetOddsUID() string {
+	if m != nil {
+		return m.OddsUID
+	}
+	return ""
+}
+
+func (m *OddsOutcome) GetResult() OddsResult {
+	if m != nil {
+		return m.Result
+	}
+	return OddsResult_ODDS_RESULT_UNSPECIFIED
+}
+
 func init() {
+	proto.RegisterEnum("sgenetwork.sge.market.OddsStatus", OddsStatus_name, OddsStatus_value)
+	proto.RegisterEnum("sgenetwork.sge.market.OddsResult", OddsResult_name, OddsResult_value)
 	proto.RegisterType((*Odds)(nil), "sgenetwork.sge.market.Odds")
+	proto.RegisterType((*BettorCaps)(nil), "sgenetwork.sge.market.BettorCaps")
+	proto.RegisterType((*OddsOutcome)(nil), "sgenetwork.sge.market.OddsOutcome")
 }
 
 func init() { proto.RegisterFile("sge/market/odds.proto", fileDescriptor_cf7f1000ed50889d) }
 
 var fileDescriptor_cf7f1000ed50889d = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2d, 0x4e, 0x4f, 0xd5,
-	0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0xcf, 0x4f, 0x49, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x02, 0x09, 0xe7, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0xeb, 0x15, 0xa7, 0xa7, 0xea,
-	0x41, 0x54, 0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x55, 0xe8, 0x83, 0x58, 0x10, 0xc5, 0x4a,
-	0x36, 0x5c, 0x2c, 0xfe, 0x29, 0x29, 0xc5, 0x42, 0x0a, 0x5c, 0xcc, 0xa5, 0x99, 0x29, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x7c, 0x8f, 0xee, 0xc9, 0x33, 0x87, 0x7a, 0xba, 0xbc, 0xba, 0x27,
-	0x0f, 0x12, 0x0d, 0x02, 0x11, 0x42, 0x42, 0x5c, 0x2c, 0xb9, 0xa9, 0x25, 0x89, 0x12, 0x4c, 0x20,
-	0x25, 0x41, 0x60, 0xb6, 0x93, 0xf3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0x69, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x17, 0xa7, 0xa7, 0xea,
-	0x42, 0x1d, 0x04, 0x62, 0xeb, 0x57, 0xc0, 0x1c, 0x5d, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
-	0x76, 0x89, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xe3, 0x61, 0x97, 0xcf, 0x00, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0x26, 0x69, 0x69, 0xa7, 0x52, 0xb1, 0xb6, 0x2d, 0x35, 0x20, 0x25, 0xa1, 0x07, 0x14,
+	0x2a, 0xd5, 0x91, 0xe8, 0x89, 0x63, 0x13, 0xbb, 0x6a, 0x44, 0xdb, 0x44, 0xbb, 0x49, 0x41, 0x5c,
+	0xac, 0x4d, 0xbc, 0xb8, 0x55, 0x70, 0x37, 0xf2, 0xae, 0xa1, 0xfd, 0x0b, 0xfe, 0x80, 0x2b, 0x9f,
+	0xd2, 0x63, 0x6f, 0x20, 0x0e, 0x16, 0x4a, 0x6f, 0x3d, 0xf1, 0x09, 0x68, 0x37, 0xc1, 0xb1, 0x2a,
+	0x40, 0x82, 0x8b, 0x3d, 0x7a, 0xef, 0xed, 0xdb, 0x99, 0x37, 0xb2, 0x61, 0x43, 0x86, 0xbc, 0x11,
+	0xb1, 0x78, 0xc4, 0x55, 0x43, 0x04, 0x81, 0x74, 0xc6, 0xb1, 0x50, 0x02, 0x6b, 0xf8, 0x9c, 0xab,
+	0x0f, 0x22, 0x1e, 0x39, 0x32, 0xe4, 0xce, 0x54, 0xf1, 0x68, 0x3d, 0x14, 0xa1, 0x30, 0x8a, 0x86,
+	0xae, 0xa6, 0xe2, 0xad, 0x1f, 0x08, 0xca, 0x9d, 0x20, 0x90, 0xb8, 0x06, 0xa5, 0xe4, 0x2c, 0xb0,
+	0x51, 0x0d, 0xd5, 0x97, 0x9b, 0xab, 0x93, 0xb4, 0x5a, 0xea, 0xb7, 0xdd, 0xdb, 0xb4, 0xaa, 0x51,
+	0xa2, 0x1f, 0x18, 0x43, 0x39, 0xe2, 0x8a, 0xd9, 0x45, 0x2d, 0x21, 0xa6, 0xc6, 0x4d, 0x58, 0x19,
+	0x70, 0xa5, 0x44, 0xec, 0x0f, 0xd9, 0x58, 0xda, 0xa5, 0x1a, 0xaa, 0xaf, 0x3c, 0x7f, 0xe2, 0xfc,
+	0xb6, 0x03, 0xa7, 0x69, 0x94, 0x2d, 0x36, 0x96, 0x04, 0x06, 0x59, 0x8d, 0x77, 0x61, 0x29, 0x12,
+	0xef, 0x79, 0xe0, 0x2b, 0x69, 0x97, 0x6b, 0xa8, 0x5e, 0x6e, 0x6e, 0x4e, 0xd2, 0xea, 0xbd, 0x23,
+	0x8d, 0xf5, 0xe8, 0x6d, 0x5a, 0xcd, 0x68, 0x92, 0x55, 0xf8, 0x05, 0x2c, 0x4a, 0xc5, 0x54, 0x22,
+	0xed, 0x85, 0x1a, 0xaa, 0xaf, 0xfe, 0xf1, 0x4e, 0x3d, 0x1b, 0x35, 0x42, 0x32, 0x3b, 0xb0, 0xf5,
+	0x19, 0x01, 0xcc, 0x5b, 0xc1, 0x2f, 0x61, 0x39, 0x62, 0x17, 0xbe, 0x54, 0x6c, 0xc4, 0x67, 0xe3,
+	0x3b, 0x57, 0x69, 0xb5, 0xf0, 0x2d, 0xad, 0x3e, 0x0d, 0xcf, 0xd4, 0x69, 0x32, 0x70, 0x86, 0x22,
+	0x6a, 0x0c, 0x85, 0x8c, 0x84, 0x9c, 0xbd, 0x76, 0x64, 0x30, 0x6a, 0xa8, 0xcb, 0x31, 0x97, 0x4e,
+	0xfb, 0x5c, 0x91, 0xa5, 0x88, 0x5d, 0x50, 0x7d, 0x1e, 0x1f, 0x01, 0x68, 0xb3, 0x31, 0xbb, 0x14,
+	0x89, 0xb2, 0x8b, 0xff, 0xe5, 0xa6, 0xdb, 0xe9, 0x1a, 0x83, 0xad, 0x2f, 0x08, 0x56, 0xf4, 0x04,
+	0x9d, 0x44, 0x0d, 0x45, 0xc4, 0x75, 0x54, 0x7a, 0xd1, 0xfe, 0x7c, 0x53, 0x26, 0x2a, 0x2d, 0x99,
+	0x6e, 0x2b, 0xa3, 0x49, 0x56, 0xe9, 0xa8, 0x62, 0x2e, 0x93, 0x77, 0xd3, 0x7e, 0xfe, 0x1e, 0x15,
+	0x31, 0x42, 0x32, 0x3b, 0x80, 0x5f, 0x83, 0x15, 0x70, 0x16, 0xf8, 0xa7, 0x9c, 0x29, 0xff, 0x2d,
+	0x1b, 0x2a, 0x11, 0xdb, 0xa5, 0x7f, 0x1e, 0xca, 0xe5, 0x43, 0xb2, 0xaa, 0x7d, 0x0e, 0x38, 0x53,
+	0xfb, 0xc6, 0x65, 0x3b, 0x01, 0x98, 0xaf, 0x06, 0x3f, 0x86, 0xcd, 0x8e, 0xeb, 0x52, 0x9f, 0xf6,
+	0xf6, 0x7a, 0x7d, 0xea, 0xf7, 0x8f, 0x69, 0xd7, 0x6b, 0xb5, 0xf7, 0xdb, 0x9e, 0x6b, 0x15, 0xf0,
+	0x03, 0xc0, 0x79, 0x72, 0xaf, 0xd5, 0x6b, 0x9f, 0x78, 0x16, 0xc2, 0x0f, 0x61, 0x23, 0x8f, 0xd3,
+	0x3e, 0xed, 0x7a, 0xc7, 0xae, 0xe7, 0x5a, 0x45, 0xbc, 0x09, 0x6b, 0x79, 0x8a, 0x78, 0x47, 0x9d,
+	0x13, 0xcf, 0xb5, 0x4a, 0xdb, 0x9f, 0x10, 0xc0, 0x7c, 0xce, 0xec, 0x5e, 0xe2, 0xd1, 0xfe, 0x61,
+	0xef, 0xce, 0xbd, 0x6b, 0x70, 0x3f, 0x4f, 0xbe, 0x6a, 0x1f, 0x5b, 0x08, 0xaf, 0x83, 0x95, 0x07,
+	0x0f, 0x3b, 0xd4, 0xb3, 0x8a, 0x77, 0xd1, 0x6e, 0x9f, 0x1e, 0x58, 0x25, 0x6c, 0xc3, 0x7a, 0x1e,
+	0x3d, 0xd8, 0x3b, 0xdc, 0x37, 0x2e, 0xe5, 0xac, 0xf5, 0x3c, 0x63, 0xac, 0x16, 0x9a, 0xad, 0xab,
+	0x49, 0x05, 0x5d, 0x4f, 0x2a, 0xe8, 0xfb, 0xa4, 0x82, 0x3e, 0xde, 0x54, 0x0a, 0xd7, 0x37, 0x95,
+	0xc2, 0xd7, 0x9b, 0x4a, 0xe1, 0xcd, 0xb3, 0x5c, 0xd4, 0x32, 0xe4, 0x3b, 0xb3, 0x15, 0xea, 0xba,
+	0x71, 0xf1, 0xeb, 0x3f, 0x60, 0x12, 0x1f, 0x2c, 0x9a, 0x8f, 0x7b, 0xf7, 0xe7, 0x00, 0x54, 0xdd,
+	0x6b, 0x94, 0x22, 0x04, 0x00, 0x00,
 }
 
 func (m *Odds) Marshal() (dAtA []byte, err error) {
@@ -120,6 +365,28 @@ func (m *Odds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintOdds(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MovedTS != 0 {
+		i = encodeVarintOdds(dAtA, i, uint64(m.MovedTS))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BettorCaps != nil {
+		{
+			size, err := m.BettorCaps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOdds(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -137,6 +404,94 @@ func (m *Odds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BettorCaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BettorCaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BettorCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPayout.Size()
+		i -= size
+		if _, err := m.MaxPayout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOdds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxStake.Size()
+		i -= size
+		if _, err := m.MaxStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOdds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OddsOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OddsOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OddsOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DeadHeatFactor.Size()
+		i -= size
+		if _, err := m.DeadHeatFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOdds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Result != 0 {
+		i = encodeVarintOdds(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OddsUID) > 0 {
+		i -= len(m.OddsUID)
+		copy(dAtA[i:], m.OddsUID)
+		i = encodeVarintOdds(dAtA, i, uint64(len(m.OddsUID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOdds(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOdds(v)
 	base := offset
@@ -162,6 +517,47 @@ func (m *Odds) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOdds(uint64(l))
 	}
+	if m.BettorCaps != nil {
+		l = m.BettorCaps.Size()
+		n += 1 + l + sovOdds(uint64(l))
+	}
+	if m.MovedTS != 0 {
+		n += 1 + sovOdds(uint64(m.MovedTS))
+	}
+	if m.Status != 0 {
+		n += 1 + sovOdds(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *BettorCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxStake.Size()
+	n += 1 + l + sovOdds(uint64(l))
+	l = m.MaxPayout.Size()
+	n += 1 + l + sovOdds(uint64(l))
+	return n
+}
+
+func (m *OddsOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OddsUID)
+	if l > 0 {
+		n += 1 + l + sovOdds(uint64(l))
+	}
+	if m.Result != 0 {
+		n += 1 + sovOdds(uint64(m.Result))
+	}
+	l = m.DeadHeatFactor.Size()
+	n += 1 + l + sovOdds(uint64(l))
 	return n
 }
 
@@ -264,6 +660,333 @@ func (m *Odds) Unmarshal(dAtA []byte) error {
 			}
 			m.Meta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BettorCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOdds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOdds
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOdds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BettorCaps == nil {
+				m.BettorCaps = &BettorCaps{}
+			}
+			if err := m.BettorCaps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedTS", wireType)
+			}
+			m.MovedTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOdds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MovedTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOdds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OddsStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOdds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOdds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BettorCaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOdds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BettorCaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BettorCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOdds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOdds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOdds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOdds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOdds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOdds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOdds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOdds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OddsOutcome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOdds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OddsOutcome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OddsOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOdds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOdds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOdds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOdds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= OddsResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadHeatFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOdds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOdds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOdds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeadHeatFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOdds(dAtA[iNdEx:])
@@ -1,33 +1,142 @@
 package types
 
 import (
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	"github.com/sge-network/sge/app/params"
+	ovmtypes "github.com/sge-network/sge/x/ovm/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	keyAllowedDenoms = []byte("AllowedDenoms")
+
+	// keyAbortGracePeriod is the duration in seconds after the end
+	// timestamp that the unresolved markets are aborted.
+	keyAbortGracePeriod = []byte("AbortGracePeriod")
+
+	// keyDisputePeriod is the duration in seconds that the declared
+	// resolutions are held before the settlement.
+	keyDisputePeriod = []byte("DisputePeriod")
+
+	// keyResolutionThreshold is the minimum count of the registered public
+	// keys that should attest the same resolution of a market.
+	keyResolutionThreshold = []byte("ResolutionThreshold")
+
+	// keyDisputeBond is the amount that is held from the disputer until
+	// the oracle confirms the result of the disputed market.
+	keyDisputeBond = []byte("DisputeBond")
+)
+
+// defaultAbortGracePeriod is the default duration that the oracle has to
+// resolve a market after its end timestamp, one week.
+const defaultAbortGracePeriod = 7 * 24 * 60 * 60
+
+// defaultDisputePeriod is the default duration that a declared resolution
+// can be corrected or disputed before the settlement, one hour.
+const defaultDisputePeriod = 60 * 60
+
+// defaultDisputeBond is the default amount of the staking bond denom that
+// is held from the disputer, one hundred sge.
+const defaultDisputeBond int64 = 100_000_000
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params { return Params{} }
+func NewParams(
+	allowedDenoms []string,
+	abortGracePeriod, disputePeriod uint64,
+	resolutionThreshold uint32,
+	disputeBond sdkmath.Int,
+) Params {
+	return Params{
+		AllowedDenoms:       allowedDenoms,
+		AbortGracePeriod:    abortGracePeriod,
+		DisputePeriod:       disputePeriod,
+		ResolutionThreshold: resolutionThreshold,
+		DisputeBond:         disputeBond,
+	}
+}
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		[]string{params.DefaultBondDenom},
+		defaultAbortGracePeriod,
+		defaultDisputePeriod,
+		0,
+		sdkmath.NewInt(defaultDisputeBond),
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
-func (*Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(keyAllowedDenoms, &p.AllowedDenoms, validateAllowedDenoms),
+		paramtypes.NewParamSetPair(keyAbortGracePeriod, &p.AbortGracePeriod, validateAbortGracePeriod),
+		paramtypes.NewParamSetPair(keyDisputePeriod, &p.DisputePeriod, validateDisputePeriod),
+		paramtypes.NewParamSetPair(keyResolutionThreshold, &p.ResolutionThreshold, validateResolutionThreshold),
+		paramtypes.NewParamSetPair(keyDisputeBond, &p.DisputeBond, validateDisputeBond),
+	}
 }
 
 // Validate validates the set of params
-func (Params) Validate() error {
-	return nil
+func (p Params) Validate() error {
+	if err := validateAllowedDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+
+	if err := validateAbortGracePeriod(p.AbortGracePeriod); err != nil {
+		return err
+	}
+
+	if err := validateDisputePeriod(p.DisputePeriod); err != nil {
+		return err
+	}
+
+	if err := validateResolutionThreshold(p.ResolutionThreshold); err != nil {
+		return err
+	}
+
+	return validateDisputeBond(p.DisputeBond)
+}
+
+// IsAbortDue returns true if the unresolved market with the end timestamp
+// should be aborted at the block time.
+func (p Params) IsAbortDue(endTS, blockTime uint64) bool {
+	return p.AbortGracePeriod > 0 && endTS+p.AbortGracePeriod <= blockTime
+}
+
+// DisputeEndTS returns the timestamp that the dispute period of a resolution
+// declared at the block time ends.
+func (p Params) DisputeEndTS(blockTime uint64) uint64 {
+	return blockTime + p.DisputePeriod
+}
+
+// IsThresholdResolution returns true if the resolution of the markets should be
+// attested by more than one registered public key.
+func (p Params) IsThresholdResolution() bool {
+	return p.ResolutionThreshold > 1
+}
+
+// IsDenomAllowed returns true if the denom is in the allowed denoms list.
+func (p Params) IsDenomAllowed(denom string) bool {
+	for _, d := range p.AllowedDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
 }
 
 // String implements the Stringer interface.
@@ -38,3 +147,81 @@ func (p Params) String() string {
 	}
 	return string(out)
 }
+
+func validateAllowedDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return fmt.Errorf("allowed denoms list can not be empty")
+	}
+
+	denomSet := make(map[string]struct{}, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed denom %s: %s", denom, err)
+		}
+		if _, exists := denomSet[denom]; exists {
+			return fmt.Errorf("duplicate allowed denom %s", denom)
+		}
+		denomSet[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateAbortGracePeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > math.MaxInt64 {
+		return fmt.Errorf("abort grace period is too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateDisputePeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > math.MaxInt64 {
+		return fmt.Errorf("dispute period is too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateResolutionThreshold(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// the key vault never holds less than the minimum count of the public
+	// keys, so the threshold stays reachable when the keys are removed.
+	if v > ovmtypes.MinPubKeysCount {
+		return fmt.Errorf("resolution threshold %d is more than the minimum count of the public keys %d", v, ovmtypes.MinPubKeysCount)
+	}
+
+	return nil
+}
+
+func validateDisputeBond(i interface{}) error {
+	v, ok := i.(sdkmath.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("dispute bond must be positive: %s", v)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -26,6 +27,28 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 // It contains bet constraints associated to a market.
 type Params struct {
+	// allowed_denoms is the list of the denominations that can be
+	// accepted by the markets.
+	AllowedDenoms []string `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty" yaml:"allowed_denoms"`
+	// abort_grace_period is the duration in seconds after the end timestamp
+	// that the unresolved markets are aborted automatically, zero value
+	// disables the automatic abort.
+	AbortGracePeriod uint64 `protobuf:"varint,2,opt,name=abort_grace_period,json=abortGracePeriod,proto3" json:"abort_grace_period,omitempty" yaml:"abort_grace_period"`
+	// dispute_period is the duration in seconds that a declared resolution is
+	// held before the settlement, in this period the resolution can be
+	// corrected by a new resolution ticket or disputed by a bonded validator,
+	// zero value settles the resolved markets immediately.
+	DisputePeriod uint64 `protobuf:"varint,3,opt,name=dispute_period,json=disputePeriod,proto3" json:"dispute_period,omitempty" yaml:"dispute_period"`
+	// resolution_threshold is the minimum count of the distinct registered
+	// public keys of the key vault that should attest the same resolution of
+	// a market, the values less than two accept the resolution ticket signed by
+	// the leader public key.
+	ResolutionThreshold uint32 `protobuf:"varint,4,opt,name=resolution_threshold,json=resolutionThreshold,proto3" json:"resolution_threshold,omitempty" yaml:"resolution_threshold"`
+	// dispute_bond is the amount of the staking bond denom that is held from
+	// the disputer until the oracle confirms the result of the disputed market,
+	// the bond is refunded if the confirmed result is the proposed result of
+	// the dispute and burned otherwise.
+	DisputeBond github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=dispute_bond,json=disputeBond,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"dispute_bond" yaml:"dispute_bond"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -60,6 +83,34 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetAbortGracePeriod() uint64 {
+	if m != nil {
+		return m.AbortGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetDisputePeriod() uint64 {
+	if m != nil {
+		return m.DisputePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetResolutionThreshold() uint32 {
+	if m != nil {
+		return m.ResolutionThreshold
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "sgenetwork.sge.market.Params")
 }
@@ -67,17 +118,31 @@ func init() {
 func init() { proto.RegisterFile("sge/market/params.proto", fileDescriptor_e166b9eeb42fd7f6) }
 
 var fileDescriptor_e166b9eeb42fd7f6 = []byte{
-	// 157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2f, 0x4e, 0x4f, 0xd5,
-	0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2d, 0x4e, 0x4f, 0xcd, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6,
-	0x2b, 0x4e, 0x4f, 0xd5, 0x83, 0xa8, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd0, 0x07,
-	0xb1, 0x20, 0x8a, 0x95, 0xf8, 0xb8, 0xd8, 0x02, 0xc0, 0x9a, 0xad, 0x58, 0x66, 0x2c, 0x90, 0x67,
-	0x70, 0x72, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
-	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xcd, 0xf4, 0xcc,
-	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe2, 0xf4, 0x54, 0x5d, 0xa8, 0x15, 0x20,
-	0xb6, 0x7e, 0x05, 0xcc, 0x21, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xb3, 0x8d, 0x01,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xb4, 0xaa, 0x61, 0xb6, 0xa3, 0x00, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xb1, 0x8a, 0xdb, 0x40,
+	0x10, 0x86, 0xa5, 0xd8, 0x31, 0x58, 0x89, 0x43, 0x90, 0x6d, 0x22, 0x27, 0x44, 0x2b, 0x54, 0x04,
+	0xa5, 0xb0, 0x54, 0xa4, 0x73, 0x15, 0x94, 0x84, 0x10, 0xd2, 0x18, 0x91, 0x2a, 0x8d, 0x90, 0xbc,
+	0xcb, 0x4a, 0x58, 0xd2, 0x88, 0xdd, 0x35, 0x8e, 0xdf, 0x22, 0x65, 0x9a, 0xc0, 0x3d, 0x8e, 0x4b,
+	0x97, 0xc7, 0x15, 0xe2, 0xb0, 0xdf, 0x40, 0x4f, 0x70, 0x68, 0x2d, 0xdf, 0xd9, 0xdc, 0x55, 0x3b,
+	0xf3, 0xcd, 0xbf, 0xff, 0xcc, 0x32, 0xab, 0xbd, 0xe1, 0x94, 0x78, 0x79, 0xc4, 0x96, 0x44, 0x78,
+	0x65, 0xc4, 0xa2, 0x9c, 0xbb, 0x25, 0x03, 0x01, 0xfa, 0x98, 0x53, 0x52, 0x10, 0xb1, 0x06, 0xb6,
+	0x74, 0x39, 0x25, 0xee, 0x51, 0xf3, 0x76, 0x44, 0x81, 0x82, 0x54, 0x78, 0x4d, 0x74, 0x14, 0xdb,
+	0xff, 0x3b, 0x5a, 0x6f, 0x2e, 0x6f, 0xeb, 0x9f, 0xb5, 0x57, 0x51, 0x96, 0xc1, 0x9a, 0xe0, 0x10,
+	0x93, 0x02, 0x72, 0x6e, 0xa8, 0x56, 0xc7, 0xe9, 0xfb, 0x93, 0xba, 0x42, 0xe3, 0x4d, 0x94, 0x67,
+	0x33, 0xfb, 0xb2, 0x6e, 0x07, 0x83, 0x16, 0x7c, 0x95, 0xb9, 0xfe, 0x53, 0xd3, 0xa3, 0x18, 0x98,
+	0x08, 0x29, 0x8b, 0x16, 0x24, 0x2c, 0x09, 0x4b, 0x01, 0x1b, 0xcf, 0x2c, 0xd5, 0xe9, 0xfa, 0xef,
+	0xeb, 0x0a, 0x4d, 0x5a, 0x97, 0x47, 0x1a, 0x3b, 0x78, 0x2d, 0xe1, 0xf7, 0x86, 0xcd, 0x25, 0x6a,
+	0xc6, 0xc1, 0x29, 0x2f, 0x57, 0xe2, 0xde, 0xa8, 0x23, 0x8d, 0xce, 0xc6, 0xb9, 0xac, 0xdb, 0xc1,
+	0xa0, 0x05, 0xad, 0x43, 0xa0, 0x8d, 0x18, 0xe1, 0x90, 0xad, 0x44, 0x0a, 0x45, 0x28, 0x12, 0x46,
+	0x78, 0x02, 0x19, 0x36, 0xba, 0x96, 0xea, 0x0c, 0x7c, 0x54, 0x57, 0xe8, 0xdd, 0xd1, 0xe7, 0x29,
+	0x95, 0x1d, 0x0c, 0x1f, 0xf0, 0xaf, 0x13, 0xd5, 0x13, 0xed, 0xe5, 0xa9, 0x6b, 0x0c, 0x05, 0x36,
+	0x9e, 0x5b, 0xaa, 0xd3, 0xf7, 0xbf, 0x6d, 0x2b, 0xa4, 0xdc, 0x54, 0xe8, 0x03, 0x4d, 0x45, 0xb2,
+	0x8a, 0xdd, 0x05, 0xe4, 0xde, 0x02, 0x78, 0x0e, 0xbc, 0x3d, 0xa6, 0x1c, 0x2f, 0x3d, 0xb1, 0x29,
+	0x09, 0x77, 0x7f, 0x14, 0xa2, 0xae, 0xd0, 0xf0, 0xf2, 0x05, 0x8d, 0x97, 0x1d, 0xbc, 0x68, 0x53,
+	0x1f, 0x0a, 0x3c, 0xeb, 0xfe, 0xbb, 0x42, 0x8a, 0xff, 0x65, 0xbb, 0x37, 0xd5, 0xdd, 0xde, 0x54,
+	0x6f, 0xf7, 0xa6, 0xfa, 0xf7, 0x60, 0x2a, 0xbb, 0x83, 0xa9, 0x5c, 0x1f, 0x4c, 0xe5, 0xf7, 0xc7,
+	0xb3, 0x5e, 0x9c, 0x92, 0x69, 0xbb, 0xf2, 0x26, 0xf6, 0xfe, 0x9c, 0x3e, 0x86, 0x6c, 0x19, 0xf7,
+	0xe4, 0xae, 0x3f, 0xdd, 0x0d, 0x00, 0x31, 0x59, 0x30, 0x25, 0x33, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -100,6 +165,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DisputeBond.Size()
+		i -= size
+		if _, err := m.DisputeBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ResolutionThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ResolutionThreshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DisputePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputePeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AbortGracePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AbortGracePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -120,6 +219,23 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AbortGracePeriod != 0 {
+		n += 1 + sovParams(uint64(m.AbortGracePeriod))
+	}
+	if m.DisputePeriod != 0 {
+		n += 1 + sovParams(uint64(m.DisputePeriod))
+	}
+	if m.ResolutionThreshold != 0 {
+		n += 1 + sovParams(uint64(m.ResolutionThreshold))
+	}
+	l = m.DisputeBond.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -158,6 +274,129 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortGracePeriod", wireType)
+			}
+			m.AbortGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbortGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriod", wireType)
+			}
+			m.DisputePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionThreshold", wireType)
+			}
+			m.ResolutionThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisputeBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/sge-network/sge/app/params"
+	"github.com/sge-network/sge/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		params types.Params
+		valid  bool
+	}{
+		{
+			desc:   "default",
+			params: types.DefaultParams(),
+			valid:  true,
+		},
+		{
+			desc:   "multiple denoms",
+			params: types.NewParams([]string{params.DefaultBondDenom, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}, 0, 0, 0, types.DefaultParams().DisputeBond),
+			valid:  true,
+		},
+		{
+			desc:   "empty list",
+			params: types.NewParams(nil, 0, 0, 0, types.DefaultParams().DisputeBond),
+		},
+		{
+			desc:   "invalid denom",
+			params: types.NewParams([]string{"1usge"}, 0, 0, 0, types.DefaultParams().DisputeBond),
+		},
+		{
+			desc:   "duplicate denom",
+			params: types.NewParams([]string{params.DefaultBondDenom, params.DefaultBondDenom}, 0, 0, 0, types.DefaultParams().DisputeBond),
+		},
+		{
+			desc:   "resolution threshold of the minimum public keys",
+			params: types.NewParams([]string{params.DefaultBondDenom}, 0, 0, 4, types.DefaultParams().DisputeBond),
+			valid:  true,
+		},
+		{
+			desc:   "too large abort grace period",
+			params: types.NewParams([]string{params.DefaultBondDenom}, math.MaxUint64, 0, 0, types.DefaultParams().DisputeBond),
+		},
+		{
+			desc:   "too large dispute period",
+			params: types.NewParams([]string{params.DefaultBondDenom}, 0, math.MaxUint64, 0, types.DefaultParams().DisputeBond),
+		},
+		{
+			desc:   "resolution threshold more than the minimum public keys",
+			params: types.NewParams([]string{params.DefaultBondDenom}, 0, 0, 5, types.DefaultParams().DisputeBond),
+		},
+		{
+			desc:   "zero dispute bond",
+			params: types.NewParams([]string{params.DefaultBondDenom}, 0, 0, 0, sdkmath.ZeroInt()),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsIsDenomAllowed(t *testing.T) {
+	p := types.DefaultParams()
+	require.True(t, p.IsDenomAllowed(params.DefaultBondDenom))
+	require.False(t, p.IsDenomAllowed("uatom"))
+}
+
+func TestParamsIsThresholdResolution(t *testing.T) {
+	p := types.DefaultParams()
+	require.False(t, p.IsThresholdResolution())
+
+	// the single attestation is the leader resolution
+	p.ResolutionThreshold = 1
+	require.False(t, p.IsThresholdResolution())
+
+	p.ResolutionThreshold = 2
+	require.True(t, p.IsThresholdResolution())
+}
+
+func TestParamsIsAbortDue(t *testing.T) {
+	p := types.NewParams([]string{params.DefaultBondDenom}, 100, 0, 0, types.DefaultParams().DisputeBond)
+	require.False(t, p.IsAbortDue(1000, 1099))
+	require.True(t, p.IsAbortDue(1000, 1100))
+
+	// zero grace period disables the automatic abort
+	p.AbortGracePeriod = 0
+	require.False(t, p.IsAbortDue(1000, 5000))
+}
@@ -409,667 +409,3268 @@ func (m *QueryMarketsByUIDsResponse) GetFailedMarkets() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "sgenetwork.sge.market.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "sgenetwork.sge.market.QueryParamsResponse")
-	proto.RegisterType((*QueryMarketRequest)(nil), "sgenetwork.sge.market.QueryMarketRequest")
-	proto.RegisterType((*QueryMarketResponse)(nil), "sgenetwork.sge.market.QueryMarketResponse")
-	proto.RegisterType((*QueryMarketsRequest)(nil), "sgenetwork.sge.market.QueryMarketsRequest")
-	proto.RegisterType((*QueryMarketsResponse)(nil), "sgenetwork.sge.market.QueryMarketsResponse")
-	proto.RegisterType((*QueryMarketsByUIDsRequest)(nil), "sgenetwork.sge.market.QueryMarketsByUIDsRequest")
-	proto.RegisterType((*QueryMarketsByUIDsResponse)(nil), "sgenetwork.sge.market.QueryMarketsByUIDsResponse")
+// QueryFixtureRequest is the request type for the
+// Query/Fixture RPC method.
+type QueryFixtureRequest struct {
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 }
 
-func init() { proto.RegisterFile("sge/market/query.proto", fileDescriptor_a0102cd07774feff) }
-
-var fileDescriptor_a0102cd07774feff = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0xb3, 0x6d, 0x4c, 0xed, 0x48, 0x45, 0x5f, 0x52, 0xb5, 0x6b, 0xdd, 0xca, 0xa2, 0xd5,
-	0x46, 0x9c, 0x31, 0xf5, 0x24, 0xe2, 0x25, 0x8a, 0xe2, 0x41, 0xa8, 0x0b, 0x5e, 0x04, 0x09, 0x93,
-	0x66, 0x1c, 0x97, 0x36, 0x3b, 0xdb, 0x9d, 0x5d, 0x35, 0x96, 0x22, 0xf4, 0x13, 0x08, 0x5e, 0x3d,
-	0x78, 0xf0, 0xc3, 0xf4, 0x58, 0xf0, 0xe2, 0xa9, 0x48, 0xe2, 0xc9, 0x4f, 0x21, 0x3b, 0x33, 0x9b,
-	0x66, 0xc9, 0xda, 0x26, 0x97, 0x76, 0x33, 0xf3, 0x7f, 0xff, 0xff, 0xef, 0xbd, 0xbc, 0x2c, 0xba,
-	0x24, 0x39, 0x23, 0x5d, 0x1a, 0x6d, 0xb1, 0x98, 0xec, 0x24, 0x2c, 0xea, 0xe1, 0x30, 0x12, 0xb1,
-	0x80, 0x45, 0xc9, 0x59, 0xc0, 0xe2, 0x0f, 0x22, 0xda, 0xc2, 0x92, 0x33, 0xac, 0x25, 0x76, 0x8d,
-	0x0b, 0x2e, 0x94, 0x82, 0xa4, 0x4f, 0x5a, 0x6c, 0x2f, 0x73, 0x21, 0xf8, 0x36, 0x23, 0x34, 0xf4,
-	0x09, 0x0d, 0x02, 0x11, 0xd3, 0xd8, 0x17, 0x81, 0x34, 0xb7, 0xf5, 0x4d, 0x21, 0xbb, 0x42, 0x92,
-	0x36, 0x95, 0x4c, 0x67, 0x90, 0xf7, 0x8d, 0x36, 0x8b, 0x69, 0x83, 0x84, 0x94, 0xfb, 0x81, 0x12,
-	0x1b, 0xed, 0xe5, 0x11, 0x9c, 0x90, 0x46, 0xb4, 0x2b, 0x0b, 0x2e, 0xf4, 0x3f, 0x7d, 0xe1, 0xd6,
-	0x10, 0xbc, 0x4c, 0x3d, 0x37, 0x94, 0xda, 0x63, 0x3b, 0x09, 0x93, 0xb1, 0xeb, 0xa1, 0x6a, 0xee,
-	0x54, 0x86, 0x22, 0x90, 0x0c, 0x1e, 0xa2, 0x8a, 0x76, 0xbd, 0x62, 0x5d, 0xb7, 0x6e, 0x9f, 0x5b,
-	0xbf, 0x86, 0x0b, 0xdb, 0xc4, 0xba, 0xac, 0x59, 0x3e, 0x38, 0x5a, 0x29, 0x79, 0xa6, 0xc4, 0x5d,
-	0x35, 0x49, 0x2f, 0x94, 0xc6, 0x24, 0xc1, 0x05, 0x34, 0x9b, 0xf8, 0x1d, 0xe5, 0x37, 0xef, 0xa5,
-	0x8f, 0xc3, 0xec, 0x4c, 0x77, 0x9c, 0xad, 0xdd, 0x4f, 0xc9, 0xd6, 0x65, 0x59, 0xb6, 0x3e, 0x74,
-	0xdf, 0xe4, 0x3c, 0xb3, 0x36, 0xe1, 0x29, 0x42, 0xc7, 0x23, 0x34, 0xbe, 0xab, 0x58, 0xcf, 0x1b,
-	0xa7, 0xf3, 0xc6, 0xfa, 0x3b, 0x35, 0xf3, 0xc6, 0x1b, 0x94, 0x33, 0x53, 0xeb, 0x8d, 0x54, 0xba,
-	0xdf, 0x2c, 0x54, 0xcb, 0xfb, 0x17, 0x40, 0xcf, 0x4e, 0x09, 0x0d, 0xcf, 0x72, 0x74, 0x33, 0x8a,
-	0xee, 0xd6, 0xa9, 0x74, 0x3a, 0x39, 0x87, 0xf7, 0x00, 0x2d, 0x8d, 0xd2, 0x35, 0x7b, 0xaf, 0x9e,
-	0x3f, 0x19, 0xce, 0x60, 0x19, 0x95, 0x13, 0xbf, 0x23, 0x15, 0xe0, 0x7c, 0xf3, 0xec, 0xdf, 0xa3,
-	0x15, 0xf5, 0xd9, 0x53, 0x7f, 0xdd, 0x7d, 0x0b, 0xd9, 0x45, 0xb5, 0xa6, 0xbf, 0x47, 0x68, 0x4e,
-	0xc3, 0xca, 0x69, 0x1a, 0xcc, 0x6a, 0xe0, 0x26, 0x3a, 0xff, 0x96, 0xfa, 0xdb, 0xac, 0xd3, 0xca,
-	0x5c, 0x66, 0x52, 0x0a, 0x6f, 0x41, 0x9f, 0x9a, 0xcc, 0xf5, 0x1f, 0x65, 0x74, 0x46, 0x41, 0xc0,
-	0x2e, 0xaa, 0xe8, 0xdd, 0x82, 0xb5, 0xff, 0x04, 0x8d, 0x2f, 0xb3, 0x5d, 0x9f, 0x44, 0xaa, 0x1b,
-	0x72, 0xed, 0xfd, 0x9f, 0x7f, 0xbe, 0xce, 0xd4, 0x00, 0xc8, 0xd8, 0x2f, 0x09, 0x3e, 0xa1, 0x8a,
-	0x26, 0x3a, 0x39, 0x3c, 0xb7, 0xdf, 0x27, 0x87, 0xe7, 0x57, 0xdc, 0x5d, 0x52, 0xe1, 0x55, 0xb8,
-	0x38, 0x1a, 0xbe, 0x9b, 0xf8, 0x9d, 0x3d, 0xf8, 0x8c, 0xe6, 0xcc, 0x34, 0x60, 0x02, 0xc7, 0x61,
-	0xeb, 0x77, 0x26, 0xd2, 0x9a, 0xf8, 0xab, 0x2a, 0x7e, 0x11, 0xaa, 0x64, 0xec, 0x65, 0x21, 0xe1,
-	0xbb, 0x85, 0x16, 0x72, 0x3b, 0x00, 0xf7, 0x26, 0xf0, 0xce, 0xad, 0x9a, 0xdd, 0x98, 0xa2, 0xc2,
-	0x30, 0xd5, 0x15, 0xd3, 0x0d, 0x70, 0x0b, 0x98, 0x5a, 0xed, 0x5e, 0x2b, 0x5d, 0x52, 0x35, 0x22,
-	0xb9, 0xd7, 0x7c, 0x7c, 0xd0, 0x77, 0xac, 0xc3, 0xbe, 0x63, 0xfd, 0xee, 0x3b, 0xd6, 0x97, 0x81,
-	0x53, 0x3a, 0x1c, 0x38, 0xa5, 0x5f, 0x03, 0xa7, 0xf4, 0x7a, 0x8d, 0xfb, 0xf1, 0xbb, 0xa4, 0x8d,
-	0x37, 0x45, 0x37, 0xf5, 0xb9, 0x6b, 0x18, 0x94, 0xe7, 0xc7, 0xcc, 0x35, 0xee, 0x85, 0x4c, 0xb6,
-	0x2b, 0xea, 0xb5, 0x78, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0xba, 0x42, 0xd2, 0xd9,
-	0x05, 0x00, 0x00,
+func (m *QueryFixtureRequest) Reset()         { *m = QueryFixtureRequest{} }
+func (m *QueryFixtureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFixtureRequest) ProtoMessage()    {}
+func (*QueryFixtureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{8}
+}
+func (m *QueryFixtureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFixtureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixtureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFixtureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixtureRequest.Merge(m, src)
+}
+func (m *QueryFixtureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFixtureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixtureRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryFixtureRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a market by uid.
-	Market(ctx context.Context, in *QueryMarketRequest, opts ...grpc.CallOption) (*QueryMarketResponse, error)
-	// Queries a list of all the markets
-	Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
-	// Queries a list of markets by UIDs.
-	MarketsByUIDs(ctx context.Context, in *QueryMarketsByUIDsRequest, opts ...grpc.CallOption) (*QueryMarketsByUIDsResponse, error)
+func (m *QueryFixtureRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryFixtureResponse is the response type for the
+// Query/Fixture RPC method.
+type QueryFixtureResponse struct {
+	Fixture Fixture `protobuf:"bytes,1,opt,name=fixture,proto3" json:"fixture"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFixtureResponse) Reset()         { *m = QueryFixtureResponse{} }
+func (m *QueryFixtureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFixtureResponse) ProtoMessage()    {}
+func (*QueryFixtureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{9}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFixtureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFixtureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixtureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFixtureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixtureResponse.Merge(m, src)
+}
+func (m *QueryFixtureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFixtureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixtureResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Market(ctx context.Context, in *QueryMarketRequest, opts ...grpc.CallOption) (*QueryMarketResponse, error) {
-	out := new(QueryMarketResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/Market", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFixtureResponse proto.InternalMessageInfo
+
+func (m *QueryFixtureResponse) GetFixture() Fixture {
+	if m != nil {
+		return m.Fixture
 	}
-	return out, nil
+	return Fixture{}
 }
 
-func (c *queryClient) Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error) {
-	out := new(QueryMarketsResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/Markets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryFixturesRequest is the request type for the
+// Query/Fixtures RPC method.
+type QueryFixturesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) MarketsByUIDs(ctx context.Context, in *QueryMarketsByUIDsRequest, opts ...grpc.CallOption) (*QueryMarketsByUIDsResponse, error) {
-	out := new(QueryMarketsByUIDsResponse)
-	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/MarketsByUIDs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFixturesRequest) Reset()         { *m = QueryFixturesRequest{} }
+func (m *QueryFixturesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFixturesRequest) ProtoMessage()    {}
+func (*QueryFixturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{10}
+}
+func (m *QueryFixturesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFixturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixturesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFixturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixturesRequest.Merge(m, src)
+}
+func (m *QueryFixturesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFixturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixturesRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a market by uid.
-	Market(context.Context, *QueryMarketRequest) (*QueryMarketResponse, error)
-	// Queries a list of all the markets
-	Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
-	// Queries a list of markets by UIDs.
-	MarketsByUIDs(context.Context, *QueryMarketsByUIDsRequest) (*QueryMarketsByUIDsResponse, error)
+var xxx_messageInfo_QueryFixturesRequest proto.InternalMessageInfo
+
+func (m *QueryFixturesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryFixturesResponse is the response type for the
+// Query/Fixtures RPC method.
+type QueryFixturesResponse struct {
+	Fixtures   []Fixture           `protobuf:"bytes,1,rep,name=fixtures,proto3" json:"fixtures"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryFixturesResponse) Reset()         { *m = QueryFixturesResponse{} }
+func (m *QueryFixturesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFixturesResponse) ProtoMessage()    {}
+func (*QueryFixturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{11}
 }
-func (*UnimplementedQueryServer) Market(ctx context.Context, req *QueryMarketRequest) (*QueryMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+func (m *QueryFixturesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Markets(ctx context.Context, req *QueryMarketsRequest) (*QueryMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+func (m *QueryFixturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixturesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) MarketsByUIDs(ctx context.Context, req *QueryMarketsByUIDsRequest) (*QueryMarketsByUIDsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketsByUIDs not implemented")
+func (m *QueryFixturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixturesResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryFixturesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFixturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixturesResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.market.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryFixturesResponse proto.InternalMessageInfo
+
+func (m *QueryFixturesResponse) GetFixtures() []Fixture {
+	if m != nil {
+		return m.Fixtures
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Market(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.market.Query/Market",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Market(ctx, req.(*QueryMarketRequest))
+func (m *QueryFixturesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Markets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.market.Query/Markets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Markets(ctx, req.(*QueryMarketsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryFixtureMarketsRequest is the request type for the
+// Query/FixtureMarkets RPC method.
+type QueryFixtureMarketsRequest struct {
+	FixtureUid string             `protobuf:"bytes,1,opt,name=fixture_uid,json=fixtureUid,proto3" json:"fixture_uid,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_MarketsByUIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarketsByUIDsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MarketsByUIDs(ctx, in)
+func (m *QueryFixtureMarketsRequest) Reset()         { *m = QueryFixtureMarketsRequest{} }
+func (m *QueryFixtureMarketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFixtureMarketsRequest) ProtoMessage()    {}
+func (*QueryFixtureMarketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{12}
+}
+func (m *QueryFixtureMarketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFixtureMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixtureMarketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sgenetwork.sge.market.Query/MarketsByUIDs",
+}
+func (m *QueryFixtureMarketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixtureMarketsRequest.Merge(m, src)
+}
+func (m *QueryFixtureMarketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFixtureMarketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixtureMarketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFixtureMarketsRequest proto.InternalMessageInfo
+
+func (m *QueryFixtureMarketsRequest) GetFixtureUid() string {
+	if m != nil {
+		return m.FixtureUid
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MarketsByUIDs(ctx, req.(*QueryMarketsByUIDsRequest))
+	return ""
+}
+
+func (m *QueryFixtureMarketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sgenetwork.sge.market.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Market",
-			Handler:    _Query_Market_Handler,
-		},
-		{
-			MethodName: "Markets",
-			Handler:    _Query_Markets_Handler,
-		},
-		{
-			MethodName: "MarketsByUIDs",
-			Handler:    _Query_MarketsByUIDs_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sge/market/query.proto",
+// QueryFixtureMarketsResponse is the response type for the
+// Query/FixtureMarkets RPC method.
+type QueryFixtureMarketsResponse struct {
+	Markets    []Market            `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFixtureMarketsResponse) Reset()         { *m = QueryFixtureMarketsResponse{} }
+func (m *QueryFixtureMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFixtureMarketsResponse) ProtoMessage()    {}
+func (*QueryFixtureMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{13}
+}
+func (m *QueryFixtureMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFixtureMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFixtureMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFixtureMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFixtureMarketsResponse.Merge(m, src)
+}
+func (m *QueryFixtureMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFixtureMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFixtureMarketsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryFixtureMarketsResponse proto.InternalMessageInfo
+
+func (m *QueryFixtureMarketsResponse) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFixtureMarketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingResolutionRequest is the request type for the
+// Query/PendingResolution RPC method.
+type QueryPendingResolutionRequest struct {
+	MarketUid string `protobuf:"bytes,1,opt,name=market_uid,json=marketUid,proto3" json:"market_uid,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingResolutionRequest) Reset()         { *m = QueryPendingResolutionRequest{} }
+func (m *QueryPendingResolutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingResolutionRequest) ProtoMessage()    {}
+func (*QueryPendingResolutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{14}
+}
+func (m *QueryPendingResolutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingResolutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingResolutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingResolutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingResolutionRequest.Merge(m, src)
+}
+func (m *QueryPendingResolutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingResolutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingResolutionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMarketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingResolutionRequest proto.InternalMessageInfo
+
+func (m *QueryPendingResolutionRequest) GetMarketUid() string {
+	if m != nil {
+		return m.MarketUid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryMarketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingResolutionResponse is the response type for the
+// Query/PendingResolution RPC method.
+type QueryPendingResolutionResponse struct {
+	PendingResolution PendingResolution `protobuf:"bytes,1,opt,name=pending_resolution,json=pendingResolution,proto3" json:"pending_resolution"`
 }
 
-func (m *QueryMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uid) > 0 {
-		i -= len(m.Uid)
-		copy(dAtA[i:], m.Uid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uid)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPendingResolutionResponse) Reset()         { *m = QueryPendingResolutionResponse{} }
+func (m *QueryPendingResolutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingResolutionResponse) ProtoMessage()    {}
+func (*QueryPendingResolutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{15}
+}
+func (m *QueryPendingResolutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingResolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingResolutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingResolutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingResolutionResponse.Merge(m, src)
+}
+func (m *QueryPendingResolutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingResolutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingResolutionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingResolutionResponse proto.InternalMessageInfo
+
+func (m *QueryPendingResolutionResponse) GetPendingResolution() PendingResolution {
+	if m != nil {
+		return m.PendingResolution
 	}
-	return dAtA[:n], nil
+	return PendingResolution{}
 }
 
-func (m *QueryMarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingResolutionsRequest is the request type for the
+// Query/PendingResolutions RPC method.
+type QueryPendingResolutionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingResolutionsRequest) Reset()         { *m = QueryPendingResolutionsRequest{} }
+func (m *QueryPendingResolutionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingResolutionsRequest) ProtoMessage()    {}
+func (*QueryPendingResolutionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{16}
+}
+func (m *QueryPendingResolutionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingResolutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingResolutionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingResolutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingResolutionsRequest.Merge(m, src)
+}
+func (m *QueryPendingResolutionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingResolutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingResolutionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMarketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingResolutionsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingResolutionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingResolutionsResponse is the response type for the
+// Query/PendingResolutions RPC method.
+type QueryPendingResolutionsResponse struct {
+	PendingResolutions []PendingResolution `protobuf:"bytes,1,rep,name=pending_resolutions,json=pendingResolutions,proto3" json:"pending_resolutions"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPendingResolutionsResponse) Reset()         { *m = QueryPendingResolutionsResponse{} }
+func (m *QueryPendingResolutionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingResolutionsResponse) ProtoMessage()    {}
+func (*QueryPendingResolutionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{17}
+}
+func (m *QueryPendingResolutionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingResolutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingResolutionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingResolutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingResolutionsResponse.Merge(m, src)
+}
+func (m *QueryPendingResolutionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingResolutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingResolutionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingResolutionsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingResolutionsResponse) GetPendingResolutions() []PendingResolution {
+	if m != nil {
+		return m.PendingResolutions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingResolutionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryResolutionAttestationsRequest is the request type for the
+// Query/ResolutionAttestations RPC method.
+type QueryResolutionAttestationsRequest struct {
+	MarketUid  string             `protobuf:"bytes,1,opt,name=market_uid,json=marketUid,proto3" json:"market_uid,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryResolutionAttestationsRequest) Reset()         { *m = QueryResolutionAttestationsRequest{} }
+func (m *QueryResolutionAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolutionAttestationsRequest) ProtoMessage()    {}
+func (*QueryResolutionAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{18}
+}
+func (m *QueryResolutionAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolutionAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolutionAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Market) > 0 {
-		for iNdEx := len(m.Market) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Market[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryResolutionAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolutionAttestationsRequest.Merge(m, src)
+}
+func (m *QueryResolutionAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolutionAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolutionAttestationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolutionAttestationsRequest proto.InternalMessageInfo
+
+func (m *QueryResolutionAttestationsRequest) GetMarketUid() string {
+	if m != nil {
+		return m.MarketUid
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryMarketsByUIDsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResolutionAttestationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMarketsByUIDsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryResolutionAttestationsResponse is the response type for the
+// Query/ResolutionAttestations RPC method.
+type QueryResolutionAttestationsResponse struct {
+	ResolutionAttestations []ResolutionAttestation `protobuf:"bytes,1,rep,name=resolution_attestations,json=resolutionAttestations,proto3" json:"resolution_attestations"`
+	Pagination             *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMarketsByUIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uids) > 0 {
-		for iNdEx := len(m.Uids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Uids[iNdEx])
-			copy(dAtA[i:], m.Uids[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Uids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryResolutionAttestationsResponse) Reset()         { *m = QueryResolutionAttestationsResponse{} }
+func (m *QueryResolutionAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolutionAttestationsResponse) ProtoMessage()    {}
+func (*QueryResolutionAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0102cd07774feff, []int{19}
+}
+func (m *QueryResolutionAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolutionAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolutionAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryResolutionAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolutionAttestationsResponse.Merge(m, src)
+}
+func (m *QueryResolutionAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolutionAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolutionAttestationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMarketsByUIDsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryResolutionAttestationsResponse proto.InternalMessageInfo
+
+func (m *QueryResolutionAttestationsResponse) GetResolutionAttestations() []ResolutionAttestation {
+	if m != nil {
+		return m.ResolutionAttestations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMarketsByUIDsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResolutionAttestationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryMarketsByUIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FailedMarkets) > 0 {
-		for iNdEx := len(m.FailedMarkets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FailedMarkets[iNdEx])
-			copy(dAtA[i:], m.FailedMarkets[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.FailedMarkets[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "sgenetwork.sge.market.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "sgenetwork.sge.market.QueryParamsResponse")
+	proto.RegisterType((*QueryMarketRequest)(nil), "sgenetwork.sge.market.QueryMarketRequest")
+	proto.RegisterType((*QueryMarketResponse)(nil), "sgenetwork.sge.market.QueryMarketResponse")
+	proto.RegisterType((*QueryMarketsRequest)(nil), "sgenetwork.sge.market.QueryMarketsRequest")
+	proto.RegisterType((*QueryMarketsResponse)(nil), "sgenetwork.sge.market.QueryMarketsResponse")
+	proto.RegisterType((*QueryMarketsByUIDsRequest)(nil), "sgenetwork.sge.market.QueryMarketsByUIDsRequest")
+	proto.RegisterType((*QueryMarketsByUIDsResponse)(nil), "sgenetwork.sge.market.QueryMarketsByUIDsResponse")
+	proto.RegisterType((*QueryFixtureRequest)(nil), "sgenetwork.sge.market.QueryFixtureRequest")
+	proto.RegisterType((*QueryFixtureResponse)(nil), "sgenetwork.sge.market.QueryFixtureResponse")
+	proto.RegisterType((*QueryFixturesRequest)(nil), "sgenetwork.sge.market.QueryFixturesRequest")
+	proto.RegisterType((*QueryFixturesResponse)(nil), "sgenetwork.sge.market.QueryFixturesResponse")
+	proto.RegisterType((*QueryFixtureMarketsRequest)(nil), "sgenetwork.sge.market.QueryFixtureMarketsRequest")
+	proto.RegisterType((*QueryFixtureMarketsResponse)(nil), "sgenetwork.sge.market.QueryFixtureMarketsResponse")
+	proto.RegisterType((*QueryPendingResolutionRequest)(nil), "sgenetwork.sge.market.QueryPendingResolutionRequest")
+	proto.RegisterType((*QueryPendingResolutionResponse)(nil), "sgenetwork.sge.market.QueryPendingResolutionResponse")
+	proto.RegisterType((*QueryPendingResolutionsRequest)(nil), "sgenetwork.sge.market.QueryPendingResolutionsRequest")
+	proto.RegisterType((*QueryPendingResolutionsResponse)(nil), "sgenetwork.sge.market.QueryPendingResolutionsResponse")
+	proto.RegisterType((*QueryResolutionAttestationsRequest)(nil), "sgenetwork.sge.market.QueryResolutionAttestationsRequest")
+	proto.RegisterType((*QueryResolutionAttestationsResponse)(nil), "sgenetwork.sge.market.QueryResolutionAttestationsResponse")
+}
+
+func init() { proto.RegisterFile("sge/market/query.proto", fileDescriptor_a0102cd07774feff) }
+
+var fileDescriptor_a0102cd07774feff = []byte{
+	// 1030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x55,
+	0x10, 0xc7, 0xf3, 0xd2, 0x92, 0x1f, 0x53, 0x5a, 0x91, 0x89, 0x93, 0xb6, 0x9b, 0xc4, 0x86, 0x05,
+	0x9a, 0xd6, 0x0d, 0xbb, 0x8d, 0x29, 0x88, 0x82, 0xa8, 0x20, 0xa0, 0x20, 0x0e, 0x95, 0x82, 0xa5,
+	0x70, 0x40, 0x2a, 0xd6, 0xba, 0x7e, 0xdd, 0xae, 0x92, 0x78, 0x5d, 0xbf, 0x35, 0x34, 0x44, 0x56,
+	0xa5, 0x22, 0xb8, 0x70, 0x41, 0x42, 0xdc, 0x38, 0x20, 0x24, 0x4e, 0x70, 0xe6, 0x6f, 0x28, 0xe2,
+	0x52, 0x89, 0x0b, 0x12, 0x52, 0x85, 0x12, 0x4e, 0xfc, 0x15, 0xc8, 0xef, 0xcd, 0x7a, 0x77, 0xbd,
+	0xbb, 0xce, 0x6e, 0xb4, 0x97, 0xc4, 0xde, 0x37, 0x33, 0xdf, 0xcf, 0xcc, 0x9b, 0x7d, 0x6f, 0x0c,
+	0x8b, 0xc2, 0xe6, 0xe6, 0x9e, 0xd5, 0xdd, 0xe1, 0x9e, 0x79, 0xbf, 0xc7, 0xbb, 0xfb, 0x46, 0xa7,
+	0xeb, 0x7a, 0x2e, 0x2e, 0x08, 0x9b, 0xb7, 0xb9, 0xf7, 0xb9, 0xdb, 0xdd, 0x31, 0x84, 0xcd, 0x0d,
+	0x65, 0xa2, 0x95, 0x6c, 0xd7, 0x76, 0xa5, 0x85, 0x39, 0xf8, 0xa4, 0x8c, 0xb5, 0x65, 0xdb, 0x75,
+	0xed, 0x5d, 0x6e, 0x5a, 0x1d, 0xc7, 0xb4, 0xda, 0x6d, 0xd7, 0xb3, 0x3c, 0xc7, 0x6d, 0x0b, 0x5a,
+	0xad, 0xde, 0x71, 0xc5, 0x9e, 0x2b, 0xcc, 0xa6, 0x25, 0xb8, 0xd2, 0x30, 0x3f, 0x5b, 0x6f, 0x72,
+	0xcf, 0x5a, 0x37, 0x3b, 0x96, 0xed, 0xb4, 0xa5, 0x31, 0xd9, 0x9e, 0x0f, 0xe1, 0x74, 0xac, 0xae,
+	0xb5, 0x27, 0x12, 0x16, 0xd4, 0x3f, 0x5a, 0xb8, 0x10, 0x5a, 0xb8, 0xeb, 0x3c, 0xf0, 0x7a, 0x5d,
+	0x4e, 0x2b, 0x4b, 0xa1, 0x95, 0x2e, 0x17, 0xee, 0x6e, 0x2f, 0x10, 0xd2, 0x4b, 0x80, 0x1f, 0x0d,
+	0x50, 0xb6, 0xa4, 0x48, 0x9d, 0xdf, 0xef, 0x71, 0xe1, 0xe9, 0x75, 0x98, 0x8f, 0x3c, 0x15, 0x1d,
+	0xb7, 0x2d, 0x38, 0xbe, 0x05, 0x53, 0x0a, 0xe6, 0x02, 0x7b, 0x9e, 0x5d, 0x3e, 0x53, 0x5b, 0x31,
+	0x12, 0xab, 0x63, 0x28, 0xb7, 0x8d, 0xd3, 0x8f, 0x9f, 0x56, 0x26, 0xea, 0xe4, 0xa2, 0x5f, 0x22,
+	0xa5, 0x5b, 0xd2, 0x86, 0x94, 0xf0, 0x39, 0x38, 0xd5, 0x73, 0x5a, 0x32, 0xde, 0x6c, 0x7d, 0xf0,
+	0x71, 0xa8, 0xed, 0xdb, 0x05, 0xda, 0x2a, 0xfa, 0x31, 0xda, 0xca, 0xcd, 0xd7, 0x56, 0x0f, 0xf5,
+	0xdb, 0x91, 0x98, 0x7e, 0x9a, 0xb8, 0x09, 0x10, 0x54, 0x9e, 0xe2, 0x5e, 0x32, 0xd4, 0x36, 0x19,
+	0x83, 0x6d, 0x32, 0x54, 0x2b, 0xd0, 0x36, 0x19, 0x5b, 0x96, 0xcd, 0xc9, 0xb7, 0x1e, 0xf2, 0xd4,
+	0x7f, 0x60, 0x50, 0x8a, 0xc6, 0x4f, 0x80, 0x3e, 0x95, 0x13, 0x1a, 0x3f, 0x88, 0xd0, 0x4d, 0x4a,
+	0xba, 0xd5, 0x63, 0xe9, 0x94, 0x72, 0x04, 0xef, 0x06, 0x5c, 0x0c, 0xd3, 0x6d, 0xec, 0x6f, 0x7f,
+	0xf8, 0xfe, 0xb0, 0x06, 0xcb, 0x70, 0xba, 0xe7, 0xb4, 0x84, 0x04, 0x9c, 0xdd, 0x98, 0xf9, 0xef,
+	0x69, 0x45, 0x7e, 0xaf, 0xcb, 0xbf, 0xfa, 0x23, 0x06, 0x5a, 0x92, 0x2f, 0xe5, 0xf7, 0x36, 0x4c,
+	0x2b, 0x58, 0x91, 0x27, 0x41, 0xdf, 0x07, 0x5f, 0x86, 0x73, 0x77, 0x2d, 0x67, 0x97, 0xb7, 0x1a,
+	0x7e, 0x94, 0xc9, 0x01, 0x45, 0xfd, 0xac, 0x7a, 0x4a, 0x9a, 0xfa, 0x2a, 0xed, 0xde, 0xa6, 0x6a,
+	0xeb, 0xf4, 0xd6, 0xf9, 0x18, 0x4a, 0x51, 0x43, 0xc2, 0xbc, 0x09, 0xd3, 0xf4, 0x4a, 0xd0, 0x26,
+	0x97, 0x53, 0x30, 0xc9, 0xd1, 0xe7, 0x24, 0x27, 0xfd, 0xd3, 0x68, 0xdc, 0xc2, 0xfb, 0xe7, 0x27,
+	0x06, 0x0b, 0x23, 0x02, 0x44, 0xfe, 0x0e, 0xcc, 0x10, 0x84, 0x5f, 0xe1, 0x6c, 0xe8, 0x43, 0xaf,
+	0xe2, 0xba, 0xe8, 0x2b, 0xbf, 0x15, 0x48, 0x69, 0xe4, 0x5d, 0xaa, 0xc0, 0x19, 0xd2, 0x6c, 0x04,
+	0xbb, 0x02, 0xf4, 0x68, 0xdb, 0x69, 0xe1, 0x66, 0x02, 0xc8, 0x49, 0x8a, 0xf5, 0x33, 0x83, 0xa5,
+	0x44, 0x8e, 0x62, 0x7a, 0xb2, 0xb0, 0x7a, 0xdd, 0x84, 0x15, 0x75, 0x86, 0xf2, 0x76, 0xcb, 0x69,
+	0xdb, 0xf5, 0xe1, 0xc9, 0xeb, 0x57, 0x6c, 0x05, 0x40, 0x89, 0x86, 0x0a, 0x36, 0xab, 0x9e, 0x6c,
+	0x3b, 0x2d, 0xfd, 0x21, 0x94, 0xd3, 0xfc, 0x29, 0xd3, 0xdb, 0x80, 0x1d, 0xb5, 0xd8, 0x08, 0xce,
+	0x75, 0x6a, 0xc3, 0xcb, 0x69, 0x47, 0xf3, 0x68, 0x34, 0xca, 0x7f, 0xae, 0x33, 0xba, 0xa0, 0xdf,
+	0x4b, 0x03, 0x28, 0xbc, 0xff, 0xff, 0x60, 0x50, 0x49, 0x95, 0xa2, 0x64, 0x1b, 0x30, 0x1f, 0x4f,
+	0xd6, 0xdf, 0xe2, 0xbc, 0xd9, 0x62, 0x2c, 0xdb, 0x02, 0x37, 0xfe, 0x1b, 0x06, 0xba, 0xcc, 0x26,
+	0x88, 0xfe, 0xae, 0xe7, 0x71, 0x41, 0xd3, 0x40, 0xb6, 0xed, 0x2f, 0xec, 0x75, 0xf9, 0x9b, 0xc1,
+	0x8b, 0x63, 0x69, 0xa8, 0xbe, 0x3b, 0x70, 0x3e, 0xa8, 0x6b, 0xc3, 0x0a, 0x99, 0x50, 0x8d, 0xd7,
+	0x52, 0x6a, 0x9c, 0x18, 0x97, 0xea, 0xbc, 0xd8, 0x4d, 0x14, 0x2d, 0xac, 0xd6, 0xb5, 0xef, 0x9f,
+	0x85, 0x67, 0x64, 0x76, 0x78, 0x00, 0x53, 0x6a, 0xec, 0xc0, 0x2b, 0x29, 0xa0, 0xf1, 0x39, 0x47,
+	0xab, 0x66, 0x31, 0x55, 0xb2, 0xba, 0xf6, 0xe8, 0xcf, 0x7f, 0xbf, 0x9b, 0x2c, 0x21, 0x9a, 0xb1,
+	0xd9, 0x0c, 0xbf, 0x80, 0x29, 0x75, 0x9a, 0x8c, 0x17, 0x8f, 0x8c, 0x3e, 0x5a, 0x35, 0x8b, 0x29,
+	0x89, 0x5f, 0x94, 0xe2, 0xf3, 0x38, 0x17, 0x16, 0x3f, 0xe8, 0x39, 0xad, 0x3e, 0x3e, 0x84, 0xe9,
+	0x5b, 0x74, 0x76, 0x65, 0x88, 0x38, 0x4c, 0xfd, 0x6a, 0x26, 0x5b, 0x92, 0x5f, 0x92, 0xf2, 0x0b,
+	0x38, 0x6f, 0xc6, 0xc6, 0x4f, 0x81, 0x3f, 0x32, 0x38, 0x1b, 0x19, 0x0f, 0xf0, 0x5a, 0x86, 0xd8,
+	0x91, 0x29, 0x44, 0x5b, 0xcf, 0xe1, 0x41, 0x4c, 0x55, 0xc9, 0xf4, 0x12, 0xea, 0x09, 0x4c, 0x8d,
+	0xe6, 0xfe, 0xe0, 0xad, 0x12, 0xb2, 0x44, 0xa2, 0x8f, 0x5f, 0x33, 0x98, 0xa6, 0xeb, 0x62, 0x7c,
+	0x91, 0xa2, 0x23, 0x86, 0x76, 0x35, 0x93, 0x2d, 0x01, 0xe9, 0x12, 0x68, 0x19, 0x35, 0x33, 0x3e,
+	0x8a, 0x0b, 0xda, 0xac, 0x2f, 0x19, 0xcc, 0x90, 0x9f, 0xc0, 0x2c, 0xd1, 0x87, 0x15, 0x5a, 0xcb,
+	0x66, 0x4c, 0x2c, 0xcb, 0x92, 0x65, 0x11, 0x4b, 0x49, 0x2c, 0xf8, 0x0b, 0x83, 0x73, 0xd1, 0xdb,
+	0x13, 0xd7, 0x33, 0x84, 0x1f, 0xe9, 0xa0, 0x5a, 0x1e, 0x17, 0xe2, 0xaa, 0x49, 0xae, 0x35, 0xac,
+	0x26, 0xd7, 0x28, 0x34, 0x41, 0xf4, 0x87, 0xfd, 0xf5, 0x1b, 0x83, 0xb9, 0xd8, 0x41, 0x8e, 0xd7,
+	0xc7, 0xbe, 0xba, 0x29, 0x77, 0xae, 0xf6, 0x5a, 0x4e, 0x2f, 0xc2, 0xbe, 0x2e, 0xb1, 0x0d, 0x5c,
+	0x8b, 0xbc, 0xfb, 0xf1, 0xeb, 0xc8, 0x3c, 0x08, 0x8e, 0xf4, 0x3e, 0xfe, 0xca, 0x00, 0xb7, 0xe2,
+	0x17, 0x4d, 0x3e, 0x86, 0x61, 0xb9, 0x5f, 0xcf, 0xeb, 0x46, 0xec, 0xab, 0x92, 0xfd, 0x05, 0xac,
+	0x1c, 0xc3, 0x8e, 0xbf, 0x33, 0x58, 0x4c, 0xbe, 0x24, 0xf0, 0xc6, 0x38, 0xed, 0xb1, 0xd7, 0x9c,
+	0xf6, 0xe6, 0x49, 0x5c, 0x09, 0xfd, 0x0d, 0x89, 0x5e, 0xc3, 0x6b, 0x66, 0xe2, 0x4f, 0xd8, 0xc8,
+	0x2d, 0x15, 0x29, 0xfd, 0xc6, 0x7b, 0x8f, 0x0f, 0xcb, 0xec, 0xc9, 0x61, 0x99, 0xfd, 0x73, 0x58,
+	0x66, 0xdf, 0x1e, 0x95, 0x27, 0x9e, 0x1c, 0x95, 0x27, 0xfe, 0x3a, 0x2a, 0x4f, 0x7c, 0x72, 0xc5,
+	0x76, 0xbc, 0x7b, 0xbd, 0xa6, 0x71, 0xc7, 0xdd, 0x1b, 0x44, 0x7d, 0x85, 0xd0, 0xa4, 0xc2, 0x03,
+	0x5f, 0xc3, 0xdb, 0xef, 0x70, 0xd1, 0x9c, 0x92, 0x3f, 0x91, 0x5f, 0xfd, 0x7f, 0x00, 0x41, 0xf4,
+	0x41, 0x68, 0x1c, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a market by uid.
+	Market(ctx context.Context, in *QueryMarketRequest, opts ...grpc.CallOption) (*QueryMarketResponse, error)
+	// Queries a list of all the markets
+	Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
+	// Queries a list of markets by UIDs.
+	MarketsByUIDs(ctx context.Context, in *QueryMarketsByUIDsRequest, opts ...grpc.CallOption) (*QueryMarketsByUIDsResponse, error)
+	// Queries a fixture by uid.
+	Fixture(ctx context.Context, in *QueryFixtureRequest, opts ...grpc.CallOption) (*QueryFixtureResponse, error)
+	// Queries a list of all the fixtures.
+	Fixtures(ctx context.Context, in *QueryFixturesRequest, opts ...grpc.CallOption) (*QueryFixturesResponse, error)
+	// Queries a list of the markets of a fixture.
+	FixtureMarkets(ctx context.Context, in *QueryFixtureMarketsRequest, opts ...grpc.CallOption) (*QueryFixtureMarketsResponse, error)
+	// Queries the pending resolution of a market.
+	PendingResolution(ctx context.Context, in *QueryPendingResolutionRequest, opts ...grpc.CallOption) (*QueryPendingResolutionResponse, error)
+	// Queries a list of all the pending resolutions.
+	PendingResolutions(ctx context.Context, in *QueryPendingResolutionsRequest, opts ...grpc.CallOption) (*QueryPendingResolutionsResponse, error)
+	// Queries a list of the resolution attestations of a market.
+	ResolutionAttestations(ctx context.Context, in *QueryResolutionAttestationsRequest, opts ...grpc.CallOption) (*QueryResolutionAttestationsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Market(ctx context.Context, in *QueryMarketRequest, opts ...grpc.CallOption) (*QueryMarketResponse, error) {
+	out := new(QueryMarketResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/Market", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error) {
+	out := new(QueryMarketsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/Markets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MarketsByUIDs(ctx context.Context, in *QueryMarketsByUIDsRequest, opts ...grpc.CallOption) (*QueryMarketsByUIDsResponse, error) {
+	out := new(QueryMarketsByUIDsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/MarketsByUIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryMarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Fixture(ctx context.Context, in *QueryFixtureRequest, opts ...grpc.CallOption) (*QueryFixtureResponse, error) {
+	out := new(QueryFixtureResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/Fixture", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Uid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Fixtures(ctx context.Context, in *QueryFixturesRequest, opts ...grpc.CallOption) (*QueryFixturesResponse, error) {
+	out := new(QueryFixturesResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/Fixtures", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FixtureMarkets(ctx context.Context, in *QueryFixtureMarketsRequest, opts ...grpc.CallOption) (*QueryFixtureMarketsResponse, error) {
+	out := new(QueryFixtureMarketsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/FixtureMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryMarketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PendingResolution(ctx context.Context, in *QueryPendingResolutionRequest, opts ...grpc.CallOption) (*QueryPendingResolutionResponse, error) {
+	out := new(QueryPendingResolutionResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/PendingResolution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) PendingResolutions(ctx context.Context, in *QueryPendingResolutionsRequest, opts ...grpc.CallOption) (*QueryPendingResolutionsResponse, error) {
+	out := new(QueryPendingResolutionsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/PendingResolutions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ResolutionAttestations(ctx context.Context, in *QueryResolutionAttestationsRequest, opts ...grpc.CallOption) (*QueryResolutionAttestationsResponse, error) {
+	out := new(QueryResolutionAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Query/ResolutionAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Market) > 0 {
-		for _, e := range m.Market {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a market by uid.
+	Market(context.Context, *QueryMarketRequest) (*QueryMarketResponse, error)
+	// Queries a list of all the markets
+	Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
+	// Queries a list of markets by UIDs.
+	MarketsByUIDs(context.Context, *QueryMarketsByUIDsRequest) (*QueryMarketsByUIDsResponse, error)
+	// Queries a fixture by uid.
+	Fixture(context.Context, *QueryFixtureRequest) (*QueryFixtureResponse, error)
+	// Queries a list of all the fixtures.
+	Fixtures(context.Context, *QueryFixturesRequest) (*QueryFixturesResponse, error)
+	// Queries a list of the markets of a fixture.
+	FixtureMarkets(context.Context, *QueryFixtureMarketsRequest) (*QueryFixtureMarketsResponse, error)
+	// Queries the pending resolution of a market.
+	PendingResolution(context.Context, *QueryPendingResolutionRequest) (*QueryPendingResolutionResponse, error)
+	// Queries a list of all the pending resolutions.
+	PendingResolutions(context.Context, *QueryPendingResolutionsRequest) (*QueryPendingResolutionsResponse, error)
+	// Queries a list of the resolution attestations of a market.
+	ResolutionAttestations(context.Context, *QueryResolutionAttestationsRequest) (*QueryResolutionAttestationsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Market(ctx context.Context, req *QueryMarketRequest) (*QueryMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+}
+func (*UnimplementedQueryServer) Markets(ctx context.Context, req *QueryMarketsRequest) (*QueryMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+}
+func (*UnimplementedQueryServer) MarketsByUIDs(ctx context.Context, req *QueryMarketsByUIDsRequest) (*QueryMarketsByUIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketsByUIDs not implemented")
+}
+func (*UnimplementedQueryServer) Fixture(ctx context.Context, req *QueryFixtureRequest) (*QueryFixtureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fixture not implemented")
+}
+func (*UnimplementedQueryServer) Fixtures(ctx context.Context, req *QueryFixturesRequest) (*QueryFixturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fixtures not implemented")
+}
+func (*UnimplementedQueryServer) FixtureMarkets(ctx context.Context, req *QueryFixtureMarketsRequest) (*QueryFixtureMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixtureMarkets not implemented")
+}
+func (*UnimplementedQueryServer) PendingResolution(ctx context.Context, req *QueryPendingResolutionRequest) (*QueryPendingResolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingResolution not implemented")
+}
+func (*UnimplementedQueryServer) PendingResolutions(ctx context.Context, req *QueryPendingResolutionsRequest) (*QueryPendingResolutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingResolutions not implemented")
+}
+func (*UnimplementedQueryServer) ResolutionAttestations(ctx context.Context, req *QueryResolutionAttestationsRequest) (*QueryResolutionAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolutionAttestations not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMarketsByUIDsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Market(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Query/Market",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Market(ctx, req.(*QueryMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Markets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Query/Markets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Markets(ctx, req.(*QueryMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketsByUIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketsByUIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketsByUIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Query/MarketsByUIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketsByUIDs(ctx, req.(*QueryMarketsByUIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Fixture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFixtureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Fixture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Query/Fixture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Fixture(ctx, req.(*QueryFixtureRequest))
 	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Fixtures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFixturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Fixtures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Query/Fixtures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Fixtures(ctx, req.(*QueryFixturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FixtureMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFixtureMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FixtureMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Query/FixtureMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FixtureMarkets(ctx, req.(*QueryFixtureMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingResolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingResolutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingResolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Query/PendingResolution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingResolution(ctx, req.(*QueryPendingResolutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingResolutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingResolutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingResolutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Query/PendingResolutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingResolutions(ctx, req.(*QueryPendingResolutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolutionAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolutionAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolutionAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Query/ResolutionAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolutionAttestations(ctx, req.(*QueryResolutionAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sgenetwork.sge.market.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Market",
+			Handler:    _Query_Market_Handler,
+		},
+		{
+			MethodName: "Markets",
+			Handler:    _Query_Markets_Handler,
+		},
+		{
+			MethodName: "MarketsByUIDs",
+			Handler:    _Query_MarketsByUIDs_Handler,
+		},
+		{
+			MethodName: "Fixture",
+			Handler:    _Query_Fixture_Handler,
+		},
+		{
+			MethodName: "Fixtures",
+			Handler:    _Query_Fixtures_Handler,
+		},
+		{
+			MethodName: "FixtureMarkets",
+			Handler:    _Query_FixtureMarkets_Handler,
+		},
+		{
+			MethodName: "PendingResolution",
+			Handler:    _Query_PendingResolution_Handler,
+		},
+		{
+			MethodName: "PendingResolutions",
+			Handler:    _Query_PendingResolutions_Handler,
+		},
+		{
+			MethodName: "ResolutionAttestations",
+			Handler:    _Query_ResolutionAttestations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sge/market/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Uids) > 0 {
-		for _, s := range m.Uids {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Market) > 0 {
+		for iNdEx := len(m.Market) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Market[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsByUIDsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsByUIDsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsByUIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uids) > 0 {
+		for iNdEx := len(m.Uids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Uids[iNdEx])
+			copy(dAtA[i:], m.Uids[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Uids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketsByUIDsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketsByUIDsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketsByUIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedMarkets) > 0 {
+		for iNdEx := len(m.FailedMarkets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedMarkets[iNdEx])
+			copy(dAtA[i:], m.FailedMarkets[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FailedMarkets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFixtureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFixtureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixtureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Uid) > 0 {
+		i -= len(m.Uid)
+		copy(dAtA[i:], m.Uid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Uid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFixtureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFixtureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixtureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fixture.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFixturesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFixturesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixturesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFixturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFixturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fixtures) > 0 {
+		for iNdEx := len(m.Fixtures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fixtures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFixtureMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFixtureMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixtureMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FixtureUid) > 0 {
+		i -= len(m.FixtureUid)
+		copy(dAtA[i:], m.FixtureUid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FixtureUid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFixtureMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFixtureMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFixtureMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingResolutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingResolutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingResolutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarketUid) > 0 {
+		i -= len(m.MarketUid)
+		copy(dAtA[i:], m.MarketUid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketUid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingResolutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingResolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingResolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingResolution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingResolutionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingResolutionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingResolutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingResolutionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingResolutionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingResolutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingResolutions) > 0 {
+		for iNdEx := len(m.PendingResolutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingResolutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolutionAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolutionAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolutionAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketUid) > 0 {
+		i -= len(m.MarketUid)
+		copy(dAtA[i:], m.MarketUid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketUid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolutionAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolutionAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolutionAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ResolutionAttestations) > 0 {
+		for iNdEx := len(m.ResolutionAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResolutionAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Market) > 0 {
+		for _, e := range m.Market {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketsByUIDsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Uids) > 0 {
+		for _, s := range m.Uids {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMarketsByUIDsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FailedMarkets) > 0 {
+		for _, s := range m.FailedMarkets {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFixtureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFixtureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fixture.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFixturesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFixturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fixtures) > 0 {
+		for _, e := range m.Fixtures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFixtureMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FixtureUid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFixtureMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingResolutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketUid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingResolutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingResolution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingResolutionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingResolutionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingResolutions) > 0 {
+		for _, e := range m.PendingResolutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolutionAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketUid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolutionAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ResolutionAttestations) > 0 {
+		for _, e := range m.ResolutionAttestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Market = append(m.Market, Market{})
+			if err := m.Market[len(m.Market)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsByUIDsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsByUIDsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsByUIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uids = append(m.Uids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketsByUIDsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketsByUIDsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketsByUIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedMarkets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedMarkets = append(m.FailedMarkets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFixtureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFixtureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFixtureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFixtureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFixtureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFixtureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixture", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fixture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFixturesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFixturesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFixturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFixturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFixturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFixturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixtures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fixtures = append(m.Fixtures, Fixture{})
+			if err := m.Fixtures[len(m.Fixtures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryMarketsByUIDsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.FailedMarkets) > 0 {
-		for _, s := range m.FailedMarkets {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFixtureMarketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1092,12 +3693,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFixtureMarketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFixtureMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixtureUid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixtureUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1119,7 +3788,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFixtureMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1142,15 +3811,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFixtureMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFixtureMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1177,7 +3846,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1202,7 +3908,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingResolutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1225,15 +3931,15 @@ func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingResolutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingResolutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1261,7 +3967,7 @@ func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uid = string(dAtA[iNdEx:postIndex])
+			m.MarketUid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1284,7 +3990,7 @@ func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingResolutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1307,15 +4013,15 @@ func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingResolutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingResolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingResolution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1342,7 +4048,7 @@ func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingResolution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1367,7 +4073,7 @@ func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingResolutionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1390,10 +4096,10 @@ func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingResolutionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingResolutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1453,7 +4159,7 @@ func (m *QueryMarketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingResolutionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1476,15 +4182,15 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingResolutionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingResolutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingResolutions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1511,8 +4217,8 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Market = append(m.Market, Market{})
-			if err := m.Market[len(m.Market)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingResolutions = append(m.PendingResolutions, PendingResolution{})
+			if err := m.PendingResolutions[len(m.PendingResolutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1573,7 +4279,7 @@ func (m *QueryMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketsByUIDsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryResolutionAttestationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1596,15 +4302,15 @@ func (m *QueryMarketsByUIDsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsByUIDsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResolutionAttestationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsByUIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResolutionAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1632,7 +4338,43 @@ func (m *QueryMarketsByUIDsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uids = append(m.Uids, string(dAtA[iNdEx:postIndex]))
+			m.MarketUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1655,7 +4397,7 @@ func (m *QueryMarketsByUIDsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketsByUIDsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResolutionAttestationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1678,15 +4420,15 @@ func (m *QueryMarketsByUIDsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketsByUIDsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResolutionAttestationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketsByUIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResolutionAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionAttestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1713,16 +4455,16 @@ func (m *QueryMarketsByUIDsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ResolutionAttestations = append(m.ResolutionAttestations, ResolutionAttestation{})
+			if err := m.ResolutionAttestations[len(m.ResolutionAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1732,23 +4474,27 @@ func (m *QueryMarketsByUIDsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedMarkets = append(m.FailedMarkets, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
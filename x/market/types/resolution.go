@@ -0,0 +1,100 @@
+package types
+
+import (
+	"sort"
+	"strings"
+)
+
+// NewPendingResolution creates a pending resolution of the resolution ticket payload
+// that is held until the dispute end timestamp.
+func NewPendingResolution(payload *MarketResolutionTicketPayload, disputeEndTS uint64) PendingResolution {
+	return PendingResolution{
+		MarketUID:      payload.UID,
+		ResolutionTS:   payload.ResolutionTS,
+		Status:         payload.Status,
+		WinnerOddsUIDs: payload.WinnerOddsUIDs,
+		OddsOutcomes:   payload.OddsOutcomes,
+		DisputeEndTS:   disputeEndTS,
+	}
+}
+
+// Payload returns the resolution ticket payload of the pending resolution to be
+// applied to the market after the dispute period.
+func (pr *PendingResolution) Payload() *MarketResolutionTicketPayload {
+	return &MarketResolutionTicketPayload{
+		UID:            pr.MarketUID,
+		ResolutionTS:   pr.ResolutionTS,
+		Status:         pr.Status,
+		WinnerOddsUIDs: pr.WinnerOddsUIDs,
+		OddsOutcomes:   pr.OddsOutcomes,
+	}
+}
+
+// IsDisputed returns true if the pending resolution is disputed and waits for
+// the confirmation of the oracle.
+func (pr *PendingResolution) IsDisputed() bool {
+	return len(pr.Disputes) > 0
+}
+
+// IsSameResult returns true if the dispute proposes the result of the payload,
+// the resolution timestamp is not compared.
+func (d *Dispute) IsSameResult(payload *MarketResolutionTicketPayload) bool {
+	return resultKey(MarketStatus_MARKET_STATUS_RESULT_DECLARED, d.WinnerOddsUIDs, d.OddsOutcomes) ==
+		resultKey(payload.Status, payload.WinnerOddsUIDs, payload.OddsOutcomes)
+}
+
+// NewResolutionAttestation creates the attestation of the resolution ticket payload
+// signed by the registered public key.
+func NewResolutionAttestation(
+	payload *MarketResolutionTicketPayload,
+	signer, creator string,
+	createdTS uint64,
+) ResolutionAttestation {
+	return ResolutionAttestation{
+		MarketUID:      payload.UID,
+		Signer:         signer,
+		Creator:        creator,
+		ResolutionTS:   payload.ResolutionTS,
+		Status:         payload.Status,
+		WinnerOddsUIDs: payload.WinnerOddsUIDs,
+		OddsOutcomes:   payload.OddsOutcomes,
+		CreatedTS:      createdTS,
+	}
+}
+
+// Payload returns the resolution ticket payload of the attestation to be
+// declared when the resolution threshold is met.
+func (ra *ResolutionAttestation) Payload() *MarketResolutionTicketPayload {
+	return &MarketResolutionTicketPayload{
+		UID:            ra.MarketUID,
+		ResolutionTS:   ra.ResolutionTS,
+		Status:         ra.Status,
+		WinnerOddsUIDs: ra.WinnerOddsUIDs,
+		OddsOutcomes:   ra.OddsOutcomes,
+	}
+}
+
+// IsSameResult returns true if the attestations declare the same status, winner odds
+// and odds outcomes regardless of their order, the resolution timestamp is not compared.
+func (ra *ResolutionAttestation) IsSameResult(other *ResolutionAttestation) bool {
+	return resultKey(ra.Status, ra.WinnerOddsUIDs, ra.OddsOutcomes) ==
+		resultKey(other.Status, other.WinnerOddsUIDs, other.OddsOutcomes)
+}
+
+// resultKey returns the order independent representation of the declared result.
+func resultKey(status MarketStatus, winnerOddsUIDs []string, oddsOutcomes []*OddsOutcome) string {
+	winners := append([]string{}, winnerOddsUIDs...)
+	sort.Strings(winners)
+
+	outcomes := make([]string, 0, len(oddsOutcomes))
+	for _, outcome := range oddsOutcomes {
+		outcomes = append(outcomes, outcome.String())
+	}
+	sort.Strings(outcomes)
+
+	return strings.Join([]string{
+		status.String(),
+		strings.Join(winners, ","),
+		strings.Join(outcomes, ","),
+	}, "|")
+}
@@ -0,0 +1,1585 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sge/market/resolution.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingResolution is the declared resolution of a market that is held
+// in the dispute period, the market is settled according to the pending
+// resolution after the dispute period is passed.
+type PendingResolution struct {
+	// market_uid is the universal unique identifier of the market.
+	MarketUID string `protobuf:"bytes,1,opt,name=market_uid,proto3" json:"market_uid"`
+	// resolution_ts is the resolution timestamp of the market.
+	ResolutionTS uint64 `protobuf:"varint,2,opt,name=resolution_ts,proto3" json:"resolution_ts"`
+	// status is the declared resolution status of the market.
+	Status MarketStatus `protobuf:"varint,3,opt,name=status,proto3,enum=sgenetwork.sge.market.MarketStatus" json:"status,omitempty"`
+	// winner_odds_uids is the universal unique identifier list of the winner
+	// odds.
+	WinnerOddsUIDs []string `protobuf:"bytes,4,rep,name=winner_odds_uids,proto3" json:"winner_odds_uids"`
+	// odds_outcomes is the list of the declared outcomes of the odds.
+	OddsOutcomes []*OddsOutcome `protobuf:"bytes,5,rep,name=odds_outcomes,json=oddsOutcomes,proto3" json:"odds_outcomes,omitempty"`
+	// dispute_end_ts is the timestamp that the dispute period ends and the
+	// settlement of the market starts.
+	DisputeEndTS uint64 `protobuf:"varint,6,opt,name=dispute_end_ts,proto3" json:"dispute_end_ts"`
+	// disputes is the list of the disputes raised against the declared
+	// resolution of the market, a declared resolution can be disputed once.
+	Disputes []Dispute `protobuf:"bytes,7,rep,name=disputes,proto3" json:"disputes"`
+}
+
+func (m *PendingResolution) Reset()         { *m = PendingResolution{} }
+func (m *PendingResolution) String() string { return proto.CompactTextString(m) }
+func (*PendingResolution) ProtoMessage()    {}
+func (*PendingResolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab476bba57ee091a, []int{0}
+}
+func (m *PendingResolution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingResolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingResolution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingResolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingResolution.Merge(m, src)
+}
+func (m *PendingResolution) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingResolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingResolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingResolution proto.InternalMessageInfo
+
+func (m *PendingResolution) GetMarketUID() string {
+	if m != nil {
+		return m.MarketUID
+	}
+	return ""
+}
+
+func (m *PendingResolution) GetResolutionTS() uint64 {
+	if m != nil {
+		return m.ResolutionTS
+	}
+	return 0
+}
+
+func (m *PendingResolution) GetStatus() MarketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
+func (m *PendingResolution) GetWinnerOddsUIDs() []string {
+	if m != nil {
+		return m.WinnerOddsUIDs
+	}
+	return nil
+}
+
+func (m *PendingResolution) GetOddsOutcomes() []*OddsOutcome {
+	if m != nil {
+		return m.OddsOutcomes
+	}
+	return nil
+}
+
+func (m *PendingResolution) GetDisputeEndTS() uint64 {
+	if m != nil {
+		return m.DisputeEndTS
+	}
+	return 0
+}
+
+func (m *PendingResolution) GetDisputes() []Dispute {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
+// Dispute is the challenge of a bonded validator to the pending resolution
+// of a market, the proposed result replaces the pending resolution only if
+// it is confirmed by the oracle.
+type Dispute struct {
+	// creator is the account address of the operator of the bonded validator.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// winner_odds_uids is the universal unique identifier list of the winner
+	// odds proposed by the dispute.
+	WinnerOddsUIDs []string `protobuf:"bytes,2,rep,name=winner_odds_uids,proto3" json:"winner_odds_uids"`
+	// odds_outcomes is the list of the outcomes of the odds proposed by the
+	// dispute.
+	OddsOutcomes []*OddsOutcome `protobuf:"bytes,3,rep,name=odds_outcomes,json=oddsOutcomes,proto3" json:"odds_outcomes,omitempty"`
+	// reason is the human-readable reason of the dispute.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// created_ts is the timestamp of the dispute.
+	CreatedTS uint64 `protobuf:"varint,5,opt,name=created_ts,proto3" json:"created_ts"`
+	// bond is the amount that is held from the creator until the oracle
+	// confirms the result of the market.
+	Bond types.Coin `protobuf:"bytes,6,opt,name=bond,proto3" json:"bond"`
+}
+
+func (m *Dispute) Reset()         { *m = Dispute{} }
+func (m *Dispute) String() string { return proto.CompactTextString(m) }
+func (*Dispute) ProtoMessage()    {}
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab476bba57ee091a, []int{1}
+}
+func (m *Dispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Dispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dispute.Merge(m, src)
+}
+func (m *Dispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Dispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dispute proto.InternalMessageInfo
+
+func (m *Dispute) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Dispute) GetWinnerOddsUIDs() []string {
+	if m != nil {
+		return m.WinnerOddsUIDs
+	}
+	return nil
+}
+
+func (m *Dispute) GetOddsOutcomes() []*OddsOutcome {
+	if m != nil {
+		return m.OddsOutcomes
+	}
+	return nil
+}
+
+func (m *Dispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Dispute) GetCreatedTS() uint64 {
+	if m != nil {
+		return m.CreatedTS
+	}
+	return 0
+}
+
+func (m *Dispute) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+// ResolutionAttestation is the resolution of a market attested by a registered
+// public key of the key vault, the market is resolved when the count of the
+// attestations with the same result reaches the resolution threshold.
+type ResolutionAttestation struct {
+	// market_uid is the universal unique identifier of the market.
+	MarketUID string `protobuf:"bytes,1,opt,name=market_uid,proto3" json:"market_uid"`
+	// signer is the registered public key that signed the resolution ticket.
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// creator is the address of the account that submitted the resolution
+	// ticket.
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// resolution_ts is the resolution timestamp of the market.
+	ResolutionTS uint64 `protobuf:"varint,4,opt,name=resolution_ts,proto3" json:"resolution_ts"`
+	// status is the attested resolution status of the market.
+	Status MarketStatus `protobuf:"varint,5,opt,name=status,proto3,enum=sgenetwork.sge.market.MarketStatus" json:"status,omitempty"`
+	// winner_odds_uids is the universal unique identifier list of the winner
+	// odds.
+	WinnerOddsUIDs []string `protobuf:"bytes,6,rep,name=winner_odds_uids,proto3" json:"winner_odds_uids"`
+	// odds_outcomes is the list of the attested outcomes of the odds.
+	OddsOutcomes []*OddsOutcome `protobuf:"bytes,7,rep,name=odds_outcomes,json=oddsOutcomes,proto3" json:"odds_outcomes,omitempty"`
+	// created_ts is the timestamp of the attestation.
+	CreatedTS uint64 `protobuf:"varint,8,opt,name=created_ts,proto3" json:"created_ts"`
+}
+
+func (m *ResolutionAttestation) Reset()         { *m = ResolutionAttestation{} }
+func (m *ResolutionAttestation) String() string { return proto.CompactTextString(m) }
+func (*ResolutionAttestation) ProtoMessage()    {}
+func (*ResolutionAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab476bba57ee091a, []int{2}
+}
+func (m *ResolutionAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolutionAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolutionAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResolutionAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolutionAttestation.Merge(m, src)
+}
+func (m *ResolutionAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolutionAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolutionAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResolutionAttestation proto.InternalMessageInfo
+
+func (m *ResolutionAttestation) GetMarketUID() string {
+	if m != nil {
+		return m.MarketUID
+	}
+	return ""
+}
+
+func (m *ResolutionAttestation) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *ResolutionAttestation) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ResolutionAttestation) GetResolutionTS() uint64 {
+	if m != nil {
+		return m.ResolutionTS
+	}
+	return 0
+}
+
+func (m *ResolutionAttestation) GetStatus() MarketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MarketStatus_MARKET_STATUS_UNSPECIFIED
+}
+
+func (m *ResolutionAttestation) GetWinnerOddsUIDs() []string {
+	if m != nil {
+		return m.WinnerOddsUIDs
+	}
+	return nil
+}
+
+func (m *ResolutionAttestation) GetOddsOutcomes() []*OddsOutcome {
+	if m != nil {
+		return m.OddsOutcomes
+	}
+	return nil
+}
+
+func (m *ResolutionAttestation) GetCreatedTS() uint64 {
+	if m != nil {
+		return m.CreatedTS
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingResolution)(nil), "sgenetwork.sge.market.PendingResolution")
+	proto.RegisterType((*Dispute)(nil), "sgenetwork.sge.market.Dispute")
+	proto.RegisterType((*ResolutionAttestation)(nil), "sgenetwork.sge.market.ResolutionAttestation")
+}
+
+func init() { proto.RegisterFile("sge/market/resolution.proto", fileDescriptor_ab476bba57ee091a) }
+
+var fileDescriptor_ab476bba57ee091a = []byte{
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x6b, 0xd7, 0x69, 0xb6, 0xa5, 0x02, 0x8b, 0x14, 0xd3, 0x4a, 0x76, 0x08, 0x12, 0x0a,
+	0x07, 0x6c, 0x35, 0x3d, 0xf6, 0x02, 0x49, 0x10, 0x2a, 0x12, 0x2a, 0xda, 0x52, 0x21, 0x71, 0x89,
+	0x9c, 0x78, 0x65, 0xac, 0x92, 0xdd, 0xc8, 0xb3, 0xa6, 0x70, 0xe4, 0x1f, 0xf0, 0xb3, 0x2a, 0x4e,
+	0x3d, 0x72, 0xb2, 0x90, 0x23, 0x71, 0xc8, 0xaf, 0x40, 0xbb, 0xde, 0x36, 0x4e, 0xd3, 0x1e, 0x68,
+	0x73, 0xf2, 0x7c, 0xbc, 0xb5, 0xdf, 0xbe, 0x99, 0x67, 0xb4, 0x03, 0x11, 0xf1, 0x47, 0x41, 0x72,
+	0x42, 0xb8, 0x9f, 0x10, 0x60, 0x5f, 0x52, 0x1e, 0x33, 0xea, 0x8d, 0x13, 0xc6, 0x99, 0x55, 0x87,
+	0x88, 0x50, 0xc2, 0x4f, 0x59, 0x72, 0xe2, 0x41, 0x44, 0xbc, 0x02, 0xb7, 0xed, 0x0c, 0x19, 0x8c,
+	0x18, 0xf8, 0x83, 0x00, 0x88, 0xff, 0x75, 0x77, 0x40, 0x78, 0xb0, 0xeb, 0x0f, 0x59, 0xac, 0x8e,
+	0x6d, 0x3f, 0x8c, 0x58, 0xc4, 0x64, 0xe8, 0x8b, 0x48, 0x55, 0x1f, 0x95, 0xbe, 0x54, 0x3c, 0x54,
+	0xa3, 0x5e, 0x6a, 0xb0, 0x30, 0x84, 0xa2, 0xdc, 0xfc, 0x61, 0xa0, 0x07, 0xef, 0x09, 0x0d, 0x63,
+	0x1a, 0xe1, 0x4b, 0x62, 0xd6, 0x3e, 0x42, 0x05, 0xb4, 0x9f, 0xc6, 0xa1, 0xad, 0x35, 0xb4, 0x56,
+	0xad, 0xb3, 0x93, 0x67, 0x6e, 0xed, 0x9d, 0xac, 0x1e, 0x1f, 0xf4, 0xa6, 0x99, 0x5b, 0x82, 0xe0,
+	0x52, 0x6c, 0xbd, 0x41, 0xf7, 0x66, 0x77, 0xec, 0x73, 0xb0, 0x57, 0x1a, 0x5a, 0xcb, 0xe8, 0x3c,
+	0xc9, 0x33, 0x77, 0x63, 0xf6, 0x8d, 0x0f, 0x47, 0xd3, 0xcc, 0x9d, 0x07, 0xe2, 0xf9, 0xd4, 0xda,
+	0x47, 0x26, 0xf0, 0x80, 0xa7, 0x60, 0xeb, 0x0d, 0xad, 0xb5, 0xd9, 0x7e, 0xea, 0x5d, 0xab, 0x94,
+	0x57, 0x90, 0x3a, 0x92, 0x50, 0xac, 0x8e, 0x58, 0x18, 0xdd, 0x3f, 0x8d, 0x29, 0x25, 0x49, 0x5f,
+	0xdc, 0x56, 0x10, 0x03, 0xdb, 0x68, 0xe8, 0xad, 0x5a, 0xe7, 0x59, 0x9e, 0xb9, 0x9b, 0x1f, 0x65,
+	0xef, 0x30, 0x0c, 0xe1, 0xf8, 0xa0, 0x07, 0xd3, 0xcc, 0x5d, 0x40, 0xe3, 0x85, 0x8a, 0xb8, 0x99,
+	0x4c, 0x58, 0xca, 0x87, 0x6c, 0x44, 0xc0, 0x5e, 0x6d, 0xe8, 0xad, 0xf5, 0x76, 0xf3, 0x06, 0x5e,
+	0xe2, 0xed, 0x87, 0x05, 0x14, 0x6f, 0xb0, 0x59, 0x02, 0xd6, 0x5b, 0xb4, 0x19, 0xc6, 0x30, 0x4e,
+	0x39, 0xe9, 0x13, 0x1a, 0x0a, 0x8d, 0x4c, 0xa9, 0x51, 0x53, 0x68, 0xd4, 0x2b, 0x3a, 0xaf, 0x69,
+	0x28, 0x35, 0xba, 0x82, 0xc4, 0x57, 0x72, 0xeb, 0x25, 0x5a, 0x53, 0x15, 0xb0, 0xab, 0x92, 0x8f,
+	0x73, 0x03, 0x1f, 0xf5, 0xe2, 0x8e, 0x71, 0x96, 0xb9, 0x15, 0x7c, 0x79, 0xaa, 0xf9, 0x6b, 0x05,
+	0x55, 0x55, 0xcf, 0xb2, 0x51, 0x75, 0x98, 0x90, 0x80, 0xb3, 0xa4, 0x18, 0x3b, 0xbe, 0x48, 0xaf,
+	0x15, 0x74, 0x65, 0xd9, 0x82, 0xea, 0xb7, 0x14, 0x74, 0x0b, 0x99, 0x09, 0x09, 0x80, 0x51, 0xdb,
+	0x90, 0xac, 0x55, 0x26, 0x16, 0x59, 0xf2, 0x27, 0x52, 0xe4, 0x55, 0x29, 0xb2, 0x5c, 0xe4, 0x6e,
+	0x51, 0x95, 0x0a, 0x97, 0x20, 0xb8, 0x14, 0x5b, 0x7b, 0xc8, 0x18, 0x30, 0x1a, 0xca, 0xd9, 0xac,
+	0xb7, 0x1f, 0x7b, 0x85, 0x21, 0x3d, 0x61, 0x48, 0x4f, 0x19, 0xd2, 0xeb, 0xb2, 0x98, 0x2a, 0x41,
+	0x25, 0xb8, 0xf9, 0x57, 0x47, 0xf5, 0xd9, 0x96, 0xbf, 0xe2, 0x9c, 0x88, 0x85, 0xbc, 0xb3, 0xa9,
+	0xb6, 0x90, 0x09, 0x71, 0x44, 0x49, 0x22, 0xdd, 0x54, 0xc3, 0x2a, 0x2b, 0xcf, 0x4b, 0x9f, 0x9f,
+	0xd7, 0x82, 0x0d, 0x8d, 0x3b, 0xdb, 0x70, 0x75, 0x39, 0x36, 0x34, 0x97, 0xbd, 0x35, 0xd5, 0x5b,
+	0x6e, 0xcd, 0xfc, 0x76, 0xac, 0xfd, 0xd7, 0x76, 0x74, 0xba, 0x67, 0xb9, 0xa3, 0x9d, 0xe7, 0x8e,
+	0xf6, 0x27, 0x77, 0xb4, 0x9f, 0x13, 0xa7, 0x72, 0x3e, 0x71, 0x2a, 0xbf, 0x27, 0x4e, 0xe5, 0xd3,
+	0xf3, 0x28, 0xe6, 0x9f, 0xd3, 0x81, 0x37, 0x64, 0x23, 0x1f, 0x22, 0xf2, 0x42, 0x71, 0x12, 0xb1,
+	0xff, 0xed, 0xe2, 0x1f, 0xcc, 0xbf, 0x8f, 0x09, 0x0c, 0x4c, 0xf9, 0x17, 0xde, 0xfb, 0x37, 0x00,
+	0x02, 0x78, 0xf2, 0xff, 0x21, 0x06, 0x00, 0x00,
+}
+
+func (m *PendingResolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingResolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingResolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResolution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DisputeEndTS != 0 {
+		i = encodeVarintResolution(dAtA, i, uint64(m.DisputeEndTS))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for iNdEx := len(m.OddsOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OddsOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResolution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.WinnerOddsUIDs) > 0 {
+		for iNdEx := len(m.WinnerOddsUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WinnerOddsUIDs[iNdEx])
+			copy(dAtA[i:], m.WinnerOddsUIDs[iNdEx])
+			i = encodeVarintResolution(dAtA, i, uint64(len(m.WinnerOddsUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintResolution(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ResolutionTS != 0 {
+		i = encodeVarintResolution(dAtA, i, uint64(m.ResolutionTS))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketUID) > 0 {
+		i -= len(m.MarketUID)
+		copy(dAtA[i:], m.MarketUID)
+		i = encodeVarintResolution(dAtA, i, uint64(len(m.MarketUID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Dispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintResolution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.CreatedTS != 0 {
+		i = encodeVarintResolution(dAtA, i, uint64(m.CreatedTS))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintResolution(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for iNdEx := len(m.OddsOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OddsOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResolution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.WinnerOddsUIDs) > 0 {
+		for iNdEx := len(m.WinnerOddsUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WinnerOddsUIDs[iNdEx])
+			copy(dAtA[i:], m.WinnerOddsUIDs[iNdEx])
+			i = encodeVarintResolution(dAtA, i, uint64(len(m.WinnerOddsUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintResolution(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResolutionAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolutionAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResolutionAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedTS != 0 {
+		i = encodeVarintResolution(dAtA, i, uint64(m.CreatedTS))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for iNdEx := len(m.OddsOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OddsOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintResolution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.WinnerOddsUIDs) > 0 {
+		for iNdEx := len(m.WinnerOddsUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WinnerOddsUIDs[iNdEx])
+			copy(dAtA[i:], m.WinnerOddsUIDs[iNdEx])
+			i = encodeVarintResolution(dAtA, i, uint64(len(m.WinnerOddsUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintResolution(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ResolutionTS != 0 {
+		i = encodeVarintResolution(dAtA, i, uint64(m.ResolutionTS))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintResolution(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintResolution(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketUID) > 0 {
+		i -= len(m.MarketUID)
+		copy(dAtA[i:], m.MarketUID)
+		i = encodeVarintResolution(dAtA, i, uint64(len(m.MarketUID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintResolution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovResolution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingResolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketUID)
+	if l > 0 {
+		n += 1 + l + sovResolution(uint64(l))
+	}
+	if m.ResolutionTS != 0 {
+		n += 1 + sovResolution(uint64(m.ResolutionTS))
+	}
+	if m.Status != 0 {
+		n += 1 + sovResolution(uint64(m.Status))
+	}
+	if len(m.WinnerOddsUIDs) > 0 {
+		for _, s := range m.WinnerOddsUIDs {
+			l = len(s)
+			n += 1 + l + sovResolution(uint64(l))
+		}
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for _, e := range m.OddsOutcomes {
+			l = e.Size()
+			n += 1 + l + sovResolution(uint64(l))
+		}
+	}
+	if m.DisputeEndTS != 0 {
+		n += 1 + sovResolution(uint64(m.DisputeEndTS))
+	}
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovResolution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Dispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovResolution(uint64(l))
+	}
+	if len(m.WinnerOddsUIDs) > 0 {
+		for _, s := range m.WinnerOddsUIDs {
+			l = len(s)
+			n += 1 + l + sovResolution(uint64(l))
+		}
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for _, e := range m.OddsOutcomes {
+			l = e.Size()
+			n += 1 + l + sovResolution(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovResolution(uint64(l))
+	}
+	if m.CreatedTS != 0 {
+		n += 1 + sovResolution(uint64(m.CreatedTS))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovResolution(uint64(l))
+	return n
+}
+
+func (m *ResolutionAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketUID)
+	if l > 0 {
+		n += 1 + l + sovResolution(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovResolution(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovResolution(uint64(l))
+	}
+	if m.ResolutionTS != 0 {
+		n += 1 + sovResolution(uint64(m.ResolutionTS))
+	}
+	if m.Status != 0 {
+		n += 1 + sovResolution(uint64(m.Status))
+	}
+	if len(m.WinnerOddsUIDs) > 0 {
+		for _, s := range m.WinnerOddsUIDs {
+			l = len(s)
+			n += 1 + l + sovResolution(uint64(l))
+		}
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for _, e := range m.OddsOutcomes {
+			l = e.Size()
+			n += 1 + l + sovResolution(uint64(l))
+		}
+	}
+	if m.CreatedTS != 0 {
+		n += 1 + sovResolution(uint64(m.CreatedTS))
+	}
+	return n
+}
+
+func sovResolution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozResolution(x uint64) (n int) {
+	return sovResolution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingResolution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingResolution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingResolution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionTS", wireType)
+			}
+			m.ResolutionTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnerOddsUIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinnerOddsUIDs = append(m.WinnerOddsUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsOutcomes = append(m.OddsOutcomes, &OddsOutcome{})
+			if err := m.OddsOutcomes[len(m.OddsOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeEndTS", wireType)
+			}
+			m.DisputeEndTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeEndTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputes = append(m.Disputes, Dispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnerOddsUIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinnerOddsUIDs = append(m.WinnerOddsUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsOutcomes = append(m.OddsOutcomes, &OddsOutcome{})
+			if err := m.OddsOutcomes[len(m.OddsOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTS", wireType)
+			}
+			m.CreatedTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolutionAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResolution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolutionAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolutionAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionTS", wireType)
+			}
+			m.ResolutionTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolutionTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnerOddsUIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinnerOddsUIDs = append(m.WinnerOddsUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResolution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsOutcomes = append(m.OddsOutcomes, &OddsOutcome{})
+			if err := m.OddsOutcomes[len(m.OddsOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTS", wireType)
+			}
+			m.CreatedTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResolution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthResolution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipResolution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowResolution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowResolution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthResolution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupResolution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthResolution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthResolution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowResolution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupResolution = fmt.Errorf("proto: unexpected end of group")
+)
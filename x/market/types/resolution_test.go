@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sge-network/sge/x/market/types"
+)
+
+func TestResolutionAttestationIsSameResult(t *testing.T) {
+	oddsUID1, oddsUID2 := uuid.NewString(), uuid.NewString()
+	attestation := types.ResolutionAttestation{
+		ResolutionTS: 1000,
+		Status:       types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		OddsOutcomes: []*types.OddsOutcome{
+			{OddsUID: oddsUID1, Result: types.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.MustNewDecFromStr("0.5")},
+			{OddsUID: oddsUID2, Result: types.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.MustNewDecFromStr("0.5")},
+		},
+	}
+
+	// the order of the outcomes and the resolution timestamp are not compared
+	other := types.ResolutionAttestation{
+		ResolutionTS: 2000,
+		Status:       types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		OddsOutcomes: []*types.OddsOutcome{attestation.OddsOutcomes[1], attestation.OddsOutcomes[0]},
+	}
+	require.True(t, attestation.IsSameResult(&other))
+
+	other.OddsOutcomes = []*types.OddsOutcome{
+		{OddsUID: oddsUID1, Result: types.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.MustNewDecFromStr("0.5")},
+	}
+	require.False(t, attestation.IsSameResult(&other))
+
+	canceled := types.ResolutionAttestation{Status: types.MarketStatus_MARKET_STATUS_CANCELED}
+	require.False(t, attestation.IsSameResult(&canceled))
+	require.True(t, canceled.IsSameResult(&types.ResolutionAttestation{Status: types.MarketStatus_MARKET_STATUS_CANCELED}))
+}
+
+func TestDisputeIsSameResult(t *testing.T) {
+	oddsUID1, oddsUID2 := uuid.NewString(), uuid.NewString()
+	dispute := types.Dispute{WinnerOddsUIDs: []string{oddsUID1, oddsUID2}}
+
+	// the dispute proposes a declared result regardless of the order of the winners
+	require.True(t, dispute.IsSameResult(&types.MarketResolutionTicketPayload{
+		ResolutionTS:   1000,
+		Status:         types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		WinnerOddsUIDs: []string{oddsUID2, oddsUID1},
+	}))
+	require.False(t, dispute.IsSameResult(&types.MarketResolutionTicketPayload{
+		Status:         types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+		WinnerOddsUIDs: []string{oddsUID1},
+	}))
+	require.False(t, dispute.IsSameResult(&types.MarketResolutionTicketPayload{
+		Status: types.MarketStatus_MARKET_STATUS_CANCELED,
+	}))
+}
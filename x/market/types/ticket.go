@@ -27,6 +27,20 @@ func (payload *MarketAddTicketPayload) Validate(ctx sdk.Context) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid uid for the market")
 	}
 
+	if payload.Denom != "" {
+		if err := sdk.ValidateDenom(payload.Denom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom for the market: %s", err)
+		}
+	}
+
+	if err := payload.BettorCaps.Validate(); err != nil {
+		return err
+	}
+
+	if payload.FixtureUID != "" && !utils.IsValidUID(payload.FixtureUID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid fixture uid for the market")
+	}
+
 	if len(payload.Odds) < 2 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "not provided enough odds for the market")
 	}
@@ -45,23 +59,8 @@ func (payload *MarketAddTicketPayload) Validate(ctx sdk.Context) error {
 
 	oddsSet := make(map[string]Odds, len(payload.Odds))
 	for _, o := range payload.Odds {
-		if o.Meta == "" {
-			return sdkerrors.Wrapf(
-				sdkerrors.ErrInvalidRequest,
-				"meta is mandatory for odds with uuid %s",
-				o.UID,
-			)
-		}
-		if len(o.Meta) > MaxAllowedCharactersForMeta {
-			return sdkerrors.Wrapf(
-				sdkerrors.ErrInvalidRequest,
-				"meta length should be less than %d characters",
-				MaxAllowedCharactersForMeta,
-			)
-		}
-		o.Meta = sanitize.XSS(o.Meta)
-		if !utils.IsValidUID(o.UID) {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds-uid passed is invalid")
+		if err := o.Validate(); err != nil {
+			return err
 		}
 		if _, exist := oddsSet[o.UID]; exist {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate odds-uid in request")
@@ -83,6 +82,59 @@ func (payload *MarketUpdateTicketPayload) Validate(ctx sdk.Context) error {
 		)
 	}
 
+	if err := payload.BettorCaps.Validate(); err != nil {
+		return err
+	}
+
+	oddsUIDs := make(map[string]struct{}, len(payload.OddsBettorCaps))
+	for _, oddsCaps := range payload.OddsBettorCaps {
+		if oddsCaps == nil || !utils.IsValidUID(oddsCaps.OddsUID) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds-uid passed is invalid")
+		}
+		if _, ok := oddsUIDs[oddsCaps.OddsUID]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate odds bettor caps for %s", oddsCaps.OddsUID)
+		}
+		oddsUIDs[oddsCaps.OddsUID] = struct{}{}
+
+		if err := oddsCaps.BettorCaps.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "odds %s", oddsCaps.OddsUID)
+		}
+	}
+
+	for _, oddsUID := range payload.MovedOddsUIDs {
+		if !utils.IsValidUID(oddsUID) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "moved odds-uid passed is invalid")
+		}
+	}
+
+	// each odds can be added or have its status changed only once in a ticket
+	changedOdds := make(map[string]struct{})
+	for _, o := range payload.NewOdds {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+		if _, ok := changedOdds[o.UID]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate odds-uid %s in request", o.UID)
+		}
+		changedOdds[o.UID] = struct{}{}
+	}
+
+	for _, oddsUIDs := range [][]string{
+		payload.SuspendedOddsUIDs,
+		payload.ReactivatedOddsUIDs,
+		payload.RemovedOddsUIDs,
+	} {
+		for _, oddsUID := range oddsUIDs {
+			if !utils.IsValidUID(oddsUID) {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds-uid passed is invalid")
+			}
+			if _, ok := changedOdds[oddsUID]; ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate odds-uid %s in request", oddsUID)
+			}
+			changedOdds[oddsUID] = struct{}{}
+		}
+	}
+
 	return validateMarketTS(ctx, payload.StartTS, payload.EndTS)
 }
 
@@ -108,7 +160,7 @@ func (payload *MarketResolutionTicketPayload) Validate() error {
 			)
 		}
 	default:
-		if len(payload.WinnerOddsUIDs) > 0 {
+		if len(payload.WinnerOddsUIDs) > 0 || len(payload.OddsOutcomes) > 0 {
 			return sdkerrors.Wrapf(
 				sdkerrors.ErrInvalidRequest,
 				"winner odds should be set if the status is 'result declared'",
@@ -127,17 +179,45 @@ func (payload *MarketResolutionTicketPayload) Validate() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid uid for the market")
 	}
 
-	if payload.Status == MarketStatus_MARKET_STATUS_RESULT_DECLARED && len(payload.WinnerOddsUIDs) < 1 {
+	if payload.Status == MarketStatus_MARKET_STATUS_RESULT_DECLARED &&
+		len(payload.WinnerOddsUIDs)+len(payload.OddsOutcomes) < 1 {
 		return sdkerrors.Wrapf(
 			sdkerrors.ErrInvalidRequest,
 			"not provided enough winner odds for the market",
 		)
 	}
 
+	oddsUIDs := make(map[string]struct{})
 	for _, wid := range payload.WinnerOddsUIDs {
 		if !utils.IsValidUID(wid) {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds-uid passed is invalid")
 		}
+		oddsUIDs[wid] = struct{}{}
+	}
+
+	for _, outcome := range payload.OddsOutcomes {
+		if outcome == nil || !utils.IsValidUID(outcome.OddsUID) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds-uid passed is invalid")
+		}
+
+		if outcome.Result == OddsResult_ODDS_RESULT_UNSPECIFIED {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "odds outcome result is not set for %s", outcome.OddsUID)
+		}
+
+		if outcome.HasDeadHeat() {
+			if outcome.Result != OddsResult_ODDS_RESULT_WIN {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "dead heat factor is only allowed for the won odds %s", outcome.OddsUID)
+			}
+
+			if !outcome.DeadHeatFactor.IsPositive() || outcome.DeadHeatFactor.GTE(sdk.OneDec()) {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "dead heat factor should be between zero and one for %s", outcome.OddsUID)
+			}
+		}
+
+		if _, ok := oddsUIDs[outcome.OddsUID]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate odds outcome for %s", outcome.OddsUID)
+		}
+		oddsUIDs[outcome.OddsUID] = struct{}{}
 	}
 
 	return nil
@@ -166,6 +246,94 @@ func (payload *MarketResolutionTicketPayload) ValidateWinnerOdds(market *Market)
 		if !validWinnerOdds {
 			return ErrInvalidWinnerOdds
 		}
+
+		for _, outcome := range payload.OddsOutcomes {
+			if !market.HasOdds(outcome.OddsUID) {
+				return ErrInvalidWinnerOdds
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate validates fixture add ticket payload.
+func (payload *FixtureAddTicketPayload) Validate(ctx sdk.Context) error {
+	// remove xss attach prone characters
+	payload.Sport = sanitize.XSS(payload.Sport)
+	payload.Competition = sanitize.XSS(payload.Competition)
+	payload.Meta = sanitize.XSS(payload.Meta)
+
+	if !utils.IsValidUID(payload.UID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid uid for the fixture")
+	}
+
+	if strings.TrimSpace(payload.Sport) == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "sport is mandatory for the fixture")
+	}
+
+	if strings.TrimSpace(payload.Competition) == "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "competition is mandatory for the fixture")
+	}
+
+	if len(payload.Participants) < 2 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "not provided enough participants for the fixture")
+	}
+
+	participants := make(map[string]struct{}, len(payload.Participants))
+	for i, p := range payload.Participants {
+		p = sanitize.XSS(p)
+		if strings.TrimSpace(p) == "" {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "participant name is mandatory")
+		}
+		if _, ok := participants[p]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate participant %s in request", p)
+		}
+		participants[p] = struct{}{}
+		payload.Participants[i] = p
+	}
+
+	for _, text := range append([]string{payload.Sport, payload.Competition, payload.Meta}, payload.Participants...) {
+		if len(text) > MaxAllowedCharactersForMeta {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"fixture data length should be less than %d characters",
+				MaxAllowedCharactersForMeta,
+			)
+		}
+	}
+
+	return validateFixtureTS(ctx, payload.ScheduledTS)
+}
+
+// Validate validates fixture update ticket payload.
+func (payload *FixtureUpdateTicketPayload) Validate(ctx sdk.Context) error {
+	if !utils.IsValidUID(payload.UID) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid uid for the fixture")
+	}
+
+	switch payload.Status {
+	case FixtureStatus_FIXTURE_STATUS_SCHEDULED:
+		return validateFixtureTS(ctx, payload.ScheduledTS)
+	case FixtureStatus_FIXTURE_STATUS_POSTPONED,
+		FixtureStatus_FIXTURE_STATUS_CANCELED:
+		// the new schedule of the postponed fixture may not be known yet.
+		if payload.ScheduledTS != 0 {
+			return validateFixtureTS(ctx, payload.ScheduledTS)
+		}
+		return nil
+	default:
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"supported update status is scheduled, postponed or canceled",
+		)
+	}
+}
+
+// validateFixtureTS validates the scheduled timestamp of a fixture.
+func validateFixtureTS(ctx sdk.Context, scheduledTS uint64) error {
+	if scheduledTS <= cast.ToUint64(ctx.BlockTime().Unix()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid scheduled timestamp for the fixture")
 	}
 
 	return nil
@@ -37,6 +37,15 @@ type MarketAddTicketPayload struct {
 	Status MarketStatus `protobuf:"varint,5,opt,name=status,proto3,enum=sgenetwork.sge.market.MarketStatus" json:"status,omitempty"`
 	// meta contains human-readable metadata of the market.
 	Meta string `protobuf:"bytes,6,opt,name=meta,proto3" json:"meta,omitempty"`
+	// denom is the accepted denomination of the bets and deposits of the market,
+	// the default bond denom is used if it is empty.
+	Denom string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	// bettor_caps is the maximum stake and potential payout of each bettor on
+	// the market.
+	BettorCaps *BettorCaps `protobuf:"bytes,8,opt,name=bettor_caps,json=bettorCaps,proto3" json:"bettor_caps,omitempty"`
+	// fixture_uid is the universal unique identifier of the fixture that the
+	// market belongs to, the market is standalone if it is empty.
+	FixtureUID string `protobuf:"bytes,9,opt,name=fixture_uid,proto3" json:"fixture_uid"`
 }
 
 func (m *MarketAddTicketPayload) Reset()         { *m = MarketAddTicketPayload{} }
@@ -114,6 +123,27 @@ func (m *MarketAddTicketPayload) GetMeta() string {
 	return ""
 }
 
+func (m *MarketAddTicketPayload) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MarketAddTicketPayload) GetBettorCaps() *BettorCaps {
+	if m != nil {
+		return m.BettorCaps
+	}
+	return nil
+}
+
+func (m *MarketAddTicketPayload) GetFixtureUID() string {
+	if m != nil {
+		return m.FixtureUID
+	}
+	return ""
+}
+
 // MarketUpdateTicketPayload indicates data of the market update ticket
 type MarketUpdateTicketPayload struct {
 	// uid is the uuid of the market
@@ -124,6 +154,29 @@ type MarketUpdateTicketPayload struct {
 	EndTS uint64 `protobuf:"varint,3,opt,name=end_ts,proto3" json:"end_ts"`
 	// status is the status of the resolution.
 	Status MarketStatus `protobuf:"varint,4,opt,name=status,proto3,enum=sgenetwork.sge.market.MarketStatus" json:"status,omitempty"`
+	// bettor_caps is the maximum stake and potential payout of each bettor on
+	// the market, the current caps are kept if it is not set.
+	BettorCaps *BettorCaps `protobuf:"bytes,5,opt,name=bettor_caps,json=bettorCaps,proto3" json:"bettor_caps,omitempty"`
+	// odds_bettor_caps is the list of the caps of the odds to be replaced, the
+	// caps of the odds that are not in the list are kept.
+	OddsBettorCaps []*OddsBettorCaps `protobuf:"bytes,6,rep,name=odds_bettor_caps,json=oddsBettorCaps,proto3" json:"odds_bettor_caps,omitempty"`
+	// moved_odds_uids is the list of the odds that their value is changed, the
+	// delayed bets on these odds that are placed before the change are
+	// rejected.
+	MovedOddsUIDs []string `protobuf:"bytes,7,rep,name=moved_odds_uids,proto3" json:"moved_odds_uids"`
+	// new_odds is the list of the odds to be added to the market, the order
+	// book exposures of the new odds are initialized for all of the existing
+	// participations.
+	NewOdds []*Odds `protobuf:"bytes,8,rep,name=new_odds,json=newOdds,proto3" json:"new_odds,omitempty"`
+	// suspended_odds_uids is the list of the odds that the bets on them are
+	// not accepted until they are reactivated.
+	SuspendedOddsUIDs []string `protobuf:"bytes,9,rep,name=suspended_odds_uids,proto3" json:"suspended_odds_uids"`
+	// reactivated_odds_uids is the list of the suspended odds to be active
+	// again.
+	ReactivatedOddsUIDs []string `protobuf:"bytes,10,rep,name=reactivated_odds_uids,proto3" json:"reactivated_odds_uids"`
+	// removed_odds_uids is the list of the odds to be removed from the market,
+	// the placed bets on the removed odds are refunded in the settlement.
+	RemovedOddsUIDs []string `protobuf:"bytes,11,rep,name=removed_odds_uids,proto3" json:"removed_odds_uids"`
 }
 
 func (m *MarketUpdateTicketPayload) Reset()         { *m = MarketUpdateTicketPayload{} }
@@ -187,6 +240,111 @@ func (m *MarketUpdateTicketPayload) GetStatus() MarketStatus {
 	return MarketStatus_MARKET_STATUS_UNSPECIFIED
 }
 
+func (m *MarketUpdateTicketPayload) GetBettorCaps() *BettorCaps {
+	if m != nil {
+		return m.BettorCaps
+	}
+	return nil
+}
+
+func (m *MarketUpdateTicketPayload) GetOddsBettorCaps() []*OddsBettorCaps {
+	if m != nil {
+		return m.OddsBettorCaps
+	}
+	return nil
+}
+
+func (m *MarketUpdateTicketPayload) GetMovedOddsUIDs() []string {
+	if m != nil {
+		return m.MovedOddsUIDs
+	}
+	return nil
+}
+
+func (m *MarketUpdateTicketPayload) GetNewOdds() []*Odds {
+	if m != nil {
+		return m.NewOdds
+	}
+	return nil
+}
+
+func (m *MarketUpdateTicketPayload) GetSuspendedOddsUIDs() []string {
+	if m != nil {
+		return m.SuspendedOddsUIDs
+	}
+	return nil
+}
+
+func (m *MarketUpdateTicketPayload) GetReactivatedOddsUIDs() []string {
+	if m != nil {
+		return m.ReactivatedOddsUIDs
+	}
+	return nil
+}
+
+func (m *MarketUpdateTicketPayload) GetRemovedOddsUIDs() []string {
+	if m != nil {
+		return m.RemovedOddsUIDs
+	}
+	return nil
+}
+
+// OddsBettorCaps is the bettor caps of a certain odds of the market.
+type OddsBettorCaps struct {
+	// odds_uid is the universal unique identifier of the odds.
+	OddsUID string `protobuf:"bytes,1,opt,name=odds_uid,proto3" json:"odds_uid"`
+	// bettor_caps is the maximum stake and potential payout of each bettor on
+	// the odds.
+	BettorCaps *BettorCaps `protobuf:"bytes,2,opt,name=bettor_caps,json=bettorCaps,proto3" json:"bettor_caps,omitempty"`
+}
+
+func (m *OddsBettorCaps) Reset()         { *m = OddsBettorCaps{} }
+func (m *OddsBettorCaps) String() string { return proto.CompactTextString(m) }
+func (*OddsBettorCaps) ProtoMessage()    {}
+func (*OddsBettorCaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dc46cd902954700, []int{2}
+}
+func (m *OddsBettorCaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OddsBettorCaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OddsBettorCaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OddsBettorCaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OddsBettorCaps.Merge(m, src)
+}
+func (m *OddsBettorCaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *OddsBettorCaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_OddsBettorCaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OddsBettorCaps proto.InternalMessageInfo
+
+func (m *OddsBettorCaps) GetOddsUID() string {
+	if m != nil {
+		return m.OddsUID
+	}
+	return ""
+}
+
+func (m *OddsBettorCaps) GetBettorCaps() *BettorCaps {
+	if m != nil {
+		return m.BettorCaps
+	}
+	return nil
+}
+
 // MarketResolutionTicketPayload indicates data of the
 // resolution of the market ticket.
 type MarketResolutionTicketPayload struct {
@@ -199,13 +357,17 @@ type MarketResolutionTicketPayload struct {
 	WinnerOddsUIDs []string `protobuf:"bytes,3,rep,name=winner_odds_uids,proto3" json:"winner_odds_uids"`
 	// status is the status of the resolution.
 	Status MarketStatus `protobuf:"varint,4,opt,name=status,proto3,enum=sgenetwork.sge.market.MarketStatus" json:"status,omitempty"`
+	// odds_outcomes is the list of the outcomes of the odds, it is used for
+	// the outcomes other than the full win and loss such as push and half
+	// win/loss of the asian handicap and total markets.
+	OddsOutcomes []*OddsOutcome `protobuf:"bytes,5,rep,name=odds_outcomes,json=oddsOutcomes,proto3" json:"odds_outcomes,omitempty"`
 }
 
 func (m *MarketResolutionTicketPayload) Reset()         { *m = MarketResolutionTicketPayload{} }
 func (m *MarketResolutionTicketPayload) String() string { return proto.CompactTextString(m) }
 func (*MarketResolutionTicketPayload) ProtoMessage()    {}
 func (*MarketResolutionTicketPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dc46cd902954700, []int{2}
+	return fileDescriptor_1dc46cd902954700, []int{3}
 }
 func (m *MarketResolutionTicketPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,44 +424,236 @@ func (m *MarketResolutionTicketPayload) GetStatus() MarketStatus {
 	return MarketStatus_MARKET_STATUS_UNSPECIFIED
 }
 
+func (m *MarketResolutionTicketPayload) GetOddsOutcomes() []*OddsOutcome {
+	if m != nil {
+		return m.OddsOutcomes
+	}
+	return nil
+}
+
+// FixtureAddTicketPayload indicates data of the add fixture ticket.
+type FixtureAddTicketPayload struct {
+	// uid is the universal unique identifier of the fixture.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// sport is the sport of the fixture.
+	Sport string `protobuf:"bytes,2,opt,name=sport,proto3" json:"sport,omitempty"`
+	// competition is the competition or league of the fixture.
+	Competition string `protobuf:"bytes,3,opt,name=competition,proto3" json:"competition,omitempty"`
+	// participants is the list of the teams or players of the fixture.
+	Participants []string `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	// scheduled_ts is the scheduled start timestamp of the fixture.
+	ScheduledTS uint64 `protobuf:"varint,5,opt,name=scheduled_ts,proto3" json:"scheduled_ts"`
+	// meta contains human-readable metadata of the fixture.
+	Meta string `protobuf:"bytes,6,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (m *FixtureAddTicketPayload) Reset()         { *m = FixtureAddTicketPayload{} }
+func (m *FixtureAddTicketPayload) String() string { return proto.CompactTextString(m) }
+func (*FixtureAddTicketPayload) ProtoMessage()    {}
+func (*FixtureAddTicketPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dc46cd902954700, []int{4}
+}
+func (m *FixtureAddTicketPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixtureAddTicketPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixtureAddTicketPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FixtureAddTicketPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixtureAddTicketPayload.Merge(m, src)
+}
+func (m *FixtureAddTicketPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixtureAddTicketPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixtureAddTicketPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixtureAddTicketPayload proto.InternalMessageInfo
+
+func (m *FixtureAddTicketPayload) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *FixtureAddTicketPayload) GetSport() string {
+	if m != nil {
+		return m.Sport
+	}
+	return ""
+}
+
+func (m *FixtureAddTicketPayload) GetCompetition() string {
+	if m != nil {
+		return m.Competition
+	}
+	return ""
+}
+
+func (m *FixtureAddTicketPayload) GetParticipants() []string {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *FixtureAddTicketPayload) GetScheduledTS() uint64 {
+	if m != nil {
+		return m.ScheduledTS
+	}
+	return 0
+}
+
+func (m *FixtureAddTicketPayload) GetMeta() string {
+	if m != nil {
+		return m.Meta
+	}
+	return ""
+}
+
+// FixtureUpdateTicketPayload indicates data of the update fixture ticket.
+type FixtureUpdateTicketPayload struct {
+	// uid is the universal unique identifier of the fixture.
+	UID string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid"`
+	// scheduled_ts is the scheduled start timestamp of the fixture.
+	ScheduledTS uint64 `protobuf:"varint,2,opt,name=scheduled_ts,proto3" json:"scheduled_ts"`
+	// status is the new status of the fixture, postponing or canceling the
+	// fixture is cascaded to the markets of the fixture.
+	Status FixtureStatus `protobuf:"varint,3,opt,name=status,proto3,enum=sgenetwork.sge.market.FixtureStatus" json:"status,omitempty"`
+}
+
+func (m *FixtureUpdateTicketPayload) Reset()         { *m = FixtureUpdateTicketPayload{} }
+func (m *FixtureUpdateTicketPayload) String() string { return proto.CompactTextString(m) }
+func (*FixtureUpdateTicketPayload) ProtoMessage()    {}
+func (*FixtureUpdateTicketPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dc46cd902954700, []int{5}
+}
+func (m *FixtureUpdateTicketPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixtureUpdateTicketPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixtureUpdateTicketPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FixtureUpdateTicketPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixtureUpdateTicketPayload.Merge(m, src)
+}
+func (m *FixtureUpdateTicketPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixtureUpdateTicketPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixtureUpdateTicketPayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixtureUpdateTicketPayload proto.InternalMessageInfo
+
+func (m *FixtureUpdateTicketPayload) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *FixtureUpdateTicketPayload) GetScheduledTS() uint64 {
+	if m != nil {
+		return m.ScheduledTS
+	}
+	return 0
+}
+
+func (m *FixtureUpdateTicketPayload) GetStatus() FixtureStatus {
+	if m != nil {
+		return m.Status
+	}
+	return FixtureStatus_FIXTURE_STATUS_UNSPECIFIED
+}
+
 func init() {
 	proto.RegisterType((*MarketAddTicketPayload)(nil), "sgenetwork.sge.market.MarketAddTicketPayload")
 	proto.RegisterType((*MarketUpdateTicketPayload)(nil), "sgenetwork.sge.market.MarketUpdateTicketPayload")
+	proto.RegisterType((*OddsBettorCaps)(nil), "sgenetwork.sge.market.OddsBettorCaps")
 	proto.RegisterType((*MarketResolutionTicketPayload)(nil), "sgenetwork.sge.market.MarketResolutionTicketPayload")
+	proto.RegisterType((*FixtureAddTicketPayload)(nil), "sgenetwork.sge.market.FixtureAddTicketPayload")
+	proto.RegisterType((*FixtureUpdateTicketPayload)(nil), "sgenetwork.sge.market.FixtureUpdateTicketPayload")
 }
 
 func init() { proto.RegisterFile("sge/market/ticket.proto", fileDescriptor_1dc46cd902954700) }
 
 var fileDescriptor_1dc46cd902954700 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xcf, 0x6a, 0xd4, 0x40,
-	0x1c, 0xde, 0xd9, 0xa4, 0xd1, 0x8e, 0xba, 0xc8, 0x68, 0x6d, 0xac, 0x98, 0x89, 0x2b, 0x48, 0x44,
-	0x4c, 0xa0, 0x3d, 0x7a, 0x32, 0x56, 0xa4, 0x07, 0x51, 0x66, 0xbb, 0x08, 0x5e, 0x96, 0xb4, 0x33,
-	0xc4, 0xb0, 0xdd, 0xcc, 0x92, 0x99, 0x50, 0xfb, 0x16, 0xde, 0x05, 0x9f, 0xc7, 0x63, 0x8f, 0x1e,
-	0x64, 0x90, 0xd9, 0xdb, 0x3e, 0x85, 0xcc, 0x24, 0xa4, 0x5b, 0xaa, 0x87, 0xc5, 0x8b, 0x97, 0xcc,
-	0x97, 0xef, 0xcf, 0x24, 0xdf, 0x6f, 0x18, 0xb8, 0x2d, 0x72, 0x96, 0xcc, 0xb2, 0x6a, 0xca, 0x64,
-	0x22, 0x8b, 0xe3, 0x29, 0x93, 0xf1, 0xbc, 0xe2, 0x92, 0xa3, 0x2d, 0x91, 0xb3, 0x92, 0xc9, 0x53,
-	0x5e, 0x4d, 0x63, 0x91, 0xb3, 0xb8, 0xf1, 0xec, 0xac, 0xfa, 0x9b, 0xa5, 0xf1, 0xef, 0x6c, 0xad,
-	0x08, 0x9c, 0x52, 0xd1, 0xd2, 0x77, 0x73, 0x9e, 0x73, 0x0b, 0x13, 0x83, 0x1a, 0x76, 0xf8, 0xad,
-	0x0f, 0xef, 0xbd, 0xb5, 0xde, 0x97, 0x94, 0x1e, 0xda, 0xcf, 0xbe, 0xcf, 0xce, 0x4e, 0x78, 0x46,
-	0x51, 0x08, 0x9d, 0xba, 0xa0, 0x3e, 0x08, 0x41, 0xb4, 0x99, 0x0e, 0xb4, 0xc2, 0xce, 0xf8, 0x60,
-	0x7f, 0xa9, 0xb0, 0x61, 0x89, 0x79, 0xa0, 0x3d, 0x78, 0x5d, 0xc8, 0xac, 0x92, 0x13, 0x29, 0xfc,
-	0x7e, 0x08, 0x22, 0x37, 0xdd, 0xd6, 0x0a, 0x5f, 0x1b, 0x19, 0xee, 0x70, 0xb4, 0x54, 0xb8, 0x93,
-	0x49, 0x87, 0xd0, 0x33, 0xe8, 0xb1, 0x92, 0x9a, 0x88, 0x63, 0x23, 0x77, 0xb4, 0xc2, 0x1b, 0xaf,
-	0x4b, 0x6a, 0x03, 0xad, 0x44, 0xda, 0x15, 0x25, 0xd0, 0x35, 0x15, 0x7c, 0x37, 0x74, 0xa2, 0x1b,
-	0xbb, 0x0f, 0xe2, 0x3f, 0x8e, 0x22, 0x7e, 0x47, 0xa9, 0x20, 0xd6, 0x88, 0x5e, 0x40, 0x4f, 0xc8,
-	0x4c, 0xd6, 0xc2, 0xdf, 0x08, 0x41, 0x34, 0xd8, 0x7d, 0xfc, 0x97, 0x48, 0xd3, 0x79, 0x64, 0xad,
-	0xa4, 0x8d, 0x20, 0x04, 0xdd, 0x19, 0x93, 0x99, 0xef, 0x99, 0xca, 0xc4, 0xe2, 0xe1, 0x4f, 0x00,
-	0xef, 0x37, 0xe6, 0xf1, 0x9c, 0x66, 0x92, 0xfd, 0x7f, 0x33, 0xba, 0xa8, 0xec, 0xae, 0x5d, 0x79,
-	0xf8, 0xb5, 0x0f, 0x1f, 0x36, 0x02, 0x61, 0x82, 0x9f, 0xd4, 0xb2, 0xe0, 0xe5, 0xba, 0x15, 0xdf,
-	0xc0, 0x5b, 0x55, 0x17, 0xbe, 0xe8, 0xf9, 0x48, 0x2b, 0x7c, 0x73, 0x65, 0x57, 0xf3, 0xef, 0x97,
-	0x8d, 0xe4, 0xf2, 0x2b, 0x22, 0xf0, 0xf6, 0x69, 0x51, 0x96, 0xac, 0x9a, 0x98, 0xb3, 0x9c, 0xd4,
-	0x05, 0x35, 0x03, 0x70, 0xa2, 0xcd, 0xf4, 0x89, 0x56, 0x78, 0xf0, 0xc1, 0x6a, 0xe6, 0xb0, 0xc7,
-	0x07, 0xfb, 0x62, 0xa9, 0xf0, 0x15, 0x37, 0xb9, 0xc2, 0xfc, 0xd3, 0x74, 0xd2, 0x57, 0xdf, 0x75,
-	0x00, 0xce, 0x75, 0x00, 0x7e, 0xe9, 0x00, 0x7c, 0x59, 0x04, 0xbd, 0xf3, 0x45, 0xd0, 0xfb, 0xb1,
-	0x08, 0x7a, 0x1f, 0x9f, 0xe6, 0x85, 0xfc, 0x54, 0x1f, 0xc5, 0xc7, 0x7c, 0x96, 0x88, 0x9c, 0x3d,
-	0x6f, 0x77, 0x34, 0x38, 0xf9, 0xdc, 0x5d, 0xe3, 0xb3, 0x39, 0x13, 0x47, 0x9e, 0xbd, 0x69, 0x7b,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xd8, 0x54, 0xce, 0xe1, 0x03, 0x00, 0x00,
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x5f, 0xc7, 0xf9, 0xfb, 0x92, 0x4d, 0xdb, 0xd9, 0x5d, 0xd6, 0x2c, 0x6a, 0xec, 0x9a, 0x82,
+	0x82, 0x80, 0x04, 0x6d, 0x25, 0x2e, 0x20, 0x21, 0xb2, 0x85, 0xaa, 0x87, 0xb2, 0x68, 0xd2, 0x05,
+	0x89, 0x03, 0x91, 0xe3, 0x99, 0xa6, 0xd6, 0x6e, 0x3c, 0x96, 0x67, 0xdc, 0xb4, 0x1f, 0x81, 0x1b,
+	0x57, 0x3e, 0x0a, 0x47, 0x6e, 0x1c, 0x7b, 0xe4, 0x64, 0x21, 0x2f, 0xa7, 0x3d, 0xf1, 0x11, 0xd0,
+	0x8c, 0x9d, 0xc4, 0x4e, 0xb2, 0x45, 0x1b, 0x2e, 0x5c, 0x92, 0xf7, 0x7e, 0xef, 0xf7, 0xde, 0x3c,
+	0xbf, 0x3f, 0x1e, 0xc3, 0x21, 0x9f, 0xd0, 0xfe, 0xd4, 0x09, 0xcf, 0xa9, 0xe8, 0x0b, 0xcf, 0x3d,
+	0xa7, 0xa2, 0x17, 0x84, 0x4c, 0x30, 0x74, 0xc0, 0x27, 0xd4, 0xa7, 0x62, 0xc6, 0xc2, 0xf3, 0x1e,
+	0x9f, 0xd0, 0x5e, 0xca, 0x39, 0xca, 0xf3, 0xd3, 0xbf, 0x94, 0x7f, 0x74, 0x90, 0x33, 0x30, 0x42,
+	0x78, 0x06, 0x1b, 0x39, 0xf8, 0x99, 0xf7, 0x52, 0x44, 0x21, 0xcd, 0x2c, 0xfb, 0x13, 0x36, 0x61,
+	0x4a, 0xec, 0x4b, 0x29, 0x45, 0xed, 0x5f, 0x75, 0x78, 0xeb, 0x89, 0xa2, 0x7f, 0x49, 0xc8, 0x53,
+	0x95, 0xd0, 0xb7, 0xce, 0xab, 0x0b, 0xe6, 0x10, 0x64, 0x81, 0x1e, 0x79, 0xc4, 0xd0, 0x2c, 0xad,
+	0xdb, 0x18, 0xb4, 0x93, 0xd8, 0xd4, 0xcf, 0x1e, 0x3f, 0xbc, 0x8a, 0x4d, 0x89, 0x62, 0xf9, 0x83,
+	0x1e, 0x40, 0x9d, 0x0b, 0x27, 0x14, 0x23, 0xc1, 0x8d, 0x92, 0xa5, 0x75, 0xcb, 0x83, 0xc3, 0x24,
+	0x36, 0x6b, 0x43, 0x89, 0x3d, 0x1d, 0x5e, 0xc5, 0xe6, 0xc2, 0x8c, 0x17, 0x12, 0xfa, 0x10, 0xaa,
+	0xd4, 0x27, 0xd2, 0x45, 0x57, 0x2e, 0x7b, 0x49, 0x6c, 0x56, 0xbe, 0xf2, 0x89, 0x72, 0xc8, 0x4c,
+	0x38, 0xfb, 0x47, 0x7d, 0x28, 0xcb, 0x87, 0x33, 0xca, 0x96, 0xde, 0x6d, 0x1e, 0xbf, 0xd3, 0xdb,
+	0x58, 0xa4, 0xde, 0x29, 0x21, 0x1c, 0x2b, 0x22, 0xfa, 0x0c, 0xaa, 0x5c, 0x38, 0x22, 0xe2, 0x46,
+	0xc5, 0xd2, 0xba, 0xed, 0xe3, 0x77, 0xaf, 0x71, 0x49, 0x9f, 0x79, 0xa8, 0xa8, 0x38, 0x73, 0x41,
+	0x08, 0xca, 0x53, 0x2a, 0x1c, 0xa3, 0x2a, 0x1f, 0x19, 0x2b, 0x19, 0xed, 0x43, 0x85, 0x50, 0x9f,
+	0x4d, 0x8d, 0x9a, 0x02, 0x53, 0x05, 0x0d, 0xa0, 0x39, 0xa6, 0x42, 0xb0, 0x70, 0xe4, 0x3a, 0x01,
+	0x37, 0xea, 0x96, 0xd6, 0x6d, 0x1e, 0xdf, 0xbb, 0xe6, 0xac, 0x81, 0x62, 0x9e, 0x38, 0x01, 0xc7,
+	0x30, 0x5e, 0xc8, 0xe8, 0x0b, 0x68, 0x66, 0x1d, 0x1a, 0xc9, 0x3a, 0x37, 0x54, 0x9d, 0xef, 0x26,
+	0xb1, 0x09, 0x5f, 0xa7, 0x70, 0x5a, 0xee, 0x3c, 0x09, 0xe7, 0x15, 0xfb, 0x97, 0x2a, 0xbc, 0x9d,
+	0x3e, 0xc7, 0x59, 0x40, 0x1c, 0x41, 0xff, 0x7f, 0xed, 0x5b, 0x76, 0xa3, 0x7c, 0xf3, 0x6e, 0xac,
+	0xd4, 0xb8, 0xb2, 0x4d, 0x8d, 0x4f, 0xe1, 0xb6, 0x1c, 0x8b, 0x51, 0x3e, 0x50, 0x55, 0xcd, 0xd2,
+	0x7b, 0x6f, 0x98, 0xa5, 0x5c, 0xb0, 0x36, 0x2b, 0xe8, 0xe8, 0x1b, 0xb8, 0x35, 0x65, 0x2f, 0x28,
+	0x19, 0xa9, 0xb0, 0x91, 0x47, 0xb8, 0x51, 0xb3, 0xf4, 0x6e, 0x63, 0x70, 0x3f, 0x89, 0xcd, 0xdd,
+	0x27, 0xd2, 0x24, 0x23, 0x9c, 0x3d, 0x7e, 0xc8, 0xaf, 0x62, 0x73, 0x95, 0x8b, 0x57, 0x01, 0xf4,
+	0x29, 0xd4, 0x7d, 0x3a, 0x53, 0x80, 0x51, 0xff, 0xf7, 0x21, 0xaf, 0xf9, 0x74, 0x26, 0x05, 0xf4,
+	0x23, 0xec, 0xf1, 0x88, 0x07, 0xd4, 0x27, 0x85, 0x5c, 0x1a, 0x2a, 0x97, 0x8f, 0x92, 0xd8, 0xbc,
+	0x33, 0x9c, 0x9b, 0x73, 0xf9, 0x6c, 0xf2, 0xc1, 0x9b, 0x40, 0xf4, 0x0c, 0x0e, 0x42, 0xea, 0xb8,
+	0xc2, 0x7b, 0xe1, 0x88, 0xc2, 0x09, 0xa0, 0x4e, 0xf8, 0x24, 0x89, 0xcd, 0x3d, 0xbc, 0x24, 0xe4,
+	0xce, 0xd8, 0xec, 0x87, 0x37, 0xc3, 0xe8, 0x3b, 0xb8, 0x13, 0xd2, 0xd5, 0x8a, 0x36, 0xd5, 0x19,
+	0xdd, 0x24, 0x36, 0x6f, 0xe1, 0xd4, 0x98, 0x8b, 0xbf, 0xce, 0xc7, 0xeb, 0x90, 0xfd, 0x93, 0x06,
+	0xed, 0x62, 0x2b, 0xe5, 0xb8, 0xcf, 0xed, 0xd9, 0x56, 0xa8, 0x71, 0xcf, 0x42, 0xcb, 0x71, 0x9f,
+	0x9b, 0xf1, 0x42, 0x5a, 0x1d, 0xc2, 0xd2, 0x16, 0x43, 0x68, 0xff, 0x55, 0x82, 0xbb, 0xe9, 0x84,
+	0x63, 0xca, 0xd9, 0x45, 0x24, 0x3c, 0xe6, 0xdf, 0x74, 0x57, 0x1f, 0xc1, 0x6e, 0xb8, 0x70, 0x5e,
+	0x2e, 0xec, 0xbd, 0x24, 0x36, 0x5b, 0xb9, 0xa8, 0x72, 0x09, 0x8b, 0x44, 0x5c, 0x54, 0x11, 0x86,
+	0xdb, 0x33, 0xcf, 0xf7, 0x69, 0x98, 0xab, 0xb7, 0xae, 0xea, 0xfd, 0x7e, 0x12, 0x9b, 0xed, 0xef,
+	0x95, 0x2d, 0x57, 0xee, 0x35, 0x36, 0x5e, 0x43, 0xfe, 0xdb, 0x9a, 0x3f, 0x82, 0x5d, 0x15, 0x89,
+	0x45, 0xc2, 0x65, 0x53, 0x2a, 0x17, 0x5d, 0xae, 0x81, 0xfd, 0x86, 0x35, 0x38, 0x4d, 0xa9, 0xb8,
+	0xc5, 0x96, 0x0a, 0xb7, 0xff, 0xd6, 0xe0, 0x30, 0x7b, 0x73, 0x6e, 0x71, 0x97, 0xed, 0x43, 0x85,
+	0x07, 0x2c, 0x14, 0xaa, 0xb0, 0x0d, 0x9c, 0x2a, 0xc8, 0x82, 0xa6, 0xcb, 0xa6, 0x01, 0x15, 0x9e,
+	0xac, 0x9f, 0x7a, 0xe5, 0x35, 0x70, 0x1e, 0x42, 0x36, 0xb4, 0x02, 0x27, 0x14, 0x9e, 0xeb, 0x05,
+	0x8e, 0x2f, 0xd2, 0x9b, 0xaa, 0x81, 0x0b, 0x18, 0x3a, 0x81, 0x16, 0x77, 0x9f, 0x53, 0x12, 0x5d,
+	0x50, 0xf5, 0xe6, 0xac, 0xa8, 0xde, 0x99, 0x49, 0x6c, 0x36, 0x87, 0x73, 0x5c, 0xb5, 0xae, 0x40,
+	0xc3, 0x05, 0x6d, 0xd3, 0xe5, 0x64, 0xff, 0xa6, 0xc1, 0xd1, 0xfc, 0xb2, 0xd8, 0xea, 0x0a, 0x58,
+	0xcd, 0xac, 0xb4, 0x4d, 0x66, 0x9f, 0x2f, 0xda, 0xaf, 0xab, 0xf6, 0xdf, 0xbf, 0xa6, 0x75, 0x59,
+	0xa6, 0xc5, 0xfe, 0x0f, 0x4e, 0x7e, 0x4f, 0x3a, 0xda, 0xeb, 0xa4, 0xa3, 0xfd, 0x99, 0x74, 0xb4,
+	0x9f, 0x2f, 0x3b, 0x3b, 0xaf, 0x2f, 0x3b, 0x3b, 0x7f, 0x5c, 0x76, 0x76, 0x7e, 0xf8, 0x60, 0xe2,
+	0x89, 0xe7, 0xd1, 0xb8, 0xe7, 0xb2, 0x69, 0x9f, 0x4f, 0xe8, 0xc7, 0x59, 0x48, 0x29, 0xf7, 0x5f,
+	0x2e, 0x3e, 0xa2, 0x5e, 0x05, 0x94, 0x8f, 0xab, 0xea, 0x6b, 0xe6, 0xc1, 0x3f, 0x03, 0x00, 0xc2,
+	0x1e, 0xa3, 0xe9, 0x5f, 0x09, 0x00, 0x00,
 }
 
 func (m *MarketAddTicketPayload) Marshal() (dAtA []byte, err error) {
@@ -322,6 +676,32 @@ func (m *MarketAddTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.FixtureUID) > 0 {
+		i -= len(m.FixtureUID)
+		copy(dAtA[i:], m.FixtureUID)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.FixtureUID)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BettorCaps != nil {
+		{
+			size, err := m.BettorCaps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTicket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Meta) > 0 {
 		i -= len(m.Meta)
 		copy(dAtA[i:], m.Meta)
@@ -388,6 +768,82 @@ func (m *MarketUpdateTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.RemovedOddsUIDs) > 0 {
+		for iNdEx := len(m.RemovedOddsUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedOddsUIDs[iNdEx])
+			copy(dAtA[i:], m.RemovedOddsUIDs[iNdEx])
+			i = encodeVarintTicket(dAtA, i, uint64(len(m.RemovedOddsUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ReactivatedOddsUIDs) > 0 {
+		for iNdEx := len(m.ReactivatedOddsUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReactivatedOddsUIDs[iNdEx])
+			copy(dAtA[i:], m.ReactivatedOddsUIDs[iNdEx])
+			i = encodeVarintTicket(dAtA, i, uint64(len(m.ReactivatedOddsUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SuspendedOddsUIDs) > 0 {
+		for iNdEx := len(m.SuspendedOddsUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SuspendedOddsUIDs[iNdEx])
+			copy(dAtA[i:], m.SuspendedOddsUIDs[iNdEx])
+			i = encodeVarintTicket(dAtA, i, uint64(len(m.SuspendedOddsUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.NewOdds) > 0 {
+		for iNdEx := len(m.NewOdds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewOdds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MovedOddsUIDs) > 0 {
+		for iNdEx := len(m.MovedOddsUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MovedOddsUIDs[iNdEx])
+			copy(dAtA[i:], m.MovedOddsUIDs[iNdEx])
+			i = encodeVarintTicket(dAtA, i, uint64(len(m.MovedOddsUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OddsBettorCaps) > 0 {
+		for iNdEx := len(m.OddsBettorCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OddsBettorCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BettorCaps != nil {
+		{
+			size, err := m.BettorCaps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTicket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Status != 0 {
 		i = encodeVarintTicket(dAtA, i, uint64(m.Status))
 		i--
@@ -413,7 +869,7 @@ func (m *MarketUpdateTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MarketResolutionTicketPayload) Marshal() (dAtA []byte, err error) {
+func (m *OddsBettorCaps) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -423,49 +879,210 @@ func (m *MarketResolutionTicketPayload) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MarketResolutionTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+func (m *OddsBettorCaps) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MarketResolutionTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OddsBettorCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTicket(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.WinnerOddsUIDs) > 0 {
-		for iNdEx := len(m.WinnerOddsUIDs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.WinnerOddsUIDs[iNdEx])
-			copy(dAtA[i:], m.WinnerOddsUIDs[iNdEx])
-			i = encodeVarintTicket(dAtA, i, uint64(len(m.WinnerOddsUIDs[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if m.BettorCaps != nil {
+		{
+			size, err := m.BettorCaps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTicket(dAtA, i, uint64(size))
 		}
-	}
-	if m.ResolutionTS != 0 {
-		i = encodeVarintTicket(dAtA, i, uint64(m.ResolutionTS))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.UID) > 0 {
-		i -= len(m.UID)
-		copy(dAtA[i:], m.UID)
-		i = encodeVarintTicket(dAtA, i, uint64(len(m.UID)))
+	if len(m.OddsUID) > 0 {
+		i -= len(m.OddsUID)
+		copy(dAtA[i:], m.OddsUID)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.OddsUID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTicket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTicket(v)
-	base := offset
-	for v >= 1<<7 {
+func (m *MarketResolutionTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketResolutionTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketResolutionTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OddsOutcomes) > 0 {
+		for iNdEx := len(m.OddsOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OddsOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTicket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintTicket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.WinnerOddsUIDs) > 0 {
+		for iNdEx := len(m.WinnerOddsUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WinnerOddsUIDs[iNdEx])
+			copy(dAtA[i:], m.WinnerOddsUIDs[iNdEx])
+			i = encodeVarintTicket(dAtA, i, uint64(len(m.WinnerOddsUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ResolutionTS != 0 {
+		i = encodeVarintTicket(dAtA, i, uint64(m.ResolutionTS))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FixtureAddTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixtureAddTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixtureAddTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Meta) > 0 {
+		i -= len(m.Meta)
+		copy(dAtA[i:], m.Meta)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Meta)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ScheduledTS != 0 {
+		i = encodeVarintTicket(dAtA, i, uint64(m.ScheduledTS))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Participants[iNdEx])
+			copy(dAtA[i:], m.Participants[iNdEx])
+			i = encodeVarintTicket(dAtA, i, uint64(len(m.Participants[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Competition) > 0 {
+		i -= len(m.Competition)
+		copy(dAtA[i:], m.Competition)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Competition)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sport) > 0 {
+		i -= len(m.Sport)
+		copy(dAtA[i:], m.Sport)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.Sport)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FixtureUpdateTicketPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixtureUpdateTicketPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixtureUpdateTicketPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTicket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ScheduledTS != 0 {
+		i = encodeVarintTicket(dAtA, i, uint64(m.ScheduledTS))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UID) > 0 {
+		i -= len(m.UID)
+		copy(dAtA[i:], m.UID)
+		i = encodeVarintTicket(dAtA, i, uint64(len(m.UID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTicket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTicket(v)
+	base := offset
+	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
@@ -502,6 +1119,18 @@ func (m *MarketAddTicketPayload) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTicket(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	if m.BettorCaps != nil {
+		l = m.BettorCaps.Size()
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = len(m.FixtureUID)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
 	return n
 }
 
@@ -524,6 +1153,63 @@ func (m *MarketUpdateTicketPayload) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovTicket(uint64(m.Status))
 	}
+	if m.BettorCaps != nil {
+		l = m.BettorCaps.Size()
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	if len(m.OddsBettorCaps) > 0 {
+		for _, e := range m.OddsBettorCaps {
+			l = e.Size()
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	if len(m.MovedOddsUIDs) > 0 {
+		for _, s := range m.MovedOddsUIDs {
+			l = len(s)
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	if len(m.NewOdds) > 0 {
+		for _, e := range m.NewOdds {
+			l = e.Size()
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	if len(m.SuspendedOddsUIDs) > 0 {
+		for _, s := range m.SuspendedOddsUIDs {
+			l = len(s)
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	if len(m.ReactivatedOddsUIDs) > 0 {
+		for _, s := range m.ReactivatedOddsUIDs {
+			l = len(s)
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	if len(m.RemovedOddsUIDs) > 0 {
+		for _, s := range m.RemovedOddsUIDs {
+			l = len(s)
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OddsBettorCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OddsUID)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	if m.BettorCaps != nil {
+		l = m.BettorCaps.Size()
+		n += 1 + l + sovTicket(uint64(l))
+	}
 	return n
 }
 
@@ -546,19 +1232,872 @@ func (m *MarketResolutionTicketPayload) Size() (n int) {
 			n += 1 + l + sovTicket(uint64(l))
 		}
 	}
-	if m.Status != 0 {
-		n += 1 + sovTicket(uint64(m.Status))
-	}
-	return n
-}
+	if m.Status != 0 {
+		n += 1 + sovTicket(uint64(m.Status))
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for _, e := range m.OddsOutcomes {
+			l = e.Size()
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FixtureAddTicketPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = len(m.Sport)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	l = len(m.Competition)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
+			n += 1 + l + sovTicket(uint64(l))
+		}
+	}
+	if m.ScheduledTS != 0 {
+		n += 1 + sovTicket(uint64(m.ScheduledTS))
+	}
+	l = len(m.Meta)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	return n
+}
+
+func (m *FixtureUpdateTicketPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovTicket(uint64(l))
+	}
+	if m.ScheduledTS != 0 {
+		n += 1 + sovTicket(uint64(m.ScheduledTS))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTicket(uint64(m.Status))
+	}
+	return n
+}
+
+func sovTicket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTicket(x uint64) (n int) {
+	return sovTicket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketAddTicketPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketAddTicketPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketAddTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTS", wireType)
+			}
+			m.StartTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTS", wireType)
+			}
+			m.EndTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Odds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Odds = append(m.Odds, &Odds{})
+			if err := m.Odds[len(m.Odds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BettorCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BettorCaps == nil {
+				m.BettorCaps = &BettorCaps{}
+			}
+			if err := m.BettorCaps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixtureUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixtureUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketUpdateTicketPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketUpdateTicketPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketUpdateTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTS", wireType)
+			}
+			m.StartTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTS", wireType)
+			}
+			m.EndTS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BettorCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BettorCaps == nil {
+				m.BettorCaps = &BettorCaps{}
+			}
+			if err := m.BettorCaps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsBettorCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsBettorCaps = append(m.OddsBettorCaps, &OddsBettorCaps{})
+			if err := m.OddsBettorCaps[len(m.OddsBettorCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedOddsUIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MovedOddsUIDs = append(m.MovedOddsUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOdds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOdds = append(m.NewOdds, &Odds{})
+			if err := m.NewOdds[len(m.NewOdds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedOddsUIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuspendedOddsUIDs = append(m.SuspendedOddsUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReactivatedOddsUIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReactivatedOddsUIDs = append(m.ReactivatedOddsUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedOddsUIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedOddsUIDs = append(m.RemovedOddsUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OddsBettorCaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTicket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OddsBettorCaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OddsBettorCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BettorCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BettorCaps == nil {
+				m.BettorCaps = &BettorCaps{}
+			}
+			if err := m.BettorCaps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTicket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTicket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTicket(x uint64) (n int) {
-	return sovTicket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MarketAddTicketPayload) Unmarshal(dAtA []byte) error {
+func (m *MarketResolutionTicketPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -581,10 +2120,10 @@ func (m *MarketAddTicketPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketAddTicketPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketResolutionTicketPayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketAddTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketResolutionTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -621,9 +2160,9 @@ func (m *MarketAddTicketPayload) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionTS", wireType)
 			}
-			m.StartTS = 0
+			m.ResolutionTS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicket
@@ -633,35 +2172,16 @@ func (m *MarketAddTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTS |= uint64(b&0x7F) << shift
+				m.ResolutionTS |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTS", wireType)
-			}
-			m.EndTS = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTicket
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndTS |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Odds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnerOddsUIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicket
@@ -671,27 +2191,25 @@ func (m *MarketAddTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTicket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTicket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Odds = append(m.Odds, &Odds{})
-			if err := m.Odds[len(m.Odds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WinnerOddsUIDs = append(m.WinnerOddsUIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
@@ -710,11 +2228,11 @@ func (m *MarketAddTicketPayload) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsOutcomes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicket
@@ -724,23 +2242,25 @@ func (m *MarketAddTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTicket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTicket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Meta = string(dAtA[iNdEx:postIndex])
+			m.OddsOutcomes = append(m.OddsOutcomes, &OddsOutcome{})
+			if err := m.OddsOutcomes[len(m.OddsOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -763,7 +2283,7 @@ func (m *MarketAddTicketPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketUpdateTicketPayload) Unmarshal(dAtA []byte) error {
+func (m *FixtureAddTicketPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -786,10 +2306,10 @@ func (m *MarketUpdateTicketPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketUpdateTicketPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: FixtureAddTicketPayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketUpdateTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FixtureAddTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -825,10 +2345,10 @@ func (m *MarketUpdateTicketPayload) Unmarshal(dAtA []byte) error {
 			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sport", wireType)
 			}
-			m.StartTS = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicket
@@ -838,16 +2358,29 @@ func (m *MarketUpdateTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTS |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sport = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Competition", wireType)
 			}
-			m.EndTS = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicket
@@ -857,16 +2390,61 @@ func (m *MarketUpdateTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndTS |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Competition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTS", wireType)
 			}
-			m.Status = 0
+			m.ScheduledTS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicket
@@ -876,11 +2454,43 @@ func (m *MarketUpdateTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarketStatus(b&0x7F) << shift
+				m.ScheduledTS |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTicket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTicket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTicket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTicket(dAtA[iNdEx:])
@@ -902,7 +2512,7 @@ func (m *MarketUpdateTicketPayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketResolutionTicketPayload) Unmarshal(dAtA []byte) error {
+func (m *FixtureUpdateTicketPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -925,10 +2535,10 @@ func (m *MarketResolutionTicketPayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketResolutionTicketPayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: FixtureUpdateTicketPayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketResolutionTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FixtureUpdateTicketPayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -965,9 +2575,9 @@ func (m *MarketResolutionTicketPayload) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResolutionTS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTS", wireType)
 			}
-			m.ResolutionTS = 0
+			m.ScheduledTS = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTicket
@@ -977,44 +2587,12 @@ func (m *MarketResolutionTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResolutionTS |= uint64(b&0x7F) << shift
+				m.ScheduledTS |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WinnerOddsUIDs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTicket
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTicket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTicket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WinnerOddsUIDs = append(m.WinnerOddsUIDs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
@@ -1028,7 +2606,7 @@ func (m *MarketResolutionTicketPayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= MarketStatus(b&0x7F) << shift
+				m.Status |= FixtureStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/google/uuid"
 	simappUtil "github.com/sge-network/sge/testutil/simapp"
@@ -170,6 +171,52 @@ func TestAddMarketTicketPayloadValidation(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidRequest,
 		},
+		{
+			name: "valid bettor caps",
+			payload: types.MarketAddTicketPayload{
+				UID:     uuid.NewString(),
+				StartTS: cast.ToUint64(ctx.BlockTime().Unix()),
+				EndTS:   cast.ToUint64(ctx.BlockTime().Add(5 * time.Minute).Unix()),
+				Odds: []*types.Odds{
+					{UID: uuid.NewString(), Meta: "odds 1", BettorCaps: &types.BettorCaps{MaxStake: sdk.ZeroInt(), MaxPayout: sdk.NewInt(1000)}},
+					{UID: uuid.NewString(), Meta: "odds 2"},
+				},
+				Status:     types.MarketStatus_MARKET_STATUS_ACTIVE,
+				Meta:       "sample market",
+				BettorCaps: &types.BettorCaps{MaxStake: sdk.NewInt(1000)},
+			},
+		},
+		{
+			name: "negative market bettor caps",
+			payload: types.MarketAddTicketPayload{
+				UID:     uuid.NewString(),
+				StartTS: cast.ToUint64(ctx.BlockTime().Unix()),
+				EndTS:   cast.ToUint64(ctx.BlockTime().Add(5 * time.Minute).Unix()),
+				Odds: []*types.Odds{
+					{UID: uuid.NewString(), Meta: "odds 1"},
+					{UID: uuid.NewString(), Meta: "odds 2"},
+				},
+				Status:     types.MarketStatus_MARKET_STATUS_ACTIVE,
+				Meta:       "sample market",
+				BettorCaps: &types.BettorCaps{MaxStake: sdk.NewInt(-1)},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "negative odds bettor caps",
+			payload: types.MarketAddTicketPayload{
+				UID:     uuid.NewString(),
+				StartTS: cast.ToUint64(ctx.BlockTime().Unix()),
+				EndTS:   cast.ToUint64(ctx.BlockTime().Add(5 * time.Minute).Unix()),
+				Odds: []*types.Odds{
+					{UID: uuid.NewString(), Meta: "odds 1", BettorCaps: &types.BettorCaps{MaxPayout: sdk.NewInt(-1)}},
+					{UID: uuid.NewString(), Meta: "odds 2"},
+				},
+				Status: types.MarketStatus_MARKET_STATUS_ACTIVE,
+				Meta:   "sample market",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -189,6 +236,8 @@ func TestUpdateMarketTicketPayloadValidation(t *testing.T) {
 
 	ctx = ctx.WithBlockTime(time.Now())
 
+	sampleUID := uuid.NewString()
+
 	tests := []struct {
 		name    string
 		payload types.MarketUpdateTicketPayload
@@ -222,6 +271,275 @@ func TestUpdateMarketTicketPayloadValidation(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidRequest,
 		},
+		{
+			name: "valid odds bettor caps",
+			payload: types.MarketUpdateTicketPayload{
+				UID:     uuid.NewString(),
+				StartTS: cast.ToUint64(ctx.BlockTime().Unix()),
+				EndTS:   cast.ToUint64(ctx.BlockTime().Add(5 * time.Minute).Unix()),
+				Status:  types.MarketStatus_MARKET_STATUS_ACTIVE,
+				OddsBettorCaps: []*types.OddsBettorCaps{
+					{OddsUID: uuid.NewString(), BettorCaps: &types.BettorCaps{MaxStake: sdk.NewInt(1000)}},
+				},
+			},
+		},
+		{
+			name: "duplicate odds bettor caps",
+			payload: types.MarketUpdateTicketPayload{
+				UID:     uuid.NewString(),
+				StartTS: cast.ToUint64(ctx.BlockTime().Unix()),
+				EndTS:   cast.ToUint64(ctx.BlockTime().Add(5 * time.Minute).Unix()),
+				Status:  types.MarketStatus_MARKET_STATUS_ACTIVE,
+				OddsBettorCaps: []*types.OddsBettorCaps{
+					{OddsUID: sampleUID},
+					{OddsUID: sampleUID},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid odds changes",
+			payload: types.MarketUpdateTicketPayload{
+				UID:     uuid.NewString(),
+				StartTS: cast.ToUint64(ctx.BlockTime().Unix()),
+				EndTS:   cast.ToUint64(ctx.BlockTime().Add(5 * time.Minute).Unix()),
+				Status:  types.MarketStatus_MARKET_STATUS_ACTIVE,
+				NewOdds: []*types.Odds{
+					{UID: uuid.NewString(), Meta: "late runner"},
+				},
+				SuspendedOddsUIDs:   []string{uuid.NewString()},
+				ReactivatedOddsUIDs: []string{uuid.NewString()},
+				RemovedOddsUIDs:     []string{uuid.NewString()},
+			},
+		},
+		{
+			name: "new odds without meta",
+			payload: types.MarketUpdateTicketPayload{
+				UID:     uuid.NewString(),
+				StartTS: cast.ToUint64(ctx.BlockTime().Unix()),
+				EndTS:   cast.ToUint64(ctx.BlockTime().Add(5 * time.Minute).Unix()),
+				Status:  types.MarketStatus_MARKET_STATUS_ACTIVE,
+				NewOdds: []*types.Odds{
+					{UID: uuid.NewString()},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "new odds added as removed",
+			payload: types.MarketUpdateTicketPayload{
+				UID:     uuid.NewString(),
+				StartTS: cast.ToUint64(ctx.BlockTime().Unix()),
+				EndTS:   cast.ToUint64(ctx.BlockTime().Add(5 * time.Minute).Unix()),
+				Status:  types.MarketStatus_MARKET_STATUS_ACTIVE,
+				NewOdds: []*types.Odds{
+					{UID: uuid.NewString(), Meta: "late runner", Status: types.OddsStatus_ODDS_STATUS_REMOVED},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid suspended odds uid",
+			payload: types.MarketUpdateTicketPayload{
+				UID:               uuid.NewString(),
+				StartTS:           cast.ToUint64(ctx.BlockTime().Unix()),
+				EndTS:             cast.ToUint64(ctx.BlockTime().Add(5 * time.Minute).Unix()),
+				Status:            types.MarketStatus_MARKET_STATUS_ACTIVE,
+				SuspendedOddsUIDs: []string{"invalid"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "odds suspended and reactivated in the same ticket",
+			payload: types.MarketUpdateTicketPayload{
+				UID:                 uuid.NewString(),
+				StartTS:             cast.ToUint64(ctx.BlockTime().Unix()),
+				EndTS:               cast.ToUint64(ctx.BlockTime().Add(5 * time.Minute).Unix()),
+				Status:              types.MarketStatus_MARKET_STATUS_ACTIVE,
+				SuspendedOddsUIDs:   []string{sampleUID},
+				ReactivatedOddsUIDs: []string{sampleUID},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAddFixtureTicketPayloadValidation(t *testing.T) {
+	_, ctx, err := simappUtil.GetTestObjects()
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(time.Now())
+
+	scheduledTS := cast.ToUint64(ctx.BlockTime().Add(time.Hour).Unix())
+
+	tests := []struct {
+		name    string
+		payload types.FixtureAddTicketPayload
+		err     error
+	}{
+		{
+			name: "valid",
+			payload: types.FixtureAddTicketPayload{
+				UID:          uuid.NewString(),
+				Sport:        "football",
+				Competition:  "premier league",
+				Participants: []string{"home", "away"},
+				ScheduledTS:  scheduledTS,
+			},
+		},
+		{
+			name: "invalid uuid",
+			payload: types.FixtureAddTicketPayload{
+				UID:          "invalid uuid",
+				Sport:        "football",
+				Competition:  "premier league",
+				Participants: []string{"home", "away"},
+				ScheduledTS:  scheduledTS,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "empty sport",
+			payload: types.FixtureAddTicketPayload{
+				UID:          uuid.NewString(),
+				Competition:  "premier league",
+				Participants: []string{"home", "away"},
+				ScheduledTS:  scheduledTS,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "empty competition",
+			payload: types.FixtureAddTicketPayload{
+				UID:          uuid.NewString(),
+				Sport:        "football",
+				Participants: []string{"home", "away"},
+				ScheduledTS:  scheduledTS,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "not enough participants",
+			payload: types.FixtureAddTicketPayload{
+				UID:          uuid.NewString(),
+				Sport:        "football",
+				Competition:  "premier league",
+				Participants: []string{"home"},
+				ScheduledTS:  scheduledTS,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "duplicate participants",
+			payload: types.FixtureAddTicketPayload{
+				UID:          uuid.NewString(),
+				Sport:        "football",
+				Competition:  "premier league",
+				Participants: []string{"home", "home"},
+				ScheduledTS:  scheduledTS,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "passed scheduled time",
+			payload: types.FixtureAddTicketPayload{
+				UID:          uuid.NewString(),
+				Sport:        "football",
+				Competition:  "premier league",
+				Participants: []string{"home", "away"},
+				ScheduledTS:  cast.ToUint64(ctx.BlockTime().Unix()),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate(ctx)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateFixtureTicketPayloadValidation(t *testing.T) {
+	_, ctx, err := simappUtil.GetTestObjects()
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(time.Now())
+
+	tests := []struct {
+		name    string
+		payload types.FixtureUpdateTicketPayload
+		err     error
+	}{
+		{
+			name: "valid reschedule",
+			payload: types.FixtureUpdateTicketPayload{
+				UID:         uuid.NewString(),
+				ScheduledTS: cast.ToUint64(ctx.BlockTime().Add(time.Hour).Unix()),
+				Status:      types.FixtureStatus_FIXTURE_STATUS_SCHEDULED,
+			},
+		},
+		{
+			name: "scheduled without time",
+			payload: types.FixtureUpdateTicketPayload{
+				UID:    uuid.NewString(),
+				Status: types.FixtureStatus_FIXTURE_STATUS_SCHEDULED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "postponed without time",
+			payload: types.FixtureUpdateTicketPayload{
+				UID:    uuid.NewString(),
+				Status: types.FixtureStatus_FIXTURE_STATUS_POSTPONED,
+			},
+		},
+		{
+			name: "postponed to the past",
+			payload: types.FixtureUpdateTicketPayload{
+				UID:         uuid.NewString(),
+				ScheduledTS: cast.ToUint64(ctx.BlockTime().Add(-time.Hour).Unix()),
+				Status:      types.FixtureStatus_FIXTURE_STATUS_POSTPONED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "canceled",
+			payload: types.FixtureUpdateTicketPayload{
+				UID:    uuid.NewString(),
+				Status: types.FixtureStatus_FIXTURE_STATUS_CANCELED,
+			},
+		},
+		{
+			name: "invalid status",
+			payload: types.FixtureUpdateTicketPayload{
+				UID:    uuid.NewString(),
+				Status: types.FixtureStatus_FIXTURE_STATUS_UNSPECIFIED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid uuid",
+			payload: types.FixtureUpdateTicketPayload{
+				UID:    "invalid uuid",
+				Status: types.FixtureStatus_FIXTURE_STATUS_CANCELED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -240,6 +558,7 @@ func TestResolveMarketTicketPayloadValidation(t *testing.T) {
 	require.NoError(t, err)
 
 	ctx = ctx.WithBlockTime(time.Now())
+	oddsUID := uuid.NewString()
 
 	tests := []struct {
 		name    string
@@ -323,6 +642,103 @@ func TestResolveMarketTicketPayloadValidation(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidRequest,
 		},
+		{
+			name: "valid odds outcomes",
+			payload: types.MarketResolutionTicketPayload{
+				UID:          uuid.NewString(),
+				ResolutionTS: cast.ToUint64(ctx.BlockTime().Add(10 * time.Minute).Unix()),
+				OddsOutcomes: []*types.OddsOutcome{
+					{OddsUID: uuid.NewString(), Result: types.OddsResult_ODDS_RESULT_HALF_WIN},
+					{OddsUID: uuid.NewString(), Result: types.OddsResult_ODDS_RESULT_HALF_LOSE},
+				},
+				Status: types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+			},
+		},
+		{
+			name: "odds outcomes when result not declared",
+			payload: types.MarketResolutionTicketPayload{
+				UID:          uuid.NewString(),
+				ResolutionTS: cast.ToUint64(ctx.BlockTime().Add(10 * time.Minute).Unix()),
+				OddsOutcomes: []*types.OddsOutcome{
+					{OddsUID: uuid.NewString(), Result: types.OddsResult_ODDS_RESULT_PUSH},
+				},
+				Status: types.MarketStatus_MARKET_STATUS_CANCELED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "odds outcome without result",
+			payload: types.MarketResolutionTicketPayload{
+				UID:          uuid.NewString(),
+				ResolutionTS: cast.ToUint64(ctx.BlockTime().Add(10 * time.Minute).Unix()),
+				OddsOutcomes: []*types.OddsOutcome{
+					{OddsUID: uuid.NewString()},
+				},
+				Status: types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "odds outcome of a winner odds",
+			payload: types.MarketResolutionTicketPayload{
+				UID:            uuid.NewString(),
+				ResolutionTS:   cast.ToUint64(ctx.BlockTime().Add(10 * time.Minute).Unix()),
+				WinnerOddsUIDs: []string{oddsUID},
+				OddsOutcomes: []*types.OddsOutcome{
+					{OddsUID: oddsUID, Result: types.OddsResult_ODDS_RESULT_PUSH},
+				},
+				Status: types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "dead heat winners",
+			payload: types.MarketResolutionTicketPayload{
+				UID:          uuid.NewString(),
+				ResolutionTS: cast.ToUint64(ctx.BlockTime().Add(10 * time.Minute).Unix()),
+				OddsOutcomes: []*types.OddsOutcome{
+					{OddsUID: uuid.NewString(), Result: types.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.NewDecWithPrec(5, 1)},
+					{OddsUID: uuid.NewString(), Result: types.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.NewDecWithPrec(5, 1)},
+				},
+				Status: types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+			},
+		},
+		{
+			name: "dead heat factor of a lost odds",
+			payload: types.MarketResolutionTicketPayload{
+				UID:          uuid.NewString(),
+				ResolutionTS: cast.ToUint64(ctx.BlockTime().Add(10 * time.Minute).Unix()),
+				OddsOutcomes: []*types.OddsOutcome{
+					{OddsUID: uuid.NewString(), Result: types.OddsResult_ODDS_RESULT_LOSE, DeadHeatFactor: sdk.NewDecWithPrec(5, 1)},
+				},
+				Status: types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "dead heat factor not less than one",
+			payload: types.MarketResolutionTicketPayload{
+				UID:          uuid.NewString(),
+				ResolutionTS: cast.ToUint64(ctx.BlockTime().Add(10 * time.Minute).Unix()),
+				OddsOutcomes: []*types.OddsOutcome{
+					{OddsUID: uuid.NewString(), Result: types.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.OneDec()},
+				},
+				Status: types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "negative dead heat factor",
+			payload: types.MarketResolutionTicketPayload{
+				UID:          uuid.NewString(),
+				ResolutionTS: cast.ToUint64(ctx.BlockTime().Add(10 * time.Minute).Unix()),
+				OddsOutcomes: []*types.OddsOutcome{
+					{OddsUID: uuid.NewString(), Result: types.OddsResult_ODDS_RESULT_WIN, DeadHeatFactor: sdk.NewDecWithPrec(-5, 1)},
+				},
+				Status: types.MarketStatus_MARKET_STATUS_RESULT_DECLARED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -360,6 +360,341 @@ func (m *MsgUpdateResponse) GetData() *Market {
 	return nil
 }
 
+// MsgAddFixture is the message type for adding the fixture into the
+// state.
+type MsgAddFixture struct {
+	// creator is the address of the creator account of the fixture.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ticket is the jwt ticket data.
+	Ticket string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (m *MsgAddFixture) Reset()         { *m = MsgAddFixture{} }
+func (m *MsgAddFixture) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFixture) ProtoMessage()    {}
+func (*MsgAddFixture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0e875658c4f19fd, []int{6}
+}
+func (m *MsgAddFixture) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFixture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFixture.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFixture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFixture.Merge(m, src)
+}
+func (m *MsgAddFixture) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFixture) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFixture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFixture proto.InternalMessageInfo
+
+func (m *MsgAddFixture) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAddFixture) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
+}
+
+// MsgAddFixtureResponse response for adding fixture.
+type MsgAddFixtureResponse struct {
+	// data is the data of fixture.
+	Data *Fixture `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgAddFixtureResponse) Reset()         { *m = MsgAddFixtureResponse{} }
+func (m *MsgAddFixtureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFixtureResponse) ProtoMessage()    {}
+func (*MsgAddFixtureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0e875658c4f19fd, []int{7}
+}
+func (m *MsgAddFixtureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFixtureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFixtureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFixtureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFixtureResponse.Merge(m, src)
+}
+func (m *MsgAddFixtureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFixtureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFixtureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFixtureResponse proto.InternalMessageInfo
+
+func (m *MsgAddFixtureResponse) GetData() *Fixture {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// MsgUpdateFixture is the message type for updating fixture data
+// in the state.
+type MsgUpdateFixture struct {
+	// creator is the address of the creator account of the fixture.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ticket is the jwt ticket data.
+	Ticket string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (m *MsgUpdateFixture) Reset()         { *m = MsgUpdateFixture{} }
+func (m *MsgUpdateFixture) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFixture) ProtoMessage()    {}
+func (*MsgUpdateFixture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0e875658c4f19fd, []int{8}
+}
+func (m *MsgUpdateFixture) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFixture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFixture.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFixture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFixture.Merge(m, src)
+}
+func (m *MsgUpdateFixture) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFixture) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFixture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFixture proto.InternalMessageInfo
+
+func (m *MsgUpdateFixture) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateFixture) GetTicket() string {
+	if m != nil {
+		return m.Ticket
+	}
+	return ""
+}
+
+// MsgUpdateFixtureResponse response for updating a fixture.
+type MsgUpdateFixtureResponse struct {
+	// data is the data of fixture.
+	Data *Fixture `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgUpdateFixtureResponse) Reset()         { *m = MsgUpdateFixtureResponse{} }
+func (m *MsgUpdateFixtureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFixtureResponse) ProtoMessage()    {}
+func (*MsgUpdateFixtureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0e875658c4f19fd, []int{9}
+}
+func (m *MsgUpdateFixtureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFixtureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFixtureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFixtureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFixtureResponse.Merge(m, src)
+}
+func (m *MsgUpdateFixtureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFixtureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFixtureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFixtureResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateFixtureResponse) GetData() *Fixture {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// MsgDisputeResolution is the message type for disputing the pending
+// resolution of a market.
+type MsgDisputeResolution struct {
+	// creator is the account address of the operator of the bonded validator.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// market_uid is the universal unique identifier of the market.
+	MarketUID string `protobuf:"bytes,2,opt,name=market_uid,proto3" json:"market_uid"`
+	// winner_odds_uids is the universal unique identifier list of the winner
+	// odds proposed by the dispute.
+	WinnerOddsUIDs []string `protobuf:"bytes,3,rep,name=winner_odds_uids,proto3" json:"winner_odds_uids"`
+	// odds_outcomes is the list of the outcomes of the odds proposed by the
+	// dispute.
+	OddsOutcomes []*OddsOutcome `protobuf:"bytes,4,rep,name=odds_outcomes,json=oddsOutcomes,proto3" json:"odds_outcomes,omitempty"`
+	// reason is the human-readable reason of the dispute.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgDisputeResolution) Reset()         { *m = MsgDisputeResolution{} }
+func (m *MsgDisputeResolution) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeResolution) ProtoMessage()    {}
+func (*MsgDisputeResolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0e875658c4f19fd, []int{10}
+}
+func (m *MsgDisputeResolution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeResolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeResolution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisputeResolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeResolution.Merge(m, src)
+}
+func (m *MsgDisputeResolution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeResolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeResolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisputeResolution proto.InternalMessageInfo
+
+func (m *MsgDisputeResolution) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgDisputeResolution) GetMarketUID() string {
+	if m != nil {
+		return m.MarketUID
+	}
+	return ""
+}
+
+func (m *MsgDisputeResolution) GetWinnerOddsUIDs() []string {
+	if m != nil {
+		return m.WinnerOddsUIDs
+	}
+	return nil
+}
+
+func (m *MsgDisputeResolution) GetOddsOutcomes() []*OddsOutcome {
+	if m != nil {
+		return m.OddsOutcomes
+	}
+	return nil
+}
+
+func (m *MsgDisputeResolution) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgDisputeResolutionResponse response for disputing a pending resolution.
+type MsgDisputeResolutionResponse struct {
+	// data is the data of the pending resolution.
+	Data *PendingResolution `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgDisputeResolutionResponse) Reset()         { *m = MsgDisputeResolutionResponse{} }
+func (m *MsgDisputeResolutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeResolutionResponse) ProtoMessage()    {}
+func (*MsgDisputeResolutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0e875658c4f19fd, []int{11}
+}
+func (m *MsgDisputeResolutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeResolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeResolutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisputeResolutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeResolutionResponse.Merge(m, src)
+}
+func (m *MsgDisputeResolutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeResolutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeResolutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisputeResolutionResponse proto.InternalMessageInfo
+
+func (m *MsgDisputeResolutionResponse) GetData() *PendingResolution {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgAdd)(nil), "sgenetwork.sge.market.MsgAdd")
 	proto.RegisterType((*MsgAddResponse)(nil), "sgenetwork.sge.market.MsgAddResponse")
@@ -367,34 +702,58 @@ func init() {
 	proto.RegisterType((*MsgResolveResponse)(nil), "sgenetwork.sge.market.MsgResolveResponse")
 	proto.RegisterType((*MsgUpdate)(nil), "sgenetwork.sge.market.MsgUpdate")
 	proto.RegisterType((*MsgUpdateResponse)(nil), "sgenetwork.sge.market.MsgUpdateResponse")
+	proto.RegisterType((*MsgAddFixture)(nil), "sgenetwork.sge.market.MsgAddFixture")
+	proto.RegisterType((*MsgAddFixtureResponse)(nil), "sgenetwork.sge.market.MsgAddFixtureResponse")
+	proto.RegisterType((*MsgUpdateFixture)(nil), "sgenetwork.sge.market.MsgUpdateFixture")
+	proto.RegisterType((*MsgUpdateFixtureResponse)(nil), "sgenetwork.sge.market.MsgUpdateFixtureResponse")
+	proto.RegisterType((*MsgDisputeResolution)(nil), "sgenetwork.sge.market.MsgDisputeResolution")
+	proto.RegisterType((*MsgDisputeResolutionResponse)(nil), "sgenetwork.sge.market.MsgDisputeResolutionResponse")
 }
 
 func init() { proto.RegisterFile("sge/market/tx.proto", fileDescriptor_d0e875658c4f19fd) }
 
 var fileDescriptor_d0e875658c4f19fd = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x9b, 0xb6, 0x5f, 0x4a, 0xef, 0x07, 0x82, 0xe3, 0xbf, 0x12, 0x30, 0xd6, 0x82, 0xd0,
-	0x2e, 0x9c, 0x40, 0x5d, 0x08, 0x82, 0x82, 0x75, 0x29, 0xd9, 0x04, 0x45, 0x70, 0x97, 0x36, 0x97,
-	0x69, 0xa9, 0xed, 0x84, 0x99, 0x51, 0xeb, 0xd2, 0x37, 0xf0, 0xb1, 0xba, 0xec, 0xd2, 0x95, 0x48,
-	0xfb, 0x22, 0xd2, 0x99, 0x24, 0xba, 0xb0, 0x16, 0x0a, 0x5d, 0xcd, 0x4c, 0xce, 0xf9, 0x1d, 0x0e,
-	0xb9, 0x5c, 0xd8, 0x92, 0x0c, 0xbd, 0x41, 0x28, 0xfa, 0xa8, 0x3c, 0x35, 0xa2, 0xb1, 0xe0, 0x8a,
-	0x93, 0x1d, 0xc9, 0x70, 0x88, 0xea, 0x99, 0x8b, 0x3e, 0x95, 0x0c, 0xa9, 0xd1, 0x9d, 0x6d, 0xc6,
-	0x19, 0xd7, 0x0e, 0x6f, 0x7e, 0x33, 0x66, 0x67, 0xef, 0x47, 0x82, 0x39, 0x8c, 0x50, 0x3b, 0x03,
-	0xdb, 0x97, 0xec, 0x32, 0x8a, 0x48, 0x05, 0x4a, 0x1d, 0x81, 0xa1, 0xe2, 0xa2, 0x62, 0x55, 0xad,
-	0x7a, 0x39, 0x48, 0x9f, 0x64, 0x17, 0x6c, 0xd5, 0xeb, 0xf4, 0x51, 0x55, 0xf2, 0x5a, 0x48, 0x5e,
-	0x35, 0x84, 0x0d, 0xc3, 0x06, 0x28, 0x63, 0x3e, 0x94, 0x48, 0x1c, 0xf8, 0x87, 0x42, 0xa4, 0x09,
-	0xad, 0xe2, 0xf8, 0xe3, 0xc0, 0x0a, 0xcc, 0x27, 0x72, 0x0a, 0xc5, 0x28, 0x54, 0xa1, 0xce, 0xf8,
-	0xdf, 0xdc, 0xa7, 0xbf, 0xd6, 0xa7, 0xbe, 0x3e, 0x12, 0x52, 0x03, 0xb5, 0x0b, 0x00, 0x5f, 0xb2,
-	0x00, 0x25, 0x7f, 0x78, 0xc2, 0x15, 0x6a, 0xf6, 0x80, 0x7c, 0xf3, 0xeb, 0xad, 0x7a, 0x0e, 0x65,
-	0x5f, 0xb2, 0xdb, 0x38, 0x0a, 0xd5, 0x2a, 0x4d, 0xbb, 0xb0, 0x99, 0xe1, 0x6b, 0x2d, 0xda, 0x7c,
-	0xcd, 0x43, 0xc1, 0x97, 0x8c, 0x5c, 0x43, 0x61, 0x3e, 0xfb, 0x85, 0xa4, 0x1e, 0xaf, 0x73, 0xf4,
-	0xa7, 0x9c, 0x35, 0xbd, 0x83, 0x52, 0x3a, 0xa5, 0xc3, 0xc5, 0x44, 0x62, 0x71, 0x1a, 0x4b, 0x2d,
-	0x59, 0xf0, 0x0d, 0xd8, 0xc9, 0x3f, 0xad, 0x2e, 0x86, 0x8c, 0xc3, 0xa9, 0x2f, 0x73, 0xa4, 0xa9,
-	0xad, 0xab, 0xf1, 0xd4, 0xb5, 0x26, 0x53, 0xd7, 0xfa, 0x9c, 0xba, 0xd6, 0xdb, 0xcc, 0xcd, 0x4d,
-	0x66, 0x6e, 0xee, 0x7d, 0xe6, 0xe6, 0xee, 0x1b, 0xac, 0xa7, 0xba, 0x8f, 0x6d, 0xda, 0xe1, 0x03,
-	0x4f, 0x32, 0x3c, 0x4e, 0xe2, 0xe6, 0x77, 0x6f, 0x94, 0x2d, 0xe2, 0x4b, 0x8c, 0xb2, 0x6d, 0xeb,
-	0x35, 0x3a, 0xf9, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x86, 0xc7, 0x1d, 0xcf, 0xa3, 0x03, 0x00, 0x00,
+	// 637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xae, 0x9b, 0x34, 0x55, 0xa6, 0xbf, 0x56, 0xad, 0x7f, 0x2d, 0x58, 0x2e, 0x38, 0x21, 0xe2,
+	0x4f, 0x2a, 0xc0, 0x96, 0xd2, 0x03, 0x08, 0x04, 0x52, 0x43, 0x04, 0xaa, 0x90, 0x55, 0xb0, 0x1a,
+	0x55, 0xe2, 0x52, 0x9c, 0x78, 0xd9, 0x5a, 0x21, 0xde, 0xc8, 0xbb, 0xa6, 0xe1, 0x2d, 0x78, 0x06,
+	0xde, 0x05, 0xa9, 0xc7, 0x1e, 0x39, 0x45, 0x28, 0xb9, 0xf5, 0x29, 0x90, 0xbd, 0x1b, 0xc7, 0x21,
+	0x75, 0x52, 0x22, 0x7a, 0xf2, 0xce, 0xcc, 0x37, 0xdf, 0x7c, 0x9e, 0xdd, 0xd9, 0x85, 0xff, 0x29,
+	0x46, 0x46, 0xdb, 0xf6, 0x5b, 0x88, 0x19, 0xac, 0xab, 0x77, 0x7c, 0xc2, 0x88, 0xbc, 0x45, 0x31,
+	0xf2, 0x10, 0x3b, 0x25, 0x7e, 0x4b, 0xa7, 0x18, 0xe9, 0x3c, 0xae, 0x6e, 0x62, 0x82, 0x49, 0x84,
+	0x30, 0xc2, 0x15, 0x07, 0xab, 0x37, 0x13, 0x0c, 0xfc, 0x23, 0x02, 0x4a, 0x22, 0xf0, 0xc9, 0xed,
+	0xb2, 0xc0, 0x47, 0x22, 0xb2, 0x95, 0x88, 0x10, 0xc7, 0xa1, 0xc2, 0xbd, 0x9d, 0x70, 0xfb, 0x88,
+	0x92, 0xcf, 0x01, 0x73, 0x89, 0xc7, 0x83, 0xa5, 0x67, 0x90, 0x33, 0x29, 0xde, 0x73, 0x1c, 0x59,
+	0x81, 0xe5, 0xa6, 0x8f, 0x6c, 0x46, 0x7c, 0x45, 0x2a, 0x4a, 0xe5, 0xbc, 0x35, 0x34, 0xe5, 0x1b,
+	0x90, 0x63, 0x6e, 0xb3, 0x85, 0x98, 0xb2, 0x18, 0x05, 0x84, 0x55, 0x42, 0xb0, 0xc6, 0x73, 0x2d,
+	0x44, 0x3b, 0xc4, 0xa3, 0x48, 0x56, 0x61, 0x09, 0xf9, 0xfe, 0x90, 0xa1, 0x9a, 0x3d, 0xeb, 0x15,
+	0x24, 0x8b, 0xbb, 0xe4, 0x27, 0x90, 0x75, 0x6c, 0x66, 0x47, 0x1c, 0x2b, 0x95, 0xdb, 0xfa, 0xa5,
+	0xcd, 0xd0, 0xcd, 0xe8, 0x23, 0x32, 0xa3, 0x84, 0xd2, 0x4b, 0x00, 0x93, 0x62, 0x2b, 0x54, 0xfe,
+	0x05, 0xcd, 0x21, 0xd3, 0x05, 0x79, 0x94, 0x7f, 0xbd, 0x52, 0x5f, 0x40, 0xde, 0xa4, 0xb8, 0xde,
+	0x71, 0x6c, 0x36, 0x8f, 0xd2, 0x13, 0xd8, 0x88, 0xd3, 0xaf, 0x57, 0xe8, 0x1e, 0xac, 0xf2, 0xad,
+	0x7b, 0xcd, 0x4f, 0xd0, 0x1c, 0x62, 0xdf, 0xc3, 0xd6, 0x18, 0x45, 0x2c, 0xf8, 0xa9, 0x10, 0x25,
+	0x45, 0xa2, 0xb4, 0x14, 0x51, 0x22, 0x6b, 0x4c, 0x55, 0x0d, 0xd6, 0xe3, 0xff, 0x9f, 0x5f, 0xd8,
+	0x21, 0x28, 0x7f, 0xb2, 0xfc, 0x03, 0x6d, 0xdf, 0x17, 0x61, 0xd3, 0xa4, 0xb8, 0xe6, 0xd2, 0x4e,
+	0x10, 0xed, 0x8e, 0x98, 0xa3, 0x29, 0x02, 0x9f, 0x03, 0x70, 0xc2, 0xe3, 0xc0, 0x75, 0xb8, 0xc8,
+	0xea, 0x76, 0xbf, 0x57, 0xc8, 0xf3, 0x0d, 0xa9, 0xef, 0xd7, 0x2e, 0x7a, 0x85, 0x04, 0xc4, 0x4a,
+	0xac, 0x65, 0x0b, 0xd6, 0x4f, 0x5d, 0xcf, 0x43, 0xfe, 0x71, 0x38, 0xca, 0xa1, 0x8b, 0x2a, 0x99,
+	0x62, 0xa6, 0x9c, 0xaf, 0xde, 0xef, 0xf7, 0x0a, 0x6b, 0x47, 0x51, 0xec, 0xc0, 0x71, 0x68, 0x7d,
+	0xbf, 0x46, 0x2f, 0x7a, 0x85, 0x09, 0xb4, 0x35, 0xe1, 0x91, 0xdf, 0xc0, 0x6a, 0x64, 0x90, 0x80,
+	0x35, 0x49, 0x1b, 0x51, 0x25, 0x5b, 0xcc, 0x94, 0x57, 0x2a, 0xa5, 0x94, 0x36, 0x84, 0xec, 0x07,
+	0x1c, 0x6a, 0xfd, 0x47, 0x46, 0x06, 0x0d, 0x5b, 0xef, 0x23, 0x9b, 0x12, 0x4f, 0x59, 0xe2, 0xad,
+	0xe7, 0x56, 0xa9, 0x01, 0xb7, 0x2e, 0xeb, 0x51, 0xdc, 0xfe, 0xea, 0x58, 0xfb, 0xcb, 0x29, 0x75,
+	0xdf, 0x21, 0xcf, 0x71, 0x3d, 0x3c, 0xca, 0x4f, 0x6e, 0x44, 0xe5, 0x47, 0x16, 0x32, 0x26, 0xc5,
+	0xf2, 0x5b, 0xc8, 0x84, 0xd7, 0x56, 0xea, 0xa1, 0x8f, 0xce, 0xa6, 0x7a, 0x6f, 0x6a, 0x38, 0x16,
+	0x76, 0x04, 0xcb, 0xc3, 0x0b, 0xe6, 0x4e, 0x7a, 0x86, 0x80, 0xa8, 0x3b, 0x33, 0x21, 0x31, 0xf1,
+	0x21, 0xe4, 0xc4, 0x75, 0x50, 0x4c, 0x4f, 0xe2, 0x08, 0xb5, 0x3c, 0x0b, 0x11, 0xb3, 0x7e, 0x04,
+	0x48, 0xcc, 0xee, 0xdd, 0xa9, 0xff, 0x28, 0x50, 0xea, 0xa3, 0xab, 0xa0, 0xe2, 0x0a, 0x2e, 0xac,
+	0x8e, 0xcf, 0xe1, 0x83, 0x59, 0xe2, 0x86, 0x75, 0x8c, 0x2b, 0x02, 0xe3, 0x52, 0x01, 0x6c, 0x4c,
+	0x4e, 0xd5, 0xc3, 0x74, 0x96, 0x09, 0xb0, 0xba, 0xfb, 0x17, 0xe0, 0x61, 0xd9, 0xea, 0xab, 0xb3,
+	0xbe, 0x26, 0x9d, 0xf7, 0x35, 0xe9, 0x57, 0x5f, 0x93, 0xbe, 0x0d, 0xb4, 0x85, 0xf3, 0x81, 0xb6,
+	0xf0, 0x73, 0xa0, 0x2d, 0x7c, 0xd8, 0xc1, 0x2e, 0x3b, 0x09, 0x1a, 0x7a, 0x93, 0xb4, 0x0d, 0x8a,
+	0xd1, 0x63, 0xc1, 0x1c, 0xae, 0x8d, 0x6e, 0xfc, 0xaa, 0x7f, 0xed, 0x20, 0xda, 0xc8, 0x45, 0xaf,
+	0xe8, 0xee, 0xef, 0x01, 0x00, 0xdc, 0x80, 0x2d, 0xfc, 0xf0, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -415,6 +774,14 @@ type MsgClient interface {
 	Resolve(ctx context.Context, in *MsgResolve, opts ...grpc.CallOption) (*MsgResolveResponse, error)
 	// Update defines a method to update a market.
 	Update(ctx context.Context, in *MsgUpdate, opts ...grpc.CallOption) (*MsgUpdateResponse, error)
+	// AddFixture defines a method to add the fixture with the given data.
+	AddFixture(ctx context.Context, in *MsgAddFixture, opts ...grpc.CallOption) (*MsgAddFixtureResponse, error)
+	// UpdateFixture defines a method to update a fixture and cascade its
+	// status to the markets of the fixture.
+	UpdateFixture(ctx context.Context, in *MsgUpdateFixture, opts ...grpc.CallOption) (*MsgUpdateFixtureResponse, error)
+	// DisputeResolution defines a method for the bonded validators to dispute
+	// the pending resolution of a market.
+	DisputeResolution(ctx context.Context, in *MsgDisputeResolution, opts ...grpc.CallOption) (*MsgDisputeResolutionResponse, error)
 }
 
 type msgClient struct {
@@ -452,6 +819,33 @@ func (c *msgClient) Update(ctx context.Context, in *MsgUpdate, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) AddFixture(ctx context.Context, in *MsgAddFixture, opts ...grpc.CallOption) (*MsgAddFixtureResponse, error) {
+	out := new(MsgAddFixtureResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Msg/AddFixture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFixture(ctx context.Context, in *MsgUpdateFixture, opts ...grpc.CallOption) (*MsgUpdateFixtureResponse, error) {
+	out := new(MsgUpdateFixtureResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Msg/UpdateFixture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisputeResolution(ctx context.Context, in *MsgDisputeResolution, opts ...grpc.CallOption) (*MsgDisputeResolutionResponse, error) {
+	out := new(MsgDisputeResolutionResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.market.Msg/DisputeResolution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Add defines a method to add the market with the given data.
@@ -460,6 +854,14 @@ type MsgServer interface {
 	Resolve(context.Context, *MsgResolve) (*MsgResolveResponse, error)
 	// Update defines a method to update a market.
 	Update(context.Context, *MsgUpdate) (*MsgUpdateResponse, error)
+	// AddFixture defines a method to add the fixture with the given data.
+	AddFixture(context.Context, *MsgAddFixture) (*MsgAddFixtureResponse, error)
+	// UpdateFixture defines a method to update a fixture and cascade its
+	// status to the markets of the fixture.
+	UpdateFixture(context.Context, *MsgUpdateFixture) (*MsgUpdateFixtureResponse, error)
+	// DisputeResolution defines a method for the bonded validators to dispute
+	// the pending resolution of a market.
+	DisputeResolution(context.Context, *MsgDisputeResolution) (*MsgDisputeResolutionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -475,6 +877,15 @@ func (*UnimplementedMsgServer) Resolve(ctx context.Context, req *MsgResolve) (*M
 func (*UnimplementedMsgServer) Update(ctx context.Context, req *MsgUpdate) (*MsgUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (*UnimplementedMsgServer) AddFixture(ctx context.Context, req *MsgAddFixture) (*MsgAddFixtureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFixture not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFixture(ctx context.Context, req *MsgUpdateFixture) (*MsgUpdateFixtureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFixture not implemented")
+}
+func (*UnimplementedMsgServer) DisputeResolution(ctx context.Context, req *MsgDisputeResolution) (*MsgDisputeResolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeResolution not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -534,35 +945,101 @@ func _Msg_Update_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sgenetwork.sge.market.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Add",
-			Handler:    _Msg_Add_Handler,
-		},
-		{
-			MethodName: "Resolve",
-			Handler:    _Msg_Resolve_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _Msg_Update_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sge/market/tx.proto",
-}
-
-func (m *MsgAdd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddFixture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFixture)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddFixture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Msg/AddFixture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFixture(ctx, req.(*MsgAddFixture))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFixture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFixture)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFixture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Msg/UpdateFixture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFixture(ctx, req.(*MsgUpdateFixture))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisputeResolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeResolution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeResolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.market.Msg/DisputeResolution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeResolution(ctx, req.(*MsgDisputeResolution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sgenetwork.sge.market.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _Msg_Add_Handler,
+		},
+		{
+			MethodName: "Resolve",
+			Handler:    _Msg_Resolve_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Msg_Update_Handler,
+		},
+		{
+			MethodName: "AddFixture",
+			Handler:    _Msg_AddFixture_Handler,
+		},
+		{
+			MethodName: "UpdateFixture",
+			Handler:    _Msg_UpdateFixture_Handler,
+		},
+		{
+			MethodName: "DisputeResolution",
+			Handler:    _Msg_DisputeResolution_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sge/market/tx.proto",
+}
+
+func (m *MsgAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *MsgAdd) MarshalTo(dAtA []byte) (int, error) {
@@ -792,126 +1269,1174 @@ func (m *MsgUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddFixture) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAdd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAddFixture) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFixture) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Ticket) > 0 {
+		i -= len(m.Ticket)
+		copy(dAtA[i:], m.Ticket)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticket)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Ticket)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddFixtureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFixtureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFixtureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgResolve) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateFixture) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFixture) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFixture) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Ticket) > 0 {
+		i -= len(m.Ticket)
+		copy(dAtA[i:], m.Ticket)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ticket)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Ticket)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgResolveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateFixtureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFixtureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFixtureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDisputeResolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeResolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeResolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for iNdEx := len(m.OddsOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OddsOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Ticket)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.WinnerOddsUIDs) > 0 {
+		for iNdEx := len(m.WinnerOddsUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WinnerOddsUIDs[iNdEx])
+			copy(dAtA[i:], m.WinnerOddsUIDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.WinnerOddsUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MarketUID) > 0 {
+		i -= len(m.MarketUID)
+		copy(dAtA[i:], m.MarketUID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketUID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeResolutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeResolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeResolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAdd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFixture) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFixtureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateFixture) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticket)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateFixtureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeResolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketUID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.WinnerOddsUIDs) > 0 {
+		for _, s := range m.WinnerOddsUIDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.OddsOutcomes) > 0 {
+		for _, e := range m.OddsOutcomes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeResolutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &Market{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &Market{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &Market{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAdd) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFixture) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -934,10 +2459,10 @@ func (m *MsgAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAdd: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFixture: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFixture: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1025,7 +2550,7 @@ func (m *MsgAdd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFixtureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1048,45 +2573,13 @@ func (m *MsgAddResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFixtureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFixtureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -1116,7 +2609,7 @@ func (m *MsgAddResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Data == nil {
-				m.Data = &Market{}
+				m.Data = &Fixture{}
 			}
 			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1143,7 +2636,7 @@ func (m *MsgAddResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResolve) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFixture) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1166,10 +2659,10 @@ func (m *MsgResolve) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResolve: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFixture: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResolve: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFixture: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1257,7 +2750,7 @@ func (m *MsgResolve) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResolveResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFixtureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1280,45 +2773,13 @@ func (m *MsgResolveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResolveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFixtureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFixtureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -1348,7 +2809,7 @@ func (m *MsgResolveResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Data == nil {
-				m.Data = &Market{}
+				m.Data = &Fixture{}
 			}
 			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1375,7 +2836,7 @@ func (m *MsgResolveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdate) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeResolution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1398,10 +2859,10 @@ func (m *MsgUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeResolution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeResolution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1438,7 +2899,7 @@ func (m *MsgUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketUID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1466,7 +2927,105 @@ func (m *MsgUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ticket = string(dAtA[iNdEx:postIndex])
+			m.MarketUID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinnerOddsUIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinnerOddsUIDs = append(m.WinnerOddsUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsOutcomes = append(m.OddsOutcomes, &OddsOutcome{})
+			if err := m.OddsOutcomes[len(m.OddsOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1489,7 +3048,7 @@ func (m *MsgUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeResolutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1512,45 +3071,13 @@ func (m *MsgUpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeResolutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeResolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -1580,7 +3107,7 @@ func (m *MsgUpdateResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Data == nil {
-				m.Data = &Market{}
+				m.Data = &PendingResolution{}
 			}
 			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -29,6 +29,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		GetCmdQueryParticipationExposures(),
 		GetCmdQueryHistoricalParticipationExposures(),
 		GetCmdQueryParticipationBets(),
+		GetCmdQueryWagerQuote(),
 	)
 
 	return orderBookQueryCmd
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	bettypes "github.com/sge-network/sge/x/bet/types"
+	"github.com/sge-network/sge/x/orderbook/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const flagMaxLossMultiplier = "max-loss-multiplier"
+
+// GetCmdQueryWagerQuote implements the command to query the expected fulfillment of a bet.
+func GetCmdQueryWagerQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote [order-book-id] [odds-uid] [odds-type] [odds-value] [amount]",
+		Short: "Query the expected fulfillment of a bet by an order book",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the max fillable amount, the expected fulfillment and the payout of a bet without placing it.
+the odds type is 1 for decimal, 2 for fractional, 3 for moneyline, 4 for hong kong, 5 for indonesian and 6 for malay odds.
+
+Example:
+$ %s query orderbook quote %s %s %d %s %d
+`,
+				version.AppName, "5531c60f-2025-48ce-ae79-1dc110f16000", "9991c60f-2025-48ce-ae79-1dc110f16990", 1, "1.5", 1000000,
+			),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			oddsType, err := cast.ToInt32E(args[2])
+			if err != nil {
+				return fmt.Errorf("odds type argument provided must be an integer: %v", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("amount argument provided must be an integer: %s", args[4])
+			}
+
+			var maxLossMultiplier sdk.Dec
+			argMaxLossMultiplier, err := cmd.Flags().GetString(flagMaxLossMultiplier)
+			if err != nil {
+				return err
+			}
+			if argMaxLossMultiplier != "" {
+				maxLossMultiplier, err = sdk.NewDecFromStr(argMaxLossMultiplier)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := &types.QueryWagerQuoteRequest{
+				OrderBookUid:      args[0],
+				OddsUid:           args[1],
+				OddsType:          bettypes.OddsType(oddsType),
+				OddsValue:         args[3],
+				Amount:            amount,
+				MaxLossMultiplier: maxLossMultiplier,
+			}
+
+			res, err := queryClient.WagerQuote(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagMaxLossMultiplier, "", "max loss multiplier applied to the odds of the order book, one is used if not set")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	bettypes "github.com/sge-network/sge/x/bet/types"
+	"github.com/sge-network/sge/x/orderbook/types"
+)
+
+// RevertBetFulfillment reverts the bet fulfillments of a canceled or voided bet from the
+// participations of the order book, the exposures of the fulfillments that belong to the
+// current round are released and the fulfillments of the previous rounds are reverted from
+// the historical participation exposures.
+func (k Keeper) RevertBetFulfillment(
+	ctx sdk.Context,
+	betID uint64,
+	oddsUID string,
+	betFulfillments []*bettypes.BetFulfillment,
+	orderBookUID string,
+) error {
+	for _, betFulfillment := range betFulfillments {
+		participation, found := k.GetOrderBookParticipation(
+			ctx,
+			orderBookUID,
+			betFulfillment.ParticipationIndex,
+		)
+		if !found {
+			return sdkerrors.Wrapf(
+				types.ErrOrderBookParticipationNotFound,
+				"%s, %d",
+				orderBookUID,
+				betFulfillment.ParticipationIndex,
+			)
+		}
+
+		if participation.IsSettled {
+			return sdkerrors.Wrapf(
+				types.ErrBookParticipationAlreadySettled,
+				"%s, %d",
+				orderBookUID,
+				betFulfillment.ParticipationIndex,
+			)
+		}
+
+		if err := k.revertFulfillment(ctx, &participation, oddsUID, betFulfillment); err != nil {
+			return err
+		}
+
+		participation.TotalBetAmount = participation.TotalBetAmount.Sub(betFulfillment.BetAmount)
+		k.SetOrderBookParticipation(ctx, participation)
+
+		k.removeParticipationBetPair(ctx, orderBookUID, participation.Index, betID)
+	}
+
+	return nil
+}
+
+// revertFulfillment reverts the bet fulfillment from the current round of the participation
+// or from the historical participation exposure of its round.
+func (k Keeper) revertFulfillment(
+	ctx sdk.Context,
+	participation *types.OrderBookParticipation,
+	oddsUID string,
+	betFulfillment *bettypes.BetFulfillment,
+) error {
+	isCurrentRound, err := k.revertCurrentRoundFulfillment(ctx, participation, oddsUID, betFulfillment)
+	if err != nil {
+		return err
+	}
+	if !isCurrentRound {
+		k.revertHistoricalFulfillment(ctx, participation.OrderBookUID, oddsUID, participation.Index, betFulfillment)
+	}
+
+	return nil
+}
+
+// revertCurrentRoundFulfillment reverts the bet fulfillment from the participation exposure and
+// the participation if the fulfillment belongs to the current round of the participation exposure,
+// the fulfilled exposure is put back into the fulfillment queue to make the released liquidity usable.
+func (k Keeper) revertCurrentRoundFulfillment(
+	ctx sdk.Context,
+	participation *types.OrderBookParticipation,
+	oddsUID string,
+	betFulfillment *bettypes.BetFulfillment,
+) (bool, error) {
+	exposures, err := k.GetExposureByOrderBookAndParticipationIndex(ctx, participation.OrderBookUID, participation.Index)
+	if err != nil {
+		return false, err
+	}
+
+	var exposure *types.ParticipationExposure
+	for i := range exposures {
+		if exposures[i].OddsUID == oddsUID {
+			exposure = &exposures[i]
+			break
+		}
+	}
+	if exposure == nil {
+		return false, sdkerrors.Wrapf(types.ErrParticipationExposureNotFound, "%s, %d", oddsUID, participation.Index)
+	}
+
+	if exposure.Round != betFulfillment.Round ||
+		exposure.Exposure.LT(betFulfillment.PayoutProfit) ||
+		exposure.BetAmount.LT(betFulfillment.BetAmount) {
+		return false, nil
+	}
+
+	exposure.RevertCurrentRound(betFulfillment.BetAmount, betFulfillment.PayoutProfit)
+	if exposure.IsFulfilled && participation.IsLiquidityInCurrentRound() {
+		exposure.IsFulfilled = false
+		participation.ExposuresNotFilled++
+		if err := k.requeueParticipation(ctx, participation.OrderBookUID, oddsUID, participation.Index); err != nil {
+			return false, err
+		}
+	}
+	k.SetParticipationExposure(ctx, *exposure)
+
+	participation.RevertCurrentRound(exposures, betFulfillment.BetAmount)
+
+	return true, nil
+}
+
+// revertHistoricalFulfillment reverts the bet amount and payout profit of the bet fulfillment
+// from the historical participation exposure of its round, the max loss of the previous rounds
+// is already deducted from the liquidity of the participation so it is kept as is.
+func (k Keeper) revertHistoricalFulfillment(
+	ctx sdk.Context,
+	orderBookUID, oddsUID string,
+	participationIndex uint64,
+	betFulfillment *bettypes.BetFulfillment,
+) {
+	store := k.getHistoricalParticipationExposureStore(ctx)
+	b := store.Get(types.GetHistoricalParticipationExposureKey(orderBookUID, oddsUID, participationIndex, betFulfillment.Round))
+	if b == nil {
+		return
+	}
+
+	var pe types.ParticipationExposure
+	k.cdc.MustUnmarshal(b, &pe)
+
+	pe.RevertCurrentRound(betFulfillment.BetAmount, betFulfillment.PayoutProfit)
+	k.SetHistoricalParticipationExposure(ctx, pe)
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevertBetFulfillment(t *testing.T) {
+	ts := newTestBetSuite(t)
+	bets, _, _ := ts.placeBetsAndTest()
+	winner1Bet, winner2Bet := bets[0], bets[1]
+	oddsUID := ts.market.Odds[0].UID
+
+	exposureOfOdds := func(participationIndex uint64) (round uint64, isFulfilled bool) {
+		exposures, err := ts.k.GetExposureByOrderBookAndParticipationIndex(ts.ctx, ts.market.BookUID, participationIndex)
+		require.NoError(t, err)
+		for _, pe := range exposures {
+			if pe.OddsUID == oddsUID {
+				return pe.Round, pe.IsFulfilled
+			}
+		}
+		t.Fatalf("exposure of participation %d not found", participationIndex)
+		return
+	}
+
+	// the fulfilled exposure of the current round is released and requeued
+	_, isFulfilled := exposureOfOdds(1)
+	require.True(t, isFulfilled)
+	participationBefore, found := ts.k.GetOrderBookParticipation(ts.ctx, ts.market.BookUID, 1)
+	require.True(t, found)
+
+	err := ts.k.RevertBetFulfillment(ts.ctx, 1, oddsUID, winner1Bet.BetFulfillment, ts.market.BookUID)
+	require.NoError(t, err)
+
+	_, isFulfilled = exposureOfOdds(1)
+	require.False(t, isFulfilled)
+
+	participation, found := ts.k.GetOrderBookParticipation(ts.ctx, ts.market.BookUID, 1)
+	require.True(t, found)
+	require.Equal(t, participationBefore.ExposuresNotFilled+1, participation.ExposuresNotFilled)
+	require.True(t, participationBefore.TotalBetAmount.Sub(winner1Bet.Amount).Equal(participation.TotalBetAmount))
+
+	oddsExposure, found := ts.k.GetOrderBookOddsExposure(ts.ctx, ts.market.BookUID, oddsUID)
+	require.True(t, found)
+	require.Equal(t, []uint64{3, 1}, oddsExposure.FulfillmentQueue)
+
+	// the fulfillment of a previous round is reverted from the historical exposure
+	participationBefore, found = ts.k.GetOrderBookParticipation(ts.ctx, ts.market.BookUID, 2)
+	require.True(t, found)
+	exposures, err := ts.k.GetExposureByOrderBookAndParticipationIndex(ts.ctx, ts.market.BookUID, 2)
+	require.NoError(t, err)
+	for _, pe := range exposures {
+		ts.k.MoveToHistoricalParticipationExposure(ts.ctx, pe)
+		ts.k.SetParticipationExposure(ts.ctx, pe.NextRound())
+	}
+
+	err = ts.k.RevertBetFulfillment(ts.ctx, 2, oddsUID, winner2Bet.BetFulfillment, ts.market.BookUID)
+	require.NoError(t, err)
+
+	round, isFulfilled := exposureOfOdds(2)
+	require.Equal(t, uint64(2), round)
+	require.False(t, isFulfilled)
+
+	historicalExposures, err := ts.k.GetAllHistoricalParticipationExposures(ts.ctx)
+	require.NoError(t, err)
+	for _, pe := range historicalExposures {
+		if pe.ParticipationIndex == 2 && pe.OddsUID == oddsUID {
+			require.True(t, pe.Exposure.IsZero())
+			require.True(t, pe.BetAmount.IsZero())
+		}
+	}
+
+	participation, found = ts.k.GetOrderBookParticipation(ts.ctx, ts.market.BookUID, 2)
+	require.True(t, found)
+	require.True(t, participationBefore.TotalBetAmount.Sub(winner2Bet.Amount).Equal(participation.TotalBetAmount))
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	bettypes "github.com/sge-network/sge/x/bet/types"
+	"github.com/sge-network/sge/x/orderbook/types"
+)
+
+// CashOutBettor pays the cash-out amount to the bettor from the order book liquidity pool,
+// the cash-out amount is divided between the bet fulfillments proportional to the fulfilled
+// bet amount and the actual profit of each participation is updated accordingly, the bet
+// fulfillments are reverted from the participations the same as the canceled bets.
+func (k Keeper) CashOutBettor(
+	ctx sdk.Context,
+	bettorAddress sdk.AccAddress,
+	betID uint64,
+	oddsUID string,
+	cashOutAmount sdkmath.Int,
+	betFulfillments []*bettypes.BetFulfillment,
+	orderBookUID string,
+) error {
+	book, found := k.GetOrderBook(ctx, orderBookUID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrOrderBookNotFound, "%s", orderBookUID)
+	}
+
+	totalBetAmount := sdk.ZeroInt()
+	for _, betFulfillment := range betFulfillments {
+		totalBetAmount = totalBetAmount.Add(betFulfillment.BetAmount)
+	}
+
+	remainingCashOutAmount := cashOutAmount
+	for i, betFulfillment := range betFulfillments {
+		participation, found := k.GetOrderBookParticipation(
+			ctx,
+			orderBookUID,
+			betFulfillment.ParticipationIndex,
+		)
+		if !found {
+			return sdkerrors.Wrapf(
+				types.ErrOrderBookParticipationNotFound,
+				"%s, %d",
+				orderBookUID,
+				betFulfillment.ParticipationIndex,
+			)
+		}
+
+		if participation.IsSettled {
+			return sdkerrors.Wrapf(
+				types.ErrBookParticipationAlreadySettled,
+				"%s, %d",
+				orderBookUID,
+				betFulfillment.ParticipationIndex,
+			)
+		}
+
+		// the last fulfillment pays the remaining of the division
+		fulfillmentCashOut := remainingCashOutAmount
+		if i != len(betFulfillments)-1 && totalBetAmount.IsPositive() {
+			fulfillmentCashOut = cashOutAmount.Mul(betFulfillment.BetAmount).Quo(totalBetAmount)
+		}
+		remainingCashOutAmount = remainingCashOutAmount.Sub(fulfillmentCashOut)
+
+		// the participation receives the bet amount and pays its portion of the cash-out amount.
+		participation.ActualProfit = participation.ActualProfit.Add(betFulfillment.BetAmount).Sub(fulfillmentCashOut)
+
+		if err := k.revertFulfillment(ctx, &participation, oddsUID, betFulfillment); err != nil {
+			return err
+		}
+
+		participation.TotalBetAmount = participation.TotalBetAmount.Sub(betFulfillment.BetAmount)
+		k.SetOrderBookParticipation(ctx, participation)
+
+		k.removeParticipationBetPair(ctx, orderBookUID, participation.Index, betID)
+	}
+
+	// pay the cash-out amount to the bettor's account from orderbook liquidity pool.
+	return k.refund(types.OrderBookLiquidityFunder{}, ctx, bettorAddress, cashOutAmount, book.Denom)
+}
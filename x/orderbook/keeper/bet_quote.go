@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	bettypes "github.com/sge-network/sge/x/bet/types"
+	"github.com/sge-network/sge/x/orderbook/types"
+)
+
+// QuoteWager simulates the fulfillment of a bet by the order book participations and returns
+// the largest fillable bet amount and the expected bet fulfillments without changing the state.
+func (k Keeper) QuoteWager(
+	ctx sdk.Context,
+	bookUID, oddsUID string,
+	oddsType bettypes.OddsType,
+	oddsVal string,
+	betAmount sdkmath.Int,
+	maxLossMultiplier sdk.Dec,
+) (sdkmath.Int, []*bettypes.BetFulfillment, error) {
+	book, found := k.GetOrderBook(ctx, bookUID)
+	if !found {
+		return sdkmath.Int{}, nil, sdkerrors.Wrapf(types.ErrOrderBookNotFound, "%s", bookUID)
+	}
+
+	if book.Status != types.OrderBookStatus_ORDER_BOOK_STATUS_STATUS_ACTIVE {
+		return sdkmath.Int{}, nil, sdkerrors.Wrapf(types.ErrOrderBookNotActive, "%s", book.Status)
+	}
+
+	market, found := k.marketKeeper.GetMarket(ctx, bookUID)
+	if !found {
+		return sdkmath.Int{}, nil, sdkerrors.Wrapf(types.ErrMarketNotFound, "%s", bookUID)
+	}
+
+	bookExposure, found := k.GetOrderBookOddsExposure(ctx, bookUID, oddsUID)
+	if !found {
+		return sdkmath.Int{}, nil, sdkerrors.Wrapf(types.ErrOrderBookExposureNotFound, "%s , %s", bookUID, oddsUID)
+	}
+
+	payoutProfit, err := bettypes.CalculatePayoutProfit(oddsType, oddsVal, betAmount)
+	if err != nil {
+		return sdkmath.Int{}, nil, err
+	}
+
+	// the max loss multiplier of the quote is applied to all of the odds of the market
+	odds := make(map[string]*bettypes.BetOddsCompact)
+	for _, uid := range market.OddsUIDS() {
+		odds[uid] = &bettypes.BetOddsCompact{UID: uid, MaxLossMultiplier: maxLossMultiplier}
+	}
+
+	// the fulfillment process modifies the participations and exposures,
+	// so it is processed in a cached context that is never written.
+	cacheCtx, _ := ctx.CacheContext()
+
+	fInfo, err := k.initFulfillmentInfo(
+		cacheCtx,
+		betAmount,
+		payoutProfit,
+		sdk.Dec{},
+		"",
+		0,
+		oddsUID,
+		oddsType,
+		oddsVal,
+		maxLossMultiplier,
+		&book,
+		odds,
+		market.OddsUIDS(),
+	)
+	if err != nil {
+		return sdkmath.Int{}, nil, err
+	}
+
+	if err := k.processFulfillmentQueue(cacheCtx, &fInfo, &book, bookExposure.FulfillmentQueue); err != nil {
+		return sdkmath.Int{}, nil, err
+	}
+
+	return fInfo.fulfilledBetAmount, fInfo.fulfillments, nil
+}
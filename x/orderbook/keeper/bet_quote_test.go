@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sge-network/sge/app/params"
+	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	bettypes "github.com/sge-network/sge/x/bet/types"
+	"github.com/sge-network/sge/x/orderbook/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWagerQuote(t *testing.T) {
+	ts := newTestBetSuite(t)
+	wctx := sdk.WrapSDKContext(ts.ctx)
+
+	_, err := ts.k.WagerQuote(wctx, &types.QueryWagerQuoteRequest{
+		OrderBookUid: ts.market.UID,
+		OddsUid:      ts.market.Odds[0].UID,
+		OddsType:     bettypes.OddsType_ODDS_TYPE_DECIMAL,
+		OddsValue:    "1.1",
+		Amount:       sdkmath.NewInt(400),
+	})
+	require.Error(t, err)
+
+	ts.tApp.MarketKeeper.SetMarket(ts.ctx, ts.market)
+	err = ts.k.InitiateOrderBook(ts.ctx, ts.market.UID, params.DefaultBondDenom, ts.market.OddsUIDS())
+	require.NoError(t, err)
+
+	participationIndex, err := ts.tApp.HouseKeeper.Deposit(
+		ts.ctx,
+		ts.deposits[0].DepositorAddress,
+		ts.deposits[0].DepositorAddress,
+		ts.market.BookUID,
+		ts.deposits[0].Amount,
+	)
+	require.NoError(t, err)
+	participationBefore, found := ts.k.GetOrderBookParticipation(ts.ctx, ts.market.UID, participationIndex)
+	require.True(t, found)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryWagerQuoteRequest
+		err     bool
+	}{
+		{
+			desc: "invalid amount",
+			request: &types.QueryWagerQuoteRequest{
+				OrderBookUid: ts.market.UID,
+				OddsUid:      ts.market.Odds[0].UID,
+				OddsType:     bettypes.OddsType_ODDS_TYPE_DECIMAL,
+				OddsValue:    "1.1",
+				Amount:       sdkmath.ZeroInt(),
+			},
+			err: true,
+		},
+		{
+			desc: "invalid max loss multiplier",
+			request: &types.QueryWagerQuoteRequest{
+				OrderBookUid:      ts.market.UID,
+				OddsUid:           ts.market.Odds[0].UID,
+				OddsType:          bettypes.OddsType_ODDS_TYPE_DECIMAL,
+				OddsValue:         "1.1",
+				Amount:            sdkmath.NewInt(400),
+				MaxLossMultiplier: sdk.MustNewDecFromStr("1.5"),
+			},
+			err: true,
+		},
+		{
+			desc: "odds not found",
+			request: &types.QueryWagerQuoteRequest{
+				OrderBookUid: ts.market.UID,
+				OddsUid:      "unknown",
+				OddsType:     bettypes.OddsType_ODDS_TYPE_DECIMAL,
+				OddsValue:    "1.1",
+				Amount:       sdkmath.NewInt(400),
+			},
+			err: true,
+		},
+		{
+			desc:    "invalid request",
+			request: nil,
+			err:     true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := ts.k.WagerQuote(wctx, tc.request)
+			require.Error(t, err)
+		})
+	}
+
+	maxLossMultiplier := sdk.MustNewDecFromStr("0.1")
+	betAmount := sdkmath.NewInt(400)
+	quote, err := ts.k.WagerQuote(wctx, &types.QueryWagerQuoteRequest{
+		OrderBookUid:      ts.market.UID,
+		OddsUid:           ts.market.Odds[0].UID,
+		OddsType:          bettypes.OddsType_ODDS_TYPE_DECIMAL,
+		OddsValue:         "1.1",
+		Amount:            betAmount,
+		MaxLossMultiplier: maxLossMultiplier,
+	})
+	require.NoError(t, err)
+	require.Equal(t, betAmount.String(), quote.MaxFillableAmount.String())
+	require.Equal(t, quote.MaxFillableAmount.Add(quote.PayoutProfit).String(), quote.Payout.String())
+
+	// the quote should not modify the state of the order book
+	participationAfter, found := ts.k.GetOrderBookParticipation(ts.ctx, ts.market.UID, participationIndex)
+	require.True(t, found)
+	require.Equal(t, participationBefore, participationAfter)
+	betPairs, err := ts.k.GetAllParticipationBetPair(ts.ctx)
+	require.NoError(t, err)
+	require.Empty(t, betPairs)
+
+	// the quoted fulfillments should be the same as the actual wager fulfillments
+	betOdds := make(map[string]*bettypes.BetOddsCompact)
+	for _, uid := range ts.market.OddsUIDS() {
+		betOdds[uid] = &bettypes.BetOddsCompact{UID: uid, MaxLossMultiplier: maxLossMultiplier}
+	}
+	_, _, betFulfillment := ts.placeTestBet(
+		simappUtil.TestParamUsers["user5"].Address,
+		ts.market.UID,
+		ts.market.Odds[0].UID,
+		1,
+		betAmount,
+		ts.betFee,
+		nil,
+		betOdds,
+		ts.market.OddsUIDS(),
+	)
+	require.Equal(t, betFulfillment, quote.BetFulfillments)
+
+	// the quote of an amount more than the liquidity returns the largest fillable amount
+	largeAmount := sdkmath.NewInt(100000000000)
+	quote, err = ts.k.WagerQuote(wctx, &types.QueryWagerQuoteRequest{
+		OrderBookUid:      ts.market.UID,
+		OddsUid:           ts.market.Odds[0].UID,
+		OddsType:          bettypes.OddsType_ODDS_TYPE_DECIMAL,
+		OddsValue:         "1.1",
+		Amount:            largeAmount,
+		MaxLossMultiplier: maxLossMultiplier,
+	})
+	require.NoError(t, err)
+	require.True(t, quote.MaxFillableAmount.IsPositive())
+	require.True(t, quote.MaxFillableAmount.LT(largeAmount))
+}
@@ -16,19 +16,23 @@ func (k Keeper) RefundBettor(
 	bettorAddress sdk.AccAddress,
 	betAmount, betFee, _ sdkmath.Int,
 	_ string,
+	denom string,
 ) error {
 	// refund bettor's account from orderbook liquidity pool.
-	if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, bettorAddress, betAmount); err != nil {
+	if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, bettorAddress, betAmount, denom); err != nil {
 		return err
 	}
 
 	// refund bettor's account from bet fee collector.
-	return k.refund(bettypes.BetFeeCollectorFunder{}, ctx, bettorAddress, betFee)
+	return k.refund(bettypes.BetFeeCollectorFunder{}, ctx, bettorAddress, betFee, denom)
 }
 
 // BettorWins process bets in case bettor is the winner,
-// transfers the bet amount and the payout profit to the bettor's account and,
+// transfers the bet amount and the won portion of the payout profit to the bettor's account and,
 // updates actual profit of the participation to the subtracted value from the payout profit.
+// the win ratio is one for the full win, one half for the half win and zero for the push.
+// the dead heat factor is the portion of the bet amount that is paid at the full odds when
+// the odds ties with other winners, the rest of the bet amount is lost to the participation.
 func (k Keeper) BettorWins(
 	ctx sdk.Context,
 	bettorAddress sdk.AccAddress,
@@ -37,7 +41,13 @@ func (k Keeper) BettorWins(
 	_ string,
 	betFulfillments []*bettypes.BetFulfillment,
 	orderBookUID string,
+	winRatio, deadHeatFactor sdk.Dec,
 ) error {
+	book, found := k.GetOrderBook(ctx, orderBookUID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrOrderBookNotFound, "%s", orderBookUID)
+	}
+
 	for _, betFulfillment := range betFulfillments {
 		orderBookParticipation, found := k.GetOrderBookParticipation(
 			ctx,
@@ -53,17 +63,19 @@ func (k Keeper) BettorWins(
 			)
 		}
 
-		betAmountAndPayout := betFulfillment.PayoutProfit.Add(betFulfillment.BetAmount)
+		payoutProfit := sdk.NewDecFromInt(betFulfillment.PayoutProfit).Mul(winRatio).Mul(deadHeatFactor).TruncateInt()
+		wonBetAmount := sdk.NewDecFromInt(betFulfillment.BetAmount).Mul(deadHeatFactor).TruncateInt()
+		betAmountAndPayout := payoutProfit.Add(wonBetAmount)
 		// refund bettor's account from orderbook liquidity pool.
-		if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, bettorAddress, betAmountAndPayout); err != nil {
+		if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, bettorAddress, betAmountAndPayout, book.Denom); err != nil {
 			return err
 		}
 
 		// update actual profit of the participation, the bettor is the winner, so we need to
-		// payout from the participant profit.
-		orderBookParticipation.ActualProfit = orderBookParticipation.ActualProfit.Sub(
-			betFulfillment.PayoutProfit,
-		)
+		// payout from the participant profit, the bet amount out of the dead heat portion
+		// is lost by the bettor and added to the participant profit.
+		lostBetAmount := betFulfillment.BetAmount.Sub(wonBetAmount)
+		orderBookParticipation.ActualProfit = orderBookParticipation.ActualProfit.Sub(payoutProfit).Add(lostBetAmount)
 		k.SetOrderBookParticipation(ctx, orderBookParticipation)
 	}
 
@@ -71,16 +83,22 @@ func (k Keeper) BettorWins(
 }
 
 // BettorLoses process bets in case bettor loses,
-// adds the bet amount to the actual profit of the participation
-// for each of the bet fulfillment records and,
-// removes the payout lock.
-func (k Keeper) BettorLoses(ctx sdk.Context, _ sdk.AccAddress,
+// adds the lost portion of the bet amount to the actual profit of the participation
+// for each of the bet fulfillment records and, returns the rest of the bet amount
+// to the bettor. the loss ratio is one for the full loss and one half for the half loss.
+func (k Keeper) BettorLoses(ctx sdk.Context, bettorAddress sdk.AccAddress,
 	_ sdkmath.Int,
 	_ sdkmath.Int,
 	_ string,
 	betFulfillments []*bettypes.BetFulfillment,
 	orderBookUID string,
+	lossRatio sdk.Dec,
 ) error {
+	book, found := k.GetOrderBook(ctx, orderBookUID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrOrderBookNotFound, "%s", orderBookUID)
+	}
+
 	for _, betFulfillment := range betFulfillments {
 		// update amount to be transferred to house
 		orderBookParticipation, found := k.GetOrderBookParticipation(
@@ -97,11 +115,17 @@ func (k Keeper) BettorLoses(ctx sdk.Context, _ sdk.AccAddress,
 			)
 		}
 
+		lostAmount := sdk.NewDecFromInt(betFulfillment.BetAmount).Mul(lossRatio).TruncateInt()
+		if returnedAmount := betFulfillment.BetAmount.Sub(lostAmount); returnedAmount.IsPositive() {
+			// refund the rest of the bet amount to the bettor's account from orderbook liquidity pool.
+			if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, bettorAddress, returnedAmount, book.Denom); err != nil {
+				return err
+			}
+		}
+
 		// update actual profit of the participation, the bettor is the loser, so we need to
 		// add the lost bet amount to the participant profit.
-		orderBookParticipation.ActualProfit = orderBookParticipation.ActualProfit.Add(
-			betFulfillment.BetAmount,
-		)
+		orderBookParticipation.ActualProfit = orderBookParticipation.ActualProfit.Add(lostAmount)
 		k.SetOrderBookParticipation(ctx, orderBookParticipation)
 	}
 
@@ -10,13 +10,15 @@ import (
 	"github.com/sge-network/sge/x/orderbook/types"
 )
 
-// ProcessWager processes bet placement
+// ProcessWager processes bet placement, if the minimum fill ratio is set, the bet is
+// partially fulfilled when the order book liquidity is not enough for the whole bet amount.
 func (k Keeper) ProcessWager(
 	ctx sdk.Context,
 	betUID, bookUID, oddsUID string,
 	maxLossMultiplier sdk.Dec,
 	betAmount sdkmath.Int,
 	payoutProfit sdk.Dec,
+	minFillRatio sdk.Dec,
 	bettorAddress sdk.AccAddress,
 	betFee sdkmath.Int,
 	oddsType bettypes.OddsType,
@@ -39,6 +41,7 @@ func (k Keeper) ProcessWager(
 		ctx,
 		betAmount,
 		payoutProfit,
+		minFillRatio,
 		betUID,
 		betID,
 		oddsUID,
@@ -61,12 +64,12 @@ func (k Keeper) ProcessWager(
 	k.SetOrderBookOddsExposure(ctx, bookExposure)
 
 	// fund bet fee collector from bettor's account.
-	if err := k.fund(bettypes.BetFeeCollectorFunder{}, ctx, bettorAddress, betFee); err != nil {
+	if err := k.fund(bettypes.BetFeeCollectorFunder{}, ctx, bettorAddress, betFee, book.Denom); err != nil {
 		return nil, err
 	}
 
 	// fund order book liquidity pool from bettor's account.
-	if err := k.fund(types.OrderBookLiquidityFunder{}, ctx, bettorAddress, fInfo.fulfilledBetAmount); err != nil {
+	if err := k.fund(types.OrderBookLiquidityFunder{}, ctx, bettorAddress, fInfo.fulfilledBetAmount, book.Denom); err != nil {
 		return nil, err
 	}
 
@@ -74,12 +77,27 @@ func (k Keeper) ProcessWager(
 }
 
 // fulfillBetByParticipationQueue fulfills the bet wagering payout using the participations
-// that is stored in the state.
+// that is stored in the state and checks if the fulfilled amount is acceptable.
 func (k Keeper) fulfillBetByParticipationQueue(
 	ctx sdk.Context,
 	fInfo *fulfillmentInfo,
 	book *types.OrderBook,
 	fulfillmentQueue []uint64,
+) error {
+	if err := k.processFulfillmentQueue(ctx, fInfo, book, fulfillmentQueue); err != nil {
+		return err
+	}
+
+	return fInfo.validateFulfilledAmount()
+}
+
+// processFulfillmentQueue iterates the fulfillment queue and fulfills the bet wagering payout
+// as much as the available liquidity of the participations allows.
+func (k Keeper) processFulfillmentQueue(
+	ctx sdk.Context,
+	fInfo *fulfillmentInfo,
+	book *types.OrderBook,
+	fulfillmentQueue []uint64,
 ) error {
 	fInfo.fulfillmentQueue = fulfillmentQueue
 	fInfo.updatedfulfillmentQueue = fulfillmentQueue
@@ -157,8 +175,25 @@ func (k Keeper) fulfillBetByParticipationQueue(
 		}
 	}
 
+	return nil
+}
+
+// validateFulfilledAmount checks if the whole bet amount is fulfilled or the fulfilled
+// amount is not less than the minimum amount if the partial fulfillment is allowed.
+func (fInfo *fulfillmentInfo) validateFulfilledAmount() error {
 	if fInfo.NoMoreLiquidityAvailable() {
-		return sdkerrors.Wrapf(types.ErrInternalProcessingBet, "insufficient liquidity in order book")
+		if !fInfo.partialFillAllowed {
+			return sdkerrors.Wrapf(types.ErrInternalProcessingBet, "insufficient liquidity in order book")
+		}
+
+		if fInfo.fulfilledBetAmount.IsZero() || fInfo.fulfilledBetAmount.LT(fInfo.minFulfilledBetAmount) {
+			return sdkerrors.Wrapf(
+				types.ErrInternalProcessingBet,
+				"insufficient liquidity in order book, fulfilled amount %s is less than the minimum %s",
+				fInfo.fulfilledBetAmount,
+				fInfo.minFulfilledBetAmount,
+			)
+		}
 	}
 
 	return nil
@@ -169,6 +204,7 @@ func (k Keeper) initFulfillmentInfo(
 	ctx sdk.Context,
 	betAmount sdkmath.Int,
 	payoutProfit sdk.Dec,
+	minFillRatio sdk.Dec,
 	betUID string,
 	betID uint64,
 	oddsUID string,
@@ -202,6 +238,11 @@ func (k Keeper) initFulfillmentInfo(
 		oddUIDS:            oddUIDS,
 	}
 
+	if !minFillRatio.IsNil() && minFillRatio.IsPositive() {
+		fInfo.partialFillAllowed = true
+		fInfo.minFulfilledBetAmount = minFillRatio.MulInt(betAmount).Ceil().TruncateInt()
+	}
+
 	bps, err := k.GetParticipationsOfOrderBook(ctx, book.UID)
 	if err != nil {
 		return
@@ -294,12 +335,14 @@ func (k Keeper) fulfill(
 		betAmountToFulfill,
 	)
 
-	fInfo.fulfillments = append(fInfo.fulfillments, bettypes.NewBetFulfillment(
+	betFulfillment := bettypes.NewBetFulfillment(
 		fInfo.inProcessItem.participation.ParticipantAddress,
 		fInfo.inProcessItem.participation.Index,
 		betAmountToFulfill,
 		payoutProfitToFulfill,
-	))
+	)
+	betFulfillment.Round = fInfo.inProcessItem.participationExposure.Round
+	fInfo.fulfillments = append(fInfo.fulfillments, betFulfillment)
 
 	// the amount has been fulfilled, so it should be subtracted from the bet amount of the
 	fInfo.betAmount = fInfo.betAmount.Sub(betAmountToFulfill)
@@ -427,6 +470,10 @@ type fulfillmentInfo struct {
 	payoutProfit       sdk.Dec
 	fulfilledBetAmount sdkmath.Int
 
+	// partial fulfillment specs
+	partialFillAllowed    bool
+	minFulfilledBetAmount sdkmath.Int
+
 	fulfillmentQueue        []uint64
 	updatedfulfillmentQueue []uint64
 	fulfillmentMap          fulfillmentMap
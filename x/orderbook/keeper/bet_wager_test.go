@@ -53,6 +53,7 @@ func newTestBetSuite(t *testing.T) testBetSuite {
 		Creator: simappUtil.TestParamUsers["user1"].Address.String(),
 		Meta:    "test market",
 		BookUID: marketUID,
+		Denom:   params.DefaultBondDenom,
 	}
 
 	deposits := []housetypes.Deposit{
@@ -78,7 +79,7 @@ func newTestBetSuite(t *testing.T) testBetSuite {
 func (ts *testBetSuite) placeBetsAndTest() ([]bettypes.Bet, sdk.Dec, sdk.Dec) {
 	ts.tApp.MarketKeeper.SetMarket(ts.ctx, ts.market)
 
-	err := ts.k.InitiateOrderBook(ts.ctx, ts.market.UID, []string{
+	err := ts.k.InitiateOrderBook(ts.ctx, ts.market.UID, params.DefaultBondDenom, []string{
 		ts.market.Odds[0].UID,
 		ts.market.Odds[1].UID,
 		ts.market.Odds[2].UID,
@@ -316,6 +317,7 @@ func (ts *testBetSuite) placeTestBet(
 		OddsValue:         "1.1",
 		Amount:            amount,
 		Fee:               fee,
+		Denom:             params.DefaultBondDenom,
 		Status:            bettypes.Bet_STATUS_PENDING,
 		Creator:           bettorAddr.String(),
 		CreatedAt:         cast.ToInt64(ts.ctx.BlockTime().Unix()),
@@ -337,7 +339,7 @@ func (ts *testBetSuite) placeTestBet(
 	)
 
 	betFulfillment, err := ts.k.ProcessWager(
-		ts.ctx, bet.UID, bet.MarketUID, bet.OddsUID, bet.MaxLossMultiplier, bet.Amount, payoutProfit,
+		ts.ctx, bet.UID, bet.MarketUID, bet.OddsUID, bet.MaxLossMultiplier, bet.Amount, payoutProfit, sdk.Dec{},
 		bettorAddr, bet.Fee, bet.OddsType, bet.OddsValue, 1, odds, oddUIDS,
 	)
 	if expErr != nil {
@@ -414,7 +416,7 @@ func (ts *testBetSuite) bulkDepositPlaceBetsAndTest() {
 	for _, o := range ts.market.Odds {
 		oddsUIDs = append(oddsUIDs, o.UID)
 	}
-	err := ts.k.InitiateOrderBook(ts.ctx, ts.market.UID, oddsUIDs)
+	err := ts.k.InitiateOrderBook(ts.ctx, ts.market.UID, params.DefaultBondDenom, oddsUIDs)
 	require.NoError(ts.t, err)
 
 	for i := 0; i < len(ts.deposits); i++ {
@@ -466,6 +468,7 @@ func (ts *testBetSuite) bulkDepositPlaceBetsAndTest() {
 		OddsValue:         "4.415",
 		Amount:            betAmount,
 		Fee:               sdk.ZeroInt(),
+		Denom:             params.DefaultBondDenom,
 		Status:            bettypes.Bet_STATUS_PENDING,
 		Creator:           bettorAddr.String(),
 		CreatedAt:         cast.ToInt64(ts.ctx.BlockTime().Unix()),
@@ -476,23 +479,23 @@ func (ts *testBetSuite) bulkDepositPlaceBetsAndTest() {
 	require.NoError(ts.t, err)
 
 	betFulfillment, err := ts.k.ProcessWager(
-		ts.ctx, bet.UID, bet.MarketUID, bet.OddsUID, bet.MaxLossMultiplier, bet.Amount, payoutProfit,
+		ts.ctx, bet.UID, bet.MarketUID, bet.OddsUID, bet.MaxLossMultiplier, bet.Amount, payoutProfit, sdk.Dec{},
 		bettorAddr, bet.Fee, bet.OddsType, bet.OddsValue, 1, betOdds, oddUIDS,
 	)
 	require.NoError(ts.t, err)
 
 	expected := []*bettypes.BetFulfillment{
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 1, BetAmount: sdkmath.NewInt(2535454), PayoutProfit: sdkmath.NewInt(8658575)},
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 2, BetAmount: sdkmath.NewInt(2535454), PayoutProfit: sdkmath.NewInt(8658575)},
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 3, BetAmount: sdkmath.NewInt(2535454), PayoutProfit: sdkmath.NewInt(8658575)},
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 4, BetAmount: sdkmath.NewInt(2535453), PayoutProfit: sdkmath.NewInt(8658575)},
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 5, BetAmount: sdkmath.NewInt(2535453), PayoutProfit: sdkmath.NewInt(8658575)},
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 6, BetAmount: sdkmath.NewInt(2535453), PayoutProfit: sdkmath.NewInt(8658575)},
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 7, BetAmount: sdkmath.NewInt(2535453), PayoutProfit: sdkmath.NewInt(8658575)},
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 8, BetAmount: sdkmath.NewInt(2535454), PayoutProfit: sdkmath.NewInt(8658575)},
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 9, BetAmount: sdkmath.NewInt(2535453), PayoutProfit: sdkmath.NewInt(8658575)},
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 10, BetAmount: sdkmath.NewInt(2535454), PayoutProfit: sdkmath.NewInt(8658575)},
-		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 11, BetAmount: sdkmath.NewInt(13), PayoutProfit: sdkmath.NewInt(31)},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 1, BetAmount: sdkmath.NewInt(2535454), PayoutProfit: sdkmath.NewInt(8658575), Round: 1},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 2, BetAmount: sdkmath.NewInt(2535454), PayoutProfit: sdkmath.NewInt(8658575), Round: 1},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 3, BetAmount: sdkmath.NewInt(2535454), PayoutProfit: sdkmath.NewInt(8658575), Round: 1},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 4, BetAmount: sdkmath.NewInt(2535453), PayoutProfit: sdkmath.NewInt(8658575), Round: 1},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 5, BetAmount: sdkmath.NewInt(2535453), PayoutProfit: sdkmath.NewInt(8658575), Round: 1},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 6, BetAmount: sdkmath.NewInt(2535453), PayoutProfit: sdkmath.NewInt(8658575), Round: 1},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 7, BetAmount: sdkmath.NewInt(2535453), PayoutProfit: sdkmath.NewInt(8658575), Round: 1},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 8, BetAmount: sdkmath.NewInt(2535454), PayoutProfit: sdkmath.NewInt(8658575), Round: 1},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 9, BetAmount: sdkmath.NewInt(2535453), PayoutProfit: sdkmath.NewInt(8658575), Round: 1},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 10, BetAmount: sdkmath.NewInt(2535454), PayoutProfit: sdkmath.NewInt(8658575), Round: 1},
+		{ParticipantAddress: simappUtil.TestParamUsers["user2"].Address.String(), ParticipationIndex: 11, BetAmount: sdkmath.NewInt(13), PayoutProfit: sdkmath.NewInt(31), Round: 1},
 	}
 	require.Equal(ts.t, expected, betFulfillment)
 
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bettypes "github.com/sge-network/sge/x/bet/types"
+)
+
+// FundBetDelayEscrow transfers the stake of a delayed bet from the bettor's account to the bet delay escrow.
+func (k Keeper) FundBetDelayEscrow(ctx sdk.Context, bettorAddress sdk.AccAddress, amount sdkmath.Int, denom string) error {
+	// fund bet delay escrow from bettor's account.
+	return k.fund(bettypes.BetDelayEscrowFunder{}, ctx, bettorAddress, amount, denom)
+}
+
+// WithdrawFromBetDelayEscrow transfers the stake of a delayed bet from the bet delay escrow to the bettor's account.
+func (k Keeper) WithdrawFromBetDelayEscrow(ctx sdk.Context, bettorAddress sdk.AccAddress, amount sdkmath.Int, denom string) error {
+	// refund bettor's account from bet delay escrow.
+	return k.refund(bettypes.BetDelayEscrowFunder{}, ctx, bettorAddress, amount, denom)
+}
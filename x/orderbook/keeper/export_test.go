@@ -14,8 +14,9 @@ func (k KeeperTest) Fund(
 	ctx sdk.Context,
 	senderAcc sdk.AccAddress,
 	amount sdkmath.Int,
+	denom string,
 ) error {
-	return k.fund(mf, ctx, senderAcc, amount)
+	return k.fund(mf, ctx, senderAcc, amount, denom)
 }
 
 func (k KeeperTest) ReFund(
@@ -23,6 +24,7 @@ func (k KeeperTest) ReFund(
 	ctx sdk.Context,
 	receiverAcc sdk.AccAddress,
 	amount sdkmath.Int,
+	denom string,
 ) error {
-	return k.refund(mf, ctx, receiverAcc, amount)
+	return k.refund(mf, ctx, receiverAcc, amount, denom)
 }
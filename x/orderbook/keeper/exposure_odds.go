@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/sge-network/sge/x/orderbook/types"
 )
@@ -104,6 +105,30 @@ func (k Keeper) initParticipationExposures(
 	return nil
 }
 
+// requeueParticipation appends the participation at index to the end of the fulfillment
+// queue of the odds exposure if it is not already in the queue.
+func (k Keeper) requeueParticipation(
+	ctx sdk.Context,
+	orderBookUID, oddsUID string,
+	participationIndex uint64,
+) error {
+	boe, found := k.GetOrderBookOddsExposure(ctx, orderBookUID, oddsUID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrOrderBookExposureNotFound, "%s , %s", orderBookUID, oddsUID)
+	}
+
+	for _, index := range boe.FulfillmentQueue {
+		if index == participationIndex {
+			return nil
+		}
+	}
+
+	boe.FulfillmentQueue = append(boe.FulfillmentQueue, participationIndex)
+	k.SetOrderBookOddsExposure(ctx, boe)
+
+	return nil
+}
+
 func (k Keeper) removeNotWithdrawableFromFulfillmentQueue(
 	ctx sdk.Context,
 	bp types.OrderBookParticipation,
@@ -5,7 +5,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/x/orderbook/types"
 )
 
@@ -18,22 +17,24 @@ func (k *Keeper) fund(
 	ctx sdk.Context,
 	senderAcc sdk.AccAddress,
 	amount sdkmath.Int,
+	denom string,
 ) error {
 	mAcc := mf.GetModuleAcc()
 
 	// Get the spendable balance of the account holder
-	usgeCoins := k.bankKeeper.SpendableCoins(ctx, senderAcc).AmountOf(params.BaseCoinUnit)
+	spendableAmount := k.bankKeeper.SpendableCoins(ctx, senderAcc).AmountOf(denom)
 
 	// If account holder has insufficient balance, return error
-	if usgeCoins.LT(amount) {
+	if spendableAmount.LT(amount) {
 		return sdkerrors.Wrapf(
 			types.ErrInsufficientAccountBalance,
-			"account Address: %s",
+			"account Address: %s, denom: %s",
 			senderAcc.String(),
+			denom,
 		)
 	}
 
-	amt := sdk.NewCoins(sdk.NewCoin(params.BaseCoinUnit, amount))
+	amt := sdk.NewCoins(sdk.NewCoin(denom, amount))
 
 	// Transfer funds
 	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAcc, mAcc, amt)
@@ -49,19 +50,20 @@ func (k *Keeper) refund(
 	ctx sdk.Context,
 	receiverAcc sdk.AccAddress,
 	amount sdkmath.Int,
+	denom string,
 ) error {
 	mAcc := mf.GetModuleAcc()
 	// Get the balance of the sender module account
 	balance := k.bankKeeper.GetBalance(
 		ctx,
 		k.accountKeeper.GetModuleAddress(mAcc),
-		params.DefaultBondDenom,
+		denom,
 	)
 
-	amt := sdk.NewCoins(sdk.NewCoin(params.DefaultBondDenom, amount))
+	amt := sdk.NewCoins(sdk.NewCoin(denom, amount))
 
 	// If module account has insufficient balance, return error
-	if balance.Amount.LT(amt.AmountOf(params.DefaultBondDenom)) {
+	if balance.Amount.LT(amt.AmountOf(denom)) {
 		return sdkerrors.Wrapf(types.ErrInsufficientBalanceInModuleAccount,
 			mAcc)
 	}
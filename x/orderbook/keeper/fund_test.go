@@ -41,6 +41,7 @@ func TestFund(t *testing.T) {
 				ctx,
 				senderAddr,
 				tc.amount,
+				params.DefaultBondDenom,
 			)
 
 			if tc.err != nil {
@@ -63,6 +64,7 @@ func TestReFund(t *testing.T) {
 		ctx,
 		simappUtil.TestParamUsers["user2"].Address,
 		successAmount,
+		params.DefaultBondDenom,
 	)
 	require.NoError(t, err)
 
@@ -91,6 +93,7 @@ func TestReFund(t *testing.T) {
 				ctx,
 				receiverAddr,
 				tc.amount,
+				params.DefaultBondDenom,
 			)
 
 			if tc.err != nil {
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sge-network/sge/consts"
+	"github.com/sge-network/sge/x/orderbook/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WagerQuote queries the expected fulfillment of a bet by the order book
+func (k Keeper) WagerQuote(
+	c context.Context,
+	req *types.QueryWagerQuoteRequest,
+) (*types.QueryWagerQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, consts.ErrTextInvalidRequest)
+	}
+
+	if req.OrderBookUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "book id can not be empty")
+	}
+
+	if req.OddsUid == "" {
+		return nil, status.Error(codes.InvalidArgument, "odds id can not be empty")
+	}
+
+	if req.Amount.IsNil() || !req.Amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount should be positive")
+	}
+
+	maxLossMultiplier := req.MaxLossMultiplier
+	if maxLossMultiplier.IsNil() || maxLossMultiplier.IsZero() {
+		maxLossMultiplier = sdk.OneDec()
+	}
+
+	if maxLossMultiplier.IsNegative() || maxLossMultiplier.GT(sdk.OneDec()) {
+		return nil, status.Error(codes.InvalidArgument, "max loss multiplier should be between zero and one")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fillableAmount, fulfillments, err := k.QuoteWager(
+		ctx,
+		req.OrderBookUid,
+		req.OddsUid,
+		req.OddsType,
+		req.OddsValue,
+		req.Amount,
+		maxLossMultiplier,
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	payoutProfit := sdk.ZeroInt()
+	for _, bf := range fulfillments {
+		payoutProfit = payoutProfit.Add(bf.PayoutProfit)
+	}
+
+	return &types.QueryWagerQuoteResponse{
+		MaxFillableAmount: fillableAmount,
+		BetFulfillments:   fulfillments,
+		PayoutProfit:      payoutProfit,
+		Payout:            fillableAmount.Add(payoutProfit),
+	}, nil
+}
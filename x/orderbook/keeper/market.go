@@ -3,11 +3,25 @@ package keeper
 import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	bettypes "github.com/sge-network/sge/x/bet/types"
+	"github.com/sge-network/sge/x/orderbook/types"
 )
 
-func (k Keeper) WithdrawBetFee(ctx sdk.Context, marketCreator sdk.AccAddress, betFee sdkmath.Int) error {
+func (k Keeper) WithdrawBetFee(ctx sdk.Context, marketCreator sdk.AccAddress, betFee sdkmath.Int, denom string) error {
 	// refund market creator's account from bet fee collector.
-	return k.refund(bettypes.BetFeeCollectorFunder{}, ctx, marketCreator, betFee)
+	return k.refund(bettypes.BetFeeCollectorFunder{}, ctx, marketCreator, betFee, denom)
+}
+
+// FundBetFee transfers the bet fee from the bettor's account to the bet fee collector
+// in the denom of the order book.
+func (k Keeper) FundBetFee(ctx sdk.Context, bettorAddress sdk.AccAddress, orderBookUID string, betFee sdkmath.Int) error {
+	book, found := k.GetOrderBook(ctx, orderBookUID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrOrderBookNotFound, "%s", orderBookUID)
+	}
+
+	// fund bet fee collector from bettor's account.
+	return k.fund(bettypes.BetFeeCollectorFunder{}, ctx, bettorAddress, betFee, book.Denom)
 }
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sge-network/sge/app/params"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, the order books without
+// denom are set to the bond denom.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	books, err := m.keeper.GetAllOrderBooks(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, book := range books {
+		if book.Denom == "" {
+			book.Denom = params.DefaultBondDenom
+			m.keeper.SetOrderBook(ctx, book)
+		}
+	}
+
+	return nil
+}
@@ -48,8 +48,8 @@ func (k Keeper) GetAllOrderBooks(ctx sdk.Context) (list []types.OrderBook, err e
 	return
 }
 
-// InitiateOrderBook initiates an order book for a given market.
-func (k Keeper) InitiateOrderBook(ctx sdk.Context, marketUID string, oddsUIDs []string) (err error) {
+// InitiateOrderBook initiates an order book for a given market with the accepted denom of the market.
+func (k Keeper) InitiateOrderBook(ctx sdk.Context, marketUID, denom string, oddsUIDs []string) (err error) {
 	// book and market have one-to-one relationship
 	orderBookUID := marketUID
 
@@ -64,6 +64,7 @@ func (k Keeper) InitiateOrderBook(ctx sdk.Context, marketUID string, oddsUIDs []
 		orderBookUID,
 		uint64(len(oddsUIDs)),
 		types.OrderBookStatus_ORDER_BOOK_STATUS_STATUS_ACTIVE,
+		denom,
 	)
 
 	// Add book exposures
@@ -76,3 +77,75 @@ func (k Keeper) InitiateOrderBook(ctx sdk.Context, marketUID string, oddsUIDs []
 
 	return nil
 }
+
+// AddOrderBookOdds adds new odds to an active order book, the odds exposures and
+// the participation exposures of the new odds are initialized for all of the
+// existing participations in their current round.
+func (k Keeper) AddOrderBookOdds(ctx sdk.Context, orderBookUID string, oddsUIDs []string) error {
+	book, found := k.GetOrderBook(ctx, orderBookUID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrOrderBookNotFound, "%s", orderBookUID)
+	}
+
+	if book.Status != types.OrderBookStatus_ORDER_BOOK_STATUS_STATUS_ACTIVE {
+		return sdkerrors.Wrapf(types.ErrOrderBookNotActive, "%s", book.Status)
+	}
+
+	for _, oddsUID := range oddsUIDs {
+		if _, found := k.GetOrderBookOddsExposure(ctx, book.UID, oddsUID); found {
+			return sdkerrors.Wrapf(types.ErrOddsExposureAlreadyPresent, "%s", oddsUID)
+		}
+	}
+
+	participations, err := k.GetParticipationsOfOrderBook(ctx, book.UID)
+	if err != nil {
+		return err
+	}
+
+	fulfillmentQueue := []uint64{}
+	for _, bp := range participations {
+		exposures, err := k.GetExposureByOrderBookAndParticipationIndex(ctx, book.UID, bp.Index)
+		if err != nil {
+			return err
+		}
+
+		round := uint64(1)
+		if len(exposures) > 0 {
+			round = exposures[0].Round
+		}
+
+		// the participations that are still in the queue of the current round
+		// need to fulfill the new odds as well, the others are marked as fulfilled.
+		inQueue := !bp.IsSettled && bp.ExposuresNotFilled > 0 && bp.IsLiquidityInCurrentRound()
+		for _, oddsUID := range oddsUIDs {
+			pe := types.NewParticipationExposure(
+				book.UID,
+				oddsUID,
+				sdk.ZeroInt(),
+				sdk.ZeroInt(),
+				bp.Index,
+				round,
+				!inQueue,
+			)
+			k.SetParticipationExposure(ctx, pe)
+		}
+
+		if inQueue {
+			bp.ExposuresNotFilled += uint64(len(oddsUIDs))
+			k.SetOrderBookParticipation(ctx, bp)
+			fulfillmentQueue = append(fulfillmentQueue, bp.Index)
+		}
+	}
+
+	for _, oddsUID := range oddsUIDs {
+		queue := make([]uint64, len(fulfillmentQueue))
+		copy(queue, fulfillmentQueue)
+		boe := types.NewOrderBookOddsExposure(book.UID, oddsUID, queue)
+		k.SetOrderBookOddsExposure(ctx, boe)
+	}
+
+	book.OddsCount += uint64(len(oddsUIDs))
+	k.SetOrderBook(ctx, book)
+
+	return nil
+}
@@ -104,6 +104,11 @@ func (k Keeper) settleParticipation(
 		)
 	}
 
+	book, found := k.GetOrderBook(ctx, bp.OrderBookUID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrOrderBookNotFound, "%s", bp.OrderBookUID)
+	}
+
 	depositorAddress, err := sdk.AccAddressFromBech32(bp.ParticipantAddress)
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, types.ErrTextInvalidDepositor, err)
@@ -115,7 +120,7 @@ func (k Keeper) settleParticipation(
 	case markettypes.MarketStatus_MARKET_STATUS_RESULT_DECLARED:
 		depositPlusProfit := bp.Liquidity.Add(bp.ActualProfit)
 		// refund participant's account from orderbook liquidity pool.
-		if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, depositorAddress, depositPlusProfit); err != nil {
+		if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, depositorAddress, depositPlusProfit, book.Denom); err != nil {
 			return err
 		}
 		if bp.NotParticipatedInBetFulfillment() {
@@ -123,8 +128,10 @@ func (k Keeper) settleParticipation(
 		}
 	case markettypes.MarketStatus_MARKET_STATUS_CANCELED,
 		markettypes.MarketStatus_MARKET_STATUS_ABORTED:
+		// the bets are refunded so the actual profit is only made by the cashed out bets.
+		depositPlusProfit := bp.Liquidity.Add(bp.ActualProfit)
 		// refund participant's account from orderbook liquidity pool.
-		if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, depositorAddress, bp.Liquidity); err != nil {
+		if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, depositorAddress, depositPlusProfit, book.Denom); err != nil {
 			return err
 		}
 		refundHouseDepositFeeToDepositor = true
@@ -139,12 +146,12 @@ func (k Keeper) settleParticipation(
 
 	if refundHouseDepositFeeToDepositor {
 		// refund participant's account from house fee collector.
-		if err := k.refund(housetypes.HouseFeeCollectorFunder{}, ctx, depositorAddress, bp.Fee); err != nil {
+		if err := k.refund(housetypes.HouseFeeCollectorFunder{}, ctx, depositorAddress, bp.Fee, book.Denom); err != nil {
 			return err
 		}
 	} else {
 		// refund participant's account from house fee collector.
-		if err := k.refund(housetypes.HouseFeeCollectorFunder{}, ctx, sdk.MustAccAddressFromBech32(market.Creator), bp.Fee); err != nil {
+		if err := k.refund(housetypes.HouseFeeCollectorFunder{}, ctx, sdk.MustAccAddressFromBech32(market.Creator), bp.Fee, book.Denom); err != nil {
 			return err
 		}
 	}
@@ -5,8 +5,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
+	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/testutil/nullify"
 	simappUtil "github.com/sge-network/sge/testutil/simapp"
+	markettypes "github.com/sge-network/sge/x/market/types"
 	"github.com/sge-network/sge/x/orderbook/keeper"
 	"github.com/sge-network/sge/x/orderbook/types"
 	"github.com/spf13/cast"
@@ -78,13 +80,68 @@ func TestInitiateOrderBook(t *testing.T) {
 		uuid.NewString(),
 	}
 
-	err := k.InitiateOrderBook(ctx, testOrderBookUID, odds)
+	err := k.InitiateOrderBook(ctx, testOrderBookUID, params.DefaultBondDenom, odds)
 	require.NoError(t, err)
 
 	exposures, err := k.GetAllOrderBookExposures(ctx)
 	require.NoError(t, err)
 	require.Equal(t, len(odds), len(exposures))
 
-	err = k.InitiateOrderBook(ctx, testOrderBookUID, odds)
+	err = k.InitiateOrderBook(ctx, testOrderBookUID, params.DefaultBondDenom, odds)
 	require.ErrorIs(t, types.ErrOrderBookAlreadyPresent, err)
 }
+
+func TestAddOrderBookOdds(t *testing.T) {
+	tApp, k, ctx := setupKeeperAndApp(t)
+
+	marketUID := uuid.NewString()
+	oddsUIDs := []string{uuid.NewString(), uuid.NewString()}
+	createTestMarket(tApp, k, ctx, marketUID, markettypes.MarketStatus_MARKET_STATUS_ACTIVE, oddsUIDs)
+
+	err := k.InitiateOrderBook(ctx, marketUID, params.DefaultBondDenom, oddsUIDs)
+	require.NoError(t, err)
+
+	for i := 1; i <= 2; i++ {
+		_, err := k.InitiateOrderBookParticipation(
+			ctx,
+			simappUtil.TestParamUsers["user"+cast.ToString(i)].Address,
+			marketUID,
+			sdk.NewInt(1000),
+			sdk.NewInt(1),
+		)
+		require.NoError(t, err)
+	}
+
+	newOddsUID := uuid.NewString()
+	err = k.AddOrderBookOdds(ctx, marketUID, []string{newOddsUID})
+	require.NoError(t, err)
+
+	book, found := k.GetOrderBook(ctx, marketUID)
+	require.True(t, found)
+	require.Equal(t, uint64(3), book.OddsCount)
+
+	boe, found := k.GetOrderBookOddsExposure(ctx, marketUID, newOddsUID)
+	require.True(t, found)
+	require.Equal(t, []uint64{1, 2}, boe.FulfillmentQueue)
+
+	pes, err := k.GetExposureByOrderBookAndOdds(ctx, marketUID, newOddsUID)
+	require.NoError(t, err)
+	require.Len(t, pes, 2)
+	for _, pe := range pes {
+		require.Equal(t, uint64(1), pe.Round)
+		require.False(t, pe.IsFulfilled)
+		require.True(t, pe.Exposure.IsZero())
+	}
+
+	participations, err := k.GetParticipationsOfOrderBook(ctx, marketUID)
+	require.NoError(t, err)
+	for _, bp := range participations {
+		require.Equal(t, uint64(3), bp.ExposuresNotFilled)
+	}
+
+	err = k.AddOrderBookOdds(ctx, marketUID, []string{newOddsUID})
+	require.ErrorIs(t, err, types.ErrOddsExposureAlreadyPresent)
+
+	err = k.AddOrderBookOdds(ctx, uuid.NewString(), []string{uuid.NewString()})
+	require.ErrorIs(t, err, types.ErrOrderBookNotFound)
+}
@@ -134,12 +134,12 @@ func (k Keeper) InitiateOrderBookParticipation(
 	)
 
 	// fund order book liquidity pool from participant's account.
-	if err = k.fund(types.OrderBookLiquidityFunder{}, ctx, addr, liquidity); err != nil {
+	if err = k.fund(types.OrderBookLiquidityFunder{}, ctx, addr, liquidity, book.Denom); err != nil {
 		return
 	}
 
 	// fund house fee collector from participant's account.
-	if err = k.fund(housetypes.HouseFeeCollectorFunder{}, ctx, addr, feeAmount); err != nil {
+	if err = k.fund(housetypes.HouseFeeCollectorFunder{}, ctx, addr, feeAmount, book.Denom); err != nil {
 		return
 	}
 
@@ -227,8 +227,13 @@ func (k Keeper) WithdrawOrderBookParticipation(
 		)
 	}
 
+	book, found := k.GetOrderBook(ctx, marketUID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrOrderBookNotFound, "%s", marketUID)
+	}
+
 	// refund participant's account from order book liquidity pool.
-	if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, sdk.MustAccAddressFromBech32(bp.ParticipantAddress), amount); err != nil {
+	if err := k.refund(types.OrderBookLiquidityFunder{}, ctx, sdk.MustAccAddressFromBech32(bp.ParticipantAddress), amount, book.Denom); err != nil {
 		return err
 	}
 
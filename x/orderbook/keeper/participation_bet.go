@@ -15,6 +15,12 @@ func (k Keeper) SetParticipationBetPair(ctx sdk.Context, bp types.ParticipationB
 	store.Set(bpKey, b)
 }
 
+// removeParticipationBetPair removes a participation bet pair.
+func (k Keeper) removeParticipationBetPair(ctx sdk.Context, bookUID string, participationIndex, betID uint64) {
+	store := k.getParticipationBetPairStore(ctx)
+	store.Delete(types.GetParticipationBetPairKey(bookUID, participationIndex, betID))
+}
+
 // GetAllParticipationBetPair returns all participation bet pairs used during genesis dump.
 func (k Keeper) GetAllParticipationBetPair(
 	ctx sdk.Context,
@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
+	"github.com/sge-network/sge/app/params"
 	"github.com/sge-network/sge/testutil/nullify"
 	"github.com/sge-network/sge/testutil/sample"
 	simappUtil "github.com/sge-network/sge/testutil/simapp"
@@ -63,6 +64,9 @@ func createTestMarket(
 		"test market",
 		marketUID,
 		status,
+		params.DefaultBondDenom,
+		nil,
+		"",
 	)
 	tApp.MarketKeeper.SetMarket(ctx, market)
 	return market
@@ -177,7 +181,7 @@ func TestInitiateOrderBookParticipation(t *testing.T) {
 			marketUID := uuid.NewString()
 			createTestMarket(tApp, k, ctx, marketUID, tc.marketStatus, oddsUIDs)
 
-			err := k.InitiateOrderBook(ctx, marketUID, oddsUIDs)
+			err := k.InitiateOrderBook(ctx, marketUID, params.DefaultBondDenom, oddsUIDs)
 			require.NoError(t, err)
 
 			participationIndex, err := k.InitiateOrderBookParticipation(ctx,
@@ -246,7 +250,7 @@ func TestWithdrawOrderBookParticipation(t *testing.T) {
 			marketUID := uuid.NewString()
 			createTestMarket(tApp, k, ctx, marketUID, markettypes.MarketStatus_MARKET_STATUS_ACTIVE, oddsUIDs)
 
-			err := k.InitiateOrderBook(ctx, marketUID, oddsUIDs)
+			err := k.InitiateOrderBook(ctx, marketUID, params.DefaultBondDenom, oddsUIDs)
 			require.NoError(t, err)
 
 			var participationIndex uint64
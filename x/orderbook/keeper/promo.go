@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bettypes "github.com/sge-network/sge/x/bet/types"
+)
+
+// FundPromoPool transfers the amount from the funder's account to the bet promo pool.
+func (k Keeper) FundPromoPool(ctx sdk.Context, funderAddress sdk.AccAddress, amount sdkmath.Int, denom string) error {
+	// fund bet promo pool from funder's account.
+	return k.fund(bettypes.BetPromoPoolFunder{}, ctx, funderAddress, amount, denom)
+}
+
+// WithdrawFromPromoPool transfers the amount from the bet promo pool to the receiver's account.
+func (k Keeper) WithdrawFromPromoPool(ctx sdk.Context, receiverAddress sdk.AccAddress, amount sdkmath.Int, denom string) error {
+	// refund receiver's account from bet promo pool.
+	return k.refund(bettypes.BetPromoPoolFunder{}, ctx, receiverAddress, amount, denom)
+}
+
+// GetPromoPoolBalance returns the balance of the bet promo pool in the denom.
+func (k Keeper) GetPromoPoolBalance(ctx sdk.Context, denom string) sdkmath.Int {
+	return k.bankKeeper.GetBalance(
+		ctx,
+		k.accountKeeper.GetModuleAddress(bettypes.BetPromoPoolFunder{}.GetModuleAcc()),
+		denom,
+	).Amount
+}
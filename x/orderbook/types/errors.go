@@ -34,6 +34,7 @@ var (
 	ErrUnknownMarketStatus                = sdkerrors.Register(ModuleName, 6025, "unknown market status of orderbook settlement")
 	ErrWithdrawalTooLarge                 = sdkerrors.Register(ModuleName, 6026, "withdrawal is more than unused amount")
 	ErrWithdrawalNotAllowedPostRequeing   = sdkerrors.Register(ModuleName, 6027, "withdrawal is not allowed post requeing")
+	ErrOddsExposureAlreadyPresent         = sdkerrors.Register(ModuleName, 6029, "odds exposure already present in the order book")
 )
 
 // ErrTextInvalidDepositor x/orderbook module sentinel error text
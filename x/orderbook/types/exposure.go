@@ -85,3 +85,10 @@ func (pe *ParticipationExposure) SetCurrentRound(betAmount, payoutProfit sdkmath
 	// add the bet amount that is being fulfilled to the exposure and participation
 	pe.BetAmount = pe.BetAmount.Add(betAmount)
 }
+
+// RevertCurrentRound reverts the bet amount and payout profit of a canceled
+// bet from the current round.
+func (pe *ParticipationExposure) RevertCurrentRound(betAmount, payoutProfit sdkmath.Int) {
+	pe.Exposure = pe.Exposure.Sub(payoutProfit)
+	pe.BetAmount = pe.BetAmount.Sub(betAmount)
+}
@@ -11,12 +11,14 @@ import (
 func NewOrderBook(
 	bookUID string, oddsCount uint64,
 	status OrderBookStatus,
+	denom string,
 ) OrderBook {
 	return OrderBook{
 		UID:                bookUID,
 		ParticipationCount: 0,
 		Status:             status,
 		OddsCount:          oddsCount,
+		Denom:              denom,
 	}
 }
 
@@ -69,6 +69,8 @@ type OrderBook struct {
 	OddsCount uint64 `protobuf:"varint,3,opt,name=odds_count,json=oddsCount,proto3" json:"odds_count,omitempty" yaml:"odds_count"`
 	// status represents the status of the order book.
 	Status OrderBookStatus `protobuf:"varint,4,opt,name=status,proto3,enum=sgenetwork.sge.orderbook.OrderBookStatus" json:"status,omitempty"`
+	// denom is the denomination of the liquidity and bets of the order book.
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *OrderBook) Reset()      { *m = OrderBook{} }
@@ -111,32 +113,33 @@ func init() {
 func init() { proto.RegisterFile("sge/orderbook/orderbook.proto", fileDescriptor_7247ccc164993ca5) }
 
 var fileDescriptor_7247ccc164993ca5 = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x4e, 0x4f, 0xd5,
-	0xcf, 0x2f, 0x4a, 0x49, 0x2d, 0x4a, 0xca, 0xcf, 0xcf, 0x46, 0xb0, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b,
-	0xf2, 0x85, 0x24, 0x8a, 0xd3, 0x53, 0xf3, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0x8a, 0xd3,
-	0x53, 0xf5, 0xe0, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x45, 0xfa, 0x20, 0x16, 0x44,
-	0xbd, 0x52, 0x2b, 0x13, 0x17, 0xa7, 0x3f, 0x48, 0x8d, 0x53, 0x7e, 0x7e, 0xb6, 0x90, 0x02, 0x17,
-	0x73, 0x69, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x13, 0xdf, 0xa3, 0x7b, 0xf2, 0xcc,
-	0xa1, 0x9e, 0x2e, 0xaf, 0xee, 0xc9, 0x83, 0x44, 0x83, 0x40, 0x84, 0x90, 0x3f, 0x97, 0x70, 0x41,
-	0x62, 0x51, 0x49, 0x66, 0x72, 0x66, 0x41, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x7c, 0x72, 0x7e, 0x69,
-	0x5e, 0x89, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x8b, 0x93, 0xdc, 0xa7, 0x7b, 0xf2, 0x52, 0x95, 0x89,
-	0xb9, 0x39, 0x56, 0x4a, 0x58, 0x14, 0x29, 0x05, 0x09, 0xa1, 0x88, 0x3a, 0x83, 0x04, 0x85, 0x4c,
-	0xb8, 0xb8, 0xf2, 0x53, 0x52, 0x8a, 0xa1, 0xe6, 0x30, 0x83, 0xcd, 0x11, 0xfd, 0x74, 0x4f, 0x5e,
-	0x10, 0x62, 0x0e, 0x42, 0x4e, 0x29, 0x88, 0x13, 0xc4, 0x81, 0xe8, 0x72, 0xe4, 0x62, 0x2b, 0x2e,
-	0x49, 0x2c, 0x29, 0x2d, 0x96, 0x60, 0x51, 0x60, 0xd4, 0xe0, 0x33, 0xd2, 0xd4, 0xc3, 0xe5, 0x6f,
-	0x3d, 0xb8, 0xef, 0x82, 0xc1, 0x1a, 0x82, 0xa0, 0x1a, 0xad, 0x78, 0x3a, 0x16, 0xc8, 0x33, 0xcc,
-	0x58, 0x20, 0xcf, 0xf0, 0x62, 0x81, 0x3c, 0x83, 0xd6, 0x32, 0x46, 0x2e, 0x7e, 0x34, 0x95, 0x42,
-	0x8a, 0x5c, 0xb2, 0xfe, 0x41, 0x2e, 0xae, 0x41, 0xf1, 0x4e, 0xfe, 0xfe, 0xde, 0xf1, 0xc1, 0x21,
-	0x8e, 0x21, 0xa1, 0xc1, 0xf1, 0xa1, 0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae, 0x2e,
-	0x02, 0x0c, 0x42, 0xca, 0x5c, 0xf2, 0x98, 0x4a, 0xa0, 0x94, 0xa3, 0x73, 0x88, 0x67, 0x98, 0xab,
-	0x00, 0xa3, 0x90, 0x2a, 0x97, 0x22, 0x4e, 0x45, 0x41, 0xae, 0xc1, 0xfe, 0x3e, 0x61, 0xae, 0x2e,
-	0x02, 0x4c, 0x42, 0x2a, 0x5c, 0x0a, 0x38, 0x95, 0x05, 0xbb, 0x86, 0x84, 0xf8, 0xb8, 0xba, 0x08,
-	0x30, 0x3b, 0xb9, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4e, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x71, 0x7a, 0xaa, 0x2e, 0x34, 0x38,
-	0x40, 0x6c, 0xfd, 0x0a, 0xa4, 0x24, 0x53, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x7f,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x78, 0x55, 0x09, 0x50, 0x02, 0x00, 0x00,
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x8b, 0xd3, 0x40,
+	0x1c, 0xc6, 0x33, 0xed, 0xee, 0x42, 0x07, 0x59, 0xeb, 0xb8, 0x42, 0x58, 0xd8, 0x4c, 0xb6, 0x2a,
+	0x54, 0xd1, 0x14, 0xd4, 0xd3, 0xde, 0x9a, 0x66, 0x16, 0x8a, 0x8b, 0x91, 0x49, 0xba, 0x07, 0x2f,
+	0x25, 0x6d, 0x86, 0x18, 0x6a, 0x33, 0x21, 0x33, 0x41, 0xf7, 0x1b, 0x78, 0xf4, 0x28, 0x78, 0xe9,
+	0xc9, 0xcf, 0xe2, 0x71, 0x8f, 0x9e, 0x82, 0xa4, 0x17, 0xf1, 0xb8, 0x9f, 0x40, 0x26, 0x09, 0x5b,
+	0x5f, 0xb6, 0x97, 0xe4, 0xff, 0xf2, 0x7b, 0x1e, 0x78, 0x86, 0x3f, 0x3c, 0x12, 0x11, 0x1b, 0xf0,
+	0x2c, 0x64, 0xd9, 0x8c, 0xf3, 0xc5, 0xa6, 0xb2, 0xd2, 0x8c, 0x4b, 0x8e, 0x74, 0x11, 0xb1, 0x84,
+	0xc9, 0xf7, 0x3c, 0x5b, 0x58, 0x22, 0x62, 0xd6, 0xf5, 0xfe, 0xf0, 0x20, 0xe2, 0x11, 0xaf, 0xa0,
+	0x81, 0xaa, 0x6a, 0xbe, 0xf7, 0xa5, 0x05, 0x3b, 0xae, 0x62, 0x6c, 0xce, 0x17, 0xc8, 0x84, 0xed,
+	0x3c, 0x0e, 0x75, 0x60, 0x82, 0x7e, 0xc7, 0xde, 0x2f, 0x0b, 0xdc, 0x9e, 0x8c, 0x9d, 0x5f, 0x05,
+	0x56, 0x53, 0xaa, 0x3e, 0xc8, 0x85, 0x77, 0xd3, 0x20, 0x93, 0xf1, 0x3c, 0x4e, 0x03, 0x19, 0xf3,
+	0x64, 0x3a, 0xe7, 0x79, 0x22, 0xf5, 0x96, 0x09, 0xfa, 0x3b, 0xb6, 0x71, 0x55, 0xe0, 0xc3, 0x8b,
+	0x60, 0xf9, 0xee, 0xa4, 0x77, 0x03, 0xd4, 0xa3, 0xe8, 0xaf, 0xe9, 0x48, 0x0d, 0xd1, 0x0b, 0x08,
+	0x79, 0x18, 0x8a, 0xc6, 0xa7, 0x5d, 0xf9, 0xdc, 0xbb, 0x2a, 0xf0, 0x9d, 0xda, 0x67, 0xb3, 0xeb,
+	0xd1, 0x8e, 0x6a, 0x6a, 0xd5, 0x10, 0xee, 0x09, 0x19, 0xc8, 0x5c, 0xe8, 0x3b, 0x26, 0xe8, 0xef,
+	0x3f, 0x7b, 0x64, 0x6d, 0xcb, 0x6d, 0x5d, 0xa7, 0xf3, 0x2a, 0x01, 0x6d, 0x84, 0xe8, 0x00, 0xee,
+	0x86, 0x2c, 0xe1, 0x4b, 0x7d, 0x57, 0xa5, 0xa5, 0x75, 0x73, 0x72, 0xeb, 0xe3, 0x0a, 0x6b, 0x9f,
+	0x57, 0x58, 0xfb, 0xb9, 0xc2, 0xda, 0xe3, 0xaf, 0x00, 0xde, 0xfe, 0x47, 0x8f, 0x8e, 0xe1, 0x91,
+	0x4b, 0x1d, 0x42, 0xa7, 0xb6, 0xeb, 0xbe, 0x9c, 0x7a, 0xfe, 0xd0, 0x9f, 0x78, 0xd3, 0xc9, 0x2b,
+	0xef, 0x35, 0x19, 0x8d, 0x4f, 0xc7, 0xc4, 0xe9, 0x6a, 0xe8, 0x3e, 0xc4, 0xff, 0x23, 0xcd, 0x6f,
+	0x38, 0xf2, 0xc7, 0xe7, 0xa4, 0x0b, 0xd0, 0x43, 0x78, 0xbc, 0x15, 0xa2, 0xc4, 0x73, 0xcf, 0xce,
+	0x89, 0xd3, 0x6d, 0xa1, 0x07, 0xd0, 0xdc, 0x8a, 0x79, 0xc4, 0xf7, 0xcf, 0x88, 0xd3, 0x6d, 0xdb,
+	0xa7, 0xdf, 0x4a, 0x03, 0x5c, 0x96, 0x06, 0xf8, 0x51, 0x1a, 0xe0, 0xd3, 0xda, 0xd0, 0x2e, 0xd7,
+	0x86, 0xf6, 0x7d, 0x6d, 0x68, 0x6f, 0x9e, 0x44, 0xb1, 0x7c, 0x9b, 0xcf, 0xac, 0x39, 0x5f, 0x0e,
+	0x44, 0xc4, 0x9e, 0x36, 0x8f, 0xa4, 0xea, 0xc1, 0x87, 0x3f, 0x0e, 0x49, 0x5e, 0xa4, 0x4c, 0xcc,
+	0xf6, 0xaa, 0xab, 0x78, 0xfe, 0x7b, 0x00, 0x4d, 0x0c, 0xd2, 0xdb, 0x66, 0x02, 0x00, 0x00,
 }
 
 func (m *OrderBook) Marshal() (dAtA []byte, err error) {
@@ -159,6 +162,13 @@ func (m *OrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Status != 0 {
 		i = encodeVarintOrderbook(dAtA, i, uint64(m.Status))
 		i--
@@ -214,6 +224,10 @@ func (m *OrderBook) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovOrderbook(uint64(m.Status))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
 	return n
 }
 
@@ -341,6 +355,38 @@ func (m *OrderBook) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrderbook(dAtA[iNdEx:])
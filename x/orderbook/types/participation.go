@@ -200,3 +200,22 @@ func (p *OrderBookParticipation) setMaxLoss(
 		}
 	}
 }
+
+// RevertCurrentRound reverts the bet amount of a canceled or cashed out bet from the current
+// round and recalculates the max loss according to the exposures of the current round.
+func (p *OrderBookParticipation) RevertCurrentRound(
+	exposures []ParticipationExposure,
+	betAmount sdkmath.Int,
+) {
+	p.CurrentRoundTotalBetAmount = p.CurrentRoundTotalBetAmount.Sub(betAmount)
+
+	p.CurrentRoundMaxLoss = sdk.ZeroInt()
+	p.CurrentRoundMaxLossOddsUID = ""
+	for i, pe := range exposures {
+		maxLoss := pe.CalculateMaxLoss(p.CurrentRoundTotalBetAmount)
+		if i == 0 || maxLoss.GT(p.CurrentRoundMaxLoss) {
+			p.CurrentRoundMaxLoss = maxLoss
+			p.CurrentRoundMaxLossOddsUID = pe.OddsUID
+		}
+	}
+}
@@ -6,10 +6,12 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/sge-network/sge/x/bet/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -1234,6 +1236,140 @@ func (m *QueryParticipationFulfilledBetsResponse) GetPagination() *query.PageRes
 	return nil
 }
 
+// QueryWagerQuoteRequest is the request type for the Query/WagerQuote RPC
+// method.
+type QueryWagerQuoteRequest struct {
+	// order_book_uid defines the order book uid to query for.
+	OrderBookUid string `protobuf:"bytes,1,opt,name=order_book_uid,json=orderBookUid,proto3" json:"order_book_uid,omitempty"`
+	// odds_uid is the universal unique identifier of the odds to wager on.
+	OddsUid string `protobuf:"bytes,2,opt,name=odds_uid,json=oddsUid,proto3" json:"odds_uid,omitempty"`
+	// odds_type is the type of the odds value.
+	OddsType types.OddsType `protobuf:"varint,3,opt,name=odds_type,json=oddsType,proto3,enum=sgenetwork.sge.bet.OddsType" json:"odds_type,omitempty"`
+	// odds_value is the odds value of the wager.
+	OddsValue string `protobuf:"bytes,4,opt,name=odds_value,json=oddsValue,proto3" json:"odds_value,omitempty"`
+	// amount is the bet amount to be fulfilled excluding the bet fee.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// max_loss_multiplier is the multiplier coefficient of max loss applied to
+	// all of the odds of the order book, one is used if not set.
+	MaxLossMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_loss_multiplier,json=maxLossMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_loss_multiplier"`
+}
+
+func (m *QueryWagerQuoteRequest) Reset()         { *m = QueryWagerQuoteRequest{} }
+func (m *QueryWagerQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWagerQuoteRequest) ProtoMessage()    {}
+func (*QueryWagerQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b016841afa49a45, []int{22}
+}
+func (m *QueryWagerQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWagerQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWagerQuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWagerQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWagerQuoteRequest.Merge(m, src)
+}
+func (m *QueryWagerQuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWagerQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWagerQuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWagerQuoteRequest proto.InternalMessageInfo
+
+func (m *QueryWagerQuoteRequest) GetOrderBookUid() string {
+	if m != nil {
+		return m.OrderBookUid
+	}
+	return ""
+}
+
+func (m *QueryWagerQuoteRequest) GetOddsUid() string {
+	if m != nil {
+		return m.OddsUid
+	}
+	return ""
+}
+
+func (m *QueryWagerQuoteRequest) GetOddsType() types.OddsType {
+	if m != nil {
+		return m.OddsType
+	}
+	return types.OddsType_ODDS_TYPE_UNSPECIFIED
+}
+
+func (m *QueryWagerQuoteRequest) GetOddsValue() string {
+	if m != nil {
+		return m.OddsValue
+	}
+	return ""
+}
+
+// QueryWagerQuoteResponse is the response type for the Query/WagerQuote RPC
+// method.
+type QueryWagerQuoteResponse struct {
+	// max_fillable_amount is the largest bet amount that can be fulfilled by
+	// the order book liquidity, it is not more than the requested amount.
+	MaxFillableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_fillable_amount,json=maxFillableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_fillable_amount"`
+	// bet_fulfillments is the expected fulfillment of the fillable amount by
+	// the order book participations.
+	BetFulfillments []*types.BetFulfillment `protobuf:"bytes,2,rep,name=bet_fulfillments,json=betFulfillments,proto3" json:"bet_fulfillments,omitempty"`
+	// payout_profit is the expected payout profit of the fillable amount.
+	PayoutProfit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=payout_profit,json=payoutProfit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"payout_profit"`
+	// payout is the expected payout of the fillable amount if the bet wins.
+	Payout github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=payout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"payout"`
+}
+
+func (m *QueryWagerQuoteResponse) Reset()         { *m = QueryWagerQuoteResponse{} }
+func (m *QueryWagerQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWagerQuoteResponse) ProtoMessage()    {}
+func (*QueryWagerQuoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b016841afa49a45, []int{23}
+}
+func (m *QueryWagerQuoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWagerQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWagerQuoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWagerQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWagerQuoteResponse.Merge(m, src)
+}
+func (m *QueryWagerQuoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWagerQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWagerQuoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWagerQuoteResponse proto.InternalMessageInfo
+
+func (m *QueryWagerQuoteResponse) GetBetFulfillments() []*types.BetFulfillment {
+	if m != nil {
+		return m.BetFulfillments
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "sgenetwork.sge.orderbook.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "sgenetwork.sge.orderbook.QueryParamsResponse")
@@ -1257,84 +1393,103 @@ func init() {
 	proto.RegisterType((*QueryHistoricalParticipationExposuresResponse)(nil), "sgenetwork.sge.orderbook.QueryHistoricalParticipationExposuresResponse")
 	proto.RegisterType((*QueryParticipationFulfilledBetsRequest)(nil), "sgenetwork.sge.orderbook.QueryParticipationFulfilledBetsRequest")
 	proto.RegisterType((*QueryParticipationFulfilledBetsResponse)(nil), "sgenetwork.sge.orderbook.QueryParticipationFulfilledBetsResponse")
+	proto.RegisterType((*QueryWagerQuoteRequest)(nil), "sgenetwork.sge.orderbook.QueryWagerQuoteRequest")
+	proto.RegisterType((*QueryWagerQuoteResponse)(nil), "sgenetwork.sge.orderbook.QueryWagerQuoteResponse")
 }
 
 func init() { proto.RegisterFile("sge/orderbook/query.proto", fileDescriptor_8b016841afa49a45) }
 
 var fileDescriptor_8b016841afa49a45 = []byte{
-	// 1139 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0xcf, 0xa4, 0x25, 0x90, 0x57, 0x84, 0x94, 0x97, 0x34, 0x9b, 0x58, 0xcd, 0x26, 0x75, 0xab,
-	0xa6, 0xd0, 0xc4, 0x4e, 0x02, 0xea, 0x1f, 0x29, 0x29, 0xb0, 0x6a, 0xd3, 0x16, 0x81, 0x12, 0x82,
-	0x72, 0x81, 0x43, 0xf0, 0xee, 0x4e, 0x5d, 0x2b, 0x9b, 0x1d, 0xd7, 0xf6, 0x42, 0xaa, 0x28, 0x17,
-	0x84, 0xc4, 0x0d, 0x21, 0x7a, 0xe8, 0x01, 0xbe, 0x02, 0xdf, 0x80, 0x1b, 0x48, 0xf4, 0x18, 0xa9,
-	0x07, 0x38, 0x55, 0x28, 0x8b, 0x04, 0x07, 0x2e, 0x7c, 0x01, 0x40, 0x1e, 0x8f, 0x9d, 0xf5, 0xda,
-	0x5e, 0xff, 0xc9, 0x4a, 0xa5, 0xdc, 0x76, 0xf7, 0xf9, 0xbd, 0xf7, 0xfb, 0xf3, 0x66, 0x3c, 0xb3,
-	0x30, 0x69, 0xeb, 0x54, 0x65, 0x56, 0x9d, 0x5a, 0x55, 0xc6, 0xb6, 0xd5, 0xfb, 0x2d, 0x6a, 0x3d,
-	0x50, 0x4c, 0x8b, 0x39, 0x0c, 0x27, 0x6c, 0x9d, 0x36, 0xa9, 0xf3, 0x29, 0xb3, 0xb6, 0x15, 0x5b,
-	0xa7, 0x4a, 0xf0, 0x94, 0xf4, 0x5a, 0x8d, 0xd9, 0x3b, 0xcc, 0x56, 0xab, 0x9a, 0x4d, 0xbd, 0x14,
-	0xf5, 0x93, 0xc5, 0x2a, 0x75, 0xb4, 0x45, 0xd5, 0xd4, 0x74, 0xa3, 0xa9, 0x39, 0x06, 0x6b, 0x7a,
-	0x55, 0xa4, 0x31, 0x9d, 0xe9, 0x8c, 0x7f, 0x54, 0xdd, 0x4f, 0xe2, 0xd7, 0x33, 0x3a, 0x63, 0x7a,
-	0x83, 0xaa, 0x9a, 0x69, 0xa8, 0x5a, 0xb3, 0xc9, 0x1c, 0x9e, 0x62, 0x8b, 0xa8, 0x14, 0x06, 0x65,
-	0x6a, 0x96, 0xb6, 0xe3, 0xc7, 0xa6, 0xc2, 0xb1, 0xe0, 0x93, 0x08, 0x9f, 0x8d, 0xa4, 0x3a, 0x46,
-	0xcd, 0x30, 0x3b, 0x11, 0x9d, 0x09, 0x3f, 0x42, 0x77, 0x4d, 0x66, 0xb7, 0x2c, 0xea, 0x45, 0xe5,
-	0x31, 0xc0, 0xf7, 0x5d, 0x46, 0xeb, 0xbc, 0xe9, 0x06, 0xbd, 0xdf, 0xa2, 0xb6, 0x23, 0x6f, 0xc2,
-	0x68, 0xe8, 0x57, 0xdb, 0x64, 0x4d, 0x9b, 0xe2, 0x75, 0x18, 0xf2, 0xc0, 0x4d, 0x90, 0x19, 0x72,
-	0xf1, 0xd4, 0xd2, 0x8c, 0x92, 0xa4, 0x99, 0xe2, 0x65, 0x56, 0x4e, 0x3e, 0x7e, 0x3a, 0x3d, 0xb0,
-	0x21, 0xb2, 0xe4, 0x5d, 0x18, 0xe7, 0x65, 0xd7, 0xdc, 0xc7, 0x2a, 0x8c, 0x6d, 0xfb, 0x0d, 0x71,
-	0x1c, 0x86, 0x6c, 0x47, 0x73, 0x5a, 0x5e, 0xe5, 0xe1, 0x0d, 0xf1, 0x0d, 0x57, 0x01, 0x8e, 0x24,
-	0x9e, 0x18, 0xe4, 0x5d, 0x2f, 0x28, 0x9e, 0x1f, 0x8a, 0xeb, 0x87, 0xe2, 0x59, 0x28, 0xfc, 0x50,
-	0xd6, 0x35, 0x9d, 0x8a, 0x9a, 0x1b, 0x1d, 0x99, 0xf2, 0x77, 0x04, 0x4a, 0x91, 0xd6, 0x82, 0xd5,
-	0x1d, 0x80, 0x00, 0xb7, 0xdb, 0xff, 0xc4, 0xc5, 0x53, 0x4b, 0xe7, 0x92, 0x99, 0x05, 0x15, 0x04,
-	0xb9, 0x8e, 0x64, 0xbc, 0x15, 0x03, 0x77, 0x36, 0x15, 0xae, 0x87, 0x23, 0x84, 0x77, 0x05, 0x4e,
-	0x87, 0xe1, 0xfa, 0x42, 0x9d, 0x87, 0x57, 0x78, 0xbf, 0x2d, 0xb7, 0xe1, 0x56, 0xcb, 0xa8, 0x0b,
-	0xc1, 0x5e, 0x66, 0xfe, 0x93, 0x9b, 0x46, 0x5d, 0xae, 0x76, 0x0b, 0x1d, 0x90, 0xbd, 0x2d, 0xc8,
-	0xf2, 0x7c, 0x61, 0x63, 0x0e, 0xb2, 0xc3, 0x41, 0x1b, 0xf9, 0x21, 0x81, 0x73, 0xe1, 0x26, 0xeb,
-	0x9d, 0xd3, 0x67, 0xe7, 0x42, 0xdc, 0x37, 0xa3, 0xdb, 0x04, 0xce, 0xf7, 0x46, 0x25, 0x84, 0xb0,
-	0x60, 0xb2, 0x03, 0x56, 0x68, 0xe1, 0xf8, 0x43, 0xb0, 0x90, 0x41, 0x97, 0x50, 0x75, 0x21, 0x52,
-	0x89, 0xc5, 0xf7, 0xee, 0xdf, 0x78, 0xec, 0x81, 0xdc, 0x83, 0x64, 0x3e, 0xe5, 0x55, 0x18, 0x0d,
-	0xb1, 0xdf, 0x32, 0x9a, 0x75, 0xba, 0xcb, 0xd1, 0x9d, 0xdc, 0xc0, 0x50, 0xe8, 0x8e, 0x1b, 0x91,
-	0x1f, 0xf5, 0x36, 0x3e, 0x50, 0xd8, 0x84, 0x89, 0x24, 0x85, 0xc5, 0xe0, 0x15, 0x15, 0x78, 0x3c,
-	0x5e, 0x60, 0xf9, 0x4b, 0x02, 0xe5, 0x30, 0xb2, 0x9b, 0x62, 0xb7, 0x7b, 0x46, 0xd3, 0xf8, 0x84,
-	0xc0, 0x74, 0x22, 0x20, 0x21, 0x93, 0x0e, 0x63, 0x1d, 0x88, 0xfc, 0xed, 0xd9, 0x9f, 0x41, 0x35,
-	0x83, 0x44, 0x6b, 0xf5, 0xba, 0xed, 0xd7, 0x15, 0x0a, 0x21, 0x8b, 0x34, 0xec, 0xdf, 0xf4, 0x7d,
-	0x0c, 0x53, 0xf1, 0xa4, 0xf2, 0x89, 0x3c, 0x09, 0x2f, 0xb1, 0x7a, 0xdd, 0xe6, 0xf1, 0x41, 0x1e,
-	0x7f, 0xd1, 0xfd, 0xee, 0xee, 0x5f, 0x5f, 0x24, 0x1a, 0x19, 0xc8, 0x46, 0x61, 0x34, 0x46, 0x36,
-	0x31, 0x58, 0x05, 0x55, 0x1b, 0x89, 0xa8, 0x26, 0x7f, 0x43, 0xe0, 0x52, 0x8f, 0x61, 0x7f, 0xc6,
-	0xf3, 0xf5, 0x3b, 0x81, 0xb9, 0x6c, 0xe8, 0x84, 0x6a, 0x4d, 0x28, 0x85, 0x17, 0x7b, 0x8e, 0x79,
-	0x8b, 0x2d, 0xed, 0xaf, 0x48, 0x33, 0xb6, 0x6f, 0xff, 0x66, 0xee, 0x07, 0x22, 0xb6, 0xbc, 0x7e,
-	0xc8, 0x9f, 0x77, 0xcb, 0xeb, 0xf2, 0xeb, 0x44, 0x61, 0xbf, 0x9e, 0xfa, 0x5b, 0xe7, 0xff, 0xd5,
-	0xa6, 0x6f, 0xfd, 0x81, 0xbc, 0x6d, 0xd8, 0x0e, 0xb3, 0x8c, 0x9a, 0xd6, 0xf8, 0x2f, 0xad, 0x97,
-	0x3f, 0x08, 0xcc, 0x67, 0x84, 0xf7, 0xbc, 0x3b, 0xf1, 0x13, 0x81, 0x0b, 0xd1, 0x51, 0x5b, 0x6d,
-	0x35, 0xee, 0x1a, 0x8d, 0x06, 0xad, 0x57, 0xa8, 0xf3, 0xbc, 0x2c, 0x9a, 0x9f, 0x09, 0xcc, 0xa6,
-	0x32, 0x11, 0x76, 0xd5, 0x20, 0x8c, 0x64, 0xab, 0x4a, 0x1d, 0xdf, 0x29, 0x25, 0xa3, 0x53, 0x15,
-	0xea, 0xac, 0x6b, 0x86, 0xe5, 0xbf, 0x13, 0xcc, 0xae, 0x58, 0xff, 0x3c, 0x5a, 0x3a, 0x1c, 0x81,
-	0x17, 0x38, 0x33, 0xfc, 0x9c, 0xc0, 0x90, 0x77, 0x65, 0xc2, 0xb9, 0x64, 0x98, 0xd1, 0x9b, 0x9a,
-	0x34, 0x9f, 0xf1, 0x69, 0xaf, 0xbb, 0x3c, 0xf5, 0xd9, 0x93, 0xdf, 0x1e, 0x0e, 0x96, 0xf0, 0xb4,
-	0x1a, 0x77, 0xe7, 0xc4, 0xaf, 0x09, 0xc0, 0xd1, 0x0d, 0x09, 0x17, 0x52, 0x8a, 0x47, 0xee, 0x71,
-	0xd2, 0x62, 0x8e, 0x0c, 0x01, 0x69, 0x9a, 0x43, 0x9a, 0xc4, 0x52, 0x17, 0xa4, 0x3d, 0xef, 0x0a,
-	0xb8, 0x8f, 0x8f, 0x08, 0x0c, 0x07, 0x79, 0xa8, 0x66, 0xed, 0xe0, 0x43, 0x5a, 0xc8, 0x9e, 0x20,
-	0x10, 0xcd, 0x72, 0x44, 0x67, 0x71, 0xba, 0x1b, 0x51, 0x78, 0x95, 0xec, 0xe3, 0x01, 0x81, 0x52,
-	0xc2, 0x3d, 0x03, 0x57, 0xb2, 0xb6, 0x8d, 0xbd, 0x35, 0x49, 0xd7, 0x8b, 0xa6, 0x0b, 0x0e, 0x97,
-	0x39, 0x87, 0x05, 0x54, 0x52, 0x38, 0x84, 0xff, 0x32, 0xb0, 0xb1, 0x4d, 0x60, 0x3c, 0xbe, 0x36,
-	0x2e, 0x17, 0x82, 0xe4, 0x13, 0x5a, 0x29, 0x98, 0x2d, 0xf8, 0xbc, 0xcb, 0xf9, 0xac, 0xe2, 0x8d,
-	0x7c, 0x7c, 0xd4, 0xbd, 0x98, 0x3d, 0x6b, 0x1f, 0xbf, 0x27, 0x80, 0xd1, 0x23, 0x39, 0x5e, 0xcd,
-	0x8a, 0xb1, 0xfb, 0x35, 0x26, 0x5d, 0x2b, 0x90, 0x29, 0x98, 0x2d, 0x72, 0x66, 0x97, 0xf0, 0xd5,
-	0x34, 0x66, 0xc1, 0x9b, 0x07, 0x7f, 0x24, 0x30, 0x12, 0xa9, 0x88, 0x57, 0xf2, 0x62, 0xf0, 0xc1,
-	0x5f, 0xcd, 0x9f, 0x28, 0xb0, 0x2f, 0x73, 0xec, 0x97, 0xf1, 0x8d, 0xcc, 0xd8, 0xd5, 0x3d, 0xff,
-	0xcc, 0xbf, 0x8f, 0x7f, 0x11, 0x98, 0x4e, 0x39, 0xb8, 0xe2, 0xcd, 0x42, 0x63, 0x13, 0xf1, 0x67,
-	0xf5, 0xb8, 0x65, 0x04, 0xe1, 0x37, 0x39, 0xe1, 0x6b, 0x78, 0x25, 0xd7, 0x18, 0xce, 0x1f, 0x59,
-	0xf7, 0x27, 0x81, 0xf1, 0x04, 0xaa, 0xcb, 0xe9, 0x5b, 0x79, 0x0f, 0x86, 0x2b, 0x05, 0xb3, 0x05,
-	0xb1, 0x4d, 0x4e, 0x6c, 0x0d, 0xdf, 0x2b, 0x48, 0x2c, 0x61, 0xa1, 0xfd, 0x43, 0x60, 0x26, 0xed,
-	0xac, 0x85, 0x69, 0xe6, 0x64, 0x3c, 0x4b, 0x4a, 0xb7, 0x8e, 0x5d, 0x47, 0x88, 0xf1, 0x0e, 0x17,
-	0xe3, 0x06, 0x56, 0xd2, 0xc4, 0xb8, 0x17, 0x54, 0x9c, 0x4f, 0x32, 0xfc, 0x6f, 0x02, 0x52, 0xf2,
-	0xc1, 0x05, 0xdf, 0xca, 0x63, 0x5b, 0xdc, 0xe9, 0x4d, 0x7a, 0xfb, 0x18, 0x15, 0x04, 0xdf, 0x8f,
-	0x38, 0xdf, 0x4d, 0xfc, 0xa0, 0x1f, 0x9b, 0xab, 0x7a, 0xd7, 0xef, 0xc1, 0x0f, 0x5f, 0x95, 0xd5,
-	0xc7, 0x87, 0x65, 0x72, 0x70, 0x58, 0x26, 0xbf, 0x1e, 0x96, 0xc9, 0x57, 0xed, 0xf2, 0xc0, 0x41,
-	0xbb, 0x3c, 0xf0, 0x4b, 0xbb, 0x3c, 0xf0, 0xe1, 0x9c, 0x6e, 0x38, 0xf7, 0x5a, 0x55, 0xa5, 0xc6,
-	0x76, 0xdc, 0xc6, 0xf3, 0x82, 0x04, 0x07, 0xb1, 0xdb, 0x01, 0xc3, 0x79, 0x60, 0x52, 0xbb, 0x3a,
-	0xc4, 0xff, 0xb0, 0x7e, 0xfd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x89, 0x3e, 0x08, 0xc3,
-	0x17, 0x00, 0x00,
+	// 1424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x5f, 0x6f, 0x14, 0x55,
+	0x14, 0xef, 0x2d, 0xa5, 0xd2, 0x03, 0xa2, 0xbd, 0x85, 0xee, 0x76, 0x43, 0xb7, 0x30, 0x10, 0x40,
+	0xa1, 0x33, 0x6d, 0x35, 0xfc, 0x49, 0x28, 0xca, 0x06, 0x16, 0x30, 0x10, 0xca, 0x62, 0x35, 0xd1,
+	0xc4, 0x75, 0xb6, 0x7b, 0x3b, 0x4c, 0xba, 0xbb, 0x77, 0x98, 0x7b, 0x17, 0xdb, 0x34, 0x7d, 0x31,
+	0x26, 0xbe, 0x19, 0x22, 0x0f, 0x3c, 0xe8, 0x37, 0x30, 0x7e, 0x03, 0xdf, 0x34, 0x91, 0x47, 0x12,
+	0x1e, 0x34, 0x9a, 0x10, 0x43, 0x35, 0xfa, 0xe0, 0x8b, 0x5f, 0x40, 0xcd, 0xdc, 0xb9, 0x33, 0xbb,
+	0xb3, 0x3b, 0xdb, 0xf9, 0xd3, 0x4d, 0x10, 0x1f, 0x9a, 0xce, 0xcc, 0xbd, 0xe7, 0x9c, 0xdf, 0xef,
+	0x77, 0xce, 0xbd, 0x73, 0xcf, 0x2c, 0x4c, 0x30, 0x83, 0x68, 0xd4, 0xae, 0x12, 0xbb, 0x42, 0xe9,
+	0x8a, 0x76, 0xa7, 0x49, 0xec, 0x35, 0xd5, 0xb2, 0x29, 0xa7, 0x38, 0xcb, 0x0c, 0xd2, 0x20, 0xfc,
+	0x23, 0x6a, 0xaf, 0xa8, 0xcc, 0x20, 0xaa, 0x3f, 0x2b, 0xf7, 0xea, 0x12, 0x65, 0x75, 0xca, 0xb4,
+	0x8a, 0xce, 0x88, 0x6b, 0xa2, 0xdd, 0x9d, 0xad, 0x10, 0xae, 0xcf, 0x6a, 0x96, 0x6e, 0x98, 0x0d,
+	0x9d, 0x9b, 0xb4, 0xe1, 0x7a, 0xc9, 0xed, 0x33, 0xa8, 0x41, 0xc5, 0xa5, 0xe6, 0x5c, 0xc9, 0xa7,
+	0x07, 0x0c, 0x4a, 0x8d, 0x1a, 0xd1, 0x74, 0xcb, 0xd4, 0xf4, 0x46, 0x83, 0x72, 0x61, 0xc2, 0xe4,
+	0x68, 0x2e, 0x08, 0xca, 0xd2, 0x6d, 0xbd, 0xee, 0x8d, 0x4d, 0x06, 0xc7, 0xfc, 0x2b, 0x39, 0x7c,
+	0xa8, 0xcb, 0x94, 0x9b, 0x4b, 0xa6, 0xd5, 0x8e, 0xe8, 0x40, 0x70, 0x0a, 0x59, 0xb5, 0x28, 0x6b,
+	0xda, 0x44, 0x8e, 0x8e, 0x3a, 0xa3, 0x15, 0xc2, 0x9d, 0x3f, 0xf9, 0x28, 0xe3, 0x3d, 0xa2, 0xd5,
+	0x2a, 0x2b, 0xf3, 0x35, 0x4b, 0xce, 0x55, 0xf6, 0x01, 0xbe, 0xe9, 0xb0, 0x5f, 0x10, 0x00, 0x4b,
+	0xe4, 0x4e, 0x93, 0x30, 0xae, 0x2c, 0xc2, 0x58, 0xe0, 0x29, 0xb3, 0x68, 0x83, 0x11, 0x7c, 0x1e,
+	0x86, 0x5d, 0x22, 0x59, 0x74, 0x10, 0x1d, 0xdf, 0x3d, 0x77, 0x50, 0xed, 0xa5, 0xaf, 0xea, 0x5a,
+	0x16, 0x86, 0x1e, 0x3e, 0x99, 0x1a, 0x28, 0x49, 0x2b, 0x65, 0x15, 0xc6, 0x85, 0xdb, 0x1b, 0xce,
+	0xb4, 0x02, 0xa5, 0x2b, 0x5e, 0x40, 0x3c, 0x0e, 0xc3, 0x8c, 0xeb, 0xbc, 0xe9, 0x7a, 0x1e, 0x29,
+	0xc9, 0x3b, 0x5c, 0x04, 0x68, 0xa5, 0x23, 0x3b, 0x28, 0xa2, 0x1e, 0x55, 0xdd, 0xdc, 0xa9, 0x4e,
+	0xee, 0x54, 0x37, 0xdd, 0x32, 0x77, 0xea, 0x82, 0x6e, 0x10, 0xe9, 0xb3, 0xd4, 0x66, 0xa9, 0x7c,
+	0x8d, 0x20, 0xd3, 0x15, 0x5a, 0xb2, 0xba, 0x0a, 0xe0, 0xe3, 0x76, 0xe2, 0xef, 0x38, 0xbe, 0x7b,
+	0xee, 0x70, 0x6f, 0x66, 0xbe, 0x07, 0x49, 0xae, 0xcd, 0x18, 0x5f, 0x0e, 0x81, 0x7b, 0x2c, 0x12,
+	0xae, 0x8b, 0x23, 0x80, 0x77, 0x1e, 0xf6, 0x07, 0xe1, 0x7a, 0x42, 0x1d, 0x81, 0xbd, 0x22, 0x5e,
+	0xd9, 0x09, 0x58, 0x6e, 0x9a, 0x55, 0x29, 0xd8, 0x1e, 0xea, 0xcd, 0x5c, 0x34, 0xab, 0x4a, 0xa5,
+	0x53, 0x68, 0x9f, 0xec, 0x15, 0x80, 0x96, 0xbd, 0x4c, 0x63, 0x02, 0xb2, 0x23, 0x7e, 0x18, 0xe5,
+	0x3e, 0x82, 0xc3, 0xc1, 0x20, 0x0b, 0xed, 0x95, 0xca, 0x12, 0x21, 0xee, 0x5b, 0xa2, 0x37, 0x11,
+	0x1c, 0xd9, 0x1a, 0x95, 0x14, 0xc2, 0x86, 0x89, 0x36, 0x58, 0x81, 0x45, 0xe6, 0x15, 0xc1, 0x4c,
+	0x0c, 0x5d, 0x02, 0xde, 0xa5, 0x48, 0x19, 0x1a, 0x1e, 0xbb, 0x7f, 0xe5, 0xb1, 0x0e, 0xca, 0x16,
+	0x24, 0x93, 0x29, 0xaf, 0xc1, 0x58, 0x80, 0x7d, 0xd9, 0x6c, 0x54, 0xc9, 0xaa, 0x40, 0x37, 0x54,
+	0xc2, 0x81, 0xa1, 0xab, 0xce, 0x88, 0xf2, 0x60, 0xeb, 0xc4, 0xfb, 0x0a, 0x5b, 0x90, 0xed, 0xa5,
+	0xb0, 0x2c, 0xbc, 0xb4, 0x02, 0x8f, 0x87, 0x0b, 0xac, 0x7c, 0x86, 0x20, 0x1f, 0x44, 0x76, 0x49,
+	0xee, 0x8c, 0xcf, 0xa8, 0x1a, 0x1f, 0x23, 0x98, 0xea, 0x09, 0x48, 0xca, 0x64, 0xc0, 0xbe, 0x36,
+	0x44, 0xde, 0x56, 0xee, 0xd5, 0xa0, 0x16, 0x43, 0xa2, 0x1b, 0xd5, 0x2a, 0xf3, 0xfc, 0x4a, 0x85,
+	0x30, 0xed, 0x0a, 0xd8, 0xbf, 0xea, 0xfb, 0x10, 0x26, 0xc3, 0x49, 0x25, 0x13, 0x79, 0x02, 0x76,
+	0x89, 0xb7, 0x91, 0x33, 0x3e, 0x28, 0xc6, 0x5f, 0x70, 0xee, 0x9d, 0xfd, 0xeb, 0xd3, 0x9e, 0x89,
+	0xf4, 0x65, 0x23, 0x30, 0x16, 0x22, 0x9b, 0x2c, 0xac, 0x94, 0xaa, 0x8d, 0x76, 0xa9, 0xa6, 0x7c,
+	0x81, 0xe0, 0xc4, 0x16, 0xc5, 0xfe, 0x8c, 0xeb, 0xeb, 0x77, 0x04, 0x27, 0xe3, 0xa1, 0x93, 0xaa,
+	0x35, 0x20, 0x13, 0x5c, 0xec, 0x09, 0xea, 0x2d, 0xd4, 0xb5, 0xb7, 0x22, 0xad, 0xd0, 0xb8, 0xfd,
+	0xab, 0xb9, 0x6f, 0x91, 0xdc, 0xf2, 0xfa, 0x21, 0x7f, 0xd2, 0x2d, 0xaf, 0x23, 0x5f, 0x3b, 0x52,
+	0xe7, 0xeb, 0x89, 0xb7, 0x75, 0xfe, 0x5f, 0xd3, 0xf4, 0xa5, 0x57, 0x90, 0x57, 0x4c, 0xc6, 0xa9,
+	0x6d, 0x2e, 0xe9, 0xb5, 0xff, 0xd2, 0x7a, 0xf9, 0x03, 0xc1, 0x74, 0x4c, 0x78, 0xcf, 0x7b, 0x26,
+	0xbe, 0x47, 0x70, 0xb4, 0xbb, 0xd4, 0x8a, 0xcd, 0xda, 0xb2, 0x59, 0xab, 0x91, 0x6a, 0x81, 0xf0,
+	0xe7, 0x65, 0xd1, 0xfc, 0x80, 0xe0, 0x58, 0x24, 0x13, 0x99, 0xae, 0x25, 0x08, 0x22, 0x29, 0x57,
+	0x08, 0xf7, 0x32, 0xa5, 0xc6, 0xcc, 0x54, 0x81, 0xf0, 0x05, 0xdd, 0xb4, 0xbd, 0x77, 0x82, 0xd5,
+	0x31, 0xd6, 0xc7, 0x1c, 0xfd, 0x3c, 0x28, 0xcf, 0xe9, 0xef, 0xea, 0x06, 0xb1, 0x6f, 0x36, 0x29,
+	0xef, 0xdb, 0x2b, 0x14, 0x9f, 0x85, 0x11, 0xbf, 0xd7, 0x13, 0xe2, 0xef, 0x9d, 0x3b, 0xd0, 0x29,
+	0x80, 0xd3, 0x1f, 0x3a, 0xaf, 0xc1, 0xb7, 0xd7, 0x2c, 0x52, 0xda, 0x45, 0xe5, 0x15, 0x9e, 0x04,
+	0x10, 0xa6, 0x77, 0xf5, 0x5a, 0x93, 0x64, 0x87, 0x84, 0x5f, 0xe1, 0xec, 0x1d, 0xe7, 0x01, 0x2e,
+	0xc2, 0xb0, 0x5e, 0xa7, 0xcd, 0x06, 0xcf, 0xee, 0x74, 0x86, 0x0a, 0xaa, 0xa3, 0xd3, 0x4f, 0x4f,
+	0xa6, 0x8e, 0x1a, 0x26, 0xbf, 0xdd, 0xac, 0xa8, 0x4b, 0xb4, 0xae, 0xc9, 0xee, 0xda, 0xfd, 0x37,
+	0xcd, 0xaa, 0x2b, 0x9a, 0x83, 0x83, 0xa9, 0x57, 0x1b, 0xbc, 0x24, 0xad, 0xf1, 0x07, 0x30, 0x56,
+	0xd7, 0x57, 0xcb, 0x35, 0xca, 0x58, 0xb9, 0xde, 0xac, 0x71, 0xd3, 0xaa, 0x99, 0xc4, 0xce, 0x0e,
+	0x27, 0x76, 0x7a, 0x91, 0x2c, 0x95, 0x46, 0xeb, 0xfa, 0xea, 0x35, 0xca, 0xd8, 0x75, 0xdf, 0x91,
+	0xf2, 0xdb, 0x20, 0x64, 0xba, 0xd4, 0x95, 0x75, 0x22, 0x63, 0x3b, 0x15, 0xa4, 0x57, 0x6a, 0xa4,
+	0x2c, 0x09, 0xa1, 0x54, 0x84, 0x9c, 0xd8, 0x45, 0xe9, 0xe9, 0x82, 0xcb, 0xed, 0x3a, 0xbc, 0x5c,
+	0x21, 0xbc, 0xbc, 0xec, 0x16, 0x69, 0x9d, 0x34, 0x38, 0xcb, 0x0e, 0x8a, 0x2a, 0x54, 0xc2, 0x92,
+	0x50, 0x20, 0xbc, 0xd8, 0x9a, 0x5a, 0x7a, 0xa9, 0x12, 0xb8, 0x67, 0xf8, 0x16, 0xbc, 0x68, 0xe9,
+	0x6b, 0xb4, 0xc9, 0xcb, 0x96, 0x4d, 0x97, 0x4d, 0x9e, 0xdd, 0x91, 0x0a, 0xe8, 0x1e, 0xd7, 0xc9,
+	0x82, 0xf0, 0xe1, 0xe4, 0xd1, 0xbd, 0xcf, 0x0e, 0xa5, 0xf2, 0x26, 0xad, 0xe7, 0xee, 0x8d, 0xc1,
+	0x4e, 0xa1, 0x33, 0xfe, 0x04, 0xc1, 0xb0, 0xdb, 0xf8, 0xe3, 0x93, 0xbd, 0x17, 0x5b, 0xf7, 0xf7,
+	0x86, 0xdc, 0x74, 0xcc, 0xd9, 0x6e, 0xf6, 0x94, 0xc9, 0x8f, 0x1f, 0xff, 0x7a, 0x7f, 0x30, 0x83,
+	0xf7, 0x6b, 0x61, 0x5f, 0x59, 0xf0, 0xe7, 0x08, 0xa0, 0xd5, 0xe7, 0xe3, 0x99, 0x08, 0xe7, 0x5d,
+	0x5f, 0x23, 0x72, 0xb3, 0x09, 0x2c, 0x24, 0xa4, 0x29, 0x01, 0x69, 0x02, 0x67, 0x3a, 0x20, 0xad,
+	0xbb, 0x1f, 0x32, 0x36, 0xf0, 0x03, 0x04, 0x23, 0xbe, 0x1d, 0xd6, 0xe2, 0x46, 0xf0, 0x20, 0xcd,
+	0xc4, 0x37, 0x90, 0x88, 0x8e, 0x09, 0x44, 0x87, 0xf0, 0x54, 0x27, 0xa2, 0xe0, 0xbe, 0xb2, 0x81,
+	0x1f, 0x21, 0xc8, 0xf4, 0xe8, 0x96, 0xf1, 0x7c, 0xdc, 0xb0, 0xa1, 0xbd, 0x7f, 0xee, 0x7c, 0x5a,
+	0x73, 0xc9, 0xe1, 0x94, 0xe0, 0x30, 0x83, 0xd5, 0x08, 0x0e, 0xc1, 0x8f, 0x64, 0x0c, 0x6f, 0x22,
+	0x18, 0x0f, 0xf7, 0x8d, 0xcf, 0xa5, 0x82, 0xe4, 0x11, 0x9a, 0x4f, 0x69, 0x2d, 0xf9, 0x5c, 0x13,
+	0x7c, 0x8a, 0xf8, 0x62, 0x32, 0x3e, 0xda, 0x7a, 0xc8, 0x9b, 0x77, 0x03, 0x7f, 0x83, 0x00, 0x77,
+	0x37, 0x96, 0xf8, 0x4c, 0x5c, 0x8c, 0x9d, 0x87, 0xb1, 0xdc, 0xd9, 0x14, 0x96, 0x92, 0xd9, 0xac,
+	0x60, 0x76, 0x02, 0xbf, 0x12, 0xc5, 0xcc, 0x3f, 0x3f, 0xe1, 0xef, 0x10, 0x8c, 0x76, 0x79, 0xc4,
+	0xa7, 0x93, 0x62, 0xf0, 0xc0, 0x9f, 0x49, 0x6e, 0x28, 0xb1, 0x9f, 0x13, 0xd8, 0x4f, 0xe1, 0xd7,
+	0x63, 0x63, 0xd7, 0xd6, 0xbd, 0xd7, 0xee, 0x06, 0xfe, 0x0b, 0xc1, 0x54, 0x44, 0xfb, 0x85, 0x2f,
+	0xa5, 0x2a, 0x9b, 0xae, 0xfc, 0x14, 0xb7, 0xeb, 0x46, 0x12, 0x7e, 0x43, 0x10, 0x3e, 0x8b, 0x4f,
+	0x27, 0x2a, 0xc3, 0xe9, 0x56, 0xea, 0xfe, 0x44, 0x30, 0xde, 0x83, 0xea, 0xb9, 0xe8, 0xad, 0x7c,
+	0x0b, 0x86, 0xf3, 0x29, 0xad, 0x25, 0xb1, 0x45, 0x41, 0xec, 0x06, 0xbe, 0x9e, 0x92, 0x58, 0x8f,
+	0x85, 0xf6, 0x0f, 0x82, 0x83, 0x51, 0x1d, 0x03, 0x8e, 0x4a, 0x4e, 0xcc, 0x8e, 0x28, 0x77, 0x79,
+	0xdb, 0x7e, 0xa4, 0x18, 0x6f, 0x09, 0x31, 0x2e, 0xe2, 0x42, 0x94, 0x18, 0xb7, 0x7d, 0x8f, 0xd3,
+	0xbd, 0x12, 0xfe, 0x37, 0x82, 0x5c, 0xef, 0xe3, 0x37, 0x7e, 0x33, 0x49, 0xda, 0xc2, 0x7a, 0x90,
+	0xdc, 0x85, 0x6d, 0x78, 0x90, 0x7c, 0xdf, 0x17, 0x7c, 0x17, 0xf1, 0xad, 0x7e, 0x6c, 0xae, 0xda,
+	0xb2, 0x17, 0x43, 0xb4, 0x10, 0xf8, 0x2b, 0x04, 0xd0, 0x3a, 0x47, 0x46, 0x9e, 0x29, 0xba, 0x0e,
+	0xf4, 0xb9, 0xd9, 0x04, 0x16, 0x92, 0xd0, 0x19, 0x41, 0x68, 0x0e, 0xcf, 0x44, 0x11, 0xba, 0xe3,
+	0x98, 0xb5, 0xed, 0x49, 0x85, 0xe2, 0xc3, 0xa7, 0x79, 0xf4, 0xe8, 0x69, 0x1e, 0xfd, 0xf2, 0x34,
+	0x8f, 0xee, 0x6d, 0xe6, 0x07, 0x1e, 0x6d, 0xe6, 0x07, 0x7e, 0xdc, 0xcc, 0x0f, 0xbc, 0x77, 0xb2,
+	0xed, 0x70, 0xc7, 0x0c, 0x32, 0x2d, 0x11, 0x89, 0x08, 0xab, 0x6d, 0x31, 0xc4, 0x31, 0xaf, 0x32,
+	0x2c, 0x7e, 0x24, 0x7a, 0xed, 0xdf, 0x01, 0x00, 0xfe, 0xe1, 0x66, 0x7a, 0x63, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1378,6 +1533,9 @@ type QueryClient interface {
 	// ParticipationFulfilledBets queries fulfilled bets for given order book
 	// participation.
 	ParticipationFulfilledBets(ctx context.Context, in *QueryParticipationFulfilledBetsRequest, opts ...grpc.CallOption) (*QueryParticipationFulfilledBetsResponse, error)
+	// WagerQuote simulates the fulfillment of a bet by the order book without
+	// writing the state.
+	WagerQuote(ctx context.Context, in *QueryWagerQuoteRequest, opts ...grpc.CallOption) (*QueryWagerQuoteResponse, error)
 }
 
 type queryClient struct {
@@ -1487,6 +1645,15 @@ func (c *queryClient) ParticipationFulfilledBets(ctx context.Context, in *QueryP
 	return out, nil
 }
 
+func (c *queryClient) WagerQuote(ctx context.Context, in *QueryWagerQuoteRequest, opts ...grpc.CallOption) (*QueryWagerQuoteResponse, error) {
+	out := new(QueryWagerQuoteResponse)
+	err := c.cc.Invoke(ctx, "/sgenetwork.sge.orderbook.Query/WagerQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1518,6 +1685,9 @@ type QueryServer interface {
 	// ParticipationFulfilledBets queries fulfilled bets for given order book
 	// participation.
 	ParticipationFulfilledBets(context.Context, *QueryParticipationFulfilledBetsRequest) (*QueryParticipationFulfilledBetsResponse, error)
+	// WagerQuote simulates the fulfillment of a bet by the order book without
+	// writing the state.
+	WagerQuote(context.Context, *QueryWagerQuoteRequest) (*QueryWagerQuoteResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1557,6 +1727,9 @@ func (*UnimplementedQueryServer) HistoricalParticipationExposures(ctx context.Co
 func (*UnimplementedQueryServer) ParticipationFulfilledBets(ctx context.Context, req *QueryParticipationFulfilledBetsRequest) (*QueryParticipationFulfilledBetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ParticipationFulfilledBets not implemented")
 }
+func (*UnimplementedQueryServer) WagerQuote(ctx context.Context, req *QueryWagerQuoteRequest) (*QueryWagerQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WagerQuote not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1760,6 +1933,24 @@ func _Query_ParticipationFulfilledBets_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_WagerQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWagerQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WagerQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sgenetwork.sge.orderbook.Query/WagerQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WagerQuote(ctx, req.(*QueryWagerQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sgenetwork.sge.orderbook.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1808,6 +1999,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ParticipationFulfilledBets",
 			Handler:    _Query_ParticipationFulfilledBets_Handler,
 		},
+		{
+			MethodName: "WagerQuote",
+			Handler:    _Query_WagerQuote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sge/orderbook/query.proto",
@@ -2717,6 +2912,142 @@ func (m *QueryParticipationFulfilledBetsResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryWagerQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWagerQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWagerQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxLossMultiplier.Size()
+		i -= size
+		if _, err := m.MaxLossMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.OddsValue) > 0 {
+		i -= len(m.OddsValue)
+		copy(dAtA[i:], m.OddsValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OddsValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OddsType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OddsType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OddsUid) > 0 {
+		i -= len(m.OddsUid)
+		copy(dAtA[i:], m.OddsUid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OddsUid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderBookUid) > 0 {
+		i -= len(m.OrderBookUid)
+		copy(dAtA[i:], m.OrderBookUid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderBookUid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWagerQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWagerQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWagerQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Payout.Size()
+		i -= size
+		if _, err := m.Payout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PayoutProfit.Size()
+		i -= size
+		if _, err := m.PayoutProfit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BetFulfillments) > 0 {
+		for iNdEx := len(m.BetFulfillments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BetFulfillments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.MaxFillableAmount.Size()
+		i -= size
+		if _, err := m.MaxFillableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3085,6 +3416,55 @@ func (m *QueryParticipationFulfilledBetsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryWagerQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderBookUid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OddsUid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OddsType != 0 {
+		n += 1 + sovQuery(uint64(m.OddsType))
+	}
+	l = len(m.OddsValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxLossMultiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWagerQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxFillableAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.BetFulfillments) > 0 {
+		for _, e := range m.BetFulfillments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.PayoutProfit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Payout.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5474,6 +5854,425 @@ func (m *QueryParticipationFulfilledBetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryWagerQuoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWagerQuoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWagerQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookUid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsUid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsUid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsType", wireType)
+			}
+			m.OddsType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OddsType |= types.OddsType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OddsValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OddsValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLossMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLossMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWagerQuoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWagerQuoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWagerQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFillableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFillableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BetFulfillments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BetFulfillments = append(m.BetFulfillments, &types.BetFulfillment{})
+			if err := m.BetFulfillments[len(m.BetFulfillments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutProfit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PayoutProfit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -113,3 +113,48 @@ func (k Keeper) verifyTicketWithKeyUnmarshal(
 
 	return nil
 }
+
+// VerifyTicketUnmarshalBySigner verifies the ticket by any of the registered public keys, then if
+// the token was verified, it unmarshal the data of ticket into clm and returns the public key of
+// the signer of the ticket.
+func (k Keeper) VerifyTicketUnmarshalBySigner(goCtx context.Context, ticketStr string, clm interface{}) (string, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// construct new ticket object from string ticket
+	ticket, err := types.NewJwtTicket(ticketStr)
+	if err != nil {
+		return "", err
+	}
+
+	// check the expiration of ticket
+	err = ticket.ValidateExpiry(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// get key vault from module state
+	keyVault, found := k.GetKeyVault(ctx)
+	if !found {
+		return "", types.ErrNoPublicKeysFound
+	}
+
+	signer := ""
+	for _, pubKey := range keyVault.PublicKeys {
+		if err := ticket.Verify(pubKey); err == nil {
+			signer = pubKey
+			break
+		}
+	}
+
+	if signer == "" {
+		return "", types.ErrInvalidSignature
+	}
+
+	// unmarshal ticket
+	err = ticket.Unmarshal(clm)
+	if err != nil {
+		return "", err
+	}
+
+	return signer, nil
+}
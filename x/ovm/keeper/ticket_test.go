@@ -149,3 +149,51 @@ func TestVerifyTicketUnmarshal(t *testing.T) {
 	})
 	_, _, _ = msgk, wctx, creator
 }
+
+func TestVerifyTicketUnmarshalBySigner(t *testing.T) {
+	k, _, ctx, wctx := setupMsgServerAndKeeper(t)
+
+	keyVault, found := k.GetKeyVault(ctx)
+	require.True(t, found)
+
+	var clm struct {
+		jwt.RegisteredClaims
+		Title string
+	}
+
+	signTicket := func(privateKey ed25519.PrivateKey) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, struct {
+			jwt.RegisteredClaims
+			Title string
+		}{
+			Title: "Test",
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+			},
+		})
+		tkn, err := token.SignedString(privateKey)
+		require.Nil(t, err)
+		return tkn
+	}
+
+	t.Run("signed by non-leader key", func(t *testing.T) {
+		signer, err := k.VerifyTicketUnmarshalBySigner(wctx, signTicket(simappUtil.TestOVMPrivateKeys[1]), &clm)
+		require.NoError(t, err)
+		require.Equal(t, keyVault.PublicKeys[1], signer)
+		require.Equal(t, "Test", clm.Title)
+	})
+
+	t.Run("signed by unregistered key", func(t *testing.T) {
+		_, unregisteredKey, err := ed25519.GenerateKey(rand.Reader)
+		require.Nil(t, err)
+
+		_, err = k.VerifyTicketUnmarshalBySigner(wctx, signTicket(unregisteredKey), &clm)
+		require.ErrorIs(t, err, types.ErrInvalidSignature)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := k.VerifyTicketUnmarshalBySigner(wctx, "invalid.Token", &clm)
+		require.Error(t, err)
+	})
+}